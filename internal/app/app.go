@@ -2,13 +2,22 @@ package app
 
 import (
 	"avito_pvz/internal/config"
+	"avito_pvz/internal/hasher"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/notifier"
+	"avito_pvz/internal/oidc"
+	"avito_pvz/internal/ratelimit"
 	"avito_pvz/internal/repository"
 	"avito_pvz/internal/service"
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
 	"log/slog"
 
 	grpcapp "avito_pvz/internal/app/grpc"
 	httpapp "avito_pvz/internal/app/http"
+	pvzgrpc "avito_pvz/internal/grpc/pvz"
 
 	httpserver "avito_pvz/internal/http"
 
@@ -20,6 +29,9 @@ import (
 type App struct {
 	grpcServer *grpcapp.App
 	httpServer *httpapp.App
+	// autoClose nil, если автозакрытие приемок выключено.
+	autoClose     *service.ReceptionAutoClose
+	passwordReset *service.PasswordReset
 }
 
 func New(ctx context.Context, cfg config.Config, log *slog.Logger) *App {
@@ -29,32 +41,301 @@ func New(ctx context.Context, cfg config.Config, log *slog.Logger) *App {
 	productRepo := repository.NewProduct(pgrepo.NewPgProduct(db))
 	pvzRepo := repository.NewPVZ(pgrepo.NewPgPvz(db))
 	receptionRepo := repository.NewReception(pgrepo.NewPgReception(db))
+	sessionRepo := repository.NewSession(pgrepo.NewPgSession(db))
+	passwordResetRepo := repository.NewPasswordReset(pgrepo.NewPgPasswordReset(db))
+	loginAttemptRepo := repository.NewLoginAttempt(pgrepo.NewPgLoginAttempt(db))
+	apiKeyRepo := repository.NewAPIKey(pgrepo.NewPgAPIKey(db))
+	auditRepo := repository.NewAudit(pgrepo.NewPgAudit(db))
+	cityRepo := repository.NewCity(pgrepo.NewPgCity(db))
+	productTypeRepo := repository.NewProductType(pgrepo.NewPgProductType(db))
+	asnRepo := repository.NewASN(pgrepo.NewPgASN(db))
 
-	productService := service.NewProduct(productRepo, receptionRepo, pvzRepo)
-	pvzService := service.NewPVZServce(pvzRepo)
-	receptionService := service.NewReceptionService(receptionRepo, pvzRepo)
-	jwtService := service.NewJWTManager(cfg.JWT.SecretKey, cfg.JWT.Expire)
-	userService := service.NewUserService(userRepo, jwtService)
+	auditService := service.NewAuditService(auditRepo, log)
+
+	productTypeService := service.NewProductTypeService(
+		productTypeRepo,
+		auditService,
+		cfg.ProductTypes.CacheTTL,
+	)
+	productService := service.NewProduct(
+		productRepo,
+		receptionRepo,
+		pvzRepo,
+		productTypeService,
+		userRepo,
+		auditService,
+	)
+	cityService := service.NewCityService(cityRepo, auditService, cfg.Cities.CacheTTL)
+	pvzService := service.NewPVZServce(pvzRepo, cityService, auditService)
+	receptionService := service.NewReceptionService(
+		receptionRepo,
+		pvzRepo,
+		userRepo,
+		auditService,
+		asnRepo,
+		productRepo,
+		cfg.Receptions.CorrectionWindow,
+	)
+	asnService := service.NewASNService(
+		asnRepo,
+		pvzRepo,
+		receptionRepo,
+		userRepo,
+		productTypeService,
+		auditService,
+	)
+	staffService := service.NewStaffService(userRepo, pvzRepo, auditService)
+
+	var autoClose *service.ReceptionAutoClose
+	if cfg.Receptions.AutoClose.Enabled {
+		autoClose = service.NewReceptionAutoClose(
+			receptionRepo,
+			log,
+			cfg.Receptions.AutoClose.IdleTimeout,
+			cfg.Receptions.AutoClose.Interval,
+		)
+	}
+
+	keyRing, err := loadKeyRing(cfg, log)
+	if err != nil {
+		panic("cannot load jwt keys: " + err.Error())
+	}
+
+	jwtService := service.NewJWTManager(keyRing, cfg.JWT.Expire)
+	sessionService := service.NewSessionService(
+		sessionRepo,
+		userRepo,
+		jwtService,
+		cfg.JWT.RefreshExpire,
+	)
+	lockout := service.NewLockout(
+		loginAttemptRepo,
+		domain.LockoutPolicy(cfg.Lockout.Account),
+		domain.LockoutPolicy(cfg.Lockout.IP),
+		log,
+	)
+
+	denylist, err := service.LoadPasswordDenylist(cfg.PasswordPolicy.DenylistPath)
+	if err != nil {
+		panic("cannot load password denylist: " + err.Error())
+	}
+
+	passwordPolicy := domain.PasswordPolicy{
+		MinLength:      cfg.PasswordPolicy.MinLength,
+		RequireUpper:   cfg.PasswordPolicy.RequireUpper,
+		RequireLower:   cfg.PasswordPolicy.RequireLower,
+		RequireDigit:   cfg.PasswordPolicy.RequireDigit,
+		RequireSpecial: cfg.PasswordPolicy.RequireSpecial,
+		Denylist:       denylist,
+	}
+	passwordHasher := newPasswordHasher(cfg.PasswordHash)
+
+	userService := service.NewUserService(
+		userRepo,
+		sessionService,
+		lockout,
+		passwordHasher,
+		passwordPolicy,
+		auditService,
+	)
+	notify, err := newNotifier(cfg.Notifier, cfg.IsProd(), log)
+	if err != nil {
+		panic("cannot create notifier: " + err.Error())
+	}
+
+	passwordResetService := service.NewPasswordResetService(
+		passwordResetRepo,
+		userRepo,
+		sessionService,
+		notify,
+		auditService,
+		passwordHasher,
+		passwordPolicy,
+		cfg.PasswordReset.TokenTTL,
+		cfg.PasswordReset.ResetURL,
+		log,
+	)
+
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, auditService)
+	authenticator := service.NewAuthenticator(
+		sessionService,
+		apiKeyService,
+		cfg.DummyLoginEnabled(),
+		newExternalAuthenticators(cfg.OIDC, userRepo, auditService)...,
+	)
 
 	hndler := httpserver.NewServer(
+		jwtService,
 		jwtService,
 		userService,
+		sessionService,
 		pvzService,
 		receptionService,
 		productService,
+		staffService,
+		passwordResetService,
+		apiKeyService,
+		auditService,
+		cityService,
+		productTypeService,
+		asnService,
+		cfg.DummyLoginEnabled(),
 	)
 
-	httpPvz := httpapp.NewApp(hndler, log)
+	limiter := ratelimit.New(newRateLimitPolicy(cfg.RateLimit))
+
+	proxies, err := domain.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		panic("cannot parse trusted proxies: " + err.Error())
+	}
+
+	httpPvz := httpapp.NewApp(hndler, authenticator, limiter, proxies, log)
 
-	grpcPVZ := grpcapp.New(log, pvzService, cfg.GRPC.Port)
+	grpcPVZ := grpcapp.New(
+		log,
+		pvzService,
+		authenticator,
+		pvzgrpc.AccessPolicy,
+		limiter,
+		proxies,
+		cfg.GRPC.Port,
+	)
 
 	return &App{
-		grpcServer: grpcPVZ,
-		httpServer: httpPvz,
+		grpcServer:    grpcPVZ,
+		httpServer:    httpPvz,
+		autoClose:     autoClose,
+		passwordReset: passwordResetService,
 	}
 }
 
+// newNotifier выбирает канал доставки. Каналы log и file пишут токены
+// восстановления пароля открытым текстом, поэтому в prod они запрещены.
+func newNotifier(cfg config.Notifier, prod bool, log *slog.Logger) (service.Notifier, error) {
+	if prod && cfg.Type != "smtp" {
+		return nil, fmt.Errorf("notifier %q is for development only, use smtp", cfg.Type)
+	}
+
+	switch cfg.Type {
+	case "smtp":
+		return notifier.NewSMTPNotifier(
+			cfg.SMTP.Host,
+			cfg.SMTP.Port,
+			cfg.SMTP.Username,
+			cfg.SMTP.Password,
+			cfg.SMTP.From,
+		), nil
+	case "file":
+		return notifier.NewFileNotifier(cfg.FilePath), nil
+	default:
+		return notifier.NewLogNotifier(log), nil
+	}
+}
+
+// loadKeyRing читает ключи подписи из файлов. Вне prod при отсутствии
+// файла создается временный ключ: выданные им токены не переживут
+// перезапуск.
+func loadKeyRing(cfg config.Config, log *slog.Logger) (*service.KeyRing, error) {
+	keyRing, err := service.LoadKeyRing(cfg.JWT.SigningKeyID, cfg.JWT.KeyPaths())
+	if errors.Is(err, fs.ErrNotExist) && !cfg.IsProd() {
+		log.Warn("jwt signing key not found, using a temporary key",
+			slog.String("kid", cfg.JWT.SigningKeyID),
+		)
+
+		return service.GenerateKeyRing(cfg.JWT.SigningKeyID)
+	}
+
+	return keyRing, err
+}
+
+// newPasswordHasher хеширует выбранным алгоритмом, а второй оставляет
+// для проверки старых хешей до их замены при входе.
+func newPasswordHasher(cfg config.PasswordHash) service.PasswordHasher {
+	argon := hasher.NewArgon2id(hasher.Argon2Params(cfg.Argon2))
+	bcrypt := hasher.NewBcrypt(cfg.BcryptCost)
+
+	if cfg.Algorithm == "bcrypt" {
+		return hasher.New(bcrypt, argon)
+	}
+
+	return hasher.New(argon, bcrypt)
+}
+
+// newExternalAuthenticators подключает внешнего провайдера OIDC, если он включен.
+func newExternalAuthenticators(
+	cfg config.OIDC,
+	users service.ExternalUserProvider,
+	audit service.AuditRecorder,
+) []service.ExternalAuthenticator {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	roles := domain.RoleMapping{
+		Claim: cfg.RoleClaim,
+		Roles: make(map[string]domain.Role, len(cfg.Roles)),
+	}
+
+	for value, role := range cfg.Roles {
+		roles.Roles[value] = domain.Role(role)
+	}
+
+	provider, err := oidc.NewProvider(cfg.Issuer, cfg.Audience, nil)
+	if err != nil {
+		panic("cannot create oidc provider: " + err.Error())
+	}
+
+	return []service.ExternalAuthenticator{
+		service.NewOIDCService(provider, users, roles, audit),
+	}
+}
+
+func newRateLimitPolicy(cfg config.RateLimit) domain.RateLimitPolicy {
+	policy := domain.RateLimitPolicy{
+		PerIP:      domain.RateLimit(cfg.PerIP),
+		Default:    newRateLimitRule(cfg.Default),
+		Operations: make(map[string]domain.RateLimitRule, len(cfg.Operations)),
+	}
+
+	for operation, rule := range cfg.Operations {
+		policy.Operations[operation] = newRateLimitRule(rule)
+	}
+
+	return policy
+}
+
+func newRateLimitRule(cfg config.RateLimitRule) domain.RateLimitRule {
+	rule := domain.RateLimitRule{
+		RateLimit: domain.RateLimit{Rate: cfg.Rate, Burst: cfg.Burst},
+		Roles:     make(map[domain.Role]domain.RateLimit, len(cfg.Roles)),
+	}
+
+	for role, limit := range cfg.Roles {
+		rule.Roles[domain.Role(role)] = domain.RateLimit(limit)
+	}
+
+	return rule
+}
+
 func (a App) Run() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		if a.autoClose != nil {
+			a.autoClose.Run(ctx)
+		}
+	}()
+
 	go a.grpcServer.MustRun()
 	a.httpServer.Run()
+
+	// httpServer.Run возвращается после сигнала остановки. Отмена ctx
+	// откатывает незавершенный проход целиком, дожидаемся его выхода.
+	cancel()
+	<-done
+
+	a.passwordReset.Wait()
 }
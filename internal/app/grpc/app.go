@@ -7,7 +7,9 @@ import (
 	"net"
 
 	pvzgrpc "avito_pvz/internal/grpc/pvz"
+	"avito_pvz/internal/models/domain"
 
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"google.golang.org/grpc"
@@ -24,6 +26,10 @@ type App struct {
 func New(
 	log *slog.Logger,
 	pvzService pvzgrpc.PVZ,
+	authenticator Authenticator,
+	policy domain.AccessPolicy,
+	limiter RateLimiter,
+	proxies domain.TrustedProxies,
 	port int,
 ) *App {
 	loggingOpts := []logging.Option{
@@ -42,10 +48,24 @@ func New(
 		}),
 	}
 
-	gRPCServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
-		recovery.UnaryServerInterceptor(recoveryOpts...),
-		logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...),
-	))
+	authFunc := AuthFunc(authenticator, policy)
+
+	gRPCServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			recovery.UnaryServerInterceptor(recoveryOpts...),
+			logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...),
+			IPRateLimitUnaryInterceptor(limiter, proxies),
+			auth.UnaryServerInterceptor(authFunc),
+			RateLimitUnaryInterceptor(limiter, proxies),
+		),
+		grpc.ChainStreamInterceptor(
+			recovery.StreamServerInterceptor(recoveryOpts...),
+			logging.StreamServerInterceptor(InterceptorLogger(log), loggingOpts...),
+			IPRateLimitStreamInterceptor(limiter, proxies),
+			auth.StreamServerInterceptor(authFunc),
+			RateLimitStreamInterceptor(limiter, proxies),
+		),
+	)
 
 	pvzgrpc.Register(gRPCServer, pvzService)
 
@@ -0,0 +1,60 @@
+package grpcapp
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator validates access tokens and resolves the caller identity.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*domain.Identity, error)
+}
+
+// AuthFunc authenticates the bearer token from the request metadata
+// and checks the called method against the access policy.
+// The same policy rules are used by the HTTP server, so failures map to
+// codes.Unauthenticated (401) and codes.PermissionDenied (403).
+func AuthFunc(authenticator Authenticator, policy domain.AccessPolicy) auth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		method, _ := grpc.Method(ctx)
+
+		identity := identityFromMD(ctx, authenticator)
+
+		err := policy.Check(method, identity)
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		if err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		if identity != nil {
+			ctx = domain.WithIdentity(ctx, *identity)
+		}
+
+		return ctx, nil
+	}
+}
+
+// identityFromMD returns nil when the token is missing or invalid,
+// leaving the decision to the access policy.
+func identityFromMD(ctx context.Context, authenticator Authenticator) *domain.Identity {
+	token, err := auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return nil
+	}
+
+	identity, err := authenticator.Authenticate(ctx, token)
+	if err != nil {
+		return nil
+	}
+
+	return identity
+}
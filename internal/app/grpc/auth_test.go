@@ -0,0 +1,128 @@
+package grpcapp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	grpcapp "avito_pvz/internal/app/grpc"
+	"avito_pvz/internal/models/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// methodStream provides the called method name to grpc.Method.
+type methodStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s methodStream) Method() string {
+	return s.method
+}
+
+func TestAuthFunc(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	policy := domain.AccessPolicy{
+		"/pvz.v1.PVZService/GetPVZList": {Public: true},
+		"/pvz.v1.PVZService/CreatePVZ": {
+			Roles: []domain.Role{domain.RoleModerator},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		token        string
+		setupMocks   func(v *grpcapp.MockAuthenticator)
+		wantCode     codes.Code
+		wantIdentity *domain.Identity
+	}{
+		{
+			name:     "public_method_without_token",
+			method:   "/pvz.v1.PVZService/GetPVZList",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "protected_method_without_token",
+			method:   "/pvz.v1.PVZService/CreatePVZ",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:   "protected_method_invalid_token",
+			method: "/pvz.v1.PVZService/CreatePVZ",
+			token:  "bad",
+			setupMocks: func(v *grpcapp.MockAuthenticator) {
+				v.On("Authenticate", mock.Anything, "bad").Return(nil, errors.New("bad token"))
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:   "protected_method_wrong_role",
+			method: "/pvz.v1.PVZService/CreatePVZ",
+			token:  "token",
+			setupMocks: func(v *grpcapp.MockAuthenticator) {
+				v.On("Authenticate", mock.Anything, "token").
+					Return(&domain.Identity{UserID: userID, Role: domain.RoleEmploye}, nil)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "protected_method_allowed",
+			method: "/pvz.v1.PVZService/CreatePVZ",
+			token:  "token",
+			setupMocks: func(v *grpcapp.MockAuthenticator) {
+				v.On("Authenticate", mock.Anything, "token").
+					Return(&domain.Identity{UserID: userID, Role: domain.RoleModerator}, nil)
+			},
+			wantCode:     codes.OK,
+			wantIdentity: &domain.Identity{UserID: userID, Role: domain.RoleModerator},
+		},
+		{
+			name:     "unknown_method",
+			method:   "/pvz.v1.PVZService/DropAll",
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authenticator := grpcapp.NewMockAuthenticator(t)
+			if tt.setupMocks != nil {
+				tt.setupMocks(authenticator)
+			}
+
+			ctx := grpc.NewContextWithServerTransportStream(
+				context.Background(),
+				methodStream{method: tt.method},
+			)
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(
+					ctx,
+					metadata.Pairs("authorization", "Bearer "+tt.token),
+				)
+			}
+
+			gotCtx, err := grpcapp.AuthFunc(authenticator, policy)(ctx)
+			require.Equal(t, tt.wantCode, status.Code(err))
+
+			if err != nil {
+				return
+			}
+
+			identity, _ := domain.IdentityFromCtx(gotCtx)
+			require.Equal(t, tt.wantIdentity, identity)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package grpcapp
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/ratelimit"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimiter decides whether the caller may invoke the method now.
+// AllowIP applies the per-address limit shared by all methods.
+type RateLimiter interface {
+	Allow(operation string, identity *domain.Identity, ip string) (time.Duration, bool)
+	AllowIP(ip string) (time.Duration, bool)
+}
+
+// IPRateLimitUnaryInterceptor throttles every call by client IP. It must run
+// before authentication so calls with missing or forged tokens are limited
+// too.
+func IPRateLimitUnaryInterceptor(
+	limiter RateLimiter,
+	proxies domain.TrustedProxies,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		wait, ok := limiter.AllowIP(clientIP(ctx, proxies))
+		if err := throttle(wait, ok, func(md metadata.MD) error {
+			return grpc.SetHeader(ctx, md)
+		}); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// IPRateLimitStreamInterceptor is the streaming counterpart of
+// IPRateLimitUnaryInterceptor.
+func IPRateLimitStreamInterceptor(
+	limiter RateLimiter,
+	proxies domain.TrustedProxies,
+) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		wait, ok := limiter.AllowIP(clientIP(ss.Context(), proxies))
+		if err := throttle(wait, ok, ss.SetHeader); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// RateLimitUnaryInterceptor throttles callers by user, or by client IP for
+// anonymous calls. It must run after authentication so the identity is
+// available. Throttled calls fail with codes.ResourceExhausted and carry
+// the retry-after header.
+func RateLimitUnaryInterceptor(
+	limiter RateLimiter,
+	proxies domain.TrustedProxies,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := allow(ctx, limiter, proxies, info.FullMethod, func(md metadata.MD) error {
+			return grpc.SetHeader(ctx, md)
+		}); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamInterceptor is the streaming counterpart of
+// RateLimitUnaryInterceptor; the limit is checked once per stream.
+func RateLimitStreamInterceptor(
+	limiter RateLimiter,
+	proxies domain.TrustedProxies,
+) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := allow(ss.Context(), limiter, proxies, info.FullMethod, ss.SetHeader); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func allow(
+	ctx context.Context,
+	limiter RateLimiter,
+	proxies domain.TrustedProxies,
+	method string,
+	setHeader func(metadata.MD) error,
+) error {
+	identity, _ := domain.IdentityFromCtx(ctx)
+
+	wait, ok := limiter.Allow(method, identity, clientIP(ctx, proxies))
+
+	return throttle(wait, ok, setHeader)
+}
+
+func throttle(wait time.Duration, ok bool, setHeader func(metadata.MD) error) error {
+	if ok {
+		return nil
+	}
+
+	_ = setHeader(metadata.Pairs("retry-after", strconv.Itoa(ratelimit.RetryAfter(wait))))
+
+	return status.Error(codes.ResourceExhausted, domain.ErrRateLimited.Error())
+}
+
+// clientIP returns the peer address, or the address forwarded by a trusted
+// proxy in the x-forwarded-for metadata.
+func clientIP(ctx context.Context, proxies domain.TrustedProxies) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	return proxies.ClientIP(peerIP(ctx), md.Get("x-forwarded-for"))
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	ip, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return ip
+}
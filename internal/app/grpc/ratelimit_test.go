@@ -0,0 +1,187 @@
+package grpcapp_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	grpcapp "avito_pvz/internal/app/grpc"
+	"avito_pvz/internal/models/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// headerStream records headers set by the interceptor.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+
+	return nil
+}
+
+func TestRateLimitUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const method = "/pvz.v1.PVZService/GetPVZList"
+
+	identity := domain.Identity{UserID: uuid.New(), Role: domain.RoleEmploye}
+
+	tests := []struct {
+		name       string
+		identity   *domain.Identity
+		setupMocks func(l *grpcapp.MockRateLimiter)
+		wantCode   codes.Code
+		wantRetry  []string
+	}{
+		{
+			name: "anonymous_keyed_by_peer_ip",
+			setupMocks: func(l *grpcapp.MockRateLimiter) {
+				l.On("Allow", method, (*domain.Identity)(nil), "10.0.0.7").
+					Return(time.Duration(0), true)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "authenticated_throttled",
+			identity: &identity,
+			setupMocks: func(l *grpcapp.MockRateLimiter) {
+				l.On("Allow", method, &identity, "10.0.0.7").
+					Return(1500*time.Millisecond, false)
+			},
+			wantCode:  codes.ResourceExhausted,
+			wantRetry: []string{"2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := grpcapp.NewMockRateLimiter(t)
+			tt.setupMocks(limiter)
+
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			ctx = peer.NewContext(ctx, &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 51000},
+			})
+
+			if tt.identity != nil {
+				ctx = domain.WithIdentity(ctx, *tt.identity)
+			}
+
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+
+				return "ok", nil
+			}
+
+			_, err := grpcapp.RateLimitUnaryInterceptor(limiter, nil)(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: method},
+				handler,
+			)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.wantCode == codes.OK, called)
+			require.Equal(t, tt.wantRetry, stream.header.Get("retry-after"))
+		})
+	}
+}
+
+func TestRateLimitUnaryInterceptor_NoPeer(t *testing.T) {
+	t.Parallel()
+
+	limiter := grpcapp.NewMockRateLimiter(t)
+	limiter.On("Allow", mock.Anything, mock.Anything, "").Return(time.Duration(0), true)
+
+	_, err := grpcapp.RateLimitUnaryInterceptor(limiter, nil)(
+		context.Background(),
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: "/pvz.v1.PVZService/GetPVZList"},
+		func(ctx context.Context, req any) (any, error) { return nil, nil },
+	)
+	require.NoError(t, err)
+}
+
+func TestIPRateLimitUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	proxies, err := domain.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		peer       string
+		forwarded  string
+		setupMocks func(l *grpcapp.MockRateLimiter)
+		wantCode   codes.Code
+		wantRetry  []string
+	}{
+		{
+			name:      "forwarded_by_trusted_proxy",
+			peer:      "10.0.0.7",
+			forwarded: "203.0.113.9",
+			setupMocks: func(l *grpcapp.MockRateLimiter) {
+				l.On("AllowIP", "203.0.113.9").Return(time.Duration(0), true)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "forwarded_by_untrusted_peer_ignored",
+			peer:      "198.51.100.4",
+			forwarded: "203.0.113.9",
+			setupMocks: func(l *grpcapp.MockRateLimiter) {
+				l.On("AllowIP", "198.51.100.4").Return(500*time.Millisecond, false)
+			},
+			wantCode:  codes.ResourceExhausted,
+			wantRetry: []string{"1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := grpcapp.NewMockRateLimiter(t)
+			tt.setupMocks(limiter)
+
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			ctx = peer.NewContext(ctx, &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP(tt.peer), Port: 51000},
+			})
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", tt.forwarded))
+
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+
+				return "ok", nil
+			}
+
+			_, err := grpcapp.IPRateLimitUnaryInterceptor(limiter, proxies)(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: "/pvz.v1.PVZService/GetPVZList"},
+				handler,
+			)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.wantCode == codes.OK, called)
+			require.Equal(t, tt.wantRetry, stream.header.Get("retry-after"))
+		})
+	}
+}
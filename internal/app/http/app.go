@@ -2,6 +2,7 @@ package httpapp
 
 import (
 	"avito_pvz/internal/http/gen"
+	"avito_pvz/internal/models/domain"
 	"context"
 	"errors"
 	"log"
@@ -21,7 +22,13 @@ type App struct {
 }
 
 // NewApp создает экземпляр App с зависимостями и handler'ом.
-func NewApp(handler gen.StrictServerInterface, log *slog.Logger) *App {
+func NewApp(
+	handler gen.StrictServerInterface,
+	authenticator httpserver.Authenticator,
+	limiter httpserver.RateLimiter,
+	proxies domain.TrustedProxies,
+	log *slog.Logger,
+) *App {
 	// Swagger schema (для валидации запросов и регистрации роутов)
 	swagger, err := gen.GetSwagger()
 	if err != nil {
@@ -29,18 +36,33 @@ func NewApp(handler gen.StrictServerInterface, log *slog.Logger) *App {
 	}
 
 	swagger.Servers = nil
-	openapiHandler := gen.NewStrictHandler(handler, nil)
+	openapiHandler := gen.NewStrictHandlerWithOptions(handler, []gen.StrictMiddlewareFunc{
+		httpserver.AccessMiddleware(httpserver.AccessPolicy),
+		// Последний middleware выполняется первым: запросы, которым
+		// политика откажет в доступе, тоже расходуют лимит.
+		httpserver.RateLimitMiddleware(limiter),
+	}, httpserver.StrictErrorOptions())
 
 	exceptPaths := map[string]bool{
-		"/register":   true,
-		"/login":      true,
-		"/dummyLogin": true,
+		"/register":              true,
+		"/login":                 true,
+		"/dummyLogin":            true,
+		"/token/refresh":         true,
+		"/.well-known/jwks.json": true,
+		"/password/forgot":       true,
+		"/password/reset":        true,
 	}
 
+	// Лимит по адресу стоит до аутентификации: иначе запросы с неверными
+	// токенами отклонялись бы, не расходуя никакого лимита.
 	middlewareChain := httpserver.LoggingMiddleware(log)(
-		httpserver.AuthMiddleware(exceptPaths)(
-			httpserver.TracingMiddleware(
-				gen.HandlerFromMux(openapiHandler, http.NewServeMux()),
+		httpserver.ClientIPMiddleware(proxies)(
+			httpserver.IPRateLimitMiddleware(limiter)(
+				httpserver.AuthMiddleware(authenticator, exceptPaths)(
+					httpserver.TracingMiddleware(
+						gen.HandlerFromMux(openapiHandler, http.NewServeMux()),
+					),
+				),
 			),
 		),
 	)
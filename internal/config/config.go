@@ -16,11 +16,195 @@ type Config struct {
 	GRPC GRPCServer `yaml:"grpcServer"`
 	HTTP HTTPServer `yaml:"httpServer"`
 	JWT  JWT        `yaml:"jwt"`
+
+	Lockout        Lockout        `yaml:"lockout"`
+	PasswordPolicy PasswordPolicy `yaml:"passwordPolicy"`
+	PasswordHash   PasswordHash   `yaml:"passwordHash"`
+	PasswordReset  PasswordReset  `yaml:"passwordReset"`
+	Notifier       Notifier       `yaml:"notifier"`
+	RateLimit      RateLimit      `yaml:"rateLimit"`
+	DummyLogin     DummyLogin     `yaml:"dummyLogin"`
+	OIDC           OIDC           `yaml:"oidc"`
+	Cities         Cities         `yaml:"cities"`
+	ProductTypes   ProductTypes   `yaml:"productTypes"`
+	Receptions     Receptions     `yaml:"receptions"`
+
+	// TrustedProxies подсети и адреса обратных прокси, которым доверяется
+	// X-Forwarded-For. Пустой список: адрес клиента — адрес соединения.
+	TrustedProxies []string `yaml:"trustedProxies"`
+}
+
+// Cities справочник городов. CacheTTL время, за которое изменения
+// справочника на другом экземпляре сервиса становятся видны этому.
+type Cities struct {
+	CacheTTL time.Duration `yaml:"cacheTTL" env-default:"1m"`
+}
+
+// ProductTypes справочник типов товаров, CacheTTL как у Cities.
+type ProductTypes struct {
+	CacheTTL time.Duration `yaml:"cacheTTL" env-default:"1m"`
+}
+
+// Receptions настройки приемок. CorrectionWindow сколько после закрытия
+// модератор еще может открыть приемку для исправления.
+type Receptions struct {
+	CorrectionWindow time.Duration `yaml:"correctionWindow" env-default:"24h"`
+	AutoClose        AutoClose     `yaml:"autoClose"`
+}
+
+// AutoClose фоновое закрытие приемок, в которые IdleTimeout не добавляли
+// товары. Проверка идет раз в Interval.
+type AutoClose struct {
+	Enabled     bool          `yaml:"enabled"     env-default:"true"`
+	IdleTimeout time.Duration `yaml:"idleTimeout" env-default:"12h"`
+	Interval    time.Duration `yaml:"interval"    env-default:"5m"`
+}
+
+// OIDC вход через внешний провайдер. Токены издателя Issuer проверяются
+// по его discovery-документу и JWKS; вход по паролю остается доступен.
+// Audience обязателен: без него подошли бы токены, выданные провайдером
+// другим клиентам.
+type OIDC struct {
+	Enabled   bool   `yaml:"enabled"`
+	Issuer    string `yaml:"issuer"`
+	Audience  string `yaml:"audience"`
+	RoleClaim string `yaml:"roleClaim" env-default:"roles"`
+	// Roles сопоставляет значения claim с ролями employee и moderator.
+	Roles map[string]string `yaml:"roles"`
+}
+
+// DummyLogin управляет выдачей тестовых токенов через /dummyLogin.
+// Если Enabled не задан, она включена везде, кроме env: prod.
+type DummyLogin struct {
+	Enabled *bool `yaml:"enabled"`
+}
+
+func (c *Config) DummyLoginEnabled() bool {
+	if c.DummyLogin.Enabled != nil {
+		return *c.DummyLogin.Enabled
+	}
+
+	return !c.IsProd()
+}
+
+func (c *Config) IsProd() bool {
+	return c.ENV == "prod"
+}
+
+// RateLimit ограничения частоты запросов (token bucket). Ключи Operations —
+// operation ID для HTTP и полное имя метода для gRPC. Правило операции
+// полностью заменяет Default. Нулевой rate снимает ограничение.
+// PerIP общий лимит адреса клиента на все запросы, который проверяется
+// до аутентификации.
+type RateLimit struct {
+	PerIP      RateLimitValue           `yaml:"perIP"`
+	Default    RateLimitRule            `yaml:"default"`
+	Operations map[string]RateLimitRule `yaml:"operations"`
+}
+
+type RateLimitRule struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+	// Roles переопределяет ограничение для ролей employee и moderator.
+	Roles map[string]RateLimitValue `yaml:"roles"`
+}
+
+type RateLimitValue struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+// PasswordPolicy требования к паролям, которые задают пользователи.
+type PasswordPolicy struct {
+	MinLength      int  `yaml:"minLength"      env-default:"8"`
+	RequireUpper   bool `yaml:"requireUpper"   env-default:"true"`
+	RequireLower   bool `yaml:"requireLower"   env-default:"true"`
+	RequireDigit   bool `yaml:"requireDigit"   env-default:"true"`
+	RequireSpecial bool `yaml:"requireSpecial" env-default:"false"`
+	// DenylistPath файл с распространенными паролями, по одному в строке.
+	DenylistPath string `yaml:"denylistPath"`
+}
+
+// PasswordHash алгоритм хеширования паролей: argon2id или bcrypt.
+// Хеши, созданные другим алгоритмом или с другими параметрами,
+// пересчитываются при следующем входе пользователя.
+type PasswordHash struct {
+	Algorithm  string `yaml:"algorithm"  env-default:"argon2id"`
+	BcryptCost int    `yaml:"bcryptCost" env-default:"10"`
+	Argon2     Argon2 `yaml:"argon2"`
+}
+
+// Argon2 параметры argon2id, Memory задается в KiB.
+type Argon2 struct {
+	Memory      uint32 `yaml:"memory"      env-default:"19456"`
+	Iterations  uint32 `yaml:"iterations"  env-default:"2"`
+	Parallelism uint8  `yaml:"parallelism" env-default:"1"`
+	SaltLength  uint32 `yaml:"saltLength"  env-default:"16"`
+	KeyLength   uint32 `yaml:"keyLength"   env-default:"32"`
+}
+
+type PasswordReset struct {
+	TokenTTL time.Duration `yaml:"tokenTTL" env-default:"1h"`
+	// ResetURL адрес страницы сброса пароля, токен добавляется параметром token.
+	// Если не задан, в письмо попадает только токен.
+	ResetURL string `yaml:"resetURL"`
+}
+
+// Notifier выбирает канал доставки сообщений: log, file или smtp.
+// log и file пишут токены восстановления открытым текстом и при env: prod
+// не запускаются.
+type Notifier struct {
+	Type     string `yaml:"type"     env-default:"log"`
+	FilePath string `yaml:"filePath" env-default:"notifications.log"`
+	SMTP     SMTP   `yaml:"smtp"`
+}
+
+type SMTP struct {
+	Host     string `yaml:"host"     env-default:"localhost"`
+	Port     int    `yaml:"port"     env-default:"25"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+	From     string `yaml:"from"     env-default:"noreply@pvz.local"`
+}
+
+// Lockout настройки блокировки входа после неудачных попыток.
+type Lockout struct {
+	Account LockoutPolicy `yaml:"account"`
+	IP      LockoutPolicy `yaml:"ip"`
+}
+
+type LockoutPolicy struct {
+	Threshold int           `yaml:"threshold" env-default:"5"`
+	BaseDelay time.Duration `yaml:"baseDelay" env-default:"30s"`
+	MaxDelay  time.Duration `yaml:"maxDelay"  env-default:"15m"`
+	Window    time.Duration `yaml:"window"    env-default:"15m"`
 }
 
 type JWT struct {
-	SecretKey string        `yaml:"secretKey"`
-	Expire    time.Duration `yaml:"expire"`
+	SigningKeyID  string        `yaml:"signingKeyId"`
+	Keys          []JWTKey      `yaml:"keys"`
+	Expire        time.Duration `yaml:"expire"`
+	RefreshExpire time.Duration `yaml:"refreshExpire" env-default:"720h"`
+}
+
+// JWTKey указывает на PEM-файл ключа подписи.
+// Для ключа, которым подписываются токены, нужен приватный ключ,
+// для выведенных из ротации достаточно публичного. Ключи в репозитории
+// не хранятся: в prod файл монтируется из секрета, для разработки его
+// создает task keys, а без файла вне prod ключ генерируется при запуске.
+type JWTKey struct {
+	ID   string `yaml:"id"`
+	Path string `yaml:"path"`
+}
+
+// KeyPaths возвращает пути к ключам по их kid.
+func (j *JWT) KeyPaths() map[string]string {
+	paths := make(map[string]string, len(j.Keys))
+	for _, k := range j.Keys {
+		paths[k.ID] = k.Path
+	}
+
+	return paths
 }
 
 type DBConfig struct {
@@ -0,0 +1,903 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: pvz/pvz.proto
+
+package pvz_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only active PVZs accept new receptions and products. Suspended takes a PVZ
+// out of service temporarily, closed permanently.
+type PVZStatus int32
+
+const (
+	PVZStatus_PVZ_STATUS_UNSPECIFIED PVZStatus = 0
+	PVZStatus_PVZ_STATUS_ACTIVE      PVZStatus = 1
+	PVZStatus_PVZ_STATUS_SUSPENDED   PVZStatus = 2
+	PVZStatus_PVZ_STATUS_CLOSED      PVZStatus = 3
+)
+
+// Enum value maps for PVZStatus.
+var (
+	PVZStatus_name = map[int32]string{
+		0: "PVZ_STATUS_UNSPECIFIED",
+		1: "PVZ_STATUS_ACTIVE",
+		2: "PVZ_STATUS_SUSPENDED",
+		3: "PVZ_STATUS_CLOSED",
+	}
+	PVZStatus_value = map[string]int32{
+		"PVZ_STATUS_UNSPECIFIED": 0,
+		"PVZ_STATUS_ACTIVE":      1,
+		"PVZ_STATUS_SUSPENDED":   2,
+		"PVZ_STATUS_CLOSED":      3,
+	}
+)
+
+func (x PVZStatus) Enum() *PVZStatus {
+	p := new(PVZStatus)
+	*p = x
+	return p
+}
+
+func (x PVZStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_pvz_proto_enumTypes[0].Descriptor()
+}
+
+func (PVZStatus) Type() protoreflect.EnumType {
+	return &file_pvz_pvz_proto_enumTypes[0]
+}
+
+func (x PVZStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZStatus.Descriptor instead.
+func (PVZStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{0}
+}
+
+type ReceptionStatus int32
+
+const (
+	ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS ReceptionStatus = 0
+	ReceptionStatus_RECEPTION_STATUS_CLOSED      ReceptionStatus = 1
+)
+
+// Enum value maps for ReceptionStatus.
+var (
+	ReceptionStatus_name = map[int32]string{
+		0: "RECEPTION_STATUS_IN_PROGRESS",
+		1: "RECEPTION_STATUS_CLOSED",
+	}
+	ReceptionStatus_value = map[string]int32{
+		"RECEPTION_STATUS_IN_PROGRESS": 0,
+		"RECEPTION_STATUS_CLOSED":      1,
+	}
+)
+
+func (x ReceptionStatus) Enum() *ReceptionStatus {
+	p := new(ReceptionStatus)
+	*p = x
+	return p
+}
+
+func (x ReceptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReceptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_pvz_proto_enumTypes[1].Descriptor()
+}
+
+func (ReceptionStatus) Type() protoreflect.EnumType {
+	return &file_pvz_pvz_proto_enumTypes[1]
+}
+
+func (x ReceptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceptionStatus.Descriptor instead.
+func (ReceptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+type PVZ struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
+	City             string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Address          string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Unset when the PVZ has no coordinates.
+	Location *GeoPoint `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	// IANA time zone name, e.g. Europe/Moscow. Working hours are local to it.
+	Timezone     string        `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	WorkingHours *WorkingHours `protobuf:"bytes,7,opt,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	// Contact phone in E.164 format.
+	Phone  string    `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	Status PVZStatus `protobuf:"varint,9,opt,name=status,proto3,enum=pvz.v1.PVZStatus" json:"status,omitempty"`
+	// Moderator's reason for the last status change.
+	StatusReason    string                 `protobuf:"bytes,10,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PVZ) Reset() {
+	*x = PVZ{}
+	mi := &file_pvz_pvz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZ) ProtoMessage() {}
+
+func (x *PVZ) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZ.ProtoReflect.Descriptor instead.
+func (*PVZ) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PVZ) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PVZ) GetRegistrationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationDate
+	}
+	return nil
+}
+
+func (x *PVZ) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PVZ) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PVZ) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PVZ) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PVZ) GetWorkingHours() *WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *PVZ) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *PVZ) GetStatus() PVZStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PVZStatus_PVZ_STATUS_UNSPECIFIED
+}
+
+func (x *PVZ) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *PVZ) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+// Coordinates in WGS 84 degrees.
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_pvz_pvz_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeoPoint) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+type WorkingHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekly        []*DayHours            `protobuf:"bytes,1,rep,name=weekly,proto3" json:"weekly,omitempty"`
+	Exceptions    []*HoursException      `protobuf:"bytes,2,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkingHours) Reset() {
+	*x = WorkingHours{}
+	mi := &file_pvz_pvz_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingHours) ProtoMessage() {}
+
+func (x *WorkingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
+func (*WorkingHours) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkingHours) GetWeekly() []*DayHours {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *WorkingHours) GetExceptions() []*HoursException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+// Opening interval on a weekday. Times are HH:MM, close may be 24:00.
+type DayHours struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// mon, tue, ..., sun.
+	Day           string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Open          string `protobuf:"bytes,2,opt,name=open,proto3" json:"open,omitempty"`
+	Close         string `protobuf:"bytes,3,opt,name=close,proto3" json:"close,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayHours) Reset() {
+	*x = DayHours{}
+	mi := &file_pvz_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayHours) ProtoMessage() {}
+
+func (x *DayHours) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayHours.ProtoReflect.Descriptor instead.
+func (*DayHours) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DayHours) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *DayHours) GetOpen() string {
+	if x != nil {
+		return x.Open
+	}
+	return ""
+}
+
+func (x *DayHours) GetClose() string {
+	if x != nil {
+		return x.Close
+	}
+	return ""
+}
+
+// A special day that replaces the weekday schedule.
+type HoursException struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD.
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Closed        bool   `protobuf:"varint,2,opt,name=closed,proto3" json:"closed,omitempty"`
+	Open          string `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`
+	Close         string `protobuf:"bytes,4,opt,name=close,proto3" json:"close,omitempty"`
+	Note          string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoursException) Reset() {
+	*x = HoursException{}
+	mi := &file_pvz_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoursException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoursException) ProtoMessage() {}
+
+func (x *HoursException) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoursException.ProtoReflect.Descriptor instead.
+func (*HoursException) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HoursException) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *HoursException) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *HoursException) GetOpen() string {
+	if x != nil {
+		return x.Open
+	}
+	return ""
+}
+
+func (x *HoursException) GetClose() string {
+	if x != nil {
+		return x.Close
+	}
+	return ""
+}
+
+func (x *HoursException) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type GetPVZListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unspecified lists PVZs in any status.
+	Status        PVZStatus `protobuf:"varint,1,opt,name=status,proto3,enum=pvz.v1.PVZStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListRequest) Reset() {
+	*x = GetPVZListRequest{}
+	mi := &file_pvz_pvz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListRequest) ProtoMessage() {}
+
+func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPVZListRequest) GetStatus() PVZStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PVZStatus_PVZ_STATUS_UNSPECIFIED
+}
+
+type GetPVZListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListResponse) Reset() {
+	*x = GetPVZListResponse{}
+	mi := &file_pvz_pvz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListResponse) ProtoMessage() {}
+
+func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPVZListResponse) GetPvzs() []*PVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+type FindNearestPVZRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Lat   float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon   float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Zero means the default of 10 km.
+	RadiusKm float64 `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	// Zero means the default of 10 results.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only PVZs open at the time of the call.
+	OpenNow       bool `protobuf:"varint,5,opt,name=open_now,json=openNow,proto3" json:"open_now,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearestPVZRequest) Reset() {
+	*x = FindNearestPVZRequest{}
+	mi := &file_pvz_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearestPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearestPVZRequest) ProtoMessage() {}
+
+func (x *FindNearestPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearestPVZRequest.ProtoReflect.Descriptor instead.
+func (*FindNearestPVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FindNearestPVZRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *FindNearestPVZRequest) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *FindNearestPVZRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *FindNearestPVZRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FindNearestPVZRequest) GetOpenNow() bool {
+	if x != nil {
+		return x.OpenNow
+	}
+	return false
+}
+
+type NearbyPVZ struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyPVZ) Reset() {
+	*x = NearbyPVZ{}
+	mi := &file_pvz_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyPVZ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyPVZ) ProtoMessage() {}
+
+func (x *NearbyPVZ) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyPVZ.ProtoReflect.Descriptor instead.
+func (*NearbyPVZ) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NearbyPVZ) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *NearbyPVZ) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type FindNearestPVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*NearbyPVZ           `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearestPVZResponse) Reset() {
+	*x = FindNearestPVZResponse{}
+	mi := &file_pvz_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearestPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearestPVZResponse) ProtoMessage() {}
+
+func (x *FindNearestPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearestPVZResponse.ProtoReflect.Descriptor instead.
+func (*FindNearestPVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FindNearestPVZResponse) GetPvzs() []*NearbyPVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+var File_pvz_pvz_proto protoreflect.FileDescriptor
+
+const file_pvz_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\rpvz/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbf\x03\n" +
+	"\x03PVZ\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12,\n" +
+	"\blocation\x18\x05 \x01(\v2\x10.pvz.v1.GeoPointR\blocation\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x129\n" +
+	"\rworking_hours\x18\a \x01(\v2\x14.pvz.v1.WorkingHoursR\fworkingHours\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\x12)\n" +
+	"\x06status\x18\t \x01(\x0e2\x11.pvz.v1.PVZStatusR\x06status\x12#\n" +
+	"\rstatus_reason\x18\n" +
+	" \x01(\tR\fstatusReason\x12F\n" +
+	"\x11status_changed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\".\n" +
+	"\bGeoPoint\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\"p\n" +
+	"\fWorkingHours\x12(\n" +
+	"\x06weekly\x18\x01 \x03(\v2\x10.pvz.v1.DayHoursR\x06weekly\x126\n" +
+	"\n" +
+	"exceptions\x18\x02 \x03(\v2\x16.pvz.v1.HoursExceptionR\n" +
+	"exceptions\"F\n" +
+	"\bDayHours\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\tR\x04open\x12\x14\n" +
+	"\x05close\x18\x03 \x01(\tR\x05close\"z\n" +
+	"\x0eHoursException\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06closed\x18\x02 \x01(\bR\x06closed\x12\x12\n" +
+	"\x04open\x18\x03 \x01(\tR\x04open\x12\x14\n" +
+	"\x05close\x18\x04 \x01(\tR\x05close\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\">\n" +
+	"\x11GetPVZListRequest\x12)\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x11.pvz.v1.PVZStatusR\x06status\"5\n" +
+	"\x12GetPVZListResponse\x12\x1f\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs\"\x89\x01\n" +
+	"\x15FindNearestPVZRequest\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\x12\x1b\n" +
+	"\tradius_km\x18\x03 \x01(\x01R\bradiusKm\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x19\n" +
+	"\bopen_now\x18\x05 \x01(\bR\aopenNow\"K\n" +
+	"\tNearbyPVZ\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\"?\n" +
+	"\x16FindNearestPVZResponse\x12%\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\x11.pvz.v1.NearbyPVZR\x04pvzs*o\n" +
+	"\tPVZStatus\x12\x1a\n" +
+	"\x16PVZ_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11PVZ_STATUS_ACTIVE\x10\x01\x12\x18\n" +
+	"\x14PVZ_STATUS_SUSPENDED\x10\x02\x12\x15\n" +
+	"\x11PVZ_STATUS_CLOSED\x10\x03*P\n" +
+	"\x0fReceptionStatus\x12 \n" +
+	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
+	"\x17RECEPTION_STATUS_CLOSED\x10\x012\xa2\x01\n" +
+	"\n" +
+	"PVZService\x12C\n" +
+	"\n" +
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\x12O\n" +
+	"\x0eFindNearestPVZ\x12\x1d.pvz.v1.FindNearestPVZRequest\x1a\x1e.pvz.v1.FindNearestPVZResponseB(Z&avito_pvz/internal/grpc/gen/pvz;pvz_v1b\x06proto3"
+
+var (
+	file_pvz_pvz_proto_rawDescOnce sync.Once
+	file_pvz_pvz_proto_rawDescData []byte
+)
+
+func file_pvz_pvz_proto_rawDescGZIP() []byte {
+	file_pvz_pvz_proto_rawDescOnce.Do(func() {
+		file_pvz_pvz_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pvz_pvz_proto_rawDesc), len(file_pvz_pvz_proto_rawDesc)))
+	})
+	return file_pvz_pvz_proto_rawDescData
+}
+
+var file_pvz_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pvz_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_pvz_pvz_proto_goTypes = []any{
+	(PVZStatus)(0),                 // 0: pvz.v1.PVZStatus
+	(ReceptionStatus)(0),           // 1: pvz.v1.ReceptionStatus
+	(*PVZ)(nil),                    // 2: pvz.v1.PVZ
+	(*GeoPoint)(nil),               // 3: pvz.v1.GeoPoint
+	(*WorkingHours)(nil),           // 4: pvz.v1.WorkingHours
+	(*DayHours)(nil),               // 5: pvz.v1.DayHours
+	(*HoursException)(nil),         // 6: pvz.v1.HoursException
+	(*GetPVZListRequest)(nil),      // 7: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),     // 8: pvz.v1.GetPVZListResponse
+	(*FindNearestPVZRequest)(nil),  // 9: pvz.v1.FindNearestPVZRequest
+	(*NearbyPVZ)(nil),              // 10: pvz.v1.NearbyPVZ
+	(*FindNearestPVZResponse)(nil), // 11: pvz.v1.FindNearestPVZResponse
+	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+}
+var file_pvz_pvz_proto_depIdxs = []int32{
+	12, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
+	3,  // 1: pvz.v1.PVZ.location:type_name -> pvz.v1.GeoPoint
+	4,  // 2: pvz.v1.PVZ.working_hours:type_name -> pvz.v1.WorkingHours
+	0,  // 3: pvz.v1.PVZ.status:type_name -> pvz.v1.PVZStatus
+	12, // 4: pvz.v1.PVZ.status_changed_at:type_name -> google.protobuf.Timestamp
+	5,  // 5: pvz.v1.WorkingHours.weekly:type_name -> pvz.v1.DayHours
+	6,  // 6: pvz.v1.WorkingHours.exceptions:type_name -> pvz.v1.HoursException
+	0,  // 7: pvz.v1.GetPVZListRequest.status:type_name -> pvz.v1.PVZStatus
+	2,  // 8: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
+	2,  // 9: pvz.v1.NearbyPVZ.pvz:type_name -> pvz.v1.PVZ
+	10, // 10: pvz.v1.FindNearestPVZResponse.pvzs:type_name -> pvz.v1.NearbyPVZ
+	7,  // 11: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	9,  // 12: pvz.v1.PVZService.FindNearestPVZ:input_type -> pvz.v1.FindNearestPVZRequest
+	8,  // 13: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	11, // 14: pvz.v1.PVZService.FindNearestPVZ:output_type -> pvz.v1.FindNearestPVZResponse
+	13, // [13:15] is the sub-list for method output_type
+	11, // [11:13] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_pvz_pvz_proto_init() }
+func file_pvz_pvz_proto_init() {
+	if File_pvz_pvz_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pvz_pvz_proto_rawDesc), len(file_pvz_pvz_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pvz_pvz_proto_goTypes,
+		DependencyIndexes: file_pvz_pvz_proto_depIdxs,
+		EnumInfos:         file_pvz_pvz_proto_enumTypes,
+		MessageInfos:      file_pvz_pvz_proto_msgTypes,
+	}.Build()
+	File_pvz_pvz_proto = out.File
+	file_pvz_pvz_proto_goTypes = nil
+	file_pvz_pvz_proto_depIdxs = nil
+}
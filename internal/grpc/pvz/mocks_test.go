@@ -39,8 +39,8 @@ func (_m *MockPVZ) EXPECT() *MockPVZ_Expecter {
 }
 
 // GetAllPVZ provides a mock function for the type MockPVZ
-func (_mock *MockPVZ) GetAllPVZ(ctx context.Context) (domain.PVZList, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockPVZ) GetAllPVZ(ctx context.Context, status *domain.PVZStatus) (domain.PVZList, error) {
+	ret := _mock.Called(ctx, status)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllPVZ")
@@ -48,18 +48,18 @@ func (_mock *MockPVZ) GetAllPVZ(ctx context.Context) (domain.PVZList, error) {
 
 	var r0 domain.PVZList
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (domain.PVZList, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZStatus) (domain.PVZList, error)); ok {
+		return returnFunc(ctx, status)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.PVZList); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZStatus) domain.PVZList); ok {
+		r0 = returnFunc(ctx, status)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(domain.PVZList)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PVZStatus) error); ok {
+		r1 = returnFunc(ctx, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,13 +73,14 @@ type MockPVZ_GetAllPVZ_Call struct {
 
 // GetAllPVZ is a helper method to define mock.On call
 //   - ctx
-func (_e *MockPVZ_Expecter) GetAllPVZ(ctx interface{}) *MockPVZ_GetAllPVZ_Call {
-	return &MockPVZ_GetAllPVZ_Call{Call: _e.mock.On("GetAllPVZ", ctx)}
+//   - status
+func (_e *MockPVZ_Expecter) GetAllPVZ(ctx interface{}, status interface{}) *MockPVZ_GetAllPVZ_Call {
+	return &MockPVZ_GetAllPVZ_Call{Call: _e.mock.On("GetAllPVZ", ctx, status)}
 }
 
-func (_c *MockPVZ_GetAllPVZ_Call) Run(run func(ctx context.Context)) *MockPVZ_GetAllPVZ_Call {
+func (_c *MockPVZ_GetAllPVZ_Call) Run(run func(ctx context.Context, status *domain.PVZStatus)) *MockPVZ_GetAllPVZ_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*domain.PVZStatus))
 	})
 	return _c
 }
@@ -89,7 +90,64 @@ func (_c *MockPVZ_GetAllPVZ_Call) Return(pVZList domain.PVZList, err error) *Moc
 	return _c
 }
 
-func (_c *MockPVZ_GetAllPVZ_Call) RunAndReturn(run func(ctx context.Context) (domain.PVZList, error)) *MockPVZ_GetAllPVZ_Call {
+func (_c *MockPVZ_GetAllPVZ_Call) RunAndReturn(run func(ctx context.Context, status *domain.PVZStatus) (domain.PVZList, error)) *MockPVZ_GetAllPVZ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Nearest provides a mock function for the type MockPVZ
+func (_mock *MockPVZ) Nearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Nearest")
+	}
+
+	var r0 []domain.NearbyPVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NearbyQuery) ([]domain.NearbyPVZ, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NearbyQuery) []domain.NearbyPVZ); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NearbyPVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.NearbyQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZ_Nearest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Nearest'
+type MockPVZ_Nearest_Call struct {
+	*mock.Call
+}
+
+// Nearest is a helper method to define mock.On call
+//   - ctx
+//   - query
+func (_e *MockPVZ_Expecter) Nearest(ctx interface{}, query interface{}) *MockPVZ_Nearest_Call {
+	return &MockPVZ_Nearest_Call{Call: _e.mock.On("Nearest", ctx, query)}
+}
+
+func (_c *MockPVZ_Nearest_Call) Run(run func(ctx context.Context, query domain.NearbyQuery)) *MockPVZ_Nearest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NearbyQuery))
+	})
+	return _c
+}
+
+func (_c *MockPVZ_Nearest_Call) Return(nearbyPVZs []domain.NearbyPVZ, err error) *MockPVZ_Nearest_Call {
+	_c.Call.Return(nearbyPVZs, err)
+	return _c
+}
+
+func (_c *MockPVZ_Nearest_Call) RunAndReturn(run func(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error)) *MockPVZ_Nearest_Call {
 	_c.Call.Return(run)
 	return _c
 }
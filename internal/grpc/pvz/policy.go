@@ -0,0 +1,17 @@
+package pvzgrpc
+
+import (
+	"avito_pvz/internal/models/domain"
+
+	pvzv1 "avito_pvz/internal/grpc/gen/pvz"
+)
+
+// AccessPolicy describes which roles may call each PVZService method.
+// Methods missing from the table are denied.
+var AccessPolicy = domain.AccessPolicy{
+	pvzv1.PVZService_GetPVZList_FullMethodName: {Public: true},
+	pvzv1.PVZService_FindNearestPVZ_FullMethodName: {
+		Roles: []domain.Role{domain.RoleEmploye, domain.RoleModerator},
+		Scope: domain.ScopePVZRead,
+	},
+}
@@ -5,16 +5,31 @@ import (
 	"avito_pvz/internal/models/domain"
 	"context"
 	"errors"
+	"time"
 
-	pvzv1 "github.com/netscrawler/pvz_proto/gen/go/pvz"
+	pvzv1 "avito_pvz/internal/grpc/gen/pvz"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var (
+	statusFromProto = map[pvzv1.PVZStatus]domain.PVZStatus{
+		pvzv1.PVZStatus_PVZ_STATUS_ACTIVE:    domain.PVZStatusActive,
+		pvzv1.PVZStatus_PVZ_STATUS_SUSPENDED: domain.PVZStatusSuspended,
+		pvzv1.PVZStatus_PVZ_STATUS_CLOSED:    domain.PVZStatusClosed,
+	}
+	statusToProto = map[domain.PVZStatus]pvzv1.PVZStatus{
+		domain.PVZStatusActive:    pvzv1.PVZStatus_PVZ_STATUS_ACTIVE,
+		domain.PVZStatusSuspended: pvzv1.PVZStatus_PVZ_STATUS_SUSPENDED,
+		domain.PVZStatusClosed:    pvzv1.PVZStatus_PVZ_STATUS_CLOSED,
+	}
+)
+
 type PVZ interface {
-	GetAllPVZ(ctx context.Context) (domain.PVZList, error)
+	GetAllPVZ(ctx context.Context, status *domain.PVZStatus) (domain.PVZList, error)
+	Nearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error)
 }
 
 type serverAPI struct {
@@ -31,11 +46,20 @@ func (s *serverAPI) GetPVZList(
 	ctx context.Context,
 	in *pvzv1.GetPVZListRequest,
 ) (*pvzv1.GetPVZListResponse, error) {
-	list, err := s.pvz.GetAllPVZ(ctx)
+	filter, ok := statusFilter(in.GetStatus())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, models.ErrInvalidPVZStatus.Error())
+	}
+
+	list, err := s.pvz.GetAllPVZ(ctx, filter)
 	if errors.Is(err, models.ErrPVZNotFound) {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
+	if errors.Is(err, models.ErrInvalidPVZStatus) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -45,13 +69,123 @@ func (s *serverAPI) GetPVZList(
 	}
 
 	for _, v := range list {
-		p := pvzv1.PVZ{
-			Id:               v.ID.String(),
-			RegistrationDate: timestamppb.New(v.RegistrationDate),
-			City:             string(v.City),
-		}
-		out.Pvzs = append(out.Pvzs, &p)
+		out.Pvzs = append(out.Pvzs, toProto(v))
 	}
 
 	return out, nil
 }
+
+func (s *serverAPI) FindNearestPVZ(
+	ctx context.Context,
+	in *pvzv1.FindNearestPVZRequest,
+) (*pvzv1.FindNearestPVZResponse, error) {
+	found, err := s.pvz.Nearest(ctx, nearbyQuery(in, time.Now()))
+	if errors.Is(err, models.ErrInvalidLocation) ||
+		errors.Is(err, models.ErrInvalidRadius) ||
+		errors.Is(err, models.ErrInvalidLimit) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := &pvzv1.FindNearestPVZResponse{
+		Pvzs: make([]*pvzv1.NearbyPVZ, 0, len(found)),
+	}
+
+	for _, v := range found {
+		out.Pvzs = append(out.Pvzs, &pvzv1.NearbyPVZ{
+			Pvz:        toProto(&v.PVZ),
+			DistanceKm: v.DistanceKm,
+		})
+	}
+
+	return out, nil
+}
+
+// nearbyQuery maps the request onto domain.NearbyQuery. Proto3 scalars have
+// no presence, so zero radius and limit fall back to the defaults.
+func nearbyQuery(in *pvzv1.FindNearestPVZRequest, now time.Time) domain.NearbyQuery {
+	query := domain.NewNearbyQuery(domain.GeoPoint{Lat: in.GetLat(), Lon: in.GetLon()})
+
+	if in.GetRadiusKm() != 0 {
+		query.RadiusKm = in.GetRadiusKm()
+	}
+
+	if in.GetLimit() != 0 {
+		query.Limit = int(in.GetLimit())
+	}
+
+	if in.GetOpenNow() {
+		query.OpenAt = &now
+	}
+
+	return query
+}
+
+func toProto(v *domain.PVZ) *pvzv1.PVZ {
+	p := &pvzv1.PVZ{
+		Id:               v.ID.String(),
+		RegistrationDate: timestamppb.New(v.RegistrationDate),
+		City:             string(v.City),
+		Address:          v.Profile.Address,
+		Timezone:         v.Profile.Timezone,
+		WorkingHours:     hoursToProto(v.Profile.Hours),
+		Phone:            v.Profile.Phone,
+		Status:           statusToProto[v.Status],
+		StatusReason:     v.StatusReason,
+	}
+
+	if v.StatusChangedAt != nil {
+		p.StatusChangedAt = timestamppb.New(*v.StatusChangedAt)
+	}
+
+	if v.Profile.Location != nil {
+		p.Location = &pvzv1.GeoPoint{Lat: v.Profile.Location.Lat, Lon: v.Profile.Location.Lon}
+	}
+
+	return p
+}
+
+func hoursToProto(hours domain.WorkingHours) *pvzv1.WorkingHours {
+	out := &pvzv1.WorkingHours{
+		Weekly:     make([]*pvzv1.DayHours, 0, len(hours.Weekly)),
+		Exceptions: make([]*pvzv1.HoursException, 0, len(hours.Exceptions)),
+	}
+
+	for _, day := range hours.Weekly {
+		out.Weekly = append(out.Weekly, &pvzv1.DayHours{
+			Day:   string(day.Day),
+			Open:  day.Open,
+			Close: day.Close,
+		})
+	}
+
+	for _, exception := range hours.Exceptions {
+		out.Exceptions = append(out.Exceptions, &pvzv1.HoursException{
+			Date:   exception.Date,
+			Closed: exception.Closed,
+			Open:   exception.Open,
+			Close:  exception.Close,
+			Note:   exception.Note,
+		})
+	}
+
+	return out
+}
+
+// statusFilter maps the requested status onto the service filter: nil for
+// unspecified, ok = false for values this server does not know.
+func statusFilter(in pvzv1.PVZStatus) (*domain.PVZStatus, bool) {
+	if in == pvzv1.PVZStatus_PVZ_STATUS_UNSPECIFIED {
+		return nil, true
+	}
+
+	filter, ok := statusFromProto[in]
+	if !ok {
+		return nil, false
+	}
+
+	return &filter, true
+}
@@ -7,29 +7,32 @@ import (
 	"testing"
 	"time"
 
+	pvzv1 "avito_pvz/internal/grpc/gen/pvz"
 	pvzgrpc "avito_pvz/internal/grpc/pvz"
 	"avito_pvz/internal/models"
 	"avito_pvz/internal/models/domain"
 
 	"github.com/google/uuid"
-	pvzv1 "github.com/netscrawler/pvz_proto/gen/go/pvz"
 	mock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetPVZList(t *testing.T) {
 	tests := []struct {
 		name       string
+		filter     pvzv1.PVZStatus
 		setupMocks func(mockPVZ *pvzgrpc.MockPVZ) // Параметр для настройки моков
 		wantErr    error
 		wantCode   codes.Code
+		check      func(t *testing.T, resp *pvzv1.GetPVZListResponse)
 	}{
 		{
 			name: "successful_response",
 			setupMocks: func(mockPVZ *pvzgrpc.MockPVZ) {
-				mockPVZ.On("GetAllPVZ", mock.Anything).Return(domain.PVZList{
+				mockPVZ.On("GetAllPVZ", mock.Anything, (*domain.PVZStatus)(nil)).Return(domain.PVZList{
 					{
 						ID:               (*domain.PVZID)(&uuid.Max),
 						RegistrationDate: time.Time{},
@@ -45,10 +48,54 @@ func TestGetPVZList(t *testing.T) {
 			wantErr:  nil,
 			wantCode: codes.OK,
 		},
+		{
+			name: "profile_mapped",
+			setupMocks: func(mockPVZ *pvzgrpc.MockPVZ) {
+				mockPVZ.On("GetAllPVZ", mock.Anything, (*domain.PVZStatus)(nil)).Return(domain.PVZList{
+					{
+						ID:   (*domain.PVZID)(&uuid.Max),
+						City: "Москва",
+						Profile: domain.PVZProfile{
+							Address:  "ул. Льва Толстого, 16",
+							Location: &domain.GeoPoint{Lat: 55.7339, Lon: 37.5875},
+							Timezone: "Europe/Moscow",
+							Hours: domain.WorkingHours{
+								Weekly: []domain.DayHours{{Day: "mon", Open: "09:00", Close: "21:00"}},
+								Exceptions: []domain.HoursException{
+									{Date: "2025-01-01", Closed: true, Note: "Новый год"},
+								},
+							},
+							Phone: "+74950000000",
+						},
+						Status:       domain.PVZStatusSuspended,
+						StatusReason: "Ремонт",
+					},
+				}, nil)
+			},
+			wantCode: codes.OK,
+			check: func(t *testing.T, resp *pvzv1.GetPVZListResponse) {
+				require.Len(t, resp.GetPvzs(), 1)
+
+				p := resp.GetPvzs()[0]
+				require.Equal(t, "ул. Льва Толстого, 16", p.GetAddress())
+				require.InDelta(t, 55.7339, p.GetLocation().GetLat(), 1e-9)
+				require.InDelta(t, 37.5875, p.GetLocation().GetLon(), 1e-9)
+				require.Equal(t, "Europe/Moscow", p.GetTimezone())
+				require.Equal(t, "+74950000000", p.GetPhone())
+				require.Len(t, p.GetWorkingHours().GetWeekly(), 1)
+				require.Equal(t, "mon", p.GetWorkingHours().GetWeekly()[0].GetDay())
+				require.Equal(t, "21:00", p.GetWorkingHours().GetWeekly()[0].GetClose())
+				require.Len(t, p.GetWorkingHours().GetExceptions(), 1)
+				require.True(t, p.GetWorkingHours().GetExceptions()[0].GetClosed())
+				require.Equal(t, pvzv1.PVZStatus_PVZ_STATUS_SUSPENDED, p.GetStatus())
+				require.Equal(t, "Ремонт", p.GetStatusReason())
+				require.Nil(t, p.GetStatusChangedAt())
+			},
+		},
 		{
 			name: "pvz_not_found",
 			setupMocks: func(mockPVZ *pvzgrpc.MockPVZ) {
-				mockPVZ.On("GetAllPVZ", mock.Anything).Return(nil, models.ErrPVZNotFound)
+				mockPVZ.On("GetAllPVZ", mock.Anything, (*domain.PVZStatus)(nil)).Return(nil, models.ErrPVZNotFound)
 			},
 			wantErr:  models.ErrPVZNotFound,
 			wantCode: codes.NotFound,
@@ -56,11 +103,28 @@ func TestGetPVZList(t *testing.T) {
 		{
 			name: "internal_error",
 			setupMocks: func(mockPVZ *pvzgrpc.MockPVZ) {
-				mockPVZ.On("GetAllPVZ", mock.Anything).Return(nil, errors.New("internal error"))
+				mockPVZ.On("GetAllPVZ", mock.Anything, (*domain.PVZStatus)(nil)).Return(nil, errors.New("internal error"))
 			},
 			wantErr:  models.ErrInternal,
 			wantCode: codes.Internal,
 		},
+		{
+			name:   "filter_by_status",
+			filter: pvzv1.PVZStatus_PVZ_STATUS_SUSPENDED,
+			setupMocks: func(mockPVZ *pvzgrpc.MockPVZ) {
+				suspended := domain.PVZStatusSuspended
+				mockPVZ.On("GetAllPVZ", mock.Anything, &suspended).Return(domain.PVZList{}, nil)
+			},
+			wantErr:  nil,
+			wantCode: codes.OK,
+		},
+		{
+			name:       "invalid_status",
+			filter:     pvzv1.PVZStatus(42),
+			setupMocks: func(mockPVZ *pvzgrpc.MockPVZ) {},
+			wantErr:    models.ErrInvalidPVZStatus,
+			wantCode:   codes.InvalidArgument,
+		},
 	}
 
 	for _, tt := range tests {
@@ -68,32 +132,14 @@ func TestGetPVZList(t *testing.T) {
 			mockPVZ := new(pvzgrpc.MockPVZ)
 			tt.setupMocks(mockPVZ)
 
-			lis, err := net.Listen("tcp", ":0") // Используем случайный порт
-			require.NoError(t, err)
-			defer lis.Close()
+			client := newTestClient(t, mockPVZ)
 
-			grpcServer := grpc.NewServer()
-			pvzgrpc.Register(grpcServer, mockPVZ)
+			resp, err := client.GetPVZList(
+				context.Background(),
+				&pvzv1.GetPVZListRequest{Status: tt.filter},
+			)
+			require.Equal(t, tt.wantCode, status.Code(err))
 
-			ready := make(chan struct{})
-			done := make(chan struct{})
-
-			go func() {
-				close(ready)
-				err := grpcServer.Serve(lis)
-				require.NoError(t, err)
-				close(done)
-			}()
-
-			<-ready
-
-			conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
-			require.NoError(t, err)
-			defer conn.Close()
-
-			client := pvzv1.NewPVZServiceClient(conn)
-
-			resp, err := client.GetPVZList(context.Background(), &pvzv1.GetPVZListRequest{})
 			if tt.wantErr != nil {
 				require.Error(t, err)
 			} else {
@@ -101,10 +147,111 @@ func TestGetPVZList(t *testing.T) {
 				require.NotNil(t, resp)
 			}
 
+			if tt.check != nil {
+				tt.check(t, resp)
+			}
+
 			mockPVZ.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFindNearestPVZ(t *testing.T) {
+	point := domain.GeoPoint{Lat: 55.75, Lon: 37.61}
+
+	tests := []struct {
+		name       string
+		req        *pvzv1.FindNearestPVZRequest
+		setupMocks func(mockPVZ *pvzgrpc.MockPVZ)
+		wantCode   codes.Code
+		check      func(t *testing.T, resp *pvzv1.FindNearestPVZResponse)
+	}{
+		{
+			name: "defaults_and_distance",
+			req:  &pvzv1.FindNearestPVZRequest{Lat: point.Lat, Lon: point.Lon},
+			setupMocks: func(mockPVZ *pvzgrpc.MockPVZ) {
+				mockPVZ.On("Nearest", mock.Anything, domain.NewNearbyQuery(point)).
+					Return([]domain.NearbyPVZ{{
+						PVZ:        domain.PVZ{ID: (*domain.PVZID)(&uuid.Max), City: "Москва"},
+						DistanceKm: 1.5,
+					}}, nil)
+			},
+			wantCode: codes.OK,
+			check: func(t *testing.T, resp *pvzv1.FindNearestPVZResponse) {
+				require.Len(t, resp.GetPvzs(), 1)
+				require.Equal(t, uuid.Max.String(), resp.GetPvzs()[0].GetPvz().GetId())
+				require.InDelta(t, 1.5, resp.GetPvzs()[0].GetDistanceKm(), 1e-9)
+			},
+		},
+		{
+			name: "open_now",
+			req: &pvzv1.FindNearestPVZRequest{
+				Lat: point.Lat, Lon: point.Lon, RadiusKm: 3, Limit: 5, OpenNow: true,
+			},
+			setupMocks: func(mockPVZ *pvzgrpc.MockPVZ) {
+				mockPVZ.On("Nearest", mock.Anything, mock.MatchedBy(func(q domain.NearbyQuery) bool {
+					return q.RadiusKm == 3 && q.Limit == 5 && q.OpenAt != nil
+				})).Return([]domain.NearbyPVZ{}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "invalid_radius",
+			req:  &pvzv1.FindNearestPVZRequest{Lat: point.Lat, Lon: point.Lon, RadiusKm: -1},
+			setupMocks: func(mockPVZ *pvzgrpc.MockPVZ) {
+				mockPVZ.On("Nearest", mock.Anything, mock.Anything).
+					Return(nil, models.ErrInvalidRadius)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPVZ := new(pvzgrpc.MockPVZ)
+			tt.setupMocks(mockPVZ)
+
+			client := newTestClient(t, mockPVZ)
+
+			resp, err := client.FindNearestPVZ(context.Background(), tt.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
 
-			grpcServer.GracefulStop()
-			<-done
+			if tt.check != nil {
+				tt.check(t, resp)
+			}
+
+			mockPVZ.AssertExpectations(t)
 		})
 	}
 }
+
+// newTestClient поднимает сервер на случайном порту и останавливает его
+// после теста.
+func newTestClient(t *testing.T, mockPVZ *pvzgrpc.MockPVZ) pvzv1.PVZServiceClient {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	pvzgrpc.Register(grpcServer, mockPVZ)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_ = grpcServer.Serve(lis)
+	}()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		grpcServer.GracefulStop()
+		<-done
+	})
+
+	return pvzv1.NewPVZServiceClient(conn)
+}
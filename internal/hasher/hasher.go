@@ -0,0 +1,58 @@
+// Package hasher хеширует пароли пользователей.
+package hasher
+
+// Algorithm реализация конкретного алгоритма хеширования.
+type Algorithm interface {
+	Hash(password string) (string, error)
+	Verify(hash, password string) bool
+	// Identify сообщает, что хеш создан этим алгоритмом.
+	Identify(hash string) bool
+	// NeedsRehash сообщает, что хеш создан с устаревшими параметрами.
+	NeedsRehash(hash string) bool
+}
+
+// Hasher хеширует пароли основным алгоритмом и проверяет хеши, созданные
+// основным или одним из устаревших алгоритмов. Устаревшие хеши
+// заменяются при следующем успешном входе.
+type Hasher struct {
+	primary Algorithm
+	legacy  []Algorithm
+}
+
+func New(primary Algorithm, legacy ...Algorithm) *Hasher {
+	return &Hasher{
+		primary: primary,
+		legacy:  legacy,
+	}
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	return h.primary.Hash(password)
+}
+
+func (h *Hasher) Verify(hash, password string) bool {
+	algorithm, ok := h.identify(hash)
+	if !ok {
+		return false
+	}
+
+	return algorithm.Verify(hash, password)
+}
+
+func (h *Hasher) NeedsRehash(hash string) bool {
+	return !h.primary.Identify(hash) || h.primary.NeedsRehash(hash)
+}
+
+func (h *Hasher) identify(hash string) (Algorithm, bool) {
+	if h.primary.Identify(hash) {
+		return h.primary, true
+	}
+
+	for _, algorithm := range h.legacy {
+		if algorithm.Identify(hash) {
+			return algorithm, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,104 @@
+package hasher_test
+
+import (
+	"strings"
+	"testing"
+
+	"avito_pvz/internal/hasher"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testParams = hasher.Argon2Params{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestArgon2id_HashVerify(t *testing.T) {
+	t.Parallel()
+
+	a := hasher.NewArgon2id(testParams)
+
+	hash, err := a.Hash("securePassword123")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	other, err := a.Hash("securePassword123")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "salt must be random")
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		want     bool
+	}{
+		{name: "valid_password", hash: hash, password: "securePassword123", want: true},
+		{name: "invalid_password", hash: hash, password: "wrongPassword", want: false},
+		{name: "empty_password", hash: hash, password: "", want: false},
+		{name: "password_case_sensitive", hash: hash, password: "SECUREPASSWORD123", want: false},
+		{name: "malformed_hash", hash: "$argon2id$v=19$m=1024", password: "securePassword123", want: false},
+		{name: "zero_parallelism", hash: "$argon2id$v=19$m=1024,t=1,p=0$c2FsdA$a2V5", password: "x", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, a.Verify(tt.hash, tt.password))
+		})
+	}
+}
+
+func TestArgon2id_NeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	a := hasher.NewArgon2id(testParams)
+
+	hash, err := a.Hash("securePassword123")
+	require.NoError(t, err)
+	assert.False(t, a.NeedsRehash(hash))
+
+	stronger := testParams
+	stronger.Iterations = 2
+	assert.True(t, hasher.NewArgon2id(stronger).NeedsRehash(hash))
+	assert.True(t, a.NeedsRehash("garbage"))
+}
+
+func TestHasher_UpgradesLegacyBcrypt(t *testing.T) {
+	t.Parallel()
+
+	legacy := hasher.NewBcrypt(bcrypt.MinCost)
+	h := hasher.New(hasher.NewArgon2id(testParams), legacy)
+
+	old, err := legacy.Hash("P@ssw0rd123!")
+	require.NoError(t, err)
+
+	assert.True(t, h.Verify(old, "P@ssw0rd123!"))
+	assert.False(t, h.Verify(old, "p@ssw0rd123!"))
+	assert.True(t, h.NeedsRehash(old))
+
+	fresh, err := h.Hash("P@ssw0rd123!")
+	require.NoError(t, err)
+	assert.True(t, h.Verify(fresh, "P@ssw0rd123!"))
+	assert.False(t, h.NeedsRehash(fresh))
+
+	assert.False(t, h.Verify("hashedPassword123", "hashedPassword123"))
+}
+
+func TestBcrypt_NeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	b := hasher.NewBcrypt(bcrypt.MinCost)
+
+	hash, err := b.Hash("securePassword123")
+	require.NoError(t, err)
+	assert.True(t, b.Identify(hash))
+	assert.False(t, b.NeedsRehash(hash))
+	assert.True(t, hasher.NewBcrypt(bcrypt.MinCost+1).NeedsRehash(hash))
+}
@@ -39,1009 +39,4348 @@ func (_m *MockServerInterface) EXPECT() *MockServerInterface_Expecter {
 	return &MockServerInterface_Expecter{mock: &_m.Mock}
 }
 
-// GetPvz provides a mock function for the type MockServerInterface
-func (_mock *MockServerInterface) GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams) {
-	_mock.Called(w, r, params)
+// DeleteApiKeysKeyId provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) DeleteApiKeysKeyId(w http.ResponseWriter, r *http.Request, keyId types.UUID) {
+	_mock.Called(w, r, keyId)
 	return
 }
 
-// MockServerInterface_GetPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvz'
-type MockServerInterface_GetPvz_Call struct {
+// MockServerInterface_DeleteApiKeysKeyId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteApiKeysKeyId'
+type MockServerInterface_DeleteApiKeysKeyId_Call struct {
 	*mock.Call
 }
 
-// GetPvz is a helper method to define mock.On call
+// DeleteApiKeysKeyId is a helper method to define mock.On call
 //   - w
 //   - r
-//   - params
-func (_e *MockServerInterface_Expecter) GetPvz(w interface{}, r interface{}, params interface{}) *MockServerInterface_GetPvz_Call {
-	return &MockServerInterface_GetPvz_Call{Call: _e.mock.On("GetPvz", w, r, params)}
+//   - keyId
+func (_e *MockServerInterface_Expecter) DeleteApiKeysKeyId(w interface{}, r interface{}, keyId interface{}) *MockServerInterface_DeleteApiKeysKeyId_Call {
+	return &MockServerInterface_DeleteApiKeysKeyId_Call{Call: _e.mock.On("DeleteApiKeysKeyId", w, r, keyId)}
 }
 
-func (_c *MockServerInterface_GetPvz_Call) Run(run func(w http.ResponseWriter, r *http.Request, params GetPvzParams)) *MockServerInterface_GetPvz_Call {
+func (_c *MockServerInterface_DeleteApiKeysKeyId_Call) Run(run func(w http.ResponseWriter, r *http.Request, keyId types.UUID)) *MockServerInterface_DeleteApiKeysKeyId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(GetPvzParams))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
 	})
 	return _c
 }
 
-func (_c *MockServerInterface_GetPvz_Call) Return() *MockServerInterface_GetPvz_Call {
+func (_c *MockServerInterface_DeleteApiKeysKeyId_Call) Return() *MockServerInterface_DeleteApiKeysKeyId_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServerInterface_GetPvz_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, params GetPvzParams)) *MockServerInterface_GetPvz_Call {
+func (_c *MockServerInterface_DeleteApiKeysKeyId_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, keyId types.UUID)) *MockServerInterface_DeleteApiKeysKeyId_Call {
 	_c.Run(run)
 	return _c
 }
 
-// PostDummyLogin provides a mock function for the type MockServerInterface
-func (_mock *MockServerInterface) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
+// DeletePvzPvzIdStaffUserId provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) DeletePvzPvzIdStaffUserId(w http.ResponseWriter, r *http.Request, pvzId types.UUID, userId types.UUID) {
+	_mock.Called(w, r, pvzId, userId)
 	return
 }
 
-// MockServerInterface_PostDummyLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostDummyLogin'
-type MockServerInterface_PostDummyLogin_Call struct {
+// MockServerInterface_DeletePvzPvzIdStaffUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePvzPvzIdStaffUserId'
+type MockServerInterface_DeletePvzPvzIdStaffUserId_Call struct {
 	*mock.Call
 }
 
-// PostDummyLogin is a helper method to define mock.On call
+// DeletePvzPvzIdStaffUserId is a helper method to define mock.On call
 //   - w
 //   - r
-func (_e *MockServerInterface_Expecter) PostDummyLogin(w interface{}, r interface{}) *MockServerInterface_PostDummyLogin_Call {
-	return &MockServerInterface_PostDummyLogin_Call{Call: _e.mock.On("PostDummyLogin", w, r)}
+//   - pvzId
+//   - userId
+func (_e *MockServerInterface_Expecter) DeletePvzPvzIdStaffUserId(w interface{}, r interface{}, pvzId interface{}, userId interface{}) *MockServerInterface_DeletePvzPvzIdStaffUserId_Call {
+	return &MockServerInterface_DeletePvzPvzIdStaffUserId_Call{Call: _e.mock.On("DeletePvzPvzIdStaffUserId", w, r, pvzId, userId)}
 }
 
-func (_c *MockServerInterface_PostDummyLogin_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostDummyLogin_Call {
+func (_c *MockServerInterface_DeletePvzPvzIdStaffUserId_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID, userId types.UUID)) *MockServerInterface_DeletePvzPvzIdStaffUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID), args[3].(types.UUID))
 	})
 	return _c
 }
 
-func (_c *MockServerInterface_PostDummyLogin_Call) Return() *MockServerInterface_PostDummyLogin_Call {
+func (_c *MockServerInterface_DeletePvzPvzIdStaffUserId_Call) Return() *MockServerInterface_DeletePvzPvzIdStaffUserId_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServerInterface_PostDummyLogin_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostDummyLogin_Call {
+func (_c *MockServerInterface_DeletePvzPvzIdStaffUserId_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID, userId types.UUID)) *MockServerInterface_DeletePvzPvzIdStaffUserId_Call {
 	_c.Run(run)
 	return _c
 }
 
-// PostLogin provides a mock function for the type MockServerInterface
-func (_mock *MockServerInterface) PostLogin(w http.ResponseWriter, r *http.Request) {
+// GetApiKeys provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetApiKeys(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
 	return
 }
 
-// MockServerInterface_PostLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostLogin'
-type MockServerInterface_PostLogin_Call struct {
+// MockServerInterface_GetApiKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApiKeys'
+type MockServerInterface_GetApiKeys_Call struct {
 	*mock.Call
 }
 
-// PostLogin is a helper method to define mock.On call
+// GetApiKeys is a helper method to define mock.On call
 //   - w
 //   - r
-func (_e *MockServerInterface_Expecter) PostLogin(w interface{}, r interface{}) *MockServerInterface_PostLogin_Call {
-	return &MockServerInterface_PostLogin_Call{Call: _e.mock.On("PostLogin", w, r)}
+func (_e *MockServerInterface_Expecter) GetApiKeys(w interface{}, r interface{}) *MockServerInterface_GetApiKeys_Call {
+	return &MockServerInterface_GetApiKeys_Call{Call: _e.mock.On("GetApiKeys", w, r)}
 }
 
-func (_c *MockServerInterface_PostLogin_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostLogin_Call {
+func (_c *MockServerInterface_GetApiKeys_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_GetApiKeys_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *MockServerInterface_PostLogin_Call) Return() *MockServerInterface_PostLogin_Call {
+func (_c *MockServerInterface_GetApiKeys_Call) Return() *MockServerInterface_GetApiKeys_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServerInterface_PostLogin_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostLogin_Call {
+func (_c *MockServerInterface_GetApiKeys_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_GetApiKeys_Call {
 	_c.Run(run)
 	return _c
 }
 
-// PostProducts provides a mock function for the type MockServerInterface
-func (_mock *MockServerInterface) PostProducts(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
+// GetAudit provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	_mock.Called(w, r, params)
 	return
 }
 
-// MockServerInterface_PostProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostProducts'
-type MockServerInterface_PostProducts_Call struct {
+// MockServerInterface_GetAudit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAudit'
+type MockServerInterface_GetAudit_Call struct {
 	*mock.Call
 }
 
-// PostProducts is a helper method to define mock.On call
+// GetAudit is a helper method to define mock.On call
 //   - w
 //   - r
-func (_e *MockServerInterface_Expecter) PostProducts(w interface{}, r interface{}) *MockServerInterface_PostProducts_Call {
-	return &MockServerInterface_PostProducts_Call{Call: _e.mock.On("PostProducts", w, r)}
+//   - params
+func (_e *MockServerInterface_Expecter) GetAudit(w interface{}, r interface{}, params interface{}) *MockServerInterface_GetAudit_Call {
+	return &MockServerInterface_GetAudit_Call{Call: _e.mock.On("GetAudit", w, r, params)}
 }
 
-func (_c *MockServerInterface_PostProducts_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostProducts_Call {
+func (_c *MockServerInterface_GetAudit_Call) Run(run func(w http.ResponseWriter, r *http.Request, params GetAuditParams)) *MockServerInterface_GetAudit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(GetAuditParams))
 	})
 	return _c
 }
 
-func (_c *MockServerInterface_PostProducts_Call) Return() *MockServerInterface_PostProducts_Call {
+func (_c *MockServerInterface_GetAudit_Call) Return() *MockServerInterface_GetAudit_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServerInterface_PostProducts_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostProducts_Call {
+func (_c *MockServerInterface_GetAudit_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, params GetAuditParams)) *MockServerInterface_GetAudit_Call {
 	_c.Run(run)
 	return _c
 }
 
-// PostPvz provides a mock function for the type MockServerInterface
-func (_mock *MockServerInterface) PostPvz(w http.ResponseWriter, r *http.Request) {
+// GetCities provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetCities(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
 	return
 }
 
-// MockServerInterface_PostPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvz'
-type MockServerInterface_PostPvz_Call struct {
+// MockServerInterface_GetCities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCities'
+type MockServerInterface_GetCities_Call struct {
 	*mock.Call
 }
 
-// PostPvz is a helper method to define mock.On call
+// GetCities is a helper method to define mock.On call
 //   - w
 //   - r
-func (_e *MockServerInterface_Expecter) PostPvz(w interface{}, r interface{}) *MockServerInterface_PostPvz_Call {
-	return &MockServerInterface_PostPvz_Call{Call: _e.mock.On("PostPvz", w, r)}
+func (_e *MockServerInterface_Expecter) GetCities(w interface{}, r interface{}) *MockServerInterface_GetCities_Call {
+	return &MockServerInterface_GetCities_Call{Call: _e.mock.On("GetCities", w, r)}
 }
 
-func (_c *MockServerInterface_PostPvz_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostPvz_Call {
+func (_c *MockServerInterface_GetCities_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_GetCities_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *MockServerInterface_PostPvz_Call) Return() *MockServerInterface_PostPvz_Call {
+func (_c *MockServerInterface_GetCities_Call) Return() *MockServerInterface_GetCities_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServerInterface_PostPvz_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostPvz_Call {
+func (_c *MockServerInterface_GetCities_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_GetCities_Call {
 	_c.Run(run)
 	return _c
 }
 
-// PostPvzPvzIdCloseLastReception provides a mock function for the type MockServerInterface
-func (_mock *MockServerInterface) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
-	_mock.Called(w, r, pvzId)
+// GetProductTypes provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetProductTypes(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
 	return
 }
 
-// MockServerInterface_PostPvzPvzIdCloseLastReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdCloseLastReception'
-type MockServerInterface_PostPvzPvzIdCloseLastReception_Call struct {
+// MockServerInterface_GetProductTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductTypes'
+type MockServerInterface_GetProductTypes_Call struct {
 	*mock.Call
 }
 
-// PostPvzPvzIdCloseLastReception is a helper method to define mock.On call
+// GetProductTypes is a helper method to define mock.On call
 //   - w
 //   - r
-//   - pvzId
-func (_e *MockServerInterface_Expecter) PostPvzPvzIdCloseLastReception(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdCloseLastReception_Call {
-	return &MockServerInterface_PostPvzPvzIdCloseLastReception_Call{Call: _e.mock.On("PostPvzPvzIdCloseLastReception", w, r, pvzId)}
+func (_e *MockServerInterface_Expecter) GetProductTypes(w interface{}, r interface{}) *MockServerInterface_GetProductTypes_Call {
+	return &MockServerInterface_GetProductTypes_Call{Call: _e.mock.On("GetProductTypes", w, r)}
 }
 
-func (_c *MockServerInterface_PostPvzPvzIdCloseLastReception_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdCloseLastReception_Call {
+func (_c *MockServerInterface_GetProductTypes_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_GetProductTypes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *MockServerInterface_PostPvzPvzIdCloseLastReception_Call) Return() *MockServerInterface_PostPvzPvzIdCloseLastReception_Call {
+func (_c *MockServerInterface_GetProductTypes_Call) Return() *MockServerInterface_GetProductTypes_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServerInterface_PostPvzPvzIdCloseLastReception_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdCloseLastReception_Call {
+func (_c *MockServerInterface_GetProductTypes_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_GetProductTypes_Call {
 	_c.Run(run)
 	return _c
 }
 
-// PostPvzPvzIdDeleteLastProduct provides a mock function for the type MockServerInterface
-func (_mock *MockServerInterface) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
-	_mock.Called(w, r, pvzId)
+// GetPvz provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams) {
+	_mock.Called(w, r, params)
 	return
 }
 
-// MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdDeleteLastProduct'
-type MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call struct {
+// MockServerInterface_GetPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvz'
+type MockServerInterface_GetPvz_Call struct {
 	*mock.Call
 }
 
-// PostPvzPvzIdDeleteLastProduct is a helper method to define mock.On call
+// GetPvz is a helper method to define mock.On call
 //   - w
 //   - r
-//   - pvzId
-func (_e *MockServerInterface_Expecter) PostPvzPvzIdDeleteLastProduct(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
-	return &MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call{Call: _e.mock.On("PostPvzPvzIdDeleteLastProduct", w, r, pvzId)}
+//   - params
+func (_e *MockServerInterface_Expecter) GetPvz(w interface{}, r interface{}, params interface{}) *MockServerInterface_GetPvz_Call {
+	return &MockServerInterface_GetPvz_Call{Call: _e.mock.On("GetPvz", w, r, params)}
 }
 
-func (_c *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+func (_c *MockServerInterface_GetPvz_Call) Run(run func(w http.ResponseWriter, r *http.Request, params GetPvzParams)) *MockServerInterface_GetPvz_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(GetPvzParams))
 	})
 	return _c
 }
 
-func (_c *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call) Return() *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+func (_c *MockServerInterface_GetPvz_Call) Return() *MockServerInterface_GetPvz_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+func (_c *MockServerInterface_GetPvz_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, params GetPvzParams)) *MockServerInterface_GetPvz_Call {
 	_c.Run(run)
 	return _c
 }
 
-// PostReceptions provides a mock function for the type MockServerInterface
-func (_mock *MockServerInterface) PostReceptions(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
+// GetPvzNearest provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetPvzNearest(w http.ResponseWriter, r *http.Request, params GetPvzNearestParams) {
+	_mock.Called(w, r, params)
 	return
 }
 
-// MockServerInterface_PostReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostReceptions'
-type MockServerInterface_PostReceptions_Call struct {
+// MockServerInterface_GetPvzNearest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzNearest'
+type MockServerInterface_GetPvzNearest_Call struct {
 	*mock.Call
 }
 
-// PostReceptions is a helper method to define mock.On call
+// GetPvzNearest is a helper method to define mock.On call
 //   - w
 //   - r
-func (_e *MockServerInterface_Expecter) PostReceptions(w interface{}, r interface{}) *MockServerInterface_PostReceptions_Call {
-	return &MockServerInterface_PostReceptions_Call{Call: _e.mock.On("PostReceptions", w, r)}
+//   - params
+func (_e *MockServerInterface_Expecter) GetPvzNearest(w interface{}, r interface{}, params interface{}) *MockServerInterface_GetPvzNearest_Call {
+	return &MockServerInterface_GetPvzNearest_Call{Call: _e.mock.On("GetPvzNearest", w, r, params)}
 }
 
-func (_c *MockServerInterface_PostReceptions_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostReceptions_Call {
+func (_c *MockServerInterface_GetPvzNearest_Call) Run(run func(w http.ResponseWriter, r *http.Request, params GetPvzNearestParams)) *MockServerInterface_GetPvzNearest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(GetPvzNearestParams))
 	})
 	return _c
 }
 
-func (_c *MockServerInterface_PostReceptions_Call) Return() *MockServerInterface_PostReceptions_Call {
+func (_c *MockServerInterface_GetPvzNearest_Call) Return() *MockServerInterface_GetPvzNearest_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServerInterface_PostReceptions_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostReceptions_Call {
+func (_c *MockServerInterface_GetPvzNearest_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, params GetPvzNearestParams)) *MockServerInterface_GetPvzNearest_Call {
 	_c.Run(run)
 	return _c
 }
 
-// PostRegister provides a mock function for the type MockServerInterface
-func (_mock *MockServerInterface) PostRegister(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
+// GetPvzPvzIdHistory provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetPvzPvzIdHistory(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
 	return
 }
 
-// MockServerInterface_PostRegister_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostRegister'
-type MockServerInterface_PostRegister_Call struct {
+// MockServerInterface_GetPvzPvzIdHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzPvzIdHistory'
+type MockServerInterface_GetPvzPvzIdHistory_Call struct {
 	*mock.Call
 }
 
-// PostRegister is a helper method to define mock.On call
+// GetPvzPvzIdHistory is a helper method to define mock.On call
 //   - w
 //   - r
-func (_e *MockServerInterface_Expecter) PostRegister(w interface{}, r interface{}) *MockServerInterface_PostRegister_Call {
-	return &MockServerInterface_PostRegister_Call{Call: _e.mock.On("PostRegister", w, r)}
+//   - pvzId
+func (_e *MockServerInterface_Expecter) GetPvzPvzIdHistory(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_GetPvzPvzIdHistory_Call {
+	return &MockServerInterface_GetPvzPvzIdHistory_Call{Call: _e.mock.On("GetPvzPvzIdHistory", w, r, pvzId)}
 }
 
-func (_c *MockServerInterface_PostRegister_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostRegister_Call {
+func (_c *MockServerInterface_GetPvzPvzIdHistory_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_GetPvzPvzIdHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
 	})
 	return _c
 }
 
-func (_c *MockServerInterface_PostRegister_Call) Return() *MockServerInterface_PostRegister_Call {
+func (_c *MockServerInterface_GetPvzPvzIdHistory_Call) Return() *MockServerInterface_GetPvzPvzIdHistory_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServerInterface_PostRegister_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostRegister_Call {
+func (_c *MockServerInterface_GetPvzPvzIdHistory_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_GetPvzPvzIdHistory_Call {
 	_c.Run(run)
 	return _c
 }
 
-// NewMockServeMux creates a new instance of MockServeMux. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockServeMux(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockServeMux {
-	mock := &MockServeMux{}
-	mock.Mock.Test(t)
+// GetPvzPvzIdStaff provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetPvzPvzIdStaff(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+// MockServerInterface_GetPvzPvzIdStaff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzPvzIdStaff'
+type MockServerInterface_GetPvzPvzIdStaff_Call struct {
+	*mock.Call
+}
 
-	return mock
+// GetPvzPvzIdStaff is a helper method to define mock.On call
+//   - w
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) GetPvzPvzIdStaff(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_GetPvzPvzIdStaff_Call {
+	return &MockServerInterface_GetPvzPvzIdStaff_Call{Call: _e.mock.On("GetPvzPvzIdStaff", w, r, pvzId)}
 }
 
-// MockServeMux is an autogenerated mock type for the ServeMux type
-type MockServeMux struct {
-	mock.Mock
+func (_c *MockServerInterface_GetPvzPvzIdStaff_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_GetPvzPvzIdStaff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+	})
+	return _c
 }
 
-type MockServeMux_Expecter struct {
-	mock *mock.Mock
+func (_c *MockServerInterface_GetPvzPvzIdStaff_Call) Return() *MockServerInterface_GetPvzPvzIdStaff_Call {
+	_c.Call.Return()
+	return _c
 }
 
-func (_m *MockServeMux) EXPECT() *MockServeMux_Expecter {
-	return &MockServeMux_Expecter{mock: &_m.Mock}
+func (_c *MockServerInterface_GetPvzPvzIdStaff_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_GetPvzPvzIdStaff_Call {
+	_c.Run(run)
+	return _c
 }
 
-// HandleFunc provides a mock function for the type MockServeMux
-func (_mock *MockServeMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
-	_mock.Called(pattern, handler)
+// GetReceptionsReceptionIdReconciliation provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetReceptionsReceptionIdReconciliation(w http.ResponseWriter, r *http.Request, receptionId types.UUID) {
+	_mock.Called(w, r, receptionId)
 	return
 }
 
-// MockServeMux_HandleFunc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleFunc'
-type MockServeMux_HandleFunc_Call struct {
+// MockServerInterface_GetReceptionsReceptionIdReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReceptionsReceptionIdReconciliation'
+type MockServerInterface_GetReceptionsReceptionIdReconciliation_Call struct {
 	*mock.Call
 }
 
-// HandleFunc is a helper method to define mock.On call
-//   - pattern
-//   - handler
-func (_e *MockServeMux_Expecter) HandleFunc(pattern interface{}, handler interface{}) *MockServeMux_HandleFunc_Call {
-	return &MockServeMux_HandleFunc_Call{Call: _e.mock.On("HandleFunc", pattern, handler)}
+// GetReceptionsReceptionIdReconciliation is a helper method to define mock.On call
+//   - w
+//   - r
+//   - receptionId
+func (_e *MockServerInterface_Expecter) GetReceptionsReceptionIdReconciliation(w interface{}, r interface{}, receptionId interface{}) *MockServerInterface_GetReceptionsReceptionIdReconciliation_Call {
+	return &MockServerInterface_GetReceptionsReceptionIdReconciliation_Call{Call: _e.mock.On("GetReceptionsReceptionIdReconciliation", w, r, receptionId)}
 }
 
-func (_c *MockServeMux_HandleFunc_Call) Run(run func(pattern string, handler func(http.ResponseWriter, *http.Request))) *MockServeMux_HandleFunc_Call {
+func (_c *MockServerInterface_GetReceptionsReceptionIdReconciliation_Call) Run(run func(w http.ResponseWriter, r *http.Request, receptionId types.UUID)) *MockServerInterface_GetReceptionsReceptionIdReconciliation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(func(http.ResponseWriter, *http.Request)))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
 	})
 	return _c
 }
 
-func (_c *MockServeMux_HandleFunc_Call) Return() *MockServeMux_HandleFunc_Call {
+func (_c *MockServerInterface_GetReceptionsReceptionIdReconciliation_Call) Return() *MockServerInterface_GetReceptionsReceptionIdReconciliation_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServeMux_HandleFunc_Call) RunAndReturn(run func(pattern string, handler func(http.ResponseWriter, *http.Request))) *MockServeMux_HandleFunc_Call {
+func (_c *MockServerInterface_GetReceptionsReceptionIdReconciliation_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, receptionId types.UUID)) *MockServerInterface_GetReceptionsReceptionIdReconciliation_Call {
 	_c.Run(run)
 	return _c
 }
 
-// ServeHTTP provides a mock function for the type MockServeMux
-func (_mock *MockServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
+// GetUsers provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetUsers(w http.ResponseWriter, r *http.Request, params GetUsersParams) {
+	_mock.Called(w, r, params)
 	return
 }
 
-// MockServeMux_ServeHTTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServeHTTP'
-type MockServeMux_ServeHTTP_Call struct {
+// MockServerInterface_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type MockServerInterface_GetUsers_Call struct {
 	*mock.Call
 }
 
-// ServeHTTP is a helper method to define mock.On call
+// GetUsers is a helper method to define mock.On call
 //   - w
 //   - r
-func (_e *MockServeMux_Expecter) ServeHTTP(w interface{}, r interface{}) *MockServeMux_ServeHTTP_Call {
-	return &MockServeMux_ServeHTTP_Call{Call: _e.mock.On("ServeHTTP", w, r)}
+//   - params
+func (_e *MockServerInterface_Expecter) GetUsers(w interface{}, r interface{}, params interface{}) *MockServerInterface_GetUsers_Call {
+	return &MockServerInterface_GetUsers_Call{Call: _e.mock.On("GetUsers", w, r, params)}
 }
 
-func (_c *MockServeMux_ServeHTTP_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServeMux_ServeHTTP_Call {
+func (_c *MockServerInterface_GetUsers_Call) Run(run func(w http.ResponseWriter, r *http.Request, params GetUsersParams)) *MockServerInterface_GetUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(GetUsersParams))
 	})
 	return _c
 }
 
-func (_c *MockServeMux_ServeHTTP_Call) Return() *MockServeMux_ServeHTTP_Call {
+func (_c *MockServerInterface_GetUsers_Call) Return() *MockServerInterface_GetUsers_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockServeMux_ServeHTTP_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServeMux_ServeHTTP_Call {
+func (_c *MockServerInterface_GetUsers_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, params GetUsersParams)) *MockServerInterface_GetUsers_Call {
 	_c.Run(run)
 	return _c
 }
 
-// NewMockPostDummyLoginResponseObject creates a new instance of MockPostDummyLoginResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPostDummyLoginResponseObject(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPostDummyLoginResponseObject {
-	mock := &MockPostDummyLoginResponseObject{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+// GetWellKnownJwksJson provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
 }
 
-// MockPostDummyLoginResponseObject is an autogenerated mock type for the PostDummyLoginResponseObject type
-type MockPostDummyLoginResponseObject struct {
-	mock.Mock
+// MockServerInterface_GetWellKnownJwksJson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWellKnownJwksJson'
+type MockServerInterface_GetWellKnownJwksJson_Call struct {
+	*mock.Call
 }
 
-type MockPostDummyLoginResponseObject_Expecter struct {
-	mock *mock.Mock
+// GetWellKnownJwksJson is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServerInterface_Expecter) GetWellKnownJwksJson(w interface{}, r interface{}) *MockServerInterface_GetWellKnownJwksJson_Call {
+	return &MockServerInterface_GetWellKnownJwksJson_Call{Call: _e.mock.On("GetWellKnownJwksJson", w, r)}
 }
 
-func (_m *MockPostDummyLoginResponseObject) EXPECT() *MockPostDummyLoginResponseObject_Expecter {
-	return &MockPostDummyLoginResponseObject_Expecter{mock: &_m.Mock}
+func (_c *MockServerInterface_GetWellKnownJwksJson_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_GetWellKnownJwksJson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
 }
 
-// VisitPostDummyLoginResponse provides a mock function for the type MockPostDummyLoginResponseObject
-func (_mock *MockPostDummyLoginResponseObject) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	ret := _mock.Called(w)
+func (_c *MockServerInterface_GetWellKnownJwksJson_Call) Return() *MockServerInterface_GetWellKnownJwksJson_Call {
+	_c.Call.Return()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for VisitPostDummyLoginResponse")
-	}
+func (_c *MockServerInterface_GetWellKnownJwksJson_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_GetWellKnownJwksJson_Call {
+	_c.Run(run)
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
-		r0 = returnFunc(w)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// PatchPvzPvzId provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PatchPvzPvzId(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
 }
 
-// MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostDummyLoginResponse'
-type MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call struct {
+// MockServerInterface_PatchPvzPvzId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchPvzPvzId'
+type MockServerInterface_PatchPvzPvzId_Call struct {
 	*mock.Call
 }
 
-// VisitPostDummyLoginResponse is a helper method to define mock.On call
+// PatchPvzPvzId is a helper method to define mock.On call
 //   - w
-func (_e *MockPostDummyLoginResponseObject_Expecter) VisitPostDummyLoginResponse(w interface{}) *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call {
-	return &MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call{Call: _e.mock.On("VisitPostDummyLoginResponse", w)}
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) PatchPvzPvzId(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PatchPvzPvzId_Call {
+	return &MockServerInterface_PatchPvzPvzId_Call{Call: _e.mock.On("PatchPvzPvzId", w, r, pvzId)}
 }
 
-func (_c *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call {
+func (_c *MockServerInterface_PatchPvzPvzId_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PatchPvzPvzId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
 	})
 	return _c
 }
 
-func (_c *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call) Return(err error) *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call {
-	_c.Call.Return(err)
+func (_c *MockServerInterface_PatchPvzPvzId_Call) Return() *MockServerInterface_PatchPvzPvzId_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call {
-	_c.Call.Return(run)
+func (_c *MockServerInterface_PatchPvzPvzId_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PatchPvzPvzId_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockPostLoginResponseObject creates a new instance of MockPostLoginResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPostLoginResponseObject(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPostLoginResponseObject {
-	mock := &MockPostLoginResponseObject{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+// PostApiKeys provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostApiKeys(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
 }
 
-// MockPostLoginResponseObject is an autogenerated mock type for the PostLoginResponseObject type
-type MockPostLoginResponseObject struct {
-	mock.Mock
+// MockServerInterface_PostApiKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostApiKeys'
+type MockServerInterface_PostApiKeys_Call struct {
+	*mock.Call
 }
 
-type MockPostLoginResponseObject_Expecter struct {
-	mock *mock.Mock
+// PostApiKeys is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServerInterface_Expecter) PostApiKeys(w interface{}, r interface{}) *MockServerInterface_PostApiKeys_Call {
+	return &MockServerInterface_PostApiKeys_Call{Call: _e.mock.On("PostApiKeys", w, r)}
 }
 
-func (_m *MockPostLoginResponseObject) EXPECT() *MockPostLoginResponseObject_Expecter {
-	return &MockPostLoginResponseObject_Expecter{mock: &_m.Mock}
+func (_c *MockServerInterface_PostApiKeys_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostApiKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
 }
 
-// VisitPostLoginResponse provides a mock function for the type MockPostLoginResponseObject
-func (_mock *MockPostLoginResponseObject) VisitPostLoginResponse(w http.ResponseWriter) error {
-	ret := _mock.Called(w)
+func (_c *MockServerInterface_PostApiKeys_Call) Return() *MockServerInterface_PostApiKeys_Call {
+	_c.Call.Return()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for VisitPostLoginResponse")
-	}
+func (_c *MockServerInterface_PostApiKeys_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostApiKeys_Call {
+	_c.Run(run)
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
-		r0 = returnFunc(w)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// PostCities provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostCities(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
 }
 
-// MockPostLoginResponseObject_VisitPostLoginResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostLoginResponse'
-type MockPostLoginResponseObject_VisitPostLoginResponse_Call struct {
+// MockServerInterface_PostCities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostCities'
+type MockServerInterface_PostCities_Call struct {
 	*mock.Call
 }
 
-// VisitPostLoginResponse is a helper method to define mock.On call
+// PostCities is a helper method to define mock.On call
 //   - w
-func (_e *MockPostLoginResponseObject_Expecter) VisitPostLoginResponse(w interface{}) *MockPostLoginResponseObject_VisitPostLoginResponse_Call {
-	return &MockPostLoginResponseObject_VisitPostLoginResponse_Call{Call: _e.mock.On("VisitPostLoginResponse", w)}
+//   - r
+func (_e *MockServerInterface_Expecter) PostCities(w interface{}, r interface{}) *MockServerInterface_PostCities_Call {
+	return &MockServerInterface_PostCities_Call{Call: _e.mock.On("PostCities", w, r)}
 }
 
-func (_c *MockPostLoginResponseObject_VisitPostLoginResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostLoginResponseObject_VisitPostLoginResponse_Call {
+func (_c *MockServerInterface_PostCities_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostCities_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *MockPostLoginResponseObject_VisitPostLoginResponse_Call) Return(err error) *MockPostLoginResponseObject_VisitPostLoginResponse_Call {
-	_c.Call.Return(err)
+func (_c *MockServerInterface_PostCities_Call) Return() *MockServerInterface_PostCities_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockPostLoginResponseObject_VisitPostLoginResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostLoginResponseObject_VisitPostLoginResponse_Call {
-	_c.Call.Return(run)
+func (_c *MockServerInterface_PostCities_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostCities_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockPostProductsResponseObject creates a new instance of MockPostProductsResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPostProductsResponseObject(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPostProductsResponseObject {
-	mock := &MockPostProductsResponseObject{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+// PostCitiesCityIdActivate provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostCitiesCityIdActivate(w http.ResponseWriter, r *http.Request, cityId CityId) {
+	_mock.Called(w, r, cityId)
+	return
 }
 
-// MockPostProductsResponseObject is an autogenerated mock type for the PostProductsResponseObject type
-type MockPostProductsResponseObject struct {
-	mock.Mock
+// MockServerInterface_PostCitiesCityIdActivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostCitiesCityIdActivate'
+type MockServerInterface_PostCitiesCityIdActivate_Call struct {
+	*mock.Call
 }
 
-type MockPostProductsResponseObject_Expecter struct {
-	mock *mock.Mock
+// PostCitiesCityIdActivate is a helper method to define mock.On call
+//   - w
+//   - r
+//   - cityId
+func (_e *MockServerInterface_Expecter) PostCitiesCityIdActivate(w interface{}, r interface{}, cityId interface{}) *MockServerInterface_PostCitiesCityIdActivate_Call {
+	return &MockServerInterface_PostCitiesCityIdActivate_Call{Call: _e.mock.On("PostCitiesCityIdActivate", w, r, cityId)}
 }
 
-func (_m *MockPostProductsResponseObject) EXPECT() *MockPostProductsResponseObject_Expecter {
-	return &MockPostProductsResponseObject_Expecter{mock: &_m.Mock}
+func (_c *MockServerInterface_PostCitiesCityIdActivate_Call) Run(run func(w http.ResponseWriter, r *http.Request, cityId CityId)) *MockServerInterface_PostCitiesCityIdActivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(CityId))
+	})
+	return _c
 }
 
-// VisitPostProductsResponse provides a mock function for the type MockPostProductsResponseObject
-func (_mock *MockPostProductsResponseObject) VisitPostProductsResponse(w http.ResponseWriter) error {
-	ret := _mock.Called(w)
+func (_c *MockServerInterface_PostCitiesCityIdActivate_Call) Return() *MockServerInterface_PostCitiesCityIdActivate_Call {
+	_c.Call.Return()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for VisitPostProductsResponse")
-	}
+func (_c *MockServerInterface_PostCitiesCityIdActivate_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, cityId CityId)) *MockServerInterface_PostCitiesCityIdActivate_Call {
+	_c.Run(run)
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
-		r0 = returnFunc(w)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// PostCitiesCityIdDeactivate provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostCitiesCityIdDeactivate(w http.ResponseWriter, r *http.Request, cityId CityId) {
+	_mock.Called(w, r, cityId)
+	return
 }
 
-// MockPostProductsResponseObject_VisitPostProductsResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostProductsResponse'
-type MockPostProductsResponseObject_VisitPostProductsResponse_Call struct {
+// MockServerInterface_PostCitiesCityIdDeactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostCitiesCityIdDeactivate'
+type MockServerInterface_PostCitiesCityIdDeactivate_Call struct {
 	*mock.Call
 }
 
-// VisitPostProductsResponse is a helper method to define mock.On call
+// PostCitiesCityIdDeactivate is a helper method to define mock.On call
 //   - w
-func (_e *MockPostProductsResponseObject_Expecter) VisitPostProductsResponse(w interface{}) *MockPostProductsResponseObject_VisitPostProductsResponse_Call {
-	return &MockPostProductsResponseObject_VisitPostProductsResponse_Call{Call: _e.mock.On("VisitPostProductsResponse", w)}
+//   - r
+//   - cityId
+func (_e *MockServerInterface_Expecter) PostCitiesCityIdDeactivate(w interface{}, r interface{}, cityId interface{}) *MockServerInterface_PostCitiesCityIdDeactivate_Call {
+	return &MockServerInterface_PostCitiesCityIdDeactivate_Call{Call: _e.mock.On("PostCitiesCityIdDeactivate", w, r, cityId)}
 }
 
-func (_c *MockPostProductsResponseObject_VisitPostProductsResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostProductsResponseObject_VisitPostProductsResponse_Call {
+func (_c *MockServerInterface_PostCitiesCityIdDeactivate_Call) Run(run func(w http.ResponseWriter, r *http.Request, cityId CityId)) *MockServerInterface_PostCitiesCityIdDeactivate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(CityId))
 	})
 	return _c
 }
 
-func (_c *MockPostProductsResponseObject_VisitPostProductsResponse_Call) Return(err error) *MockPostProductsResponseObject_VisitPostProductsResponse_Call {
-	_c.Call.Return(err)
+func (_c *MockServerInterface_PostCitiesCityIdDeactivate_Call) Return() *MockServerInterface_PostCitiesCityIdDeactivate_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockPostProductsResponseObject_VisitPostProductsResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostProductsResponseObject_VisitPostProductsResponse_Call {
-	_c.Call.Return(run)
+func (_c *MockServerInterface_PostCitiesCityIdDeactivate_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, cityId CityId)) *MockServerInterface_PostCitiesCityIdDeactivate_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockGetPvzResponseObject creates a new instance of MockGetPvzResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockGetPvzResponseObject(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockGetPvzResponseObject {
-	mock := &MockGetPvzResponseObject{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+// PostDummyLogin provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
 }
 
-// MockGetPvzResponseObject is an autogenerated mock type for the GetPvzResponseObject type
-type MockGetPvzResponseObject struct {
-	mock.Mock
+// MockServerInterface_PostDummyLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostDummyLogin'
+type MockServerInterface_PostDummyLogin_Call struct {
+	*mock.Call
 }
 
-type MockGetPvzResponseObject_Expecter struct {
-	mock *mock.Mock
+// PostDummyLogin is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServerInterface_Expecter) PostDummyLogin(w interface{}, r interface{}) *MockServerInterface_PostDummyLogin_Call {
+	return &MockServerInterface_PostDummyLogin_Call{Call: _e.mock.On("PostDummyLogin", w, r)}
 }
 
-func (_m *MockGetPvzResponseObject) EXPECT() *MockGetPvzResponseObject_Expecter {
-	return &MockGetPvzResponseObject_Expecter{mock: &_m.Mock}
+func (_c *MockServerInterface_PostDummyLogin_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostDummyLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
 }
 
-// VisitGetPvzResponse provides a mock function for the type MockGetPvzResponseObject
-func (_mock *MockGetPvzResponseObject) VisitGetPvzResponse(w http.ResponseWriter) error {
-	ret := _mock.Called(w)
+func (_c *MockServerInterface_PostDummyLogin_Call) Return() *MockServerInterface_PostDummyLogin_Call {
+	_c.Call.Return()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for VisitGetPvzResponse")
-	}
+func (_c *MockServerInterface_PostDummyLogin_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostDummyLogin_Call {
+	_c.Run(run)
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
-		r0 = returnFunc(w)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// PostLogin provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostLogin(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
 }
 
-// MockGetPvzResponseObject_VisitGetPvzResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetPvzResponse'
-type MockGetPvzResponseObject_VisitGetPvzResponse_Call struct {
+// MockServerInterface_PostLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostLogin'
+type MockServerInterface_PostLogin_Call struct {
 	*mock.Call
 }
 
-// VisitGetPvzResponse is a helper method to define mock.On call
+// PostLogin is a helper method to define mock.On call
 //   - w
-func (_e *MockGetPvzResponseObject_Expecter) VisitGetPvzResponse(w interface{}) *MockGetPvzResponseObject_VisitGetPvzResponse_Call {
-	return &MockGetPvzResponseObject_VisitGetPvzResponse_Call{Call: _e.mock.On("VisitGetPvzResponse", w)}
+//   - r
+func (_e *MockServerInterface_Expecter) PostLogin(w interface{}, r interface{}) *MockServerInterface_PostLogin_Call {
+	return &MockServerInterface_PostLogin_Call{Call: _e.mock.On("PostLogin", w, r)}
 }
 
-func (_c *MockGetPvzResponseObject_VisitGetPvzResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetPvzResponseObject_VisitGetPvzResponse_Call {
+func (_c *MockServerInterface_PostLogin_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostLogin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *MockGetPvzResponseObject_VisitGetPvzResponse_Call) Return(err error) *MockGetPvzResponseObject_VisitGetPvzResponse_Call {
-	_c.Call.Return(err)
+func (_c *MockServerInterface_PostLogin_Call) Return() *MockServerInterface_PostLogin_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockGetPvzResponseObject_VisitGetPvzResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetPvzResponseObject_VisitGetPvzResponse_Call {
-	_c.Call.Return(run)
+func (_c *MockServerInterface_PostLogin_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostLogin_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockPostPvzResponseObject creates a new instance of MockPostPvzResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPostPvzResponseObject(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPostPvzResponseObject {
-	mock := &MockPostPvzResponseObject{}
-	mock.Mock.Test(t)
+// PostLogout provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostLogout(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+// MockServerInterface_PostLogout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostLogout'
+type MockServerInterface_PostLogout_Call struct {
+	*mock.Call
+}
 
-	return mock
+// PostLogout is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServerInterface_Expecter) PostLogout(w interface{}, r interface{}) *MockServerInterface_PostLogout_Call {
+	return &MockServerInterface_PostLogout_Call{Call: _e.mock.On("PostLogout", w, r)}
 }
 
-// MockPostPvzResponseObject is an autogenerated mock type for the PostPvzResponseObject type
-type MockPostPvzResponseObject struct {
-	mock.Mock
+func (_c *MockServerInterface_PostLogout_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostLogout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
 }
 
-type MockPostPvzResponseObject_Expecter struct {
-	mock *mock.Mock
+func (_c *MockServerInterface_PostLogout_Call) Return() *MockServerInterface_PostLogout_Call {
+	_c.Call.Return()
+	return _c
 }
 
-func (_m *MockPostPvzResponseObject) EXPECT() *MockPostPvzResponseObject_Expecter {
-	return &MockPostPvzResponseObject_Expecter{mock: &_m.Mock}
+func (_c *MockServerInterface_PostLogout_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostLogout_Call {
+	_c.Run(run)
+	return _c
 }
 
-// VisitPostPvzResponse provides a mock function for the type MockPostPvzResponseObject
-func (_mock *MockPostPvzResponseObject) VisitPostPvzResponse(w http.ResponseWriter) error {
-	ret := _mock.Called(w)
+// PostPasswordForgot provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPasswordForgot(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for VisitPostPvzResponse")
-	}
+// MockServerInterface_PostPasswordForgot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPasswordForgot'
+type MockServerInterface_PostPasswordForgot_Call struct {
+	*mock.Call
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
-		r0 = returnFunc(w)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
-}
-
-// MockPostPvzResponseObject_VisitPostPvzResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzResponse'
-type MockPostPvzResponseObject_VisitPostPvzResponse_Call struct {
-	*mock.Call
-}
-
-// VisitPostPvzResponse is a helper method to define mock.On call
+// PostPasswordForgot is a helper method to define mock.On call
 //   - w
-func (_e *MockPostPvzResponseObject_Expecter) VisitPostPvzResponse(w interface{}) *MockPostPvzResponseObject_VisitPostPvzResponse_Call {
-	return &MockPostPvzResponseObject_VisitPostPvzResponse_Call{Call: _e.mock.On("VisitPostPvzResponse", w)}
+//   - r
+func (_e *MockServerInterface_Expecter) PostPasswordForgot(w interface{}, r interface{}) *MockServerInterface_PostPasswordForgot_Call {
+	return &MockServerInterface_PostPasswordForgot_Call{Call: _e.mock.On("PostPasswordForgot", w, r)}
 }
 
-func (_c *MockPostPvzResponseObject_VisitPostPvzResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzResponseObject_VisitPostPvzResponse_Call {
+func (_c *MockServerInterface_PostPasswordForgot_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostPasswordForgot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *MockPostPvzResponseObject_VisitPostPvzResponse_Call) Return(err error) *MockPostPvzResponseObject_VisitPostPvzResponse_Call {
-	_c.Call.Return(err)
+func (_c *MockServerInterface_PostPasswordForgot_Call) Return() *MockServerInterface_PostPasswordForgot_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockPostPvzResponseObject_VisitPostPvzResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzResponseObject_VisitPostPvzResponse_Call {
-	_c.Call.Return(run)
+func (_c *MockServerInterface_PostPasswordForgot_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostPasswordForgot_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockPostPvzPvzIdCloseLastReceptionResponseObject creates a new instance of MockPostPvzPvzIdCloseLastReceptionResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPostPvzPvzIdCloseLastReceptionResponseObject(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPostPvzPvzIdCloseLastReceptionResponseObject {
-	mock := &MockPostPvzPvzIdCloseLastReceptionResponseObject{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+// PostPasswordReset provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPasswordReset(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
 }
 
-// MockPostPvzPvzIdCloseLastReceptionResponseObject is an autogenerated mock type for the PostPvzPvzIdCloseLastReceptionResponseObject type
-type MockPostPvzPvzIdCloseLastReceptionResponseObject struct {
-	mock.Mock
+// MockServerInterface_PostPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPasswordReset'
+type MockServerInterface_PostPasswordReset_Call struct {
+	*mock.Call
 }
 
-type MockPostPvzPvzIdCloseLastReceptionResponseObject_Expecter struct {
-	mock *mock.Mock
+// PostPasswordReset is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServerInterface_Expecter) PostPasswordReset(w interface{}, r interface{}) *MockServerInterface_PostPasswordReset_Call {
+	return &MockServerInterface_PostPasswordReset_Call{Call: _e.mock.On("PostPasswordReset", w, r)}
 }
 
-func (_m *MockPostPvzPvzIdCloseLastReceptionResponseObject) EXPECT() *MockPostPvzPvzIdCloseLastReceptionResponseObject_Expecter {
-	return &MockPostPvzPvzIdCloseLastReceptionResponseObject_Expecter{mock: &_m.Mock}
+func (_c *MockServerInterface_PostPasswordReset_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
 }
 
-// VisitPostPvzPvzIdCloseLastReceptionResponse provides a mock function for the type MockPostPvzPvzIdCloseLastReceptionResponseObject
-func (_mock *MockPostPvzPvzIdCloseLastReceptionResponseObject) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	ret := _mock.Called(w)
+func (_c *MockServerInterface_PostPasswordReset_Call) Return() *MockServerInterface_PostPasswordReset_Call {
+	_c.Call.Return()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for VisitPostPvzPvzIdCloseLastReceptionResponse")
-	}
+func (_c *MockServerInterface_PostPasswordReset_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostPasswordReset_Call {
+	_c.Run(run)
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
-		r0 = returnFunc(w)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// PostProductTypes provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostProductTypes(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
 }
 
-// MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdCloseLastReceptionResponse'
-type MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call struct {
+// MockServerInterface_PostProductTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostProductTypes'
+type MockServerInterface_PostProductTypes_Call struct {
 	*mock.Call
 }
 
-// VisitPostPvzPvzIdCloseLastReceptionResponse is a helper method to define mock.On call
+// PostProductTypes is a helper method to define mock.On call
 //   - w
-func (_e *MockPostPvzPvzIdCloseLastReceptionResponseObject_Expecter) VisitPostPvzPvzIdCloseLastReceptionResponse(w interface{}) *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call {
-	return &MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdCloseLastReceptionResponse", w)}
+//   - r
+func (_e *MockServerInterface_Expecter) PostProductTypes(w interface{}, r interface{}) *MockServerInterface_PostProductTypes_Call {
+	return &MockServerInterface_PostProductTypes_Call{Call: _e.mock.On("PostProductTypes", w, r)}
 }
 
-func (_c *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call {
+func (_c *MockServerInterface_PostProductTypes_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostProductTypes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call) Return(err error) *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call {
-	_c.Call.Return(err)
+func (_c *MockServerInterface_PostProductTypes_Call) Return() *MockServerInterface_PostProductTypes_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call {
-	_c.Call.Return(run)
+func (_c *MockServerInterface_PostProductTypes_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostProductTypes_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockPostPvzPvzIdDeleteLastProductResponseObject creates a new instance of MockPostPvzPvzIdDeleteLastProductResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPostPvzPvzIdDeleteLastProductResponseObject(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPostPvzPvzIdDeleteLastProductResponseObject {
-	mock := &MockPostPvzPvzIdDeleteLastProductResponseObject{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+// PostProducts provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostProducts(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
 }
 
-// MockPostPvzPvzIdDeleteLastProductResponseObject is an autogenerated mock type for the PostPvzPvzIdDeleteLastProductResponseObject type
-type MockPostPvzPvzIdDeleteLastProductResponseObject struct {
-	mock.Mock
+// MockServerInterface_PostProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostProducts'
+type MockServerInterface_PostProducts_Call struct {
+	*mock.Call
 }
 
-type MockPostPvzPvzIdDeleteLastProductResponseObject_Expecter struct {
-	mock *mock.Mock
+// PostProducts is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServerInterface_Expecter) PostProducts(w interface{}, r interface{}) *MockServerInterface_PostProducts_Call {
+	return &MockServerInterface_PostProducts_Call{Call: _e.mock.On("PostProducts", w, r)}
 }
 
-func (_m *MockPostPvzPvzIdDeleteLastProductResponseObject) EXPECT() *MockPostPvzPvzIdDeleteLastProductResponseObject_Expecter {
-	return &MockPostPvzPvzIdDeleteLastProductResponseObject_Expecter{mock: &_m.Mock}
+func (_c *MockServerInterface_PostProducts_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
 }
 
-// VisitPostPvzPvzIdDeleteLastProductResponse provides a mock function for the type MockPostPvzPvzIdDeleteLastProductResponseObject
-func (_mock *MockPostPvzPvzIdDeleteLastProductResponseObject) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	ret := _mock.Called(w)
+func (_c *MockServerInterface_PostProducts_Call) Return() *MockServerInterface_PostProducts_Call {
+	_c.Call.Return()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for VisitPostPvzPvzIdDeleteLastProductResponse")
-	}
+func (_c *MockServerInterface_PostProducts_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostProducts_Call {
+	_c.Run(run)
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
-		r0 = returnFunc(w)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// PostPvz provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPvz(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
 }
 
-// MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdDeleteLastProductResponse'
-type MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call struct {
+// MockServerInterface_PostPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvz'
+type MockServerInterface_PostPvz_Call struct {
 	*mock.Call
 }
 
-// VisitPostPvzPvzIdDeleteLastProductResponse is a helper method to define mock.On call
+// PostPvz is a helper method to define mock.On call
 //   - w
-func (_e *MockPostPvzPvzIdDeleteLastProductResponseObject_Expecter) VisitPostPvzPvzIdDeleteLastProductResponse(w interface{}) *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call {
-	return &MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdDeleteLastProductResponse", w)}
+//   - r
+func (_e *MockServerInterface_Expecter) PostPvz(w interface{}, r interface{}) *MockServerInterface_PostPvz_Call {
+	return &MockServerInterface_PostPvz_Call{Call: _e.mock.On("PostPvz", w, r)}
 }
 
-func (_c *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call {
+func (_c *MockServerInterface_PostPvz_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostPvz_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call) Return(err error) *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call {
-	_c.Call.Return(err)
+func (_c *MockServerInterface_PostPvz_Call) Return() *MockServerInterface_PostPvz_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call {
-	_c.Call.Return(run)
+func (_c *MockServerInterface_PostPvz_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostPvz_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockPostReceptionsResponseObject creates a new instance of MockPostReceptionsResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPostReceptionsResponseObject(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPostReceptionsResponseObject {
-	mock := &MockPostReceptionsResponseObject{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+// PostPvzPvzIdAsn provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPvzPvzIdAsn(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
 }
 
-// MockPostReceptionsResponseObject is an autogenerated mock type for the PostReceptionsResponseObject type
-type MockPostReceptionsResponseObject struct {
-	mock.Mock
+// MockServerInterface_PostPvzPvzIdAsn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdAsn'
+type MockServerInterface_PostPvzPvzIdAsn_Call struct {
+	*mock.Call
 }
 
-type MockPostReceptionsResponseObject_Expecter struct {
-	mock *mock.Mock
+// PostPvzPvzIdAsn is a helper method to define mock.On call
+//   - w
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) PostPvzPvzIdAsn(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdAsn_Call {
+	return &MockServerInterface_PostPvzPvzIdAsn_Call{Call: _e.mock.On("PostPvzPvzIdAsn", w, r, pvzId)}
 }
 
-func (_m *MockPostReceptionsResponseObject) EXPECT() *MockPostReceptionsResponseObject_Expecter {
-	return &MockPostReceptionsResponseObject_Expecter{mock: &_m.Mock}
+func (_c *MockServerInterface_PostPvzPvzIdAsn_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdAsn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+	})
+	return _c
 }
 
-// VisitPostReceptionsResponse provides a mock function for the type MockPostReceptionsResponseObject
-func (_mock *MockPostReceptionsResponseObject) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	ret := _mock.Called(w)
+func (_c *MockServerInterface_PostPvzPvzIdAsn_Call) Return() *MockServerInterface_PostPvzPvzIdAsn_Call {
+	_c.Call.Return()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for VisitPostReceptionsResponse")
-	}
+func (_c *MockServerInterface_PostPvzPvzIdAsn_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdAsn_Call {
+	_c.Run(run)
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
-		r0 = returnFunc(w)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// PostPvzPvzIdClose provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPvzPvzIdClose(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
 }
 
-// MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostReceptionsResponse'
-type MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call struct {
+// MockServerInterface_PostPvzPvzIdClose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdClose'
+type MockServerInterface_PostPvzPvzIdClose_Call struct {
 	*mock.Call
 }
 
-// VisitPostReceptionsResponse is a helper method to define mock.On call
+// PostPvzPvzIdClose is a helper method to define mock.On call
 //   - w
-func (_e *MockPostReceptionsResponseObject_Expecter) VisitPostReceptionsResponse(w interface{}) *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call {
-	return &MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call{Call: _e.mock.On("VisitPostReceptionsResponse", w)}
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) PostPvzPvzIdClose(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdClose_Call {
+	return &MockServerInterface_PostPvzPvzIdClose_Call{Call: _e.mock.On("PostPvzPvzIdClose", w, r, pvzId)}
 }
 
-func (_c *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call {
+func (_c *MockServerInterface_PostPvzPvzIdClose_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdClose_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
 	})
 	return _c
 }
 
-func (_c *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call) Return(err error) *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call {
-	_c.Call.Return(err)
+func (_c *MockServerInterface_PostPvzPvzIdClose_Call) Return() *MockServerInterface_PostPvzPvzIdClose_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call {
-	_c.Call.Return(run)
+func (_c *MockServerInterface_PostPvzPvzIdClose_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdClose_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockPostRegisterResponseObject creates a new instance of MockPostRegisterResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPostRegisterResponseObject(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPostRegisterResponseObject {
-	mock := &MockPostRegisterResponseObject{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+// PostPvzPvzIdCloseLastReception provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
+}
+
+// MockServerInterface_PostPvzPvzIdCloseLastReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdCloseLastReception'
+type MockServerInterface_PostPvzPvzIdCloseLastReception_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdCloseLastReception is a helper method to define mock.On call
+//   - w
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) PostPvzPvzIdCloseLastReception(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdCloseLastReception_Call {
+	return &MockServerInterface_PostPvzPvzIdCloseLastReception_Call{Call: _e.mock.On("PostPvzPvzIdCloseLastReception", w, r, pvzId)}
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdCloseLastReception_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdCloseLastReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdCloseLastReception_Call) Return() *MockServerInterface_PostPvzPvzIdCloseLastReception_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdCloseLastReception_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdCloseLastReception_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostPvzPvzIdDeleteLastProduct provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
+}
+
+// MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdDeleteLastProduct'
+type MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdDeleteLastProduct is a helper method to define mock.On call
+//   - w
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) PostPvzPvzIdDeleteLastProduct(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+	return &MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call{Call: _e.mock.On("PostPvzPvzIdDeleteLastProduct", w, r, pvzId)}
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call) Return() *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostPvzPvzIdProductsBatch provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPvzPvzIdProductsBatch(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
+}
+
+// MockServerInterface_PostPvzPvzIdProductsBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdProductsBatch'
+type MockServerInterface_PostPvzPvzIdProductsBatch_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdProductsBatch is a helper method to define mock.On call
+//   - w
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) PostPvzPvzIdProductsBatch(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdProductsBatch_Call {
+	return &MockServerInterface_PostPvzPvzIdProductsBatch_Call{Call: _e.mock.On("PostPvzPvzIdProductsBatch", w, r, pvzId)}
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdProductsBatch_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdProductsBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdProductsBatch_Call) Return() *MockServerInterface_PostPvzPvzIdProductsBatch_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdProductsBatch_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdProductsBatch_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostPvzPvzIdProductsVoid provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPvzPvzIdProductsVoid(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
+}
+
+// MockServerInterface_PostPvzPvzIdProductsVoid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdProductsVoid'
+type MockServerInterface_PostPvzPvzIdProductsVoid_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdProductsVoid is a helper method to define mock.On call
+//   - w
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) PostPvzPvzIdProductsVoid(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdProductsVoid_Call {
+	return &MockServerInterface_PostPvzPvzIdProductsVoid_Call{Call: _e.mock.On("PostPvzPvzIdProductsVoid", w, r, pvzId)}
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdProductsVoid_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdProductsVoid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdProductsVoid_Call) Return() *MockServerInterface_PostPvzPvzIdProductsVoid_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdProductsVoid_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdProductsVoid_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostPvzPvzIdReopen provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPvzPvzIdReopen(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
+}
+
+// MockServerInterface_PostPvzPvzIdReopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdReopen'
+type MockServerInterface_PostPvzPvzIdReopen_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdReopen is a helper method to define mock.On call
+//   - w
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) PostPvzPvzIdReopen(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdReopen_Call {
+	return &MockServerInterface_PostPvzPvzIdReopen_Call{Call: _e.mock.On("PostPvzPvzIdReopen", w, r, pvzId)}
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdReopen_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdReopen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdReopen_Call) Return() *MockServerInterface_PostPvzPvzIdReopen_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdReopen_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdReopen_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostPvzPvzIdSuspend provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostPvzPvzIdSuspend(w http.ResponseWriter, r *http.Request, pvzId types.UUID) {
+	_mock.Called(w, r, pvzId)
+	return
+}
+
+// MockServerInterface_PostPvzPvzIdSuspend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdSuspend'
+type MockServerInterface_PostPvzPvzIdSuspend_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdSuspend is a helper method to define mock.On call
+//   - w
+//   - r
+//   - pvzId
+func (_e *MockServerInterface_Expecter) PostPvzPvzIdSuspend(w interface{}, r interface{}, pvzId interface{}) *MockServerInterface_PostPvzPvzIdSuspend_Call {
+	return &MockServerInterface_PostPvzPvzIdSuspend_Call{Call: _e.mock.On("PostPvzPvzIdSuspend", w, r, pvzId)}
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdSuspend_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdSuspend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdSuspend_Call) Return() *MockServerInterface_PostPvzPvzIdSuspend_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostPvzPvzIdSuspend_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID)) *MockServerInterface_PostPvzPvzIdSuspend_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostReceptions provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostReceptions(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockServerInterface_PostReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostReceptions'
+type MockServerInterface_PostReceptions_Call struct {
+	*mock.Call
+}
+
+// PostReceptions is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServerInterface_Expecter) PostReceptions(w interface{}, r interface{}) *MockServerInterface_PostReceptions_Call {
+	return &MockServerInterface_PostReceptions_Call{Call: _e.mock.On("PostReceptions", w, r)}
+}
+
+func (_c *MockServerInterface_PostReceptions_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostReceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostReceptions_Call) Return() *MockServerInterface_PostReceptions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostReceptions_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostReceptions_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostReceptionsReceptionIdReopen provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, receptionId types.UUID) {
+	_mock.Called(w, r, receptionId)
+	return
+}
+
+// MockServerInterface_PostReceptionsReceptionIdReopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostReceptionsReceptionIdReopen'
+type MockServerInterface_PostReceptionsReceptionIdReopen_Call struct {
+	*mock.Call
+}
+
+// PostReceptionsReceptionIdReopen is a helper method to define mock.On call
+//   - w
+//   - r
+//   - receptionId
+func (_e *MockServerInterface_Expecter) PostReceptionsReceptionIdReopen(w interface{}, r interface{}, receptionId interface{}) *MockServerInterface_PostReceptionsReceptionIdReopen_Call {
+	return &MockServerInterface_PostReceptionsReceptionIdReopen_Call{Call: _e.mock.On("PostReceptionsReceptionIdReopen", w, r, receptionId)}
+}
+
+func (_c *MockServerInterface_PostReceptionsReceptionIdReopen_Call) Run(run func(w http.ResponseWriter, r *http.Request, receptionId types.UUID)) *MockServerInterface_PostReceptionsReceptionIdReopen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostReceptionsReceptionIdReopen_Call) Return() *MockServerInterface_PostReceptionsReceptionIdReopen_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostReceptionsReceptionIdReopen_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, receptionId types.UUID)) *MockServerInterface_PostReceptionsReceptionIdReopen_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostRegister provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostRegister(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockServerInterface_PostRegister_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostRegister'
+type MockServerInterface_PostRegister_Call struct {
+	*mock.Call
+}
+
+// PostRegister is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServerInterface_Expecter) PostRegister(w interface{}, r interface{}) *MockServerInterface_PostRegister_Call {
+	return &MockServerInterface_PostRegister_Call{Call: _e.mock.On("PostRegister", w, r)}
+}
+
+func (_c *MockServerInterface_PostRegister_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostRegister_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostRegister_Call) Return() *MockServerInterface_PostRegister_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostRegister_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostRegister_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostTokenRefresh provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostTokenRefresh(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockServerInterface_PostTokenRefresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostTokenRefresh'
+type MockServerInterface_PostTokenRefresh_Call struct {
+	*mock.Call
+}
+
+// PostTokenRefresh is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServerInterface_Expecter) PostTokenRefresh(w interface{}, r interface{}) *MockServerInterface_PostTokenRefresh_Call {
+	return &MockServerInterface_PostTokenRefresh_Call{Call: _e.mock.On("PostTokenRefresh", w, r)}
+}
+
+func (_c *MockServerInterface_PostTokenRefresh_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostTokenRefresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostTokenRefresh_Call) Return() *MockServerInterface_PostTokenRefresh_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostTokenRefresh_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServerInterface_PostTokenRefresh_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostUsersUserIdActivate provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostUsersUserIdActivate(w http.ResponseWriter, r *http.Request, userId UserId) {
+	_mock.Called(w, r, userId)
+	return
+}
+
+// MockServerInterface_PostUsersUserIdActivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostUsersUserIdActivate'
+type MockServerInterface_PostUsersUserIdActivate_Call struct {
+	*mock.Call
+}
+
+// PostUsersUserIdActivate is a helper method to define mock.On call
+//   - w
+//   - r
+//   - userId
+func (_e *MockServerInterface_Expecter) PostUsersUserIdActivate(w interface{}, r interface{}, userId interface{}) *MockServerInterface_PostUsersUserIdActivate_Call {
+	return &MockServerInterface_PostUsersUserIdActivate_Call{Call: _e.mock.On("PostUsersUserIdActivate", w, r, userId)}
+}
+
+func (_c *MockServerInterface_PostUsersUserIdActivate_Call) Run(run func(w http.ResponseWriter, r *http.Request, userId UserId)) *MockServerInterface_PostUsersUserIdActivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(UserId))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostUsersUserIdActivate_Call) Return() *MockServerInterface_PostUsersUserIdActivate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostUsersUserIdActivate_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, userId UserId)) *MockServerInterface_PostUsersUserIdActivate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostUsersUserIdDeactivate provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostUsersUserIdDeactivate(w http.ResponseWriter, r *http.Request, userId UserId) {
+	_mock.Called(w, r, userId)
+	return
+}
+
+// MockServerInterface_PostUsersUserIdDeactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostUsersUserIdDeactivate'
+type MockServerInterface_PostUsersUserIdDeactivate_Call struct {
+	*mock.Call
+}
+
+// PostUsersUserIdDeactivate is a helper method to define mock.On call
+//   - w
+//   - r
+//   - userId
+func (_e *MockServerInterface_Expecter) PostUsersUserIdDeactivate(w interface{}, r interface{}, userId interface{}) *MockServerInterface_PostUsersUserIdDeactivate_Call {
+	return &MockServerInterface_PostUsersUserIdDeactivate_Call{Call: _e.mock.On("PostUsersUserIdDeactivate", w, r, userId)}
+}
+
+func (_c *MockServerInterface_PostUsersUserIdDeactivate_Call) Run(run func(w http.ResponseWriter, r *http.Request, userId UserId)) *MockServerInterface_PostUsersUserIdDeactivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(UserId))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostUsersUserIdDeactivate_Call) Return() *MockServerInterface_PostUsersUserIdDeactivate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostUsersUserIdDeactivate_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, userId UserId)) *MockServerInterface_PostUsersUserIdDeactivate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostUsersUserIdPasswordReset provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostUsersUserIdPasswordReset(w http.ResponseWriter, r *http.Request, userId UserId) {
+	_mock.Called(w, r, userId)
+	return
+}
+
+// MockServerInterface_PostUsersUserIdPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostUsersUserIdPasswordReset'
+type MockServerInterface_PostUsersUserIdPasswordReset_Call struct {
+	*mock.Call
+}
+
+// PostUsersUserIdPasswordReset is a helper method to define mock.On call
+//   - w
+//   - r
+//   - userId
+func (_e *MockServerInterface_Expecter) PostUsersUserIdPasswordReset(w interface{}, r interface{}, userId interface{}) *MockServerInterface_PostUsersUserIdPasswordReset_Call {
+	return &MockServerInterface_PostUsersUserIdPasswordReset_Call{Call: _e.mock.On("PostUsersUserIdPasswordReset", w, r, userId)}
+}
+
+func (_c *MockServerInterface_PostUsersUserIdPasswordReset_Call) Run(run func(w http.ResponseWriter, r *http.Request, userId UserId)) *MockServerInterface_PostUsersUserIdPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(UserId))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostUsersUserIdPasswordReset_Call) Return() *MockServerInterface_PostUsersUserIdPasswordReset_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostUsersUserIdPasswordReset_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, userId UserId)) *MockServerInterface_PostUsersUserIdPasswordReset_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostUsersUserIdRole provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PostUsersUserIdRole(w http.ResponseWriter, r *http.Request, userId UserId) {
+	_mock.Called(w, r, userId)
+	return
+}
+
+// MockServerInterface_PostUsersUserIdRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostUsersUserIdRole'
+type MockServerInterface_PostUsersUserIdRole_Call struct {
+	*mock.Call
+}
+
+// PostUsersUserIdRole is a helper method to define mock.On call
+//   - w
+//   - r
+//   - userId
+func (_e *MockServerInterface_Expecter) PostUsersUserIdRole(w interface{}, r interface{}, userId interface{}) *MockServerInterface_PostUsersUserIdRole_Call {
+	return &MockServerInterface_PostUsersUserIdRole_Call{Call: _e.mock.On("PostUsersUserIdRole", w, r, userId)}
+}
+
+func (_c *MockServerInterface_PostUsersUserIdRole_Call) Run(run func(w http.ResponseWriter, r *http.Request, userId UserId)) *MockServerInterface_PostUsersUserIdRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(UserId))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PostUsersUserIdRole_Call) Return() *MockServerInterface_PostUsersUserIdRole_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PostUsersUserIdRole_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, userId UserId)) *MockServerInterface_PostUsersUserIdRole_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PutProductTypesCode provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PutProductTypesCode(w http.ResponseWriter, r *http.Request, code ProductTypeCode) {
+	_mock.Called(w, r, code)
+	return
+}
+
+// MockServerInterface_PutProductTypesCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutProductTypesCode'
+type MockServerInterface_PutProductTypesCode_Call struct {
+	*mock.Call
+}
+
+// PutProductTypesCode is a helper method to define mock.On call
+//   - w
+//   - r
+//   - code
+func (_e *MockServerInterface_Expecter) PutProductTypesCode(w interface{}, r interface{}, code interface{}) *MockServerInterface_PutProductTypesCode_Call {
+	return &MockServerInterface_PutProductTypesCode_Call{Call: _e.mock.On("PutProductTypesCode", w, r, code)}
+}
+
+func (_c *MockServerInterface_PutProductTypesCode_Call) Run(run func(w http.ResponseWriter, r *http.Request, code ProductTypeCode)) *MockServerInterface_PutProductTypesCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(ProductTypeCode))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PutProductTypesCode_Call) Return() *MockServerInterface_PutProductTypesCode_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PutProductTypesCode_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, code ProductTypeCode)) *MockServerInterface_PutProductTypesCode_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PutPvzPvzIdStaffUserId provides a mock function for the type MockServerInterface
+func (_mock *MockServerInterface) PutPvzPvzIdStaffUserId(w http.ResponseWriter, r *http.Request, pvzId types.UUID, userId types.UUID) {
+	_mock.Called(w, r, pvzId, userId)
+	return
+}
+
+// MockServerInterface_PutPvzPvzIdStaffUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutPvzPvzIdStaffUserId'
+type MockServerInterface_PutPvzPvzIdStaffUserId_Call struct {
+	*mock.Call
+}
+
+// PutPvzPvzIdStaffUserId is a helper method to define mock.On call
+//   - w
+//   - r
+//   - pvzId
+//   - userId
+func (_e *MockServerInterface_Expecter) PutPvzPvzIdStaffUserId(w interface{}, r interface{}, pvzId interface{}, userId interface{}) *MockServerInterface_PutPvzPvzIdStaffUserId_Call {
+	return &MockServerInterface_PutPvzPvzIdStaffUserId_Call{Call: _e.mock.On("PutPvzPvzIdStaffUserId", w, r, pvzId, userId)}
+}
+
+func (_c *MockServerInterface_PutPvzPvzIdStaffUserId_Call) Run(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID, userId types.UUID)) *MockServerInterface_PutPvzPvzIdStaffUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request), args[2].(types.UUID), args[3].(types.UUID))
+	})
+	return _c
+}
+
+func (_c *MockServerInterface_PutPvzPvzIdStaffUserId_Call) Return() *MockServerInterface_PutPvzPvzIdStaffUserId_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServerInterface_PutPvzPvzIdStaffUserId_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, pvzId types.UUID, userId types.UUID)) *MockServerInterface_PutPvzPvzIdStaffUserId_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockServeMux creates a new instance of MockServeMux. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockServeMux(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockServeMux {
+	mock := &MockServeMux{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockServeMux is an autogenerated mock type for the ServeMux type
+type MockServeMux struct {
+	mock.Mock
+}
+
+type MockServeMux_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockServeMux) EXPECT() *MockServeMux_Expecter {
+	return &MockServeMux_Expecter{mock: &_m.Mock}
+}
+
+// HandleFunc provides a mock function for the type MockServeMux
+func (_mock *MockServeMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	_mock.Called(pattern, handler)
+	return
+}
+
+// MockServeMux_HandleFunc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleFunc'
+type MockServeMux_HandleFunc_Call struct {
+	*mock.Call
+}
+
+// HandleFunc is a helper method to define mock.On call
+//   - pattern
+//   - handler
+func (_e *MockServeMux_Expecter) HandleFunc(pattern interface{}, handler interface{}) *MockServeMux_HandleFunc_Call {
+	return &MockServeMux_HandleFunc_Call{Call: _e.mock.On("HandleFunc", pattern, handler)}
+}
+
+func (_c *MockServeMux_HandleFunc_Call) Run(run func(pattern string, handler func(http.ResponseWriter, *http.Request))) *MockServeMux_HandleFunc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func(http.ResponseWriter, *http.Request)))
+	})
+	return _c
+}
+
+func (_c *MockServeMux_HandleFunc_Call) Return() *MockServeMux_HandleFunc_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServeMux_HandleFunc_Call) RunAndReturn(run func(pattern string, handler func(http.ResponseWriter, *http.Request))) *MockServeMux_HandleFunc_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ServeHTTP provides a mock function for the type MockServeMux
+func (_mock *MockServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockServeMux_ServeHTTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServeHTTP'
+type MockServeMux_ServeHTTP_Call struct {
+	*mock.Call
+}
+
+// ServeHTTP is a helper method to define mock.On call
+//   - w
+//   - r
+func (_e *MockServeMux_Expecter) ServeHTTP(w interface{}, r interface{}) *MockServeMux_ServeHTTP_Call {
+	return &MockServeMux_ServeHTTP_Call{Call: _e.mock.On("ServeHTTP", w, r)}
+}
+
+func (_c *MockServeMux_ServeHTTP_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockServeMux_ServeHTTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *MockServeMux_ServeHTTP_Call) Return() *MockServeMux_ServeHTTP_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServeMux_ServeHTTP_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockServeMux_ServeHTTP_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockGetWellKnownJwksJsonResponseObject creates a new instance of MockGetWellKnownJwksJsonResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetWellKnownJwksJsonResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetWellKnownJwksJsonResponseObject {
+	mock := &MockGetWellKnownJwksJsonResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetWellKnownJwksJsonResponseObject is an autogenerated mock type for the GetWellKnownJwksJsonResponseObject type
+type MockGetWellKnownJwksJsonResponseObject struct {
+	mock.Mock
+}
+
+type MockGetWellKnownJwksJsonResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetWellKnownJwksJsonResponseObject) EXPECT() *MockGetWellKnownJwksJsonResponseObject_Expecter {
+	return &MockGetWellKnownJwksJsonResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetWellKnownJwksJsonResponse provides a mock function for the type MockGetWellKnownJwksJsonResponseObject
+func (_mock *MockGetWellKnownJwksJsonResponseObject) VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetWellKnownJwksJsonResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetWellKnownJwksJsonResponse'
+type MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetWellKnownJwksJsonResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetWellKnownJwksJsonResponseObject_Expecter) VisitGetWellKnownJwksJsonResponse(w interface{}) *MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call {
+	return &MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call{Call: _e.mock.On("VisitGetWellKnownJwksJsonResponse", w)}
+}
+
+func (_c *MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call) Return(err error) *MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetWellKnownJwksJsonResponseObject_VisitGetWellKnownJwksJsonResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetApiKeysResponseObject creates a new instance of MockGetApiKeysResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetApiKeysResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetApiKeysResponseObject {
+	mock := &MockGetApiKeysResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetApiKeysResponseObject is an autogenerated mock type for the GetApiKeysResponseObject type
+type MockGetApiKeysResponseObject struct {
+	mock.Mock
+}
+
+type MockGetApiKeysResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetApiKeysResponseObject) EXPECT() *MockGetApiKeysResponseObject_Expecter {
+	return &MockGetApiKeysResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetApiKeysResponse provides a mock function for the type MockGetApiKeysResponseObject
+func (_mock *MockGetApiKeysResponseObject) VisitGetApiKeysResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetApiKeysResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetApiKeysResponse'
+type MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetApiKeysResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetApiKeysResponseObject_Expecter) VisitGetApiKeysResponse(w interface{}) *MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call {
+	return &MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call{Call: _e.mock.On("VisitGetApiKeysResponse", w)}
+}
+
+func (_c *MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call) Return(err error) *MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetApiKeysResponseObject_VisitGetApiKeysResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostApiKeysResponseObject creates a new instance of MockPostApiKeysResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostApiKeysResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostApiKeysResponseObject {
+	mock := &MockPostApiKeysResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostApiKeysResponseObject is an autogenerated mock type for the PostApiKeysResponseObject type
+type MockPostApiKeysResponseObject struct {
+	mock.Mock
+}
+
+type MockPostApiKeysResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostApiKeysResponseObject) EXPECT() *MockPostApiKeysResponseObject_Expecter {
+	return &MockPostApiKeysResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostApiKeysResponse provides a mock function for the type MockPostApiKeysResponseObject
+func (_mock *MockPostApiKeysResponseObject) VisitPostApiKeysResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostApiKeysResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostApiKeysResponse'
+type MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostApiKeysResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostApiKeysResponseObject_Expecter) VisitPostApiKeysResponse(w interface{}) *MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call {
+	return &MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call{Call: _e.mock.On("VisitPostApiKeysResponse", w)}
+}
+
+func (_c *MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call) Return(err error) *MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostApiKeysResponseObject_VisitPostApiKeysResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteApiKeysKeyIdResponseObject creates a new instance of MockDeleteApiKeysKeyIdResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteApiKeysKeyIdResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteApiKeysKeyIdResponseObject {
+	mock := &MockDeleteApiKeysKeyIdResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleteApiKeysKeyIdResponseObject is an autogenerated mock type for the DeleteApiKeysKeyIdResponseObject type
+type MockDeleteApiKeysKeyIdResponseObject struct {
+	mock.Mock
+}
+
+type MockDeleteApiKeysKeyIdResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteApiKeysKeyIdResponseObject) EXPECT() *MockDeleteApiKeysKeyIdResponseObject_Expecter {
+	return &MockDeleteApiKeysKeyIdResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitDeleteApiKeysKeyIdResponse provides a mock function for the type MockDeleteApiKeysKeyIdResponseObject
+func (_mock *MockDeleteApiKeysKeyIdResponseObject) VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitDeleteApiKeysKeyIdResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitDeleteApiKeysKeyIdResponse'
+type MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call struct {
+	*mock.Call
+}
+
+// VisitDeleteApiKeysKeyIdResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockDeleteApiKeysKeyIdResponseObject_Expecter) VisitDeleteApiKeysKeyIdResponse(w interface{}) *MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call {
+	return &MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call{Call: _e.mock.On("VisitDeleteApiKeysKeyIdResponse", w)}
+}
+
+func (_c *MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call) Run(run func(w http.ResponseWriter)) *MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call) Return(err error) *MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockDeleteApiKeysKeyIdResponseObject_VisitDeleteApiKeysKeyIdResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetAuditResponseObject creates a new instance of MockGetAuditResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetAuditResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetAuditResponseObject {
+	mock := &MockGetAuditResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetAuditResponseObject is an autogenerated mock type for the GetAuditResponseObject type
+type MockGetAuditResponseObject struct {
+	mock.Mock
+}
+
+type MockGetAuditResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetAuditResponseObject) EXPECT() *MockGetAuditResponseObject_Expecter {
+	return &MockGetAuditResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetAuditResponse provides a mock function for the type MockGetAuditResponseObject
+func (_mock *MockGetAuditResponseObject) VisitGetAuditResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetAuditResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetAuditResponseObject_VisitGetAuditResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetAuditResponse'
+type MockGetAuditResponseObject_VisitGetAuditResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetAuditResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetAuditResponseObject_Expecter) VisitGetAuditResponse(w interface{}) *MockGetAuditResponseObject_VisitGetAuditResponse_Call {
+	return &MockGetAuditResponseObject_VisitGetAuditResponse_Call{Call: _e.mock.On("VisitGetAuditResponse", w)}
+}
+
+func (_c *MockGetAuditResponseObject_VisitGetAuditResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetAuditResponseObject_VisitGetAuditResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetAuditResponseObject_VisitGetAuditResponse_Call) Return(err error) *MockGetAuditResponseObject_VisitGetAuditResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetAuditResponseObject_VisitGetAuditResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetAuditResponseObject_VisitGetAuditResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCitiesResponseObject creates a new instance of MockGetCitiesResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCitiesResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCitiesResponseObject {
+	mock := &MockGetCitiesResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetCitiesResponseObject is an autogenerated mock type for the GetCitiesResponseObject type
+type MockGetCitiesResponseObject struct {
+	mock.Mock
+}
+
+type MockGetCitiesResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCitiesResponseObject) EXPECT() *MockGetCitiesResponseObject_Expecter {
+	return &MockGetCitiesResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetCitiesResponse provides a mock function for the type MockGetCitiesResponseObject
+func (_mock *MockGetCitiesResponseObject) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetCitiesResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetCitiesResponseObject_VisitGetCitiesResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetCitiesResponse'
+type MockGetCitiesResponseObject_VisitGetCitiesResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetCitiesResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetCitiesResponseObject_Expecter) VisitGetCitiesResponse(w interface{}) *MockGetCitiesResponseObject_VisitGetCitiesResponse_Call {
+	return &MockGetCitiesResponseObject_VisitGetCitiesResponse_Call{Call: _e.mock.On("VisitGetCitiesResponse", w)}
+}
+
+func (_c *MockGetCitiesResponseObject_VisitGetCitiesResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetCitiesResponseObject_VisitGetCitiesResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetCitiesResponseObject_VisitGetCitiesResponse_Call) Return(err error) *MockGetCitiesResponseObject_VisitGetCitiesResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetCitiesResponseObject_VisitGetCitiesResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetCitiesResponseObject_VisitGetCitiesResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostCitiesResponseObject creates a new instance of MockPostCitiesResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostCitiesResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostCitiesResponseObject {
+	mock := &MockPostCitiesResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostCitiesResponseObject is an autogenerated mock type for the PostCitiesResponseObject type
+type MockPostCitiesResponseObject struct {
+	mock.Mock
+}
+
+type MockPostCitiesResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostCitiesResponseObject) EXPECT() *MockPostCitiesResponseObject_Expecter {
+	return &MockPostCitiesResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostCitiesResponse provides a mock function for the type MockPostCitiesResponseObject
+func (_mock *MockPostCitiesResponseObject) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostCitiesResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostCitiesResponseObject_VisitPostCitiesResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostCitiesResponse'
+type MockPostCitiesResponseObject_VisitPostCitiesResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostCitiesResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostCitiesResponseObject_Expecter) VisitPostCitiesResponse(w interface{}) *MockPostCitiesResponseObject_VisitPostCitiesResponse_Call {
+	return &MockPostCitiesResponseObject_VisitPostCitiesResponse_Call{Call: _e.mock.On("VisitPostCitiesResponse", w)}
+}
+
+func (_c *MockPostCitiesResponseObject_VisitPostCitiesResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostCitiesResponseObject_VisitPostCitiesResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostCitiesResponseObject_VisitPostCitiesResponse_Call) Return(err error) *MockPostCitiesResponseObject_VisitPostCitiesResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostCitiesResponseObject_VisitPostCitiesResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostCitiesResponseObject_VisitPostCitiesResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostCitiesCityIdActivateResponseObject creates a new instance of MockPostCitiesCityIdActivateResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostCitiesCityIdActivateResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostCitiesCityIdActivateResponseObject {
+	mock := &MockPostCitiesCityIdActivateResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostCitiesCityIdActivateResponseObject is an autogenerated mock type for the PostCitiesCityIdActivateResponseObject type
+type MockPostCitiesCityIdActivateResponseObject struct {
+	mock.Mock
+}
+
+type MockPostCitiesCityIdActivateResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostCitiesCityIdActivateResponseObject) EXPECT() *MockPostCitiesCityIdActivateResponseObject_Expecter {
+	return &MockPostCitiesCityIdActivateResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostCitiesCityIdActivateResponse provides a mock function for the type MockPostCitiesCityIdActivateResponseObject
+func (_mock *MockPostCitiesCityIdActivateResponseObject) VisitPostCitiesCityIdActivateResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostCitiesCityIdActivateResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostCitiesCityIdActivateResponse'
+type MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostCitiesCityIdActivateResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostCitiesCityIdActivateResponseObject_Expecter) VisitPostCitiesCityIdActivateResponse(w interface{}) *MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call {
+	return &MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call{Call: _e.mock.On("VisitPostCitiesCityIdActivateResponse", w)}
+}
+
+func (_c *MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call) Return(err error) *MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostCitiesCityIdActivateResponseObject_VisitPostCitiesCityIdActivateResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostCitiesCityIdDeactivateResponseObject creates a new instance of MockPostCitiesCityIdDeactivateResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostCitiesCityIdDeactivateResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostCitiesCityIdDeactivateResponseObject {
+	mock := &MockPostCitiesCityIdDeactivateResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostCitiesCityIdDeactivateResponseObject is an autogenerated mock type for the PostCitiesCityIdDeactivateResponseObject type
+type MockPostCitiesCityIdDeactivateResponseObject struct {
+	mock.Mock
+}
+
+type MockPostCitiesCityIdDeactivateResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostCitiesCityIdDeactivateResponseObject) EXPECT() *MockPostCitiesCityIdDeactivateResponseObject_Expecter {
+	return &MockPostCitiesCityIdDeactivateResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostCitiesCityIdDeactivateResponse provides a mock function for the type MockPostCitiesCityIdDeactivateResponseObject
+func (_mock *MockPostCitiesCityIdDeactivateResponseObject) VisitPostCitiesCityIdDeactivateResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostCitiesCityIdDeactivateResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostCitiesCityIdDeactivateResponse'
+type MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostCitiesCityIdDeactivateResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostCitiesCityIdDeactivateResponseObject_Expecter) VisitPostCitiesCityIdDeactivateResponse(w interface{}) *MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call {
+	return &MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call{Call: _e.mock.On("VisitPostCitiesCityIdDeactivateResponse", w)}
+}
+
+func (_c *MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call) Return(err error) *MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostCitiesCityIdDeactivateResponseObject_VisitPostCitiesCityIdDeactivateResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostDummyLoginResponseObject creates a new instance of MockPostDummyLoginResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostDummyLoginResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostDummyLoginResponseObject {
+	mock := &MockPostDummyLoginResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostDummyLoginResponseObject is an autogenerated mock type for the PostDummyLoginResponseObject type
+type MockPostDummyLoginResponseObject struct {
+	mock.Mock
+}
+
+type MockPostDummyLoginResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostDummyLoginResponseObject) EXPECT() *MockPostDummyLoginResponseObject_Expecter {
+	return &MockPostDummyLoginResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostDummyLoginResponse provides a mock function for the type MockPostDummyLoginResponseObject
+func (_mock *MockPostDummyLoginResponseObject) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostDummyLoginResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostDummyLoginResponse'
+type MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostDummyLoginResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostDummyLoginResponseObject_Expecter) VisitPostDummyLoginResponse(w interface{}) *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call {
+	return &MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call{Call: _e.mock.On("VisitPostDummyLoginResponse", w)}
+}
+
+func (_c *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call) Return(err error) *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostDummyLoginResponseObject_VisitPostDummyLoginResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostLoginResponseObject creates a new instance of MockPostLoginResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostLoginResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostLoginResponseObject {
+	mock := &MockPostLoginResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostLoginResponseObject is an autogenerated mock type for the PostLoginResponseObject type
+type MockPostLoginResponseObject struct {
+	mock.Mock
+}
+
+type MockPostLoginResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostLoginResponseObject) EXPECT() *MockPostLoginResponseObject_Expecter {
+	return &MockPostLoginResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostLoginResponse provides a mock function for the type MockPostLoginResponseObject
+func (_mock *MockPostLoginResponseObject) VisitPostLoginResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostLoginResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostLoginResponseObject_VisitPostLoginResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostLoginResponse'
+type MockPostLoginResponseObject_VisitPostLoginResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostLoginResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostLoginResponseObject_Expecter) VisitPostLoginResponse(w interface{}) *MockPostLoginResponseObject_VisitPostLoginResponse_Call {
+	return &MockPostLoginResponseObject_VisitPostLoginResponse_Call{Call: _e.mock.On("VisitPostLoginResponse", w)}
+}
+
+func (_c *MockPostLoginResponseObject_VisitPostLoginResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostLoginResponseObject_VisitPostLoginResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostLoginResponseObject_VisitPostLoginResponse_Call) Return(err error) *MockPostLoginResponseObject_VisitPostLoginResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostLoginResponseObject_VisitPostLoginResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostLoginResponseObject_VisitPostLoginResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostLogoutResponseObject creates a new instance of MockPostLogoutResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostLogoutResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostLogoutResponseObject {
+	mock := &MockPostLogoutResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostLogoutResponseObject is an autogenerated mock type for the PostLogoutResponseObject type
+type MockPostLogoutResponseObject struct {
+	mock.Mock
+}
+
+type MockPostLogoutResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostLogoutResponseObject) EXPECT() *MockPostLogoutResponseObject_Expecter {
+	return &MockPostLogoutResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostLogoutResponse provides a mock function for the type MockPostLogoutResponseObject
+func (_mock *MockPostLogoutResponseObject) VisitPostLogoutResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostLogoutResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostLogoutResponseObject_VisitPostLogoutResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostLogoutResponse'
+type MockPostLogoutResponseObject_VisitPostLogoutResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostLogoutResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostLogoutResponseObject_Expecter) VisitPostLogoutResponse(w interface{}) *MockPostLogoutResponseObject_VisitPostLogoutResponse_Call {
+	return &MockPostLogoutResponseObject_VisitPostLogoutResponse_Call{Call: _e.mock.On("VisitPostLogoutResponse", w)}
+}
+
+func (_c *MockPostLogoutResponseObject_VisitPostLogoutResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostLogoutResponseObject_VisitPostLogoutResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostLogoutResponseObject_VisitPostLogoutResponse_Call) Return(err error) *MockPostLogoutResponseObject_VisitPostLogoutResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostLogoutResponseObject_VisitPostLogoutResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostLogoutResponseObject_VisitPostLogoutResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPasswordForgotResponseObject creates a new instance of MockPostPasswordForgotResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPasswordForgotResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPasswordForgotResponseObject {
+	mock := &MockPostPasswordForgotResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPasswordForgotResponseObject is an autogenerated mock type for the PostPasswordForgotResponseObject type
+type MockPostPasswordForgotResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPasswordForgotResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPasswordForgotResponseObject) EXPECT() *MockPostPasswordForgotResponseObject_Expecter {
+	return &MockPostPasswordForgotResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPasswordForgotResponse provides a mock function for the type MockPostPasswordForgotResponseObject
+func (_mock *MockPostPasswordForgotResponseObject) VisitPostPasswordForgotResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPasswordForgotResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPasswordForgotResponse'
+type MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPasswordForgotResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPasswordForgotResponseObject_Expecter) VisitPostPasswordForgotResponse(w interface{}) *MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call {
+	return &MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call{Call: _e.mock.On("VisitPostPasswordForgotResponse", w)}
+}
+
+func (_c *MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call) Return(err error) *MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPasswordForgotResponseObject_VisitPostPasswordForgotResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPasswordResetResponseObject creates a new instance of MockPostPasswordResetResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPasswordResetResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPasswordResetResponseObject {
+	mock := &MockPostPasswordResetResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPasswordResetResponseObject is an autogenerated mock type for the PostPasswordResetResponseObject type
+type MockPostPasswordResetResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPasswordResetResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPasswordResetResponseObject) EXPECT() *MockPostPasswordResetResponseObject_Expecter {
+	return &MockPostPasswordResetResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPasswordResetResponse provides a mock function for the type MockPostPasswordResetResponseObject
+func (_mock *MockPostPasswordResetResponseObject) VisitPostPasswordResetResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPasswordResetResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPasswordResetResponse'
+type MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPasswordResetResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPasswordResetResponseObject_Expecter) VisitPostPasswordResetResponse(w interface{}) *MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call {
+	return &MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call{Call: _e.mock.On("VisitPostPasswordResetResponse", w)}
+}
+
+func (_c *MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call) Return(err error) *MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPasswordResetResponseObject_VisitPostPasswordResetResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetProductTypesResponseObject creates a new instance of MockGetProductTypesResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetProductTypesResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetProductTypesResponseObject {
+	mock := &MockGetProductTypesResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetProductTypesResponseObject is an autogenerated mock type for the GetProductTypesResponseObject type
+type MockGetProductTypesResponseObject struct {
+	mock.Mock
+}
+
+type MockGetProductTypesResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetProductTypesResponseObject) EXPECT() *MockGetProductTypesResponseObject_Expecter {
+	return &MockGetProductTypesResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetProductTypesResponse provides a mock function for the type MockGetProductTypesResponseObject
+func (_mock *MockGetProductTypesResponseObject) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetProductTypesResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetProductTypesResponse'
+type MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetProductTypesResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetProductTypesResponseObject_Expecter) VisitGetProductTypesResponse(w interface{}) *MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call {
+	return &MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call{Call: _e.mock.On("VisitGetProductTypesResponse", w)}
+}
+
+func (_c *MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call) Return(err error) *MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetProductTypesResponseObject_VisitGetProductTypesResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostProductTypesResponseObject creates a new instance of MockPostProductTypesResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostProductTypesResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostProductTypesResponseObject {
+	mock := &MockPostProductTypesResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostProductTypesResponseObject is an autogenerated mock type for the PostProductTypesResponseObject type
+type MockPostProductTypesResponseObject struct {
+	mock.Mock
+}
+
+type MockPostProductTypesResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostProductTypesResponseObject) EXPECT() *MockPostProductTypesResponseObject_Expecter {
+	return &MockPostProductTypesResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostProductTypesResponse provides a mock function for the type MockPostProductTypesResponseObject
+func (_mock *MockPostProductTypesResponseObject) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostProductTypesResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostProductTypesResponse'
+type MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostProductTypesResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostProductTypesResponseObject_Expecter) VisitPostProductTypesResponse(w interface{}) *MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call {
+	return &MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call{Call: _e.mock.On("VisitPostProductTypesResponse", w)}
+}
+
+func (_c *MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call) Return(err error) *MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostProductTypesResponseObject_VisitPostProductTypesResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPutProductTypesCodeResponseObject creates a new instance of MockPutProductTypesCodeResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPutProductTypesCodeResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPutProductTypesCodeResponseObject {
+	mock := &MockPutProductTypesCodeResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPutProductTypesCodeResponseObject is an autogenerated mock type for the PutProductTypesCodeResponseObject type
+type MockPutProductTypesCodeResponseObject struct {
+	mock.Mock
+}
+
+type MockPutProductTypesCodeResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPutProductTypesCodeResponseObject) EXPECT() *MockPutProductTypesCodeResponseObject_Expecter {
+	return &MockPutProductTypesCodeResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPutProductTypesCodeResponse provides a mock function for the type MockPutProductTypesCodeResponseObject
+func (_mock *MockPutProductTypesCodeResponseObject) VisitPutProductTypesCodeResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPutProductTypesCodeResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPutProductTypesCodeResponse'
+type MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPutProductTypesCodeResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPutProductTypesCodeResponseObject_Expecter) VisitPutProductTypesCodeResponse(w interface{}) *MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call {
+	return &MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call{Call: _e.mock.On("VisitPutProductTypesCodeResponse", w)}
+}
+
+func (_c *MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call) Run(run func(w http.ResponseWriter)) *MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call) Return(err error) *MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPutProductTypesCodeResponseObject_VisitPutProductTypesCodeResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostProductsResponseObject creates a new instance of MockPostProductsResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostProductsResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostProductsResponseObject {
+	mock := &MockPostProductsResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostProductsResponseObject is an autogenerated mock type for the PostProductsResponseObject type
+type MockPostProductsResponseObject struct {
+	mock.Mock
+}
+
+type MockPostProductsResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostProductsResponseObject) EXPECT() *MockPostProductsResponseObject_Expecter {
+	return &MockPostProductsResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostProductsResponse provides a mock function for the type MockPostProductsResponseObject
+func (_mock *MockPostProductsResponseObject) VisitPostProductsResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostProductsResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostProductsResponseObject_VisitPostProductsResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostProductsResponse'
+type MockPostProductsResponseObject_VisitPostProductsResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostProductsResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostProductsResponseObject_Expecter) VisitPostProductsResponse(w interface{}) *MockPostProductsResponseObject_VisitPostProductsResponse_Call {
+	return &MockPostProductsResponseObject_VisitPostProductsResponse_Call{Call: _e.mock.On("VisitPostProductsResponse", w)}
+}
+
+func (_c *MockPostProductsResponseObject_VisitPostProductsResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostProductsResponseObject_VisitPostProductsResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostProductsResponseObject_VisitPostProductsResponse_Call) Return(err error) *MockPostProductsResponseObject_VisitPostProductsResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostProductsResponseObject_VisitPostProductsResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostProductsResponseObject_VisitPostProductsResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetPvzResponseObject creates a new instance of MockGetPvzResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetPvzResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetPvzResponseObject {
+	mock := &MockGetPvzResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetPvzResponseObject is an autogenerated mock type for the GetPvzResponseObject type
+type MockGetPvzResponseObject struct {
+	mock.Mock
+}
+
+type MockGetPvzResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetPvzResponseObject) EXPECT() *MockGetPvzResponseObject_Expecter {
+	return &MockGetPvzResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetPvzResponse provides a mock function for the type MockGetPvzResponseObject
+func (_mock *MockGetPvzResponseObject) VisitGetPvzResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetPvzResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetPvzResponseObject_VisitGetPvzResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetPvzResponse'
+type MockGetPvzResponseObject_VisitGetPvzResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetPvzResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetPvzResponseObject_Expecter) VisitGetPvzResponse(w interface{}) *MockGetPvzResponseObject_VisitGetPvzResponse_Call {
+	return &MockGetPvzResponseObject_VisitGetPvzResponse_Call{Call: _e.mock.On("VisitGetPvzResponse", w)}
+}
+
+func (_c *MockGetPvzResponseObject_VisitGetPvzResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetPvzResponseObject_VisitGetPvzResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetPvzResponseObject_VisitGetPvzResponse_Call) Return(err error) *MockGetPvzResponseObject_VisitGetPvzResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetPvzResponseObject_VisitGetPvzResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetPvzResponseObject_VisitGetPvzResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPvzResponseObject creates a new instance of MockPostPvzResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPvzResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPvzResponseObject {
+	mock := &MockPostPvzResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPvzResponseObject is an autogenerated mock type for the PostPvzResponseObject type
+type MockPostPvzResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPvzResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPvzResponseObject) EXPECT() *MockPostPvzResponseObject_Expecter {
+	return &MockPostPvzResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPvzResponse provides a mock function for the type MockPostPvzResponseObject
+func (_mock *MockPostPvzResponseObject) VisitPostPvzResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPvzResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPvzResponseObject_VisitPostPvzResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzResponse'
+type MockPostPvzResponseObject_VisitPostPvzResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPvzResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPvzResponseObject_Expecter) VisitPostPvzResponse(w interface{}) *MockPostPvzResponseObject_VisitPostPvzResponse_Call {
+	return &MockPostPvzResponseObject_VisitPostPvzResponse_Call{Call: _e.mock.On("VisitPostPvzResponse", w)}
+}
+
+func (_c *MockPostPvzResponseObject_VisitPostPvzResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzResponseObject_VisitPostPvzResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPvzResponseObject_VisitPostPvzResponse_Call) Return(err error) *MockPostPvzResponseObject_VisitPostPvzResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPvzResponseObject_VisitPostPvzResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzResponseObject_VisitPostPvzResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetPvzNearestResponseObject creates a new instance of MockGetPvzNearestResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetPvzNearestResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetPvzNearestResponseObject {
+	mock := &MockGetPvzNearestResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetPvzNearestResponseObject is an autogenerated mock type for the GetPvzNearestResponseObject type
+type MockGetPvzNearestResponseObject struct {
+	mock.Mock
+}
+
+type MockGetPvzNearestResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetPvzNearestResponseObject) EXPECT() *MockGetPvzNearestResponseObject_Expecter {
+	return &MockGetPvzNearestResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetPvzNearestResponse provides a mock function for the type MockGetPvzNearestResponseObject
+func (_mock *MockGetPvzNearestResponseObject) VisitGetPvzNearestResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetPvzNearestResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetPvzNearestResponse'
+type MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetPvzNearestResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetPvzNearestResponseObject_Expecter) VisitGetPvzNearestResponse(w interface{}) *MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call {
+	return &MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call{Call: _e.mock.On("VisitGetPvzNearestResponse", w)}
+}
+
+func (_c *MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call) Return(err error) *MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetPvzNearestResponseObject_VisitGetPvzNearestResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPatchPvzPvzIdResponseObject creates a new instance of MockPatchPvzPvzIdResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPatchPvzPvzIdResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPatchPvzPvzIdResponseObject {
+	mock := &MockPatchPvzPvzIdResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPatchPvzPvzIdResponseObject is an autogenerated mock type for the PatchPvzPvzIdResponseObject type
+type MockPatchPvzPvzIdResponseObject struct {
+	mock.Mock
+}
+
+type MockPatchPvzPvzIdResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPatchPvzPvzIdResponseObject) EXPECT() *MockPatchPvzPvzIdResponseObject_Expecter {
+	return &MockPatchPvzPvzIdResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPatchPvzPvzIdResponse provides a mock function for the type MockPatchPvzPvzIdResponseObject
+func (_mock *MockPatchPvzPvzIdResponseObject) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPatchPvzPvzIdResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPatchPvzPvzIdResponse'
+type MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPatchPvzPvzIdResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPatchPvzPvzIdResponseObject_Expecter) VisitPatchPvzPvzIdResponse(w interface{}) *MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call {
+	return &MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call{Call: _e.mock.On("VisitPatchPvzPvzIdResponse", w)}
+}
+
+func (_c *MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call) Run(run func(w http.ResponseWriter)) *MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call) Return(err error) *MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPatchPvzPvzIdResponseObject_VisitPatchPvzPvzIdResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPvzPvzIdAsnResponseObject creates a new instance of MockPostPvzPvzIdAsnResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPvzPvzIdAsnResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPvzPvzIdAsnResponseObject {
+	mock := &MockPostPvzPvzIdAsnResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPvzPvzIdAsnResponseObject is an autogenerated mock type for the PostPvzPvzIdAsnResponseObject type
+type MockPostPvzPvzIdAsnResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPvzPvzIdAsnResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPvzPvzIdAsnResponseObject) EXPECT() *MockPostPvzPvzIdAsnResponseObject_Expecter {
+	return &MockPostPvzPvzIdAsnResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPvzPvzIdAsnResponse provides a mock function for the type MockPostPvzPvzIdAsnResponseObject
+func (_mock *MockPostPvzPvzIdAsnResponseObject) VisitPostPvzPvzIdAsnResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPvzPvzIdAsnResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdAsnResponse'
+type MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPvzPvzIdAsnResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPvzPvzIdAsnResponseObject_Expecter) VisitPostPvzPvzIdAsnResponse(w interface{}) *MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call {
+	return &MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdAsnResponse", w)}
+}
+
+func (_c *MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call) Return(err error) *MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdAsnResponseObject_VisitPostPvzPvzIdAsnResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPvzPvzIdCloseResponseObject creates a new instance of MockPostPvzPvzIdCloseResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPvzPvzIdCloseResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPvzPvzIdCloseResponseObject {
+	mock := &MockPostPvzPvzIdCloseResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPvzPvzIdCloseResponseObject is an autogenerated mock type for the PostPvzPvzIdCloseResponseObject type
+type MockPostPvzPvzIdCloseResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPvzPvzIdCloseResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPvzPvzIdCloseResponseObject) EXPECT() *MockPostPvzPvzIdCloseResponseObject_Expecter {
+	return &MockPostPvzPvzIdCloseResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPvzPvzIdCloseResponse provides a mock function for the type MockPostPvzPvzIdCloseResponseObject
+func (_mock *MockPostPvzPvzIdCloseResponseObject) VisitPostPvzPvzIdCloseResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPvzPvzIdCloseResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdCloseResponse'
+type MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPvzPvzIdCloseResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPvzPvzIdCloseResponseObject_Expecter) VisitPostPvzPvzIdCloseResponse(w interface{}) *MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call {
+	return &MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdCloseResponse", w)}
+}
+
+func (_c *MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call) Return(err error) *MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdCloseResponseObject_VisitPostPvzPvzIdCloseResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPvzPvzIdCloseLastReceptionResponseObject creates a new instance of MockPostPvzPvzIdCloseLastReceptionResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPvzPvzIdCloseLastReceptionResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPvzPvzIdCloseLastReceptionResponseObject {
+	mock := &MockPostPvzPvzIdCloseLastReceptionResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPvzPvzIdCloseLastReceptionResponseObject is an autogenerated mock type for the PostPvzPvzIdCloseLastReceptionResponseObject type
+type MockPostPvzPvzIdCloseLastReceptionResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPvzPvzIdCloseLastReceptionResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPvzPvzIdCloseLastReceptionResponseObject) EXPECT() *MockPostPvzPvzIdCloseLastReceptionResponseObject_Expecter {
+	return &MockPostPvzPvzIdCloseLastReceptionResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPvzPvzIdCloseLastReceptionResponse provides a mock function for the type MockPostPvzPvzIdCloseLastReceptionResponseObject
+func (_mock *MockPostPvzPvzIdCloseLastReceptionResponseObject) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPvzPvzIdCloseLastReceptionResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdCloseLastReceptionResponse'
+type MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPvzPvzIdCloseLastReceptionResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPvzPvzIdCloseLastReceptionResponseObject_Expecter) VisitPostPvzPvzIdCloseLastReceptionResponse(w interface{}) *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call {
+	return &MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdCloseLastReceptionResponse", w)}
+}
+
+func (_c *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call) Return(err error) *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdCloseLastReceptionResponseObject_VisitPostPvzPvzIdCloseLastReceptionResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPvzPvzIdDeleteLastProductResponseObject creates a new instance of MockPostPvzPvzIdDeleteLastProductResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPvzPvzIdDeleteLastProductResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPvzPvzIdDeleteLastProductResponseObject {
+	mock := &MockPostPvzPvzIdDeleteLastProductResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPvzPvzIdDeleteLastProductResponseObject is an autogenerated mock type for the PostPvzPvzIdDeleteLastProductResponseObject type
+type MockPostPvzPvzIdDeleteLastProductResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPvzPvzIdDeleteLastProductResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPvzPvzIdDeleteLastProductResponseObject) EXPECT() *MockPostPvzPvzIdDeleteLastProductResponseObject_Expecter {
+	return &MockPostPvzPvzIdDeleteLastProductResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPvzPvzIdDeleteLastProductResponse provides a mock function for the type MockPostPvzPvzIdDeleteLastProductResponseObject
+func (_mock *MockPostPvzPvzIdDeleteLastProductResponseObject) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPvzPvzIdDeleteLastProductResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdDeleteLastProductResponse'
+type MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPvzPvzIdDeleteLastProductResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPvzPvzIdDeleteLastProductResponseObject_Expecter) VisitPostPvzPvzIdDeleteLastProductResponse(w interface{}) *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call {
+	return &MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdDeleteLastProductResponse", w)}
+}
+
+func (_c *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call) Return(err error) *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdDeleteLastProductResponseObject_VisitPostPvzPvzIdDeleteLastProductResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetPvzPvzIdHistoryResponseObject creates a new instance of MockGetPvzPvzIdHistoryResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetPvzPvzIdHistoryResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetPvzPvzIdHistoryResponseObject {
+	mock := &MockGetPvzPvzIdHistoryResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetPvzPvzIdHistoryResponseObject is an autogenerated mock type for the GetPvzPvzIdHistoryResponseObject type
+type MockGetPvzPvzIdHistoryResponseObject struct {
+	mock.Mock
+}
+
+type MockGetPvzPvzIdHistoryResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetPvzPvzIdHistoryResponseObject) EXPECT() *MockGetPvzPvzIdHistoryResponseObject_Expecter {
+	return &MockGetPvzPvzIdHistoryResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetPvzPvzIdHistoryResponse provides a mock function for the type MockGetPvzPvzIdHistoryResponseObject
+func (_mock *MockGetPvzPvzIdHistoryResponseObject) VisitGetPvzPvzIdHistoryResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetPvzPvzIdHistoryResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetPvzPvzIdHistoryResponse'
+type MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetPvzPvzIdHistoryResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetPvzPvzIdHistoryResponseObject_Expecter) VisitGetPvzPvzIdHistoryResponse(w interface{}) *MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call {
+	return &MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call{Call: _e.mock.On("VisitGetPvzPvzIdHistoryResponse", w)}
+}
+
+func (_c *MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call) Return(err error) *MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetPvzPvzIdHistoryResponseObject_VisitGetPvzPvzIdHistoryResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPvzPvzIdProductsVoidResponseObject creates a new instance of MockPostPvzPvzIdProductsVoidResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPvzPvzIdProductsVoidResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPvzPvzIdProductsVoidResponseObject {
+	mock := &MockPostPvzPvzIdProductsVoidResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPvzPvzIdProductsVoidResponseObject is an autogenerated mock type for the PostPvzPvzIdProductsVoidResponseObject type
+type MockPostPvzPvzIdProductsVoidResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPvzPvzIdProductsVoidResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPvzPvzIdProductsVoidResponseObject) EXPECT() *MockPostPvzPvzIdProductsVoidResponseObject_Expecter {
+	return &MockPostPvzPvzIdProductsVoidResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPvzPvzIdProductsVoidResponse provides a mock function for the type MockPostPvzPvzIdProductsVoidResponseObject
+func (_mock *MockPostPvzPvzIdProductsVoidResponseObject) VisitPostPvzPvzIdProductsVoidResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPvzPvzIdProductsVoidResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdProductsVoidResponse'
+type MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPvzPvzIdProductsVoidResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPvzPvzIdProductsVoidResponseObject_Expecter) VisitPostPvzPvzIdProductsVoidResponse(w interface{}) *MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call {
+	return &MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdProductsVoidResponse", w)}
+}
+
+func (_c *MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call) Return(err error) *MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdProductsVoidResponseObject_VisitPostPvzPvzIdProductsVoidResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPvzPvzIdProductsBatchResponseObject creates a new instance of MockPostPvzPvzIdProductsBatchResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPvzPvzIdProductsBatchResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPvzPvzIdProductsBatchResponseObject {
+	mock := &MockPostPvzPvzIdProductsBatchResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPvzPvzIdProductsBatchResponseObject is an autogenerated mock type for the PostPvzPvzIdProductsBatchResponseObject type
+type MockPostPvzPvzIdProductsBatchResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPvzPvzIdProductsBatchResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPvzPvzIdProductsBatchResponseObject) EXPECT() *MockPostPvzPvzIdProductsBatchResponseObject_Expecter {
+	return &MockPostPvzPvzIdProductsBatchResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPvzPvzIdProductsBatchResponse provides a mock function for the type MockPostPvzPvzIdProductsBatchResponseObject
+func (_mock *MockPostPvzPvzIdProductsBatchResponseObject) VisitPostPvzPvzIdProductsBatchResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPvzPvzIdProductsBatchResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdProductsBatchResponse'
+type MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPvzPvzIdProductsBatchResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPvzPvzIdProductsBatchResponseObject_Expecter) VisitPostPvzPvzIdProductsBatchResponse(w interface{}) *MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call {
+	return &MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdProductsBatchResponse", w)}
+}
+
+func (_c *MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call) Return(err error) *MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdProductsBatchResponseObject_VisitPostPvzPvzIdProductsBatchResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPvzPvzIdReopenResponseObject creates a new instance of MockPostPvzPvzIdReopenResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPvzPvzIdReopenResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPvzPvzIdReopenResponseObject {
+	mock := &MockPostPvzPvzIdReopenResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPvzPvzIdReopenResponseObject is an autogenerated mock type for the PostPvzPvzIdReopenResponseObject type
+type MockPostPvzPvzIdReopenResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPvzPvzIdReopenResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPvzPvzIdReopenResponseObject) EXPECT() *MockPostPvzPvzIdReopenResponseObject_Expecter {
+	return &MockPostPvzPvzIdReopenResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPvzPvzIdReopenResponse provides a mock function for the type MockPostPvzPvzIdReopenResponseObject
+func (_mock *MockPostPvzPvzIdReopenResponseObject) VisitPostPvzPvzIdReopenResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPvzPvzIdReopenResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdReopenResponse'
+type MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPvzPvzIdReopenResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPvzPvzIdReopenResponseObject_Expecter) VisitPostPvzPvzIdReopenResponse(w interface{}) *MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call {
+	return &MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdReopenResponse", w)}
+}
+
+func (_c *MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call) Return(err error) *MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdReopenResponseObject_VisitPostPvzPvzIdReopenResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetPvzPvzIdStaffResponseObject creates a new instance of MockGetPvzPvzIdStaffResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetPvzPvzIdStaffResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetPvzPvzIdStaffResponseObject {
+	mock := &MockGetPvzPvzIdStaffResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetPvzPvzIdStaffResponseObject is an autogenerated mock type for the GetPvzPvzIdStaffResponseObject type
+type MockGetPvzPvzIdStaffResponseObject struct {
+	mock.Mock
+}
+
+type MockGetPvzPvzIdStaffResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetPvzPvzIdStaffResponseObject) EXPECT() *MockGetPvzPvzIdStaffResponseObject_Expecter {
+	return &MockGetPvzPvzIdStaffResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetPvzPvzIdStaffResponse provides a mock function for the type MockGetPvzPvzIdStaffResponseObject
+func (_mock *MockGetPvzPvzIdStaffResponseObject) VisitGetPvzPvzIdStaffResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetPvzPvzIdStaffResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetPvzPvzIdStaffResponse'
+type MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetPvzPvzIdStaffResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetPvzPvzIdStaffResponseObject_Expecter) VisitGetPvzPvzIdStaffResponse(w interface{}) *MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call {
+	return &MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call{Call: _e.mock.On("VisitGetPvzPvzIdStaffResponse", w)}
+}
+
+func (_c *MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call) Return(err error) *MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetPvzPvzIdStaffResponseObject_VisitGetPvzPvzIdStaffResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeletePvzPvzIdStaffUserIdResponseObject creates a new instance of MockDeletePvzPvzIdStaffUserIdResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeletePvzPvzIdStaffUserIdResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeletePvzPvzIdStaffUserIdResponseObject {
+	mock := &MockDeletePvzPvzIdStaffUserIdResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeletePvzPvzIdStaffUserIdResponseObject is an autogenerated mock type for the DeletePvzPvzIdStaffUserIdResponseObject type
+type MockDeletePvzPvzIdStaffUserIdResponseObject struct {
+	mock.Mock
+}
+
+type MockDeletePvzPvzIdStaffUserIdResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeletePvzPvzIdStaffUserIdResponseObject) EXPECT() *MockDeletePvzPvzIdStaffUserIdResponseObject_Expecter {
+	return &MockDeletePvzPvzIdStaffUserIdResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitDeletePvzPvzIdStaffUserIdResponse provides a mock function for the type MockDeletePvzPvzIdStaffUserIdResponseObject
+func (_mock *MockDeletePvzPvzIdStaffUserIdResponseObject) VisitDeletePvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitDeletePvzPvzIdStaffUserIdResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitDeletePvzPvzIdStaffUserIdResponse'
+type MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call struct {
+	*mock.Call
+}
+
+// VisitDeletePvzPvzIdStaffUserIdResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockDeletePvzPvzIdStaffUserIdResponseObject_Expecter) VisitDeletePvzPvzIdStaffUserIdResponse(w interface{}) *MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call {
+	return &MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call{Call: _e.mock.On("VisitDeletePvzPvzIdStaffUserIdResponse", w)}
+}
+
+func (_c *MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call) Run(run func(w http.ResponseWriter)) *MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call) Return(err error) *MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockDeletePvzPvzIdStaffUserIdResponseObject_VisitDeletePvzPvzIdStaffUserIdResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPutPvzPvzIdStaffUserIdResponseObject creates a new instance of MockPutPvzPvzIdStaffUserIdResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPutPvzPvzIdStaffUserIdResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPutPvzPvzIdStaffUserIdResponseObject {
+	mock := &MockPutPvzPvzIdStaffUserIdResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPutPvzPvzIdStaffUserIdResponseObject is an autogenerated mock type for the PutPvzPvzIdStaffUserIdResponseObject type
+type MockPutPvzPvzIdStaffUserIdResponseObject struct {
+	mock.Mock
+}
+
+type MockPutPvzPvzIdStaffUserIdResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPutPvzPvzIdStaffUserIdResponseObject) EXPECT() *MockPutPvzPvzIdStaffUserIdResponseObject_Expecter {
+	return &MockPutPvzPvzIdStaffUserIdResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPutPvzPvzIdStaffUserIdResponse provides a mock function for the type MockPutPvzPvzIdStaffUserIdResponseObject
+func (_mock *MockPutPvzPvzIdStaffUserIdResponseObject) VisitPutPvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPutPvzPvzIdStaffUserIdResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPutPvzPvzIdStaffUserIdResponse'
+type MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPutPvzPvzIdStaffUserIdResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPutPvzPvzIdStaffUserIdResponseObject_Expecter) VisitPutPvzPvzIdStaffUserIdResponse(w interface{}) *MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call {
+	return &MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call{Call: _e.mock.On("VisitPutPvzPvzIdStaffUserIdResponse", w)}
+}
+
+func (_c *MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call) Run(run func(w http.ResponseWriter)) *MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call) Return(err error) *MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPutPvzPvzIdStaffUserIdResponseObject_VisitPutPvzPvzIdStaffUserIdResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostPvzPvzIdSuspendResponseObject creates a new instance of MockPostPvzPvzIdSuspendResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostPvzPvzIdSuspendResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostPvzPvzIdSuspendResponseObject {
+	mock := &MockPostPvzPvzIdSuspendResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostPvzPvzIdSuspendResponseObject is an autogenerated mock type for the PostPvzPvzIdSuspendResponseObject type
+type MockPostPvzPvzIdSuspendResponseObject struct {
+	mock.Mock
+}
+
+type MockPostPvzPvzIdSuspendResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostPvzPvzIdSuspendResponseObject) EXPECT() *MockPostPvzPvzIdSuspendResponseObject_Expecter {
+	return &MockPostPvzPvzIdSuspendResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostPvzPvzIdSuspendResponse provides a mock function for the type MockPostPvzPvzIdSuspendResponseObject
+func (_mock *MockPostPvzPvzIdSuspendResponseObject) VisitPostPvzPvzIdSuspendResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostPvzPvzIdSuspendResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostPvzPvzIdSuspendResponse'
+type MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostPvzPvzIdSuspendResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostPvzPvzIdSuspendResponseObject_Expecter) VisitPostPvzPvzIdSuspendResponse(w interface{}) *MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call {
+	return &MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call{Call: _e.mock.On("VisitPostPvzPvzIdSuspendResponse", w)}
+}
+
+func (_c *MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call) Return(err error) *MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostPvzPvzIdSuspendResponseObject_VisitPostPvzPvzIdSuspendResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostReceptionsResponseObject creates a new instance of MockPostReceptionsResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostReceptionsResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostReceptionsResponseObject {
+	mock := &MockPostReceptionsResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostReceptionsResponseObject is an autogenerated mock type for the PostReceptionsResponseObject type
+type MockPostReceptionsResponseObject struct {
+	mock.Mock
+}
+
+type MockPostReceptionsResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostReceptionsResponseObject) EXPECT() *MockPostReceptionsResponseObject_Expecter {
+	return &MockPostReceptionsResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostReceptionsResponse provides a mock function for the type MockPostReceptionsResponseObject
+func (_mock *MockPostReceptionsResponseObject) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostReceptionsResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostReceptionsResponse'
+type MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostReceptionsResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostReceptionsResponseObject_Expecter) VisitPostReceptionsResponse(w interface{}) *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call {
+	return &MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call{Call: _e.mock.On("VisitPostReceptionsResponse", w)}
+}
+
+func (_c *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call) Return(err error) *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostReceptionsResponseObject_VisitPostReceptionsResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetReceptionsReceptionIdReconciliationResponseObject creates a new instance of MockGetReceptionsReceptionIdReconciliationResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetReceptionsReceptionIdReconciliationResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetReceptionsReceptionIdReconciliationResponseObject {
+	mock := &MockGetReceptionsReceptionIdReconciliationResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetReceptionsReceptionIdReconciliationResponseObject is an autogenerated mock type for the GetReceptionsReceptionIdReconciliationResponseObject type
+type MockGetReceptionsReceptionIdReconciliationResponseObject struct {
+	mock.Mock
+}
+
+type MockGetReceptionsReceptionIdReconciliationResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetReceptionsReceptionIdReconciliationResponseObject) EXPECT() *MockGetReceptionsReceptionIdReconciliationResponseObject_Expecter {
+	return &MockGetReceptionsReceptionIdReconciliationResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetReceptionsReceptionIdReconciliationResponse provides a mock function for the type MockGetReceptionsReceptionIdReconciliationResponseObject
+func (_mock *MockGetReceptionsReceptionIdReconciliationResponseObject) VisitGetReceptionsReceptionIdReconciliationResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetReceptionsReceptionIdReconciliationResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetReceptionsReceptionIdReconciliationResponse'
+type MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetReceptionsReceptionIdReconciliationResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetReceptionsReceptionIdReconciliationResponseObject_Expecter) VisitGetReceptionsReceptionIdReconciliationResponse(w interface{}) *MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call {
+	return &MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call{Call: _e.mock.On("VisitGetReceptionsReceptionIdReconciliationResponse", w)}
+}
+
+func (_c *MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call) Return(err error) *MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetReceptionsReceptionIdReconciliationResponseObject_VisitGetReceptionsReceptionIdReconciliationResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostReceptionsReceptionIdReopenResponseObject creates a new instance of MockPostReceptionsReceptionIdReopenResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostReceptionsReceptionIdReopenResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostReceptionsReceptionIdReopenResponseObject {
+	mock := &MockPostReceptionsReceptionIdReopenResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostReceptionsReceptionIdReopenResponseObject is an autogenerated mock type for the PostReceptionsReceptionIdReopenResponseObject type
+type MockPostReceptionsReceptionIdReopenResponseObject struct {
+	mock.Mock
+}
+
+type MockPostReceptionsReceptionIdReopenResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostReceptionsReceptionIdReopenResponseObject) EXPECT() *MockPostReceptionsReceptionIdReopenResponseObject_Expecter {
+	return &MockPostReceptionsReceptionIdReopenResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostReceptionsReceptionIdReopenResponse provides a mock function for the type MockPostReceptionsReceptionIdReopenResponseObject
+func (_mock *MockPostReceptionsReceptionIdReopenResponseObject) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostReceptionsReceptionIdReopenResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostReceptionsReceptionIdReopenResponse'
+type MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostReceptionsReceptionIdReopenResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostReceptionsReceptionIdReopenResponseObject_Expecter) VisitPostReceptionsReceptionIdReopenResponse(w interface{}) *MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call {
+	return &MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call{Call: _e.mock.On("VisitPostReceptionsReceptionIdReopenResponse", w)}
+}
+
+func (_c *MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call) Return(err error) *MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostReceptionsReceptionIdReopenResponseObject_VisitPostReceptionsReceptionIdReopenResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostRegisterResponseObject creates a new instance of MockPostRegisterResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostRegisterResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostRegisterResponseObject {
+	mock := &MockPostRegisterResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
 }
 
 // MockPostRegisterResponseObject is an autogenerated mock type for the PostRegisterResponseObject type
@@ -1049,107 +4388,2534 @@ type MockPostRegisterResponseObject struct {
 	mock.Mock
 }
 
-type MockPostRegisterResponseObject_Expecter struct {
-	mock *mock.Mock
+type MockPostRegisterResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostRegisterResponseObject) EXPECT() *MockPostRegisterResponseObject_Expecter {
+	return &MockPostRegisterResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostRegisterResponse provides a mock function for the type MockPostRegisterResponseObject
+func (_mock *MockPostRegisterResponseObject) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostRegisterResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRegisterResponseObject_VisitPostRegisterResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostRegisterResponse'
+type MockPostRegisterResponseObject_VisitPostRegisterResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostRegisterResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostRegisterResponseObject_Expecter) VisitPostRegisterResponse(w interface{}) *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call {
+	return &MockPostRegisterResponseObject_VisitPostRegisterResponse_Call{Call: _e.mock.On("VisitPostRegisterResponse", w)}
+}
+
+func (_c *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call) Return(err error) *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostTokenRefreshResponseObject creates a new instance of MockPostTokenRefreshResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostTokenRefreshResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostTokenRefreshResponseObject {
+	mock := &MockPostTokenRefreshResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostTokenRefreshResponseObject is an autogenerated mock type for the PostTokenRefreshResponseObject type
+type MockPostTokenRefreshResponseObject struct {
+	mock.Mock
+}
+
+type MockPostTokenRefreshResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostTokenRefreshResponseObject) EXPECT() *MockPostTokenRefreshResponseObject_Expecter {
+	return &MockPostTokenRefreshResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostTokenRefreshResponse provides a mock function for the type MockPostTokenRefreshResponseObject
+func (_mock *MockPostTokenRefreshResponseObject) VisitPostTokenRefreshResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostTokenRefreshResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostTokenRefreshResponse'
+type MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostTokenRefreshResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostTokenRefreshResponseObject_Expecter) VisitPostTokenRefreshResponse(w interface{}) *MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call {
+	return &MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call{Call: _e.mock.On("VisitPostTokenRefreshResponse", w)}
+}
+
+func (_c *MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call) Return(err error) *MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostTokenRefreshResponseObject_VisitPostTokenRefreshResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetUsersResponseObject creates a new instance of MockGetUsersResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetUsersResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetUsersResponseObject {
+	mock := &MockGetUsersResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetUsersResponseObject is an autogenerated mock type for the GetUsersResponseObject type
+type MockGetUsersResponseObject struct {
+	mock.Mock
+}
+
+type MockGetUsersResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetUsersResponseObject) EXPECT() *MockGetUsersResponseObject_Expecter {
+	return &MockGetUsersResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitGetUsersResponse provides a mock function for the type MockGetUsersResponseObject
+func (_mock *MockGetUsersResponseObject) VisitGetUsersResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitGetUsersResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGetUsersResponseObject_VisitGetUsersResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitGetUsersResponse'
+type MockGetUsersResponseObject_VisitGetUsersResponse_Call struct {
+	*mock.Call
+}
+
+// VisitGetUsersResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockGetUsersResponseObject_Expecter) VisitGetUsersResponse(w interface{}) *MockGetUsersResponseObject_VisitGetUsersResponse_Call {
+	return &MockGetUsersResponseObject_VisitGetUsersResponse_Call{Call: _e.mock.On("VisitGetUsersResponse", w)}
+}
+
+func (_c *MockGetUsersResponseObject_VisitGetUsersResponse_Call) Run(run func(w http.ResponseWriter)) *MockGetUsersResponseObject_VisitGetUsersResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockGetUsersResponseObject_VisitGetUsersResponse_Call) Return(err error) *MockGetUsersResponseObject_VisitGetUsersResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGetUsersResponseObject_VisitGetUsersResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockGetUsersResponseObject_VisitGetUsersResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostUsersUserIdActivateResponseObject creates a new instance of MockPostUsersUserIdActivateResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostUsersUserIdActivateResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostUsersUserIdActivateResponseObject {
+	mock := &MockPostUsersUserIdActivateResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostUsersUserIdActivateResponseObject is an autogenerated mock type for the PostUsersUserIdActivateResponseObject type
+type MockPostUsersUserIdActivateResponseObject struct {
+	mock.Mock
+}
+
+type MockPostUsersUserIdActivateResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostUsersUserIdActivateResponseObject) EXPECT() *MockPostUsersUserIdActivateResponseObject_Expecter {
+	return &MockPostUsersUserIdActivateResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostUsersUserIdActivateResponse provides a mock function for the type MockPostUsersUserIdActivateResponseObject
+func (_mock *MockPostUsersUserIdActivateResponseObject) VisitPostUsersUserIdActivateResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostUsersUserIdActivateResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostUsersUserIdActivateResponse'
+type MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostUsersUserIdActivateResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostUsersUserIdActivateResponseObject_Expecter) VisitPostUsersUserIdActivateResponse(w interface{}) *MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call {
+	return &MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call{Call: _e.mock.On("VisitPostUsersUserIdActivateResponse", w)}
+}
+
+func (_c *MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call) Return(err error) *MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostUsersUserIdActivateResponseObject_VisitPostUsersUserIdActivateResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostUsersUserIdDeactivateResponseObject creates a new instance of MockPostUsersUserIdDeactivateResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostUsersUserIdDeactivateResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostUsersUserIdDeactivateResponseObject {
+	mock := &MockPostUsersUserIdDeactivateResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostUsersUserIdDeactivateResponseObject is an autogenerated mock type for the PostUsersUserIdDeactivateResponseObject type
+type MockPostUsersUserIdDeactivateResponseObject struct {
+	mock.Mock
+}
+
+type MockPostUsersUserIdDeactivateResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostUsersUserIdDeactivateResponseObject) EXPECT() *MockPostUsersUserIdDeactivateResponseObject_Expecter {
+	return &MockPostUsersUserIdDeactivateResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostUsersUserIdDeactivateResponse provides a mock function for the type MockPostUsersUserIdDeactivateResponseObject
+func (_mock *MockPostUsersUserIdDeactivateResponseObject) VisitPostUsersUserIdDeactivateResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostUsersUserIdDeactivateResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostUsersUserIdDeactivateResponse'
+type MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostUsersUserIdDeactivateResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostUsersUserIdDeactivateResponseObject_Expecter) VisitPostUsersUserIdDeactivateResponse(w interface{}) *MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call {
+	return &MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call{Call: _e.mock.On("VisitPostUsersUserIdDeactivateResponse", w)}
+}
+
+func (_c *MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call) Return(err error) *MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostUsersUserIdDeactivateResponseObject_VisitPostUsersUserIdDeactivateResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostUsersUserIdPasswordResetResponseObject creates a new instance of MockPostUsersUserIdPasswordResetResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostUsersUserIdPasswordResetResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostUsersUserIdPasswordResetResponseObject {
+	mock := &MockPostUsersUserIdPasswordResetResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostUsersUserIdPasswordResetResponseObject is an autogenerated mock type for the PostUsersUserIdPasswordResetResponseObject type
+type MockPostUsersUserIdPasswordResetResponseObject struct {
+	mock.Mock
+}
+
+type MockPostUsersUserIdPasswordResetResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostUsersUserIdPasswordResetResponseObject) EXPECT() *MockPostUsersUserIdPasswordResetResponseObject_Expecter {
+	return &MockPostUsersUserIdPasswordResetResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostUsersUserIdPasswordResetResponse provides a mock function for the type MockPostUsersUserIdPasswordResetResponseObject
+func (_mock *MockPostUsersUserIdPasswordResetResponseObject) VisitPostUsersUserIdPasswordResetResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostUsersUserIdPasswordResetResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostUsersUserIdPasswordResetResponse'
+type MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostUsersUserIdPasswordResetResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostUsersUserIdPasswordResetResponseObject_Expecter) VisitPostUsersUserIdPasswordResetResponse(w interface{}) *MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call {
+	return &MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call{Call: _e.mock.On("VisitPostUsersUserIdPasswordResetResponse", w)}
+}
+
+func (_c *MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call) Return(err error) *MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostUsersUserIdPasswordResetResponseObject_VisitPostUsersUserIdPasswordResetResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostUsersUserIdRoleResponseObject creates a new instance of MockPostUsersUserIdRoleResponseObject. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostUsersUserIdRoleResponseObject(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostUsersUserIdRoleResponseObject {
+	mock := &MockPostUsersUserIdRoleResponseObject{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostUsersUserIdRoleResponseObject is an autogenerated mock type for the PostUsersUserIdRoleResponseObject type
+type MockPostUsersUserIdRoleResponseObject struct {
+	mock.Mock
+}
+
+type MockPostUsersUserIdRoleResponseObject_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostUsersUserIdRoleResponseObject) EXPECT() *MockPostUsersUserIdRoleResponseObject_Expecter {
+	return &MockPostUsersUserIdRoleResponseObject_Expecter{mock: &_m.Mock}
+}
+
+// VisitPostUsersUserIdRoleResponse provides a mock function for the type MockPostUsersUserIdRoleResponseObject
+func (_mock *MockPostUsersUserIdRoleResponseObject) VisitPostUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	ret := _mock.Called(w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VisitPostUsersUserIdRoleResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
+		r0 = returnFunc(w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostUsersUserIdRoleResponse'
+type MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call struct {
+	*mock.Call
+}
+
+// VisitPostUsersUserIdRoleResponse is a helper method to define mock.On call
+//   - w
+func (_e *MockPostUsersUserIdRoleResponseObject_Expecter) VisitPostUsersUserIdRoleResponse(w interface{}) *MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call {
+	return &MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call{Call: _e.mock.On("VisitPostUsersUserIdRoleResponse", w)}
+}
+
+func (_c *MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call) Return(err error) *MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostUsersUserIdRoleResponseObject_VisitPostUsersUserIdRoleResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStrictServerInterface creates a new instance of MockStrictServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStrictServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStrictServerInterface {
+	mock := &MockStrictServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStrictServerInterface is an autogenerated mock type for the StrictServerInterface type
+type MockStrictServerInterface struct {
+	mock.Mock
+}
+
+type MockStrictServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStrictServerInterface) EXPECT() *MockStrictServerInterface_Expecter {
+	return &MockStrictServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// DeleteApiKeysKeyId provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) DeleteApiKeysKeyId(ctx context.Context, request DeleteApiKeysKeyIdRequestObject) (DeleteApiKeysKeyIdResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteApiKeysKeyId")
+	}
+
+	var r0 DeleteApiKeysKeyIdResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DeleteApiKeysKeyIdRequestObject) (DeleteApiKeysKeyIdResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DeleteApiKeysKeyIdRequestObject) DeleteApiKeysKeyIdResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(DeleteApiKeysKeyIdResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, DeleteApiKeysKeyIdRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_DeleteApiKeysKeyId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteApiKeysKeyId'
+type MockStrictServerInterface_DeleteApiKeysKeyId_Call struct {
+	*mock.Call
+}
+
+// DeleteApiKeysKeyId is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) DeleteApiKeysKeyId(ctx interface{}, request interface{}) *MockStrictServerInterface_DeleteApiKeysKeyId_Call {
+	return &MockStrictServerInterface_DeleteApiKeysKeyId_Call{Call: _e.mock.On("DeleteApiKeysKeyId", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_DeleteApiKeysKeyId_Call) Run(run func(ctx context.Context, request DeleteApiKeysKeyIdRequestObject)) *MockStrictServerInterface_DeleteApiKeysKeyId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeleteApiKeysKeyIdRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_DeleteApiKeysKeyId_Call) Return(deleteApiKeysKeyIdResponseObject DeleteApiKeysKeyIdResponseObject, err error) *MockStrictServerInterface_DeleteApiKeysKeyId_Call {
+	_c.Call.Return(deleteApiKeysKeyIdResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_DeleteApiKeysKeyId_Call) RunAndReturn(run func(ctx context.Context, request DeleteApiKeysKeyIdRequestObject) (DeleteApiKeysKeyIdResponseObject, error)) *MockStrictServerInterface_DeleteApiKeysKeyId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePvzPvzIdStaffUserId provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) DeletePvzPvzIdStaffUserId(ctx context.Context, request DeletePvzPvzIdStaffUserIdRequestObject) (DeletePvzPvzIdStaffUserIdResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePvzPvzIdStaffUserId")
+	}
+
+	var r0 DeletePvzPvzIdStaffUserIdResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DeletePvzPvzIdStaffUserIdRequestObject) (DeletePvzPvzIdStaffUserIdResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DeletePvzPvzIdStaffUserIdRequestObject) DeletePvzPvzIdStaffUserIdResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(DeletePvzPvzIdStaffUserIdResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, DeletePvzPvzIdStaffUserIdRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePvzPvzIdStaffUserId'
+type MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call struct {
+	*mock.Call
+}
+
+// DeletePvzPvzIdStaffUserId is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) DeletePvzPvzIdStaffUserId(ctx interface{}, request interface{}) *MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call {
+	return &MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call{Call: _e.mock.On("DeletePvzPvzIdStaffUserId", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call) Run(run func(ctx context.Context, request DeletePvzPvzIdStaffUserIdRequestObject)) *MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeletePvzPvzIdStaffUserIdRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call) Return(deletePvzPvzIdStaffUserIdResponseObject DeletePvzPvzIdStaffUserIdResponseObject, err error) *MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call {
+	_c.Call.Return(deletePvzPvzIdStaffUserIdResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call) RunAndReturn(run func(ctx context.Context, request DeletePvzPvzIdStaffUserIdRequestObject) (DeletePvzPvzIdStaffUserIdResponseObject, error)) *MockStrictServerInterface_DeletePvzPvzIdStaffUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApiKeys provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetApiKeys(ctx context.Context, request GetApiKeysRequestObject) (GetApiKeysResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApiKeys")
+	}
+
+	var r0 GetApiKeysResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetApiKeysRequestObject) (GetApiKeysResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetApiKeysRequestObject) GetApiKeysResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetApiKeysResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetApiKeysRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetApiKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApiKeys'
+type MockStrictServerInterface_GetApiKeys_Call struct {
+	*mock.Call
+}
+
+// GetApiKeys is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetApiKeys(ctx interface{}, request interface{}) *MockStrictServerInterface_GetApiKeys_Call {
+	return &MockStrictServerInterface_GetApiKeys_Call{Call: _e.mock.On("GetApiKeys", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetApiKeys_Call) Run(run func(ctx context.Context, request GetApiKeysRequestObject)) *MockStrictServerInterface_GetApiKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetApiKeysRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetApiKeys_Call) Return(getApiKeysResponseObject GetApiKeysResponseObject, err error) *MockStrictServerInterface_GetApiKeys_Call {
+	_c.Call.Return(getApiKeysResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetApiKeys_Call) RunAndReturn(run func(ctx context.Context, request GetApiKeysRequestObject) (GetApiKeysResponseObject, error)) *MockStrictServerInterface_GetApiKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAudit provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetAudit(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudit")
+	}
+
+	var r0 GetAuditResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetAuditRequestObject) (GetAuditResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetAuditRequestObject) GetAuditResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetAuditResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetAuditRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetAudit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAudit'
+type MockStrictServerInterface_GetAudit_Call struct {
+	*mock.Call
+}
+
+// GetAudit is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetAudit(ctx interface{}, request interface{}) *MockStrictServerInterface_GetAudit_Call {
+	return &MockStrictServerInterface_GetAudit_Call{Call: _e.mock.On("GetAudit", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetAudit_Call) Run(run func(ctx context.Context, request GetAuditRequestObject)) *MockStrictServerInterface_GetAudit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetAuditRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetAudit_Call) Return(getAuditResponseObject GetAuditResponseObject, err error) *MockStrictServerInterface_GetAudit_Call {
+	_c.Call.Return(getAuditResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetAudit_Call) RunAndReturn(run func(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error)) *MockStrictServerInterface_GetAudit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCities provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetCities(ctx context.Context, request GetCitiesRequestObject) (GetCitiesResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCities")
+	}
+
+	var r0 GetCitiesResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCitiesRequestObject) (GetCitiesResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCitiesRequestObject) GetCitiesResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetCitiesResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetCitiesRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetCities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCities'
+type MockStrictServerInterface_GetCities_Call struct {
+	*mock.Call
+}
+
+// GetCities is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetCities(ctx interface{}, request interface{}) *MockStrictServerInterface_GetCities_Call {
+	return &MockStrictServerInterface_GetCities_Call{Call: _e.mock.On("GetCities", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetCities_Call) Run(run func(ctx context.Context, request GetCitiesRequestObject)) *MockStrictServerInterface_GetCities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetCitiesRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetCities_Call) Return(getCitiesResponseObject GetCitiesResponseObject, err error) *MockStrictServerInterface_GetCities_Call {
+	_c.Call.Return(getCitiesResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetCities_Call) RunAndReturn(run func(ctx context.Context, request GetCitiesRequestObject) (GetCitiesResponseObject, error)) *MockStrictServerInterface_GetCities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductTypes provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetProductTypes(ctx context.Context, request GetProductTypesRequestObject) (GetProductTypesResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductTypes")
+	}
+
+	var r0 GetProductTypesResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetProductTypesRequestObject) (GetProductTypesResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetProductTypesRequestObject) GetProductTypesResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetProductTypesResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetProductTypesRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetProductTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductTypes'
+type MockStrictServerInterface_GetProductTypes_Call struct {
+	*mock.Call
+}
+
+// GetProductTypes is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetProductTypes(ctx interface{}, request interface{}) *MockStrictServerInterface_GetProductTypes_Call {
+	return &MockStrictServerInterface_GetProductTypes_Call{Call: _e.mock.On("GetProductTypes", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetProductTypes_Call) Run(run func(ctx context.Context, request GetProductTypesRequestObject)) *MockStrictServerInterface_GetProductTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetProductTypesRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetProductTypes_Call) Return(getProductTypesResponseObject GetProductTypesResponseObject, err error) *MockStrictServerInterface_GetProductTypes_Call {
+	_c.Call.Return(getProductTypesResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetProductTypes_Call) RunAndReturn(run func(ctx context.Context, request GetProductTypesRequestObject) (GetProductTypesResponseObject, error)) *MockStrictServerInterface_GetProductTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPvz provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPvz")
+	}
+
+	var r0 GetPvzResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzRequestObject) (GetPvzResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzRequestObject) GetPvzResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetPvzResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetPvzRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvz'
+type MockStrictServerInterface_GetPvz_Call struct {
+	*mock.Call
+}
+
+// GetPvz is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetPvz(ctx interface{}, request interface{}) *MockStrictServerInterface_GetPvz_Call {
+	return &MockStrictServerInterface_GetPvz_Call{Call: _e.mock.On("GetPvz", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetPvz_Call) Run(run func(ctx context.Context, request GetPvzRequestObject)) *MockStrictServerInterface_GetPvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetPvzRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetPvz_Call) Return(getPvzResponseObject GetPvzResponseObject, err error) *MockStrictServerInterface_GetPvz_Call {
+	_c.Call.Return(getPvzResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetPvz_Call) RunAndReturn(run func(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)) *MockStrictServerInterface_GetPvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPvzNearest provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetPvzNearest(ctx context.Context, request GetPvzNearestRequestObject) (GetPvzNearestResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPvzNearest")
+	}
+
+	var r0 GetPvzNearestResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzNearestRequestObject) (GetPvzNearestResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzNearestRequestObject) GetPvzNearestResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetPvzNearestResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetPvzNearestRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetPvzNearest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzNearest'
+type MockStrictServerInterface_GetPvzNearest_Call struct {
+	*mock.Call
+}
+
+// GetPvzNearest is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetPvzNearest(ctx interface{}, request interface{}) *MockStrictServerInterface_GetPvzNearest_Call {
+	return &MockStrictServerInterface_GetPvzNearest_Call{Call: _e.mock.On("GetPvzNearest", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetPvzNearest_Call) Run(run func(ctx context.Context, request GetPvzNearestRequestObject)) *MockStrictServerInterface_GetPvzNearest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetPvzNearestRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetPvzNearest_Call) Return(getPvzNearestResponseObject GetPvzNearestResponseObject, err error) *MockStrictServerInterface_GetPvzNearest_Call {
+	_c.Call.Return(getPvzNearestResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetPvzNearest_Call) RunAndReturn(run func(ctx context.Context, request GetPvzNearestRequestObject) (GetPvzNearestResponseObject, error)) *MockStrictServerInterface_GetPvzNearest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPvzPvzIdHistory provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetPvzPvzIdHistory(ctx context.Context, request GetPvzPvzIdHistoryRequestObject) (GetPvzPvzIdHistoryResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPvzPvzIdHistory")
+	}
+
+	var r0 GetPvzPvzIdHistoryResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzPvzIdHistoryRequestObject) (GetPvzPvzIdHistoryResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzPvzIdHistoryRequestObject) GetPvzPvzIdHistoryResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetPvzPvzIdHistoryResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetPvzPvzIdHistoryRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetPvzPvzIdHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzPvzIdHistory'
+type MockStrictServerInterface_GetPvzPvzIdHistory_Call struct {
+	*mock.Call
+}
+
+// GetPvzPvzIdHistory is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetPvzPvzIdHistory(ctx interface{}, request interface{}) *MockStrictServerInterface_GetPvzPvzIdHistory_Call {
+	return &MockStrictServerInterface_GetPvzPvzIdHistory_Call{Call: _e.mock.On("GetPvzPvzIdHistory", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetPvzPvzIdHistory_Call) Run(run func(ctx context.Context, request GetPvzPvzIdHistoryRequestObject)) *MockStrictServerInterface_GetPvzPvzIdHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetPvzPvzIdHistoryRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetPvzPvzIdHistory_Call) Return(getPvzPvzIdHistoryResponseObject GetPvzPvzIdHistoryResponseObject, err error) *MockStrictServerInterface_GetPvzPvzIdHistory_Call {
+	_c.Call.Return(getPvzPvzIdHistoryResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetPvzPvzIdHistory_Call) RunAndReturn(run func(ctx context.Context, request GetPvzPvzIdHistoryRequestObject) (GetPvzPvzIdHistoryResponseObject, error)) *MockStrictServerInterface_GetPvzPvzIdHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPvzPvzIdStaff provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetPvzPvzIdStaff(ctx context.Context, request GetPvzPvzIdStaffRequestObject) (GetPvzPvzIdStaffResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPvzPvzIdStaff")
+	}
+
+	var r0 GetPvzPvzIdStaffResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzPvzIdStaffRequestObject) (GetPvzPvzIdStaffResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzPvzIdStaffRequestObject) GetPvzPvzIdStaffResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetPvzPvzIdStaffResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetPvzPvzIdStaffRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetPvzPvzIdStaff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzPvzIdStaff'
+type MockStrictServerInterface_GetPvzPvzIdStaff_Call struct {
+	*mock.Call
+}
+
+// GetPvzPvzIdStaff is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetPvzPvzIdStaff(ctx interface{}, request interface{}) *MockStrictServerInterface_GetPvzPvzIdStaff_Call {
+	return &MockStrictServerInterface_GetPvzPvzIdStaff_Call{Call: _e.mock.On("GetPvzPvzIdStaff", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetPvzPvzIdStaff_Call) Run(run func(ctx context.Context, request GetPvzPvzIdStaffRequestObject)) *MockStrictServerInterface_GetPvzPvzIdStaff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetPvzPvzIdStaffRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetPvzPvzIdStaff_Call) Return(getPvzPvzIdStaffResponseObject GetPvzPvzIdStaffResponseObject, err error) *MockStrictServerInterface_GetPvzPvzIdStaff_Call {
+	_c.Call.Return(getPvzPvzIdStaffResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetPvzPvzIdStaff_Call) RunAndReturn(run func(ctx context.Context, request GetPvzPvzIdStaffRequestObject) (GetPvzPvzIdStaffResponseObject, error)) *MockStrictServerInterface_GetPvzPvzIdStaff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReceptionsReceptionIdReconciliation provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetReceptionsReceptionIdReconciliation(ctx context.Context, request GetReceptionsReceptionIdReconciliationRequestObject) (GetReceptionsReceptionIdReconciliationResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReceptionsReceptionIdReconciliation")
+	}
+
+	var r0 GetReceptionsReceptionIdReconciliationResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetReceptionsReceptionIdReconciliationRequestObject) (GetReceptionsReceptionIdReconciliationResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetReceptionsReceptionIdReconciliationRequestObject) GetReceptionsReceptionIdReconciliationResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetReceptionsReceptionIdReconciliationResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetReceptionsReceptionIdReconciliationRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReceptionsReceptionIdReconciliation'
+type MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call struct {
+	*mock.Call
+}
+
+// GetReceptionsReceptionIdReconciliation is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetReceptionsReceptionIdReconciliation(ctx interface{}, request interface{}) *MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call {
+	return &MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call{Call: _e.mock.On("GetReceptionsReceptionIdReconciliation", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call) Run(run func(ctx context.Context, request GetReceptionsReceptionIdReconciliationRequestObject)) *MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetReceptionsReceptionIdReconciliationRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call) Return(getReceptionsReceptionIdReconciliationResponseObject GetReceptionsReceptionIdReconciliationResponseObject, err error) *MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call {
+	_c.Call.Return(getReceptionsReceptionIdReconciliationResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call) RunAndReturn(run func(ctx context.Context, request GetReceptionsReceptionIdReconciliationRequestObject) (GetReceptionsReceptionIdReconciliationResponseObject, error)) *MockStrictServerInterface_GetReceptionsReceptionIdReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetUsers(ctx context.Context, request GetUsersRequestObject) (GetUsersResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 GetUsersResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetUsersRequestObject) (GetUsersResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetUsersRequestObject) GetUsersResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetUsersResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetUsersRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type MockStrictServerInterface_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetUsers(ctx interface{}, request interface{}) *MockStrictServerInterface_GetUsers_Call {
+	return &MockStrictServerInterface_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetUsers_Call) Run(run func(ctx context.Context, request GetUsersRequestObject)) *MockStrictServerInterface_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetUsersRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetUsers_Call) Return(getUsersResponseObject GetUsersResponseObject, err error) *MockStrictServerInterface_GetUsers_Call {
+	_c.Call.Return(getUsersResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetUsers_Call) RunAndReturn(run func(ctx context.Context, request GetUsersRequestObject) (GetUsersResponseObject, error)) *MockStrictServerInterface_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWellKnownJwksJson provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) GetWellKnownJwksJson(ctx context.Context, request GetWellKnownJwksJsonRequestObject) (GetWellKnownJwksJsonResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWellKnownJwksJson")
+	}
+
+	var r0 GetWellKnownJwksJsonResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetWellKnownJwksJsonRequestObject) (GetWellKnownJwksJsonResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetWellKnownJwksJsonRequestObject) GetWellKnownJwksJsonResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetWellKnownJwksJsonResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetWellKnownJwksJsonRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_GetWellKnownJwksJson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWellKnownJwksJson'
+type MockStrictServerInterface_GetWellKnownJwksJson_Call struct {
+	*mock.Call
+}
+
+// GetWellKnownJwksJson is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) GetWellKnownJwksJson(ctx interface{}, request interface{}) *MockStrictServerInterface_GetWellKnownJwksJson_Call {
+	return &MockStrictServerInterface_GetWellKnownJwksJson_Call{Call: _e.mock.On("GetWellKnownJwksJson", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_GetWellKnownJwksJson_Call) Run(run func(ctx context.Context, request GetWellKnownJwksJsonRequestObject)) *MockStrictServerInterface_GetWellKnownJwksJson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetWellKnownJwksJsonRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetWellKnownJwksJson_Call) Return(getWellKnownJwksJsonResponseObject GetWellKnownJwksJsonResponseObject, err error) *MockStrictServerInterface_GetWellKnownJwksJson_Call {
+	_c.Call.Return(getWellKnownJwksJsonResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_GetWellKnownJwksJson_Call) RunAndReturn(run func(ctx context.Context, request GetWellKnownJwksJsonRequestObject) (GetWellKnownJwksJsonResponseObject, error)) *MockStrictServerInterface_GetWellKnownJwksJson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchPvzPvzId provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PatchPvzPvzId(ctx context.Context, request PatchPvzPvzIdRequestObject) (PatchPvzPvzIdResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchPvzPvzId")
+	}
+
+	var r0 PatchPvzPvzIdResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PatchPvzPvzIdRequestObject) (PatchPvzPvzIdResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PatchPvzPvzIdRequestObject) PatchPvzPvzIdResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PatchPvzPvzIdResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PatchPvzPvzIdRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PatchPvzPvzId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchPvzPvzId'
+type MockStrictServerInterface_PatchPvzPvzId_Call struct {
+	*mock.Call
+}
+
+// PatchPvzPvzId is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PatchPvzPvzId(ctx interface{}, request interface{}) *MockStrictServerInterface_PatchPvzPvzId_Call {
+	return &MockStrictServerInterface_PatchPvzPvzId_Call{Call: _e.mock.On("PatchPvzPvzId", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PatchPvzPvzId_Call) Run(run func(ctx context.Context, request PatchPvzPvzIdRequestObject)) *MockStrictServerInterface_PatchPvzPvzId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PatchPvzPvzIdRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PatchPvzPvzId_Call) Return(patchPvzPvzIdResponseObject PatchPvzPvzIdResponseObject, err error) *MockStrictServerInterface_PatchPvzPvzId_Call {
+	_c.Call.Return(patchPvzPvzIdResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PatchPvzPvzId_Call) RunAndReturn(run func(ctx context.Context, request PatchPvzPvzIdRequestObject) (PatchPvzPvzIdResponseObject, error)) *MockStrictServerInterface_PatchPvzPvzId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostApiKeys provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostApiKeys(ctx context.Context, request PostApiKeysRequestObject) (PostApiKeysResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostApiKeys")
+	}
+
+	var r0 PostApiKeysResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostApiKeysRequestObject) (PostApiKeysResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostApiKeysRequestObject) PostApiKeysResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostApiKeysResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostApiKeysRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostApiKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostApiKeys'
+type MockStrictServerInterface_PostApiKeys_Call struct {
+	*mock.Call
+}
+
+// PostApiKeys is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostApiKeys(ctx interface{}, request interface{}) *MockStrictServerInterface_PostApiKeys_Call {
+	return &MockStrictServerInterface_PostApiKeys_Call{Call: _e.mock.On("PostApiKeys", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostApiKeys_Call) Run(run func(ctx context.Context, request PostApiKeysRequestObject)) *MockStrictServerInterface_PostApiKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostApiKeysRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostApiKeys_Call) Return(postApiKeysResponseObject PostApiKeysResponseObject, err error) *MockStrictServerInterface_PostApiKeys_Call {
+	_c.Call.Return(postApiKeysResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostApiKeys_Call) RunAndReturn(run func(ctx context.Context, request PostApiKeysRequestObject) (PostApiKeysResponseObject, error)) *MockStrictServerInterface_PostApiKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostCities provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostCities(ctx context.Context, request PostCitiesRequestObject) (PostCitiesResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostCities")
+	}
+
+	var r0 PostCitiesResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostCitiesRequestObject) (PostCitiesResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostCitiesRequestObject) PostCitiesResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostCitiesResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostCitiesRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostCities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostCities'
+type MockStrictServerInterface_PostCities_Call struct {
+	*mock.Call
+}
+
+// PostCities is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostCities(ctx interface{}, request interface{}) *MockStrictServerInterface_PostCities_Call {
+	return &MockStrictServerInterface_PostCities_Call{Call: _e.mock.On("PostCities", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostCities_Call) Run(run func(ctx context.Context, request PostCitiesRequestObject)) *MockStrictServerInterface_PostCities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostCitiesRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostCities_Call) Return(postCitiesResponseObject PostCitiesResponseObject, err error) *MockStrictServerInterface_PostCities_Call {
+	_c.Call.Return(postCitiesResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostCities_Call) RunAndReturn(run func(ctx context.Context, request PostCitiesRequestObject) (PostCitiesResponseObject, error)) *MockStrictServerInterface_PostCities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostCitiesCityIdActivate provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostCitiesCityIdActivate(ctx context.Context, request PostCitiesCityIdActivateRequestObject) (PostCitiesCityIdActivateResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostCitiesCityIdActivate")
+	}
+
+	var r0 PostCitiesCityIdActivateResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostCitiesCityIdActivateRequestObject) (PostCitiesCityIdActivateResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostCitiesCityIdActivateRequestObject) PostCitiesCityIdActivateResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostCitiesCityIdActivateResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostCitiesCityIdActivateRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostCitiesCityIdActivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostCitiesCityIdActivate'
+type MockStrictServerInterface_PostCitiesCityIdActivate_Call struct {
+	*mock.Call
+}
+
+// PostCitiesCityIdActivate is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostCitiesCityIdActivate(ctx interface{}, request interface{}) *MockStrictServerInterface_PostCitiesCityIdActivate_Call {
+	return &MockStrictServerInterface_PostCitiesCityIdActivate_Call{Call: _e.mock.On("PostCitiesCityIdActivate", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostCitiesCityIdActivate_Call) Run(run func(ctx context.Context, request PostCitiesCityIdActivateRequestObject)) *MockStrictServerInterface_PostCitiesCityIdActivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostCitiesCityIdActivateRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostCitiesCityIdActivate_Call) Return(postCitiesCityIdActivateResponseObject PostCitiesCityIdActivateResponseObject, err error) *MockStrictServerInterface_PostCitiesCityIdActivate_Call {
+	_c.Call.Return(postCitiesCityIdActivateResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostCitiesCityIdActivate_Call) RunAndReturn(run func(ctx context.Context, request PostCitiesCityIdActivateRequestObject) (PostCitiesCityIdActivateResponseObject, error)) *MockStrictServerInterface_PostCitiesCityIdActivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostCitiesCityIdDeactivate provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostCitiesCityIdDeactivate(ctx context.Context, request PostCitiesCityIdDeactivateRequestObject) (PostCitiesCityIdDeactivateResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostCitiesCityIdDeactivate")
+	}
+
+	var r0 PostCitiesCityIdDeactivateResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostCitiesCityIdDeactivateRequestObject) (PostCitiesCityIdDeactivateResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostCitiesCityIdDeactivateRequestObject) PostCitiesCityIdDeactivateResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostCitiesCityIdDeactivateResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostCitiesCityIdDeactivateRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostCitiesCityIdDeactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostCitiesCityIdDeactivate'
+type MockStrictServerInterface_PostCitiesCityIdDeactivate_Call struct {
+	*mock.Call
+}
+
+// PostCitiesCityIdDeactivate is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostCitiesCityIdDeactivate(ctx interface{}, request interface{}) *MockStrictServerInterface_PostCitiesCityIdDeactivate_Call {
+	return &MockStrictServerInterface_PostCitiesCityIdDeactivate_Call{Call: _e.mock.On("PostCitiesCityIdDeactivate", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostCitiesCityIdDeactivate_Call) Run(run func(ctx context.Context, request PostCitiesCityIdDeactivateRequestObject)) *MockStrictServerInterface_PostCitiesCityIdDeactivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostCitiesCityIdDeactivateRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostCitiesCityIdDeactivate_Call) Return(postCitiesCityIdDeactivateResponseObject PostCitiesCityIdDeactivateResponseObject, err error) *MockStrictServerInterface_PostCitiesCityIdDeactivate_Call {
+	_c.Call.Return(postCitiesCityIdDeactivateResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostCitiesCityIdDeactivate_Call) RunAndReturn(run func(ctx context.Context, request PostCitiesCityIdDeactivateRequestObject) (PostCitiesCityIdDeactivateResponseObject, error)) *MockStrictServerInterface_PostCitiesCityIdDeactivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostDummyLogin provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostDummyLogin")
+	}
+
+	var r0 PostDummyLoginResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostDummyLoginRequestObject) PostDummyLoginResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostDummyLoginResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostDummyLoginRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostDummyLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostDummyLogin'
+type MockStrictServerInterface_PostDummyLogin_Call struct {
+	*mock.Call
+}
+
+// PostDummyLogin is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostDummyLogin(ctx interface{}, request interface{}) *MockStrictServerInterface_PostDummyLogin_Call {
+	return &MockStrictServerInterface_PostDummyLogin_Call{Call: _e.mock.On("PostDummyLogin", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostDummyLogin_Call) Run(run func(ctx context.Context, request PostDummyLoginRequestObject)) *MockStrictServerInterface_PostDummyLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostDummyLoginRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostDummyLogin_Call) Return(postDummyLoginResponseObject PostDummyLoginResponseObject, err error) *MockStrictServerInterface_PostDummyLogin_Call {
+	_c.Call.Return(postDummyLoginResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostDummyLogin_Call) RunAndReturn(run func(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)) *MockStrictServerInterface_PostDummyLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostLogin provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostLogin")
+	}
+
+	var r0 PostLoginResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostLoginRequestObject) (PostLoginResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostLoginRequestObject) PostLoginResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostLoginResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostLoginRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostLogin'
+type MockStrictServerInterface_PostLogin_Call struct {
+	*mock.Call
+}
+
+// PostLogin is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostLogin(ctx interface{}, request interface{}) *MockStrictServerInterface_PostLogin_Call {
+	return &MockStrictServerInterface_PostLogin_Call{Call: _e.mock.On("PostLogin", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostLogin_Call) Run(run func(ctx context.Context, request PostLoginRequestObject)) *MockStrictServerInterface_PostLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostLoginRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostLogin_Call) Return(postLoginResponseObject PostLoginResponseObject, err error) *MockStrictServerInterface_PostLogin_Call {
+	_c.Call.Return(postLoginResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostLogin_Call) RunAndReturn(run func(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)) *MockStrictServerInterface_PostLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostLogout provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostLogout(ctx context.Context, request PostLogoutRequestObject) (PostLogoutResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostLogout")
+	}
+
+	var r0 PostLogoutResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostLogoutRequestObject) (PostLogoutResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostLogoutRequestObject) PostLogoutResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostLogoutResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostLogoutRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostLogout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostLogout'
+type MockStrictServerInterface_PostLogout_Call struct {
+	*mock.Call
+}
+
+// PostLogout is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostLogout(ctx interface{}, request interface{}) *MockStrictServerInterface_PostLogout_Call {
+	return &MockStrictServerInterface_PostLogout_Call{Call: _e.mock.On("PostLogout", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostLogout_Call) Run(run func(ctx context.Context, request PostLogoutRequestObject)) *MockStrictServerInterface_PostLogout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostLogoutRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostLogout_Call) Return(postLogoutResponseObject PostLogoutResponseObject, err error) *MockStrictServerInterface_PostLogout_Call {
+	_c.Call.Return(postLogoutResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostLogout_Call) RunAndReturn(run func(ctx context.Context, request PostLogoutRequestObject) (PostLogoutResponseObject, error)) *MockStrictServerInterface_PostLogout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPasswordForgot provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPasswordForgot(ctx context.Context, request PostPasswordForgotRequestObject) (PostPasswordForgotResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPasswordForgot")
+	}
+
+	var r0 PostPasswordForgotResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPasswordForgotRequestObject) (PostPasswordForgotResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPasswordForgotRequestObject) PostPasswordForgotResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPasswordForgotResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPasswordForgotRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostPasswordForgot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPasswordForgot'
+type MockStrictServerInterface_PostPasswordForgot_Call struct {
+	*mock.Call
+}
+
+// PostPasswordForgot is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPasswordForgot(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPasswordForgot_Call {
+	return &MockStrictServerInterface_PostPasswordForgot_Call{Call: _e.mock.On("PostPasswordForgot", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPasswordForgot_Call) Run(run func(ctx context.Context, request PostPasswordForgotRequestObject)) *MockStrictServerInterface_PostPasswordForgot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPasswordForgotRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPasswordForgot_Call) Return(postPasswordForgotResponseObject PostPasswordForgotResponseObject, err error) *MockStrictServerInterface_PostPasswordForgot_Call {
+	_c.Call.Return(postPasswordForgotResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPasswordForgot_Call) RunAndReturn(run func(ctx context.Context, request PostPasswordForgotRequestObject) (PostPasswordForgotResponseObject, error)) *MockStrictServerInterface_PostPasswordForgot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPasswordReset provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPasswordReset(ctx context.Context, request PostPasswordResetRequestObject) (PostPasswordResetResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPasswordReset")
+	}
+
+	var r0 PostPasswordResetResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPasswordResetRequestObject) (PostPasswordResetResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPasswordResetRequestObject) PostPasswordResetResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPasswordResetResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPasswordResetRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPasswordReset'
+type MockStrictServerInterface_PostPasswordReset_Call struct {
+	*mock.Call
+}
+
+// PostPasswordReset is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPasswordReset(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPasswordReset_Call {
+	return &MockStrictServerInterface_PostPasswordReset_Call{Call: _e.mock.On("PostPasswordReset", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPasswordReset_Call) Run(run func(ctx context.Context, request PostPasswordResetRequestObject)) *MockStrictServerInterface_PostPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPasswordResetRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPasswordReset_Call) Return(postPasswordResetResponseObject PostPasswordResetResponseObject, err error) *MockStrictServerInterface_PostPasswordReset_Call {
+	_c.Call.Return(postPasswordResetResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPasswordReset_Call) RunAndReturn(run func(ctx context.Context, request PostPasswordResetRequestObject) (PostPasswordResetResponseObject, error)) *MockStrictServerInterface_PostPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostProductTypes provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostProductTypes(ctx context.Context, request PostProductTypesRequestObject) (PostProductTypesResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostProductTypes")
+	}
+
+	var r0 PostProductTypesResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostProductTypesRequestObject) (PostProductTypesResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostProductTypesRequestObject) PostProductTypesResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostProductTypesResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostProductTypesRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostProductTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostProductTypes'
+type MockStrictServerInterface_PostProductTypes_Call struct {
+	*mock.Call
+}
+
+// PostProductTypes is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostProductTypes(ctx interface{}, request interface{}) *MockStrictServerInterface_PostProductTypes_Call {
+	return &MockStrictServerInterface_PostProductTypes_Call{Call: _e.mock.On("PostProductTypes", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostProductTypes_Call) Run(run func(ctx context.Context, request PostProductTypesRequestObject)) *MockStrictServerInterface_PostProductTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostProductTypesRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostProductTypes_Call) Return(postProductTypesResponseObject PostProductTypesResponseObject, err error) *MockStrictServerInterface_PostProductTypes_Call {
+	_c.Call.Return(postProductTypesResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostProductTypes_Call) RunAndReturn(run func(ctx context.Context, request PostProductTypesRequestObject) (PostProductTypesResponseObject, error)) *MockStrictServerInterface_PostProductTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostProducts provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostProducts")
+	}
+
+	var r0 PostProductsResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostProductsRequestObject) (PostProductsResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostProductsRequestObject) PostProductsResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostProductsResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostProductsRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostProducts'
+type MockStrictServerInterface_PostProducts_Call struct {
+	*mock.Call
+}
+
+// PostProducts is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostProducts(ctx interface{}, request interface{}) *MockStrictServerInterface_PostProducts_Call {
+	return &MockStrictServerInterface_PostProducts_Call{Call: _e.mock.On("PostProducts", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostProducts_Call) Run(run func(ctx context.Context, request PostProductsRequestObject)) *MockStrictServerInterface_PostProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostProductsRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostProducts_Call) Return(postProductsResponseObject PostProductsResponseObject, err error) *MockStrictServerInterface_PostProducts_Call {
+	_c.Call.Return(postProductsResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostProducts_Call) RunAndReturn(run func(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)) *MockStrictServerInterface_PostProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPvz provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPvz")
+	}
+
+	var r0 PostPvzResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzRequestObject) (PostPvzResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzRequestObject) PostPvzResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPvzResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvz'
+type MockStrictServerInterface_PostPvz_Call struct {
+	*mock.Call
+}
+
+// PostPvz is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPvz(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvz_Call {
+	return &MockStrictServerInterface_PostPvz_Call{Call: _e.mock.On("PostPvz", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPvz_Call) Run(run func(ctx context.Context, request PostPvzRequestObject)) *MockStrictServerInterface_PostPvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPvzRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvz_Call) Return(postPvzResponseObject PostPvzResponseObject, err error) *MockStrictServerInterface_PostPvz_Call {
+	_c.Call.Return(postPvzResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvz_Call) RunAndReturn(run func(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)) *MockStrictServerInterface_PostPvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPvzPvzIdAsn provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPvzPvzIdAsn(ctx context.Context, request PostPvzPvzIdAsnRequestObject) (PostPvzPvzIdAsnResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPvzPvzIdAsn")
+	}
+
+	var r0 PostPvzPvzIdAsnResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdAsnRequestObject) (PostPvzPvzIdAsnResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdAsnRequestObject) PostPvzPvzIdAsnResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPvzPvzIdAsnResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdAsnRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostPvzPvzIdAsn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdAsn'
+type MockStrictServerInterface_PostPvzPvzIdAsn_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdAsn is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdAsn(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdAsn_Call {
+	return &MockStrictServerInterface_PostPvzPvzIdAsn_Call{Call: _e.mock.On("PostPvzPvzIdAsn", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdAsn_Call) Run(run func(ctx context.Context, request PostPvzPvzIdAsnRequestObject)) *MockStrictServerInterface_PostPvzPvzIdAsn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPvzPvzIdAsnRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdAsn_Call) Return(postPvzPvzIdAsnResponseObject PostPvzPvzIdAsnResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdAsn_Call {
+	_c.Call.Return(postPvzPvzIdAsnResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdAsn_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdAsnRequestObject) (PostPvzPvzIdAsnResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdAsn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPvzPvzIdClose provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPvzPvzIdClose(ctx context.Context, request PostPvzPvzIdCloseRequestObject) (PostPvzPvzIdCloseResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPvzPvzIdClose")
+	}
+
+	var r0 PostPvzPvzIdCloseResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdCloseRequestObject) (PostPvzPvzIdCloseResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdCloseRequestObject) PostPvzPvzIdCloseResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPvzPvzIdCloseResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdCloseRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostPvzPvzIdClose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdClose'
+type MockStrictServerInterface_PostPvzPvzIdClose_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdClose is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdClose(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdClose_Call {
+	return &MockStrictServerInterface_PostPvzPvzIdClose_Call{Call: _e.mock.On("PostPvzPvzIdClose", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdClose_Call) Run(run func(ctx context.Context, request PostPvzPvzIdCloseRequestObject)) *MockStrictServerInterface_PostPvzPvzIdClose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPvzPvzIdCloseRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdClose_Call) Return(postPvzPvzIdCloseResponseObject PostPvzPvzIdCloseResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdClose_Call {
+	_c.Call.Return(postPvzPvzIdCloseResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdClose_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdCloseRequestObject) (PostPvzPvzIdCloseResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdClose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPvzPvzIdCloseLastReception provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPvzPvzIdCloseLastReception")
+	}
+
+	var r0 PostPvzPvzIdCloseLastReceptionResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdCloseLastReceptionRequestObject) PostPvzPvzIdCloseLastReceptionResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPvzPvzIdCloseLastReceptionResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdCloseLastReceptionRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-func (_m *MockPostRegisterResponseObject) EXPECT() *MockPostRegisterResponseObject_Expecter {
-	return &MockPostRegisterResponseObject_Expecter{mock: &_m.Mock}
+// MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdCloseLastReception'
+type MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call struct {
+	*mock.Call
 }
 
-// VisitPostRegisterResponse provides a mock function for the type MockPostRegisterResponseObject
-func (_mock *MockPostRegisterResponseObject) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	ret := _mock.Called(w)
+// PostPvzPvzIdCloseLastReception is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdCloseLastReception(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call {
+	return &MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call{Call: _e.mock.On("PostPvzPvzIdCloseLastReception", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call) Run(run func(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject)) *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPvzPvzIdCloseLastReceptionRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call) Return(postPvzPvzIdCloseLastReceptionResponseObject PostPvzPvzIdCloseLastReceptionResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call {
+	_c.Call.Return(postPvzPvzIdCloseLastReceptionResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPvzPvzIdDeleteLastProduct provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for VisitPostRegisterResponse")
+		panic("no return value specified for PostPvzPvzIdDeleteLastProduct")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(http.ResponseWriter) error); ok {
-		r0 = returnFunc(w)
+	var r0 PostPvzPvzIdDeleteLastProductResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdDeleteLastProductRequestObject) PostPvzPvzIdDeleteLastProductResponseObject); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPvzPvzIdDeleteLastProductResponseObject)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdDeleteLastProductRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockPostRegisterResponseObject_VisitPostRegisterResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VisitPostRegisterResponse'
-type MockPostRegisterResponseObject_VisitPostRegisterResponse_Call struct {
+// MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdDeleteLastProduct'
+type MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdDeleteLastProduct is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdDeleteLastProduct(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+	return &MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call{Call: _e.mock.On("PostPvzPvzIdDeleteLastProduct", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call) Run(run func(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject)) *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPvzPvzIdDeleteLastProductRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call) Return(postPvzPvzIdDeleteLastProductResponseObject PostPvzPvzIdDeleteLastProductResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+	_c.Call.Return(postPvzPvzIdDeleteLastProductResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPvzPvzIdProductsBatch provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPvzPvzIdProductsBatch(ctx context.Context, request PostPvzPvzIdProductsBatchRequestObject) (PostPvzPvzIdProductsBatchResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPvzPvzIdProductsBatch")
+	}
+
+	var r0 PostPvzPvzIdProductsBatchResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdProductsBatchRequestObject) (PostPvzPvzIdProductsBatchResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdProductsBatchRequestObject) PostPvzPvzIdProductsBatchResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPvzPvzIdProductsBatchResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdProductsBatchRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdProductsBatch'
+type MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdProductsBatch is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdProductsBatch(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call {
+	return &MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call{Call: _e.mock.On("PostPvzPvzIdProductsBatch", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call) Run(run func(ctx context.Context, request PostPvzPvzIdProductsBatchRequestObject)) *MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPvzPvzIdProductsBatchRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call) Return(postPvzPvzIdProductsBatchResponseObject PostPvzPvzIdProductsBatchResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call {
+	_c.Call.Return(postPvzPvzIdProductsBatchResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdProductsBatchRequestObject) (PostPvzPvzIdProductsBatchResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdProductsBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPvzPvzIdProductsVoid provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPvzPvzIdProductsVoid(ctx context.Context, request PostPvzPvzIdProductsVoidRequestObject) (PostPvzPvzIdProductsVoidResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPvzPvzIdProductsVoid")
+	}
+
+	var r0 PostPvzPvzIdProductsVoidResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdProductsVoidRequestObject) (PostPvzPvzIdProductsVoidResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdProductsVoidRequestObject) PostPvzPvzIdProductsVoidResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPvzPvzIdProductsVoidResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdProductsVoidRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdProductsVoid'
+type MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdProductsVoid is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdProductsVoid(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call {
+	return &MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call{Call: _e.mock.On("PostPvzPvzIdProductsVoid", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call) Run(run func(ctx context.Context, request PostPvzPvzIdProductsVoidRequestObject)) *MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPvzPvzIdProductsVoidRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call) Return(postPvzPvzIdProductsVoidResponseObject PostPvzPvzIdProductsVoidResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call {
+	_c.Call.Return(postPvzPvzIdProductsVoidResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdProductsVoidRequestObject) (PostPvzPvzIdProductsVoidResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdProductsVoid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPvzPvzIdReopen provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPvzPvzIdReopen(ctx context.Context, request PostPvzPvzIdReopenRequestObject) (PostPvzPvzIdReopenResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPvzPvzIdReopen")
+	}
+
+	var r0 PostPvzPvzIdReopenResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdReopenRequestObject) (PostPvzPvzIdReopenResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdReopenRequestObject) PostPvzPvzIdReopenResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPvzPvzIdReopenResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdReopenRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostPvzPvzIdReopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdReopen'
+type MockStrictServerInterface_PostPvzPvzIdReopen_Call struct {
+	*mock.Call
+}
+
+// PostPvzPvzIdReopen is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdReopen(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdReopen_Call {
+	return &MockStrictServerInterface_PostPvzPvzIdReopen_Call{Call: _e.mock.On("PostPvzPvzIdReopen", ctx, request)}
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdReopen_Call) Run(run func(ctx context.Context, request PostPvzPvzIdReopenRequestObject)) *MockStrictServerInterface_PostPvzPvzIdReopen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostPvzPvzIdReopenRequestObject))
+	})
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdReopen_Call) Return(postPvzPvzIdReopenResponseObject PostPvzPvzIdReopenResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdReopen_Call {
+	_c.Call.Return(postPvzPvzIdReopenResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostPvzPvzIdReopen_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdReopenRequestObject) (PostPvzPvzIdReopenResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdReopen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostPvzPvzIdSuspend provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostPvzPvzIdSuspend(ctx context.Context, request PostPvzPvzIdSuspendRequestObject) (PostPvzPvzIdSuspendResponseObject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostPvzPvzIdSuspend")
+	}
+
+	var r0 PostPvzPvzIdSuspendResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdSuspendRequestObject) (PostPvzPvzIdSuspendResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdSuspendRequestObject) PostPvzPvzIdSuspendResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostPvzPvzIdSuspendResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdSuspendRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStrictServerInterface_PostPvzPvzIdSuspend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdSuspend'
+type MockStrictServerInterface_PostPvzPvzIdSuspend_Call struct {
 	*mock.Call
 }
 
-// VisitPostRegisterResponse is a helper method to define mock.On call
-//   - w
-func (_e *MockPostRegisterResponseObject_Expecter) VisitPostRegisterResponse(w interface{}) *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call {
-	return &MockPostRegisterResponseObject_VisitPostRegisterResponse_Call{Call: _e.mock.On("VisitPostRegisterResponse", w)}
+// PostPvzPvzIdSuspend is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdSuspend(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdSuspend_Call {
+	return &MockStrictServerInterface_PostPvzPvzIdSuspend_Call{Call: _e.mock.On("PostPvzPvzIdSuspend", ctx, request)}
 }
 
-func (_c *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call) Run(run func(w http.ResponseWriter)) *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call {
+func (_c *MockStrictServerInterface_PostPvzPvzIdSuspend_Call) Run(run func(ctx context.Context, request PostPvzPvzIdSuspendRequestObject)) *MockStrictServerInterface_PostPvzPvzIdSuspend_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(http.ResponseWriter))
+		run(args[0].(context.Context), args[1].(PostPvzPvzIdSuspendRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call) Return(err error) *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call {
-	_c.Call.Return(err)
+func (_c *MockStrictServerInterface_PostPvzPvzIdSuspend_Call) Return(postPvzPvzIdSuspendResponseObject PostPvzPvzIdSuspendResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdSuspend_Call {
+	_c.Call.Return(postPvzPvzIdSuspendResponseObject, err)
 	return _c
 }
 
-func (_c *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call) RunAndReturn(run func(w http.ResponseWriter) error) *MockPostRegisterResponseObject_VisitPostRegisterResponse_Call {
+func (_c *MockStrictServerInterface_PostPvzPvzIdSuspend_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdSuspendRequestObject) (PostPvzPvzIdSuspendResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdSuspend_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockStrictServerInterface creates a new instance of MockStrictServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockStrictServerInterface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockStrictServerInterface {
-	mock := &MockStrictServerInterface{}
-	mock.Mock.Test(t)
+// PostReceptions provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error) {
+	ret := _mock.Called(ctx, request)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for PostReceptions")
+	}
 
-	return mock
+	var r0 PostReceptionsResponseObject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostReceptionsRequestObject) (PostReceptionsResponseObject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostReceptionsRequestObject) PostReceptionsResponseObject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PostReceptionsResponseObject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostReceptionsRequestObject) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStrictServerInterface is an autogenerated mock type for the StrictServerInterface type
-type MockStrictServerInterface struct {
-	mock.Mock
+// MockStrictServerInterface_PostReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostReceptions'
+type MockStrictServerInterface_PostReceptions_Call struct {
+	*mock.Call
 }
 
-type MockStrictServerInterface_Expecter struct {
-	mock *mock.Mock
+// PostReceptions is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockStrictServerInterface_Expecter) PostReceptions(ctx interface{}, request interface{}) *MockStrictServerInterface_PostReceptions_Call {
+	return &MockStrictServerInterface_PostReceptions_Call{Call: _e.mock.On("PostReceptions", ctx, request)}
 }
 
-func (_m *MockStrictServerInterface) EXPECT() *MockStrictServerInterface_Expecter {
-	return &MockStrictServerInterface_Expecter{mock: &_m.Mock}
+func (_c *MockStrictServerInterface_PostReceptions_Call) Run(run func(ctx context.Context, request PostReceptionsRequestObject)) *MockStrictServerInterface_PostReceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(PostReceptionsRequestObject))
+	})
+	return _c
 }
 
-// GetPvz provides a mock function for the type MockStrictServerInterface
-func (_mock *MockStrictServerInterface) GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error) {
+func (_c *MockStrictServerInterface_PostReceptions_Call) Return(postReceptionsResponseObject PostReceptionsResponseObject, err error) *MockStrictServerInterface_PostReceptions_Call {
+	_c.Call.Return(postReceptionsResponseObject, err)
+	return _c
+}
+
+func (_c *MockStrictServerInterface_PostReceptions_Call) RunAndReturn(run func(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)) *MockStrictServerInterface_PostReceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostReceptionsReceptionIdReopen provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostReceptionsReceptionIdReopen(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPvz")
+		panic("no return value specified for PostReceptionsReceptionIdReopen")
 	}
 
-	var r0 GetPvzResponseObject
+	var r0 PostReceptionsReceptionIdReopenResponseObject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzRequestObject) (GetPvzResponseObject, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, GetPvzRequestObject) GetPvzResponseObject); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostReceptionsReceptionIdReopenRequestObject) PostReceptionsReceptionIdReopenResponseObject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(GetPvzResponseObject)
+			r0 = ret.Get(0).(PostReceptionsReceptionIdReopenResponseObject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, GetPvzRequestObject) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostReceptionsReceptionIdReopenRequestObject) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1157,56 +6923,56 @@ func (_mock *MockStrictServerInterface) GetPvz(ctx context.Context, request GetP
 	return r0, r1
 }
 
-// MockStrictServerInterface_GetPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvz'
-type MockStrictServerInterface_GetPvz_Call struct {
+// MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostReceptionsReceptionIdReopen'
+type MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call struct {
 	*mock.Call
 }
 
-// GetPvz is a helper method to define mock.On call
+// PostReceptionsReceptionIdReopen is a helper method to define mock.On call
 //   - ctx
 //   - request
-func (_e *MockStrictServerInterface_Expecter) GetPvz(ctx interface{}, request interface{}) *MockStrictServerInterface_GetPvz_Call {
-	return &MockStrictServerInterface_GetPvz_Call{Call: _e.mock.On("GetPvz", ctx, request)}
+func (_e *MockStrictServerInterface_Expecter) PostReceptionsReceptionIdReopen(ctx interface{}, request interface{}) *MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call {
+	return &MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call{Call: _e.mock.On("PostReceptionsReceptionIdReopen", ctx, request)}
 }
 
-func (_c *MockStrictServerInterface_GetPvz_Call) Run(run func(ctx context.Context, request GetPvzRequestObject)) *MockStrictServerInterface_GetPvz_Call {
+func (_c *MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call) Run(run func(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject)) *MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(GetPvzRequestObject))
+		run(args[0].(context.Context), args[1].(PostReceptionsReceptionIdReopenRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockStrictServerInterface_GetPvz_Call) Return(getPvzResponseObject GetPvzResponseObject, err error) *MockStrictServerInterface_GetPvz_Call {
-	_c.Call.Return(getPvzResponseObject, err)
+func (_c *MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call) Return(postReceptionsReceptionIdReopenResponseObject PostReceptionsReceptionIdReopenResponseObject, err error) *MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call {
+	_c.Call.Return(postReceptionsReceptionIdReopenResponseObject, err)
 	return _c
 }
 
-func (_c *MockStrictServerInterface_GetPvz_Call) RunAndReturn(run func(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)) *MockStrictServerInterface_GetPvz_Call {
+func (_c *MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call) RunAndReturn(run func(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error)) *MockStrictServerInterface_PostReceptionsReceptionIdReopen_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PostDummyLogin provides a mock function for the type MockStrictServerInterface
-func (_mock *MockStrictServerInterface) PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error) {
+// PostRegister provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PostDummyLogin")
+		panic("no return value specified for PostRegister")
 	}
 
-	var r0 PostDummyLoginResponseObject
+	var r0 PostRegisterResponseObject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostRegisterRequestObject) (PostRegisterResponseObject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostDummyLoginRequestObject) PostDummyLoginResponseObject); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostRegisterRequestObject) PostRegisterResponseObject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(PostDummyLoginResponseObject)
+			r0 = ret.Get(0).(PostRegisterResponseObject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, PostDummyLoginRequestObject) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostRegisterRequestObject) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1214,56 +6980,56 @@ func (_mock *MockStrictServerInterface) PostDummyLogin(ctx context.Context, requ
 	return r0, r1
 }
 
-// MockStrictServerInterface_PostDummyLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostDummyLogin'
-type MockStrictServerInterface_PostDummyLogin_Call struct {
+// MockStrictServerInterface_PostRegister_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostRegister'
+type MockStrictServerInterface_PostRegister_Call struct {
 	*mock.Call
 }
 
-// PostDummyLogin is a helper method to define mock.On call
+// PostRegister is a helper method to define mock.On call
 //   - ctx
 //   - request
-func (_e *MockStrictServerInterface_Expecter) PostDummyLogin(ctx interface{}, request interface{}) *MockStrictServerInterface_PostDummyLogin_Call {
-	return &MockStrictServerInterface_PostDummyLogin_Call{Call: _e.mock.On("PostDummyLogin", ctx, request)}
+func (_e *MockStrictServerInterface_Expecter) PostRegister(ctx interface{}, request interface{}) *MockStrictServerInterface_PostRegister_Call {
+	return &MockStrictServerInterface_PostRegister_Call{Call: _e.mock.On("PostRegister", ctx, request)}
 }
 
-func (_c *MockStrictServerInterface_PostDummyLogin_Call) Run(run func(ctx context.Context, request PostDummyLoginRequestObject)) *MockStrictServerInterface_PostDummyLogin_Call {
+func (_c *MockStrictServerInterface_PostRegister_Call) Run(run func(ctx context.Context, request PostRegisterRequestObject)) *MockStrictServerInterface_PostRegister_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(PostDummyLoginRequestObject))
+		run(args[0].(context.Context), args[1].(PostRegisterRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostDummyLogin_Call) Return(postDummyLoginResponseObject PostDummyLoginResponseObject, err error) *MockStrictServerInterface_PostDummyLogin_Call {
-	_c.Call.Return(postDummyLoginResponseObject, err)
+func (_c *MockStrictServerInterface_PostRegister_Call) Return(postRegisterResponseObject PostRegisterResponseObject, err error) *MockStrictServerInterface_PostRegister_Call {
+	_c.Call.Return(postRegisterResponseObject, err)
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostDummyLogin_Call) RunAndReturn(run func(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)) *MockStrictServerInterface_PostDummyLogin_Call {
+func (_c *MockStrictServerInterface_PostRegister_Call) RunAndReturn(run func(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)) *MockStrictServerInterface_PostRegister_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PostLogin provides a mock function for the type MockStrictServerInterface
-func (_mock *MockStrictServerInterface) PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error) {
+// PostTokenRefresh provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostTokenRefresh(ctx context.Context, request PostTokenRefreshRequestObject) (PostTokenRefreshResponseObject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PostLogin")
+		panic("no return value specified for PostTokenRefresh")
 	}
 
-	var r0 PostLoginResponseObject
+	var r0 PostTokenRefreshResponseObject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostLoginRequestObject) (PostLoginResponseObject, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostTokenRefreshRequestObject) (PostTokenRefreshResponseObject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostLoginRequestObject) PostLoginResponseObject); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostTokenRefreshRequestObject) PostTokenRefreshResponseObject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(PostLoginResponseObject)
+			r0 = ret.Get(0).(PostTokenRefreshResponseObject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, PostLoginRequestObject) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostTokenRefreshRequestObject) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1271,56 +7037,56 @@ func (_mock *MockStrictServerInterface) PostLogin(ctx context.Context, request P
 	return r0, r1
 }
 
-// MockStrictServerInterface_PostLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostLogin'
-type MockStrictServerInterface_PostLogin_Call struct {
+// MockStrictServerInterface_PostTokenRefresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostTokenRefresh'
+type MockStrictServerInterface_PostTokenRefresh_Call struct {
 	*mock.Call
 }
 
-// PostLogin is a helper method to define mock.On call
+// PostTokenRefresh is a helper method to define mock.On call
 //   - ctx
 //   - request
-func (_e *MockStrictServerInterface_Expecter) PostLogin(ctx interface{}, request interface{}) *MockStrictServerInterface_PostLogin_Call {
-	return &MockStrictServerInterface_PostLogin_Call{Call: _e.mock.On("PostLogin", ctx, request)}
+func (_e *MockStrictServerInterface_Expecter) PostTokenRefresh(ctx interface{}, request interface{}) *MockStrictServerInterface_PostTokenRefresh_Call {
+	return &MockStrictServerInterface_PostTokenRefresh_Call{Call: _e.mock.On("PostTokenRefresh", ctx, request)}
 }
 
-func (_c *MockStrictServerInterface_PostLogin_Call) Run(run func(ctx context.Context, request PostLoginRequestObject)) *MockStrictServerInterface_PostLogin_Call {
+func (_c *MockStrictServerInterface_PostTokenRefresh_Call) Run(run func(ctx context.Context, request PostTokenRefreshRequestObject)) *MockStrictServerInterface_PostTokenRefresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(PostLoginRequestObject))
+		run(args[0].(context.Context), args[1].(PostTokenRefreshRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostLogin_Call) Return(postLoginResponseObject PostLoginResponseObject, err error) *MockStrictServerInterface_PostLogin_Call {
-	_c.Call.Return(postLoginResponseObject, err)
+func (_c *MockStrictServerInterface_PostTokenRefresh_Call) Return(postTokenRefreshResponseObject PostTokenRefreshResponseObject, err error) *MockStrictServerInterface_PostTokenRefresh_Call {
+	_c.Call.Return(postTokenRefreshResponseObject, err)
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostLogin_Call) RunAndReturn(run func(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)) *MockStrictServerInterface_PostLogin_Call {
+func (_c *MockStrictServerInterface_PostTokenRefresh_Call) RunAndReturn(run func(ctx context.Context, request PostTokenRefreshRequestObject) (PostTokenRefreshResponseObject, error)) *MockStrictServerInterface_PostTokenRefresh_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PostProducts provides a mock function for the type MockStrictServerInterface
-func (_mock *MockStrictServerInterface) PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error) {
+// PostUsersUserIdActivate provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostUsersUserIdActivate(ctx context.Context, request PostUsersUserIdActivateRequestObject) (PostUsersUserIdActivateResponseObject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PostProducts")
+		panic("no return value specified for PostUsersUserIdActivate")
 	}
 
-	var r0 PostProductsResponseObject
+	var r0 PostUsersUserIdActivateResponseObject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostProductsRequestObject) (PostProductsResponseObject, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostUsersUserIdActivateRequestObject) (PostUsersUserIdActivateResponseObject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostProductsRequestObject) PostProductsResponseObject); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostUsersUserIdActivateRequestObject) PostUsersUserIdActivateResponseObject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(PostProductsResponseObject)
+			r0 = ret.Get(0).(PostUsersUserIdActivateResponseObject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, PostProductsRequestObject) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostUsersUserIdActivateRequestObject) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1328,56 +7094,56 @@ func (_mock *MockStrictServerInterface) PostProducts(ctx context.Context, reques
 	return r0, r1
 }
 
-// MockStrictServerInterface_PostProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostProducts'
-type MockStrictServerInterface_PostProducts_Call struct {
+// MockStrictServerInterface_PostUsersUserIdActivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostUsersUserIdActivate'
+type MockStrictServerInterface_PostUsersUserIdActivate_Call struct {
 	*mock.Call
 }
 
-// PostProducts is a helper method to define mock.On call
+// PostUsersUserIdActivate is a helper method to define mock.On call
 //   - ctx
 //   - request
-func (_e *MockStrictServerInterface_Expecter) PostProducts(ctx interface{}, request interface{}) *MockStrictServerInterface_PostProducts_Call {
-	return &MockStrictServerInterface_PostProducts_Call{Call: _e.mock.On("PostProducts", ctx, request)}
+func (_e *MockStrictServerInterface_Expecter) PostUsersUserIdActivate(ctx interface{}, request interface{}) *MockStrictServerInterface_PostUsersUserIdActivate_Call {
+	return &MockStrictServerInterface_PostUsersUserIdActivate_Call{Call: _e.mock.On("PostUsersUserIdActivate", ctx, request)}
 }
 
-func (_c *MockStrictServerInterface_PostProducts_Call) Run(run func(ctx context.Context, request PostProductsRequestObject)) *MockStrictServerInterface_PostProducts_Call {
+func (_c *MockStrictServerInterface_PostUsersUserIdActivate_Call) Run(run func(ctx context.Context, request PostUsersUserIdActivateRequestObject)) *MockStrictServerInterface_PostUsersUserIdActivate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(PostProductsRequestObject))
+		run(args[0].(context.Context), args[1].(PostUsersUserIdActivateRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostProducts_Call) Return(postProductsResponseObject PostProductsResponseObject, err error) *MockStrictServerInterface_PostProducts_Call {
-	_c.Call.Return(postProductsResponseObject, err)
+func (_c *MockStrictServerInterface_PostUsersUserIdActivate_Call) Return(postUsersUserIdActivateResponseObject PostUsersUserIdActivateResponseObject, err error) *MockStrictServerInterface_PostUsersUserIdActivate_Call {
+	_c.Call.Return(postUsersUserIdActivateResponseObject, err)
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostProducts_Call) RunAndReturn(run func(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)) *MockStrictServerInterface_PostProducts_Call {
+func (_c *MockStrictServerInterface_PostUsersUserIdActivate_Call) RunAndReturn(run func(ctx context.Context, request PostUsersUserIdActivateRequestObject) (PostUsersUserIdActivateResponseObject, error)) *MockStrictServerInterface_PostUsersUserIdActivate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PostPvz provides a mock function for the type MockStrictServerInterface
-func (_mock *MockStrictServerInterface) PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error) {
+// PostUsersUserIdDeactivate provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostUsersUserIdDeactivate(ctx context.Context, request PostUsersUserIdDeactivateRequestObject) (PostUsersUserIdDeactivateResponseObject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PostPvz")
+		panic("no return value specified for PostUsersUserIdDeactivate")
 	}
 
-	var r0 PostPvzResponseObject
+	var r0 PostUsersUserIdDeactivateResponseObject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzRequestObject) (PostPvzResponseObject, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostUsersUserIdDeactivateRequestObject) (PostUsersUserIdDeactivateResponseObject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzRequestObject) PostPvzResponseObject); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostUsersUserIdDeactivateRequestObject) PostUsersUserIdDeactivateResponseObject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(PostPvzResponseObject)
+			r0 = ret.Get(0).(PostUsersUserIdDeactivateResponseObject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzRequestObject) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostUsersUserIdDeactivateRequestObject) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1385,56 +7151,56 @@ func (_mock *MockStrictServerInterface) PostPvz(ctx context.Context, request Pos
 	return r0, r1
 }
 
-// MockStrictServerInterface_PostPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvz'
-type MockStrictServerInterface_PostPvz_Call struct {
+// MockStrictServerInterface_PostUsersUserIdDeactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostUsersUserIdDeactivate'
+type MockStrictServerInterface_PostUsersUserIdDeactivate_Call struct {
 	*mock.Call
 }
 
-// PostPvz is a helper method to define mock.On call
+// PostUsersUserIdDeactivate is a helper method to define mock.On call
 //   - ctx
 //   - request
-func (_e *MockStrictServerInterface_Expecter) PostPvz(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvz_Call {
-	return &MockStrictServerInterface_PostPvz_Call{Call: _e.mock.On("PostPvz", ctx, request)}
+func (_e *MockStrictServerInterface_Expecter) PostUsersUserIdDeactivate(ctx interface{}, request interface{}) *MockStrictServerInterface_PostUsersUserIdDeactivate_Call {
+	return &MockStrictServerInterface_PostUsersUserIdDeactivate_Call{Call: _e.mock.On("PostUsersUserIdDeactivate", ctx, request)}
 }
 
-func (_c *MockStrictServerInterface_PostPvz_Call) Run(run func(ctx context.Context, request PostPvzRequestObject)) *MockStrictServerInterface_PostPvz_Call {
+func (_c *MockStrictServerInterface_PostUsersUserIdDeactivate_Call) Run(run func(ctx context.Context, request PostUsersUserIdDeactivateRequestObject)) *MockStrictServerInterface_PostUsersUserIdDeactivate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(PostPvzRequestObject))
+		run(args[0].(context.Context), args[1].(PostUsersUserIdDeactivateRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostPvz_Call) Return(postPvzResponseObject PostPvzResponseObject, err error) *MockStrictServerInterface_PostPvz_Call {
-	_c.Call.Return(postPvzResponseObject, err)
+func (_c *MockStrictServerInterface_PostUsersUserIdDeactivate_Call) Return(postUsersUserIdDeactivateResponseObject PostUsersUserIdDeactivateResponseObject, err error) *MockStrictServerInterface_PostUsersUserIdDeactivate_Call {
+	_c.Call.Return(postUsersUserIdDeactivateResponseObject, err)
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostPvz_Call) RunAndReturn(run func(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)) *MockStrictServerInterface_PostPvz_Call {
+func (_c *MockStrictServerInterface_PostUsersUserIdDeactivate_Call) RunAndReturn(run func(ctx context.Context, request PostUsersUserIdDeactivateRequestObject) (PostUsersUserIdDeactivateResponseObject, error)) *MockStrictServerInterface_PostUsersUserIdDeactivate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PostPvzPvzIdCloseLastReception provides a mock function for the type MockStrictServerInterface
-func (_mock *MockStrictServerInterface) PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error) {
+// PostUsersUserIdPasswordReset provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostUsersUserIdPasswordReset(ctx context.Context, request PostUsersUserIdPasswordResetRequestObject) (PostUsersUserIdPasswordResetResponseObject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PostPvzPvzIdCloseLastReception")
+		panic("no return value specified for PostUsersUserIdPasswordReset")
 	}
 
-	var r0 PostPvzPvzIdCloseLastReceptionResponseObject
+	var r0 PostUsersUserIdPasswordResetResponseObject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostUsersUserIdPasswordResetRequestObject) (PostUsersUserIdPasswordResetResponseObject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdCloseLastReceptionRequestObject) PostPvzPvzIdCloseLastReceptionResponseObject); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostUsersUserIdPasswordResetRequestObject) PostUsersUserIdPasswordResetResponseObject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(PostPvzPvzIdCloseLastReceptionResponseObject)
+			r0 = ret.Get(0).(PostUsersUserIdPasswordResetResponseObject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdCloseLastReceptionRequestObject) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostUsersUserIdPasswordResetRequestObject) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1442,56 +7208,56 @@ func (_mock *MockStrictServerInterface) PostPvzPvzIdCloseLastReception(ctx conte
 	return r0, r1
 }
 
-// MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdCloseLastReception'
-type MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call struct {
+// MockStrictServerInterface_PostUsersUserIdPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostUsersUserIdPasswordReset'
+type MockStrictServerInterface_PostUsersUserIdPasswordReset_Call struct {
 	*mock.Call
 }
 
-// PostPvzPvzIdCloseLastReception is a helper method to define mock.On call
+// PostUsersUserIdPasswordReset is a helper method to define mock.On call
 //   - ctx
 //   - request
-func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdCloseLastReception(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call {
-	return &MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call{Call: _e.mock.On("PostPvzPvzIdCloseLastReception", ctx, request)}
+func (_e *MockStrictServerInterface_Expecter) PostUsersUserIdPasswordReset(ctx interface{}, request interface{}) *MockStrictServerInterface_PostUsersUserIdPasswordReset_Call {
+	return &MockStrictServerInterface_PostUsersUserIdPasswordReset_Call{Call: _e.mock.On("PostUsersUserIdPasswordReset", ctx, request)}
 }
 
-func (_c *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call) Run(run func(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject)) *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call {
+func (_c *MockStrictServerInterface_PostUsersUserIdPasswordReset_Call) Run(run func(ctx context.Context, request PostUsersUserIdPasswordResetRequestObject)) *MockStrictServerInterface_PostUsersUserIdPasswordReset_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(PostPvzPvzIdCloseLastReceptionRequestObject))
+		run(args[0].(context.Context), args[1].(PostUsersUserIdPasswordResetRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call) Return(postPvzPvzIdCloseLastReceptionResponseObject PostPvzPvzIdCloseLastReceptionResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call {
-	_c.Call.Return(postPvzPvzIdCloseLastReceptionResponseObject, err)
+func (_c *MockStrictServerInterface_PostUsersUserIdPasswordReset_Call) Return(postUsersUserIdPasswordResetResponseObject PostUsersUserIdPasswordResetResponseObject, err error) *MockStrictServerInterface_PostUsersUserIdPasswordReset_Call {
+	_c.Call.Return(postUsersUserIdPasswordResetResponseObject, err)
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdCloseLastReception_Call {
+func (_c *MockStrictServerInterface_PostUsersUserIdPasswordReset_Call) RunAndReturn(run func(ctx context.Context, request PostUsersUserIdPasswordResetRequestObject) (PostUsersUserIdPasswordResetResponseObject, error)) *MockStrictServerInterface_PostUsersUserIdPasswordReset_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PostPvzPvzIdDeleteLastProduct provides a mock function for the type MockStrictServerInterface
-func (_mock *MockStrictServerInterface) PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error) {
+// PostUsersUserIdRole provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PostUsersUserIdRole(ctx context.Context, request PostUsersUserIdRoleRequestObject) (PostUsersUserIdRoleResponseObject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PostPvzPvzIdDeleteLastProduct")
+		panic("no return value specified for PostUsersUserIdRole")
 	}
 
-	var r0 PostPvzPvzIdDeleteLastProductResponseObject
+	var r0 PostUsersUserIdRoleResponseObject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostUsersUserIdRoleRequestObject) (PostUsersUserIdRoleResponseObject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostPvzPvzIdDeleteLastProductRequestObject) PostPvzPvzIdDeleteLastProductResponseObject); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PostUsersUserIdRoleRequestObject) PostUsersUserIdRoleResponseObject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(PostPvzPvzIdDeleteLastProductResponseObject)
+			r0 = ret.Get(0).(PostUsersUserIdRoleResponseObject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, PostPvzPvzIdDeleteLastProductRequestObject) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PostUsersUserIdRoleRequestObject) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1499,56 +7265,56 @@ func (_mock *MockStrictServerInterface) PostPvzPvzIdDeleteLastProduct(ctx contex
 	return r0, r1
 }
 
-// MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostPvzPvzIdDeleteLastProduct'
-type MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call struct {
+// MockStrictServerInterface_PostUsersUserIdRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostUsersUserIdRole'
+type MockStrictServerInterface_PostUsersUserIdRole_Call struct {
 	*mock.Call
 }
 
-// PostPvzPvzIdDeleteLastProduct is a helper method to define mock.On call
+// PostUsersUserIdRole is a helper method to define mock.On call
 //   - ctx
 //   - request
-func (_e *MockStrictServerInterface_Expecter) PostPvzPvzIdDeleteLastProduct(ctx interface{}, request interface{}) *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
-	return &MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call{Call: _e.mock.On("PostPvzPvzIdDeleteLastProduct", ctx, request)}
+func (_e *MockStrictServerInterface_Expecter) PostUsersUserIdRole(ctx interface{}, request interface{}) *MockStrictServerInterface_PostUsersUserIdRole_Call {
+	return &MockStrictServerInterface_PostUsersUserIdRole_Call{Call: _e.mock.On("PostUsersUserIdRole", ctx, request)}
 }
 
-func (_c *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call) Run(run func(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject)) *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+func (_c *MockStrictServerInterface_PostUsersUserIdRole_Call) Run(run func(ctx context.Context, request PostUsersUserIdRoleRequestObject)) *MockStrictServerInterface_PostUsersUserIdRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(PostPvzPvzIdDeleteLastProductRequestObject))
+		run(args[0].(context.Context), args[1].(PostUsersUserIdRoleRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call) Return(postPvzPvzIdDeleteLastProductResponseObject PostPvzPvzIdDeleteLastProductResponseObject, err error) *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
-	_c.Call.Return(postPvzPvzIdDeleteLastProductResponseObject, err)
+func (_c *MockStrictServerInterface_PostUsersUserIdRole_Call) Return(postUsersUserIdRoleResponseObject PostUsersUserIdRoleResponseObject, err error) *MockStrictServerInterface_PostUsersUserIdRole_Call {
+	_c.Call.Return(postUsersUserIdRoleResponseObject, err)
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call) RunAndReturn(run func(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)) *MockStrictServerInterface_PostPvzPvzIdDeleteLastProduct_Call {
+func (_c *MockStrictServerInterface_PostUsersUserIdRole_Call) RunAndReturn(run func(ctx context.Context, request PostUsersUserIdRoleRequestObject) (PostUsersUserIdRoleResponseObject, error)) *MockStrictServerInterface_PostUsersUserIdRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PostReceptions provides a mock function for the type MockStrictServerInterface
-func (_mock *MockStrictServerInterface) PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error) {
+// PutProductTypesCode provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PutProductTypesCode(ctx context.Context, request PutProductTypesCodeRequestObject) (PutProductTypesCodeResponseObject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PostReceptions")
+		panic("no return value specified for PutProductTypesCode")
 	}
 
-	var r0 PostReceptionsResponseObject
+	var r0 PutProductTypesCodeResponseObject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostReceptionsRequestObject) (PostReceptionsResponseObject, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PutProductTypesCodeRequestObject) (PutProductTypesCodeResponseObject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostReceptionsRequestObject) PostReceptionsResponseObject); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PutProductTypesCodeRequestObject) PutProductTypesCodeResponseObject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(PostReceptionsResponseObject)
+			r0 = ret.Get(0).(PutProductTypesCodeResponseObject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, PostReceptionsRequestObject) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PutProductTypesCodeRequestObject) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1556,56 +7322,56 @@ func (_mock *MockStrictServerInterface) PostReceptions(ctx context.Context, requ
 	return r0, r1
 }
 
-// MockStrictServerInterface_PostReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostReceptions'
-type MockStrictServerInterface_PostReceptions_Call struct {
+// MockStrictServerInterface_PutProductTypesCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutProductTypesCode'
+type MockStrictServerInterface_PutProductTypesCode_Call struct {
 	*mock.Call
 }
 
-// PostReceptions is a helper method to define mock.On call
+// PutProductTypesCode is a helper method to define mock.On call
 //   - ctx
 //   - request
-func (_e *MockStrictServerInterface_Expecter) PostReceptions(ctx interface{}, request interface{}) *MockStrictServerInterface_PostReceptions_Call {
-	return &MockStrictServerInterface_PostReceptions_Call{Call: _e.mock.On("PostReceptions", ctx, request)}
+func (_e *MockStrictServerInterface_Expecter) PutProductTypesCode(ctx interface{}, request interface{}) *MockStrictServerInterface_PutProductTypesCode_Call {
+	return &MockStrictServerInterface_PutProductTypesCode_Call{Call: _e.mock.On("PutProductTypesCode", ctx, request)}
 }
 
-func (_c *MockStrictServerInterface_PostReceptions_Call) Run(run func(ctx context.Context, request PostReceptionsRequestObject)) *MockStrictServerInterface_PostReceptions_Call {
+func (_c *MockStrictServerInterface_PutProductTypesCode_Call) Run(run func(ctx context.Context, request PutProductTypesCodeRequestObject)) *MockStrictServerInterface_PutProductTypesCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(PostReceptionsRequestObject))
+		run(args[0].(context.Context), args[1].(PutProductTypesCodeRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostReceptions_Call) Return(postReceptionsResponseObject PostReceptionsResponseObject, err error) *MockStrictServerInterface_PostReceptions_Call {
-	_c.Call.Return(postReceptionsResponseObject, err)
+func (_c *MockStrictServerInterface_PutProductTypesCode_Call) Return(putProductTypesCodeResponseObject PutProductTypesCodeResponseObject, err error) *MockStrictServerInterface_PutProductTypesCode_Call {
+	_c.Call.Return(putProductTypesCodeResponseObject, err)
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostReceptions_Call) RunAndReturn(run func(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)) *MockStrictServerInterface_PostReceptions_Call {
+func (_c *MockStrictServerInterface_PutProductTypesCode_Call) RunAndReturn(run func(ctx context.Context, request PutProductTypesCodeRequestObject) (PutProductTypesCodeResponseObject, error)) *MockStrictServerInterface_PutProductTypesCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PostRegister provides a mock function for the type MockStrictServerInterface
-func (_mock *MockStrictServerInterface) PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error) {
+// PutPvzPvzIdStaffUserId provides a mock function for the type MockStrictServerInterface
+func (_mock *MockStrictServerInterface) PutPvzPvzIdStaffUserId(ctx context.Context, request PutPvzPvzIdStaffUserIdRequestObject) (PutPvzPvzIdStaffUserIdResponseObject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PostRegister")
+		panic("no return value specified for PutPvzPvzIdStaffUserId")
 	}
 
-	var r0 PostRegisterResponseObject
+	var r0 PutPvzPvzIdStaffUserIdResponseObject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostRegisterRequestObject) (PostRegisterResponseObject, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PutPvzPvzIdStaffUserIdRequestObject) (PutPvzPvzIdStaffUserIdResponseObject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, PostRegisterRequestObject) PostRegisterResponseObject); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PutPvzPvzIdStaffUserIdRequestObject) PutPvzPvzIdStaffUserIdResponseObject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(PostRegisterResponseObject)
+			r0 = ret.Get(0).(PutPvzPvzIdStaffUserIdResponseObject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, PostRegisterRequestObject) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PutPvzPvzIdStaffUserIdRequestObject) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1613,31 +7379,31 @@ func (_mock *MockStrictServerInterface) PostRegister(ctx context.Context, reques
 	return r0, r1
 }
 
-// MockStrictServerInterface_PostRegister_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostRegister'
-type MockStrictServerInterface_PostRegister_Call struct {
+// MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutPvzPvzIdStaffUserId'
+type MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call struct {
 	*mock.Call
 }
 
-// PostRegister is a helper method to define mock.On call
+// PutPvzPvzIdStaffUserId is a helper method to define mock.On call
 //   - ctx
 //   - request
-func (_e *MockStrictServerInterface_Expecter) PostRegister(ctx interface{}, request interface{}) *MockStrictServerInterface_PostRegister_Call {
-	return &MockStrictServerInterface_PostRegister_Call{Call: _e.mock.On("PostRegister", ctx, request)}
+func (_e *MockStrictServerInterface_Expecter) PutPvzPvzIdStaffUserId(ctx interface{}, request interface{}) *MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call {
+	return &MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call{Call: _e.mock.On("PutPvzPvzIdStaffUserId", ctx, request)}
 }
 
-func (_c *MockStrictServerInterface_PostRegister_Call) Run(run func(ctx context.Context, request PostRegisterRequestObject)) *MockStrictServerInterface_PostRegister_Call {
+func (_c *MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call) Run(run func(ctx context.Context, request PutPvzPvzIdStaffUserIdRequestObject)) *MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(PostRegisterRequestObject))
+		run(args[0].(context.Context), args[1].(PutPvzPvzIdStaffUserIdRequestObject))
 	})
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostRegister_Call) Return(postRegisterResponseObject PostRegisterResponseObject, err error) *MockStrictServerInterface_PostRegister_Call {
-	_c.Call.Return(postRegisterResponseObject, err)
+func (_c *MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call) Return(putPvzPvzIdStaffUserIdResponseObject PutPvzPvzIdStaffUserIdResponseObject, err error) *MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call {
+	_c.Call.Return(putPvzPvzIdStaffUserIdResponseObject, err)
 	return _c
 }
 
-func (_c *MockStrictServerInterface_PostRegister_Call) RunAndReturn(run func(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)) *MockStrictServerInterface_PostRegister_Call {
+func (_c *MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call) RunAndReturn(run func(ctx context.Context, request PutPvzPvzIdStaffUserIdRequestObject) (PutPvzPvzIdStaffUserIdResponseObject, error)) *MockStrictServerInterface_PutPvzPvzIdStaffUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -28,24 +28,94 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for PVZCity.
+// Defines values for APIKeyRole.
 const (
-	Казань         PVZCity = "Казань"
-	Москва         PVZCity = "Москва"
-	СанктПетербург PVZCity = "Санкт-Петербург"
+	APIKeyRoleEmployee  APIKeyRole = "employee"
+	APIKeyRoleModerator APIKeyRole = "moderator"
 )
 
-// Defines values for ProductType.
+// Defines values for APIKeyScopes.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	APIKeyScopesProductsRead    APIKeyScopes = "products:read"
+	APIKeyScopesProductsWrite   APIKeyScopes = "products:write"
+	APIKeyScopesPvzRead         APIKeyScopes = "pvz:read"
+	APIKeyScopesPvzWrite        APIKeyScopes = "pvz:write"
+	APIKeyScopesReceptionsWrite APIKeyScopes = "receptions:write"
+)
+
+// Defines values for AuditEntryActorType.
+const (
+	AuditEntryActorTypeAnonymous AuditEntryActorType = "anonymous"
+	AuditEntryActorTypeApiKey    AuditEntryActorType = "api_key"
+	AuditEntryActorTypeDummy     AuditEntryActorType = "dummy"
+	AuditEntryActorTypeSystem    AuditEntryActorType = "system"
+	AuditEntryActorTypeUser      AuditEntryActorType = "user"
+)
+
+// Defines values for AuditEntryEntityType.
+const (
+	AuditEntryEntityTypeApiKey      AuditEntryEntityType = "api_key"
+	AuditEntryEntityTypeAsn         AuditEntryEntityType = "asn"
+	AuditEntryEntityTypeCity        AuditEntryEntityType = "city"
+	AuditEntryEntityTypeProduct     AuditEntryEntityType = "product"
+	AuditEntryEntityTypeProductType AuditEntryEntityType = "product_type"
+	AuditEntryEntityTypePvz         AuditEntryEntityType = "pvz"
+	AuditEntryEntityTypeReception   AuditEntryEntityType = "reception"
+	AuditEntryEntityTypeUser        AuditEntryEntityType = "user"
+)
+
+// Defines values for ClosedReceptionCloseReason.
+const (
+	ClosedReceptionCloseReasonClosedBySystem ClosedReceptionCloseReason = "closed_by_system"
+)
+
+// Defines values for ClosedReceptionStatus.
+const (
+	ClosedReceptionStatusClose      ClosedReceptionStatus = "close"
+	ClosedReceptionStatusCorrected  ClosedReceptionStatus = "corrected"
+	ClosedReceptionStatusInProgress ClosedReceptionStatus = "in_progress"
+)
+
+// Defines values for DayHoursDay.
+const (
+	Fri DayHoursDay = "fri"
+	Mon DayHoursDay = "mon"
+	Sat DayHoursDay = "sat"
+	Sun DayHoursDay = "sun"
+	Thu DayHoursDay = "thu"
+	Tue DayHoursDay = "tue"
+	Wed DayHoursDay = "wed"
+)
+
+// Defines values for JWKAlg.
+const (
+	EdDSA JWKAlg = "EdDSA"
+	RS256 JWKAlg = "RS256"
+)
+
+// Defines values for JWKKty.
+const (
+	OKP JWKKty = "OKP"
+	RSA JWKKty = "RSA"
+)
+
+// Defines values for PVZStatus.
+const (
+	Active    PVZStatus = "active"
+	Closed    PVZStatus = "closed"
+	Suspended PVZStatus = "suspended"
+)
+
+// Defines values for ReceptionCloseReason.
+const (
+	ReceptionCloseReasonClosedBySystem ReceptionCloseReason = "closed_by_system"
 )
 
 // Defines values for ReceptionStatus.
 const (
-	Close      ReceptionStatus = "close"
-	InProgress ReceptionStatus = "in_progress"
+	ReceptionStatusClose      ReceptionStatus = "close"
+	ReceptionStatusCorrected  ReceptionStatus = "corrected"
+	ReceptionStatusInProgress ReceptionStatus = "in_progress"
 )
 
 // Defines values for UserRole.
@@ -54,75 +124,536 @@ const (
 	UserRoleModerator UserRole = "moderator"
 )
 
+// Defines values for PostApiKeysJSONBodyRole.
+const (
+	PostApiKeysJSONBodyRoleEmployee  PostApiKeysJSONBodyRole = "employee"
+	PostApiKeysJSONBodyRoleModerator PostApiKeysJSONBodyRole = "moderator"
+)
+
+// Defines values for PostApiKeysJSONBodyScopes.
+const (
+	PostApiKeysJSONBodyScopesProductsRead    PostApiKeysJSONBodyScopes = "products:read"
+	PostApiKeysJSONBodyScopesProductsWrite   PostApiKeysJSONBodyScopes = "products:write"
+	PostApiKeysJSONBodyScopesPvzRead         PostApiKeysJSONBodyScopes = "pvz:read"
+	PostApiKeysJSONBodyScopesPvzWrite        PostApiKeysJSONBodyScopes = "pvz:write"
+	PostApiKeysJSONBodyScopesReceptionsWrite PostApiKeysJSONBodyScopes = "receptions:write"
+)
+
+// Defines values for GetAuditParamsEntityType.
+const (
+	GetAuditParamsEntityTypeApiKey      GetAuditParamsEntityType = "api_key"
+	GetAuditParamsEntityTypeAsn         GetAuditParamsEntityType = "asn"
+	GetAuditParamsEntityTypeCity        GetAuditParamsEntityType = "city"
+	GetAuditParamsEntityTypeProduct     GetAuditParamsEntityType = "product"
+	GetAuditParamsEntityTypeProductType GetAuditParamsEntityType = "product_type"
+	GetAuditParamsEntityTypePvz         GetAuditParamsEntityType = "pvz"
+	GetAuditParamsEntityTypeReception   GetAuditParamsEntityType = "reception"
+	GetAuditParamsEntityTypeUser        GetAuditParamsEntityType = "user"
+)
+
 // Defines values for PostDummyLoginJSONBodyRole.
 const (
 	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
 	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for PostRegisterJSONBodyRole.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	PostRegisterJSONBodyRoleEmployee  PostRegisterJSONBodyRole = "employee"
+	PostRegisterJSONBodyRoleModerator PostRegisterJSONBodyRole = "moderator"
 )
 
-// Defines values for PostRegisterJSONBodyRole.
+// Defines values for GetUsersParamsRole.
+const (
+	GetUsersParamsRoleEmployee  GetUsersParamsRole = "employee"
+	GetUsersParamsRoleModerator GetUsersParamsRole = "moderator"
+)
+
+// Defines values for PostUsersUserIdRoleJSONBodyRole.
 const (
-	Employee  PostRegisterJSONBodyRole = "employee"
-	Moderator PostRegisterJSONBodyRole = "moderator"
+	Employee  PostUsersUserIdRoleJSONBodyRole = "employee"
+	Moderator PostUsersUserIdRoleJSONBodyRole = "moderator"
 )
 
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt  time.Time          `json:"createdAt"`
+	CreatedBy  openapi_types.UUID `json:"createdBy"`
+	ExpiresAt  *time.Time         `json:"expiresAt,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	LastUsedAt *time.Time         `json:"lastUsedAt,omitempty"`
+	Name       string             `json:"name"`
+
+	// PvzId ПВЗ, к которому ограничен ключ. Если не задан, ключ действует для всех ПВЗ.
+	PvzId     *openapi_types.UUID `json:"pvzId,omitempty"`
+	RevokedAt *time.Time          `json:"revokedAt,omitempty"`
+	Role      APIKeyRole          `json:"role"`
+	Scopes    []APIKeyScopes      `json:"scopes"`
+}
+
+// APIKeyRole defines model for APIKey.Role.
+type APIKeyRole string
+
+// APIKeyScopes defines model for APIKey.Scopes.
+type APIKeyScopes string
+
+// ASN Манифест ожидаемой поставки (advance shipment notice)
+type ASN struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	Id        openapi_types.UUID  `json:"id"`
+	Lines     []ASNLine           `json:"lines"`
+	PvzId     openapi_types.UUID  `json:"pvzId"`
+
+	// ReceptionId Приемка, с которой сверен манифест; пусто, пока поставка ожидается
+	ReceptionId *openapi_types.UUID `json:"receptionId,omitempty"`
+
+	// Reference Номер поставки у отправителя
+	Reference *string `json:"reference,omitempty"`
+}
+
+// ASNDiscrepancy defines model for ASNDiscrepancy.
+type ASNDiscrepancy struct {
+	Barcode  *string `json:"barcode,omitempty"`
+	Expected int     `json:"expected"`
+	Received int     `json:"received"`
+	Type     *string `json:"type,omitempty"`
+}
+
+// ASNLine Задается ровно одно из barcode и type. Строка со штрихкодом ожидает один товар, строка с типом — quantity товаров этого типа без штрихкода из манифеста.
+type ASNLine struct {
+	Barcode *string `json:"barcode,omitempty"`
+
+	// Quantity Для строки со штрихкодом всегда 1
+	Quantity *int `json:"quantity,omitempty"`
+
+	// Type Код или название типа из справочника
+	Type *string `json:"type,omitempty"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action string `json:"action"`
+
+	// ActorId Пользователь или API-ключ. Отсутствует для анонимных действий (регистрация).
+	ActorId   *openapi_types.UUID `json:"actorId,omitempty"`
+	ActorRole *string             `json:"actorRole,omitempty"`
+	ActorType AuditEntryActorType `json:"actorType"`
+
+	// After Состояние сущности после изменения
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before Состояние сущности до изменения
+	Before     *map[string]interface{} `json:"before,omitempty"`
+	CreatedAt  time.Time               `json:"createdAt"`
+	EntityId   openapi_types.UUID      `json:"entityId"`
+	EntityType AuditEntryEntityType    `json:"entityType"`
+	Id         openapi_types.UUID      `json:"id"`
+	RequestId  *string                 `json:"requestId,omitempty"`
+}
+
+// AuditEntryActorType defines model for AuditEntry.ActorType.
+type AuditEntryActorType string
+
+// AuditEntryEntityType defines model for AuditEntry.EntityType.
+type AuditEntryEntityType string
+
+// City defines model for City.
+type City struct {
+	// Active В неактивном городе нельзя открыть новый ПВЗ
+	Active    bool               `json:"active"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+}
+
+// ClosedReception defines model for ClosedReception.
+type ClosedReception struct {
+	// CloseReason closed_by_system — приемка закрыта автоматически после простоя
+	CloseReason *ClosedReceptionCloseReason `json:"closeReason,omitempty"`
+	ClosedAt    *time.Time                  `json:"closedAt,omitempty"`
+	DateTime    time.Time                   `json:"dateTime"`
+	Id          *openapi_types.UUID         `json:"id,omitempty"`
+	PvzId       openapi_types.UUID          `json:"pvzId"`
+
+	// Reconciliation Сверка закрытой приемки с манифестом
+	Reconciliation *ReceptionReconciliation `json:"reconciliation,omitempty"`
+
+	// Status corrected — приемка закрыта повторно после исправления модератором
+	Status ClosedReceptionStatus `json:"status"`
+}
+
+// ClosedReceptionCloseReason closed_by_system — приемка закрыта автоматически после простоя
+type ClosedReceptionCloseReason string
+
+// ClosedReceptionStatus corrected — приемка закрыта повторно после исправления модератором
+type ClosedReceptionStatus string
+
+// DayHours defines model for DayHours.
+type DayHours struct {
+	// Close Время закрытия, 24:00 означает конец дня
+	Close string      `json:"close"`
+	Day   DayHoursDay `json:"day"`
+	Open  string      `json:"open"`
+}
+
+// DayHoursDay defines model for DayHours.Day.
+type DayHoursDay string
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
 }
 
+// GeoPoint defines model for GeoPoint.
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// HoursException defines model for HoursException.
+type HoursException struct {
+	// Close Обязательно, если день не выходной
+	Close  *string            `json:"close,omitempty"`
+	Closed bool               `json:"closed"`
+	Date   openapi_types.Date `json:"date"`
+	Note   *string            `json:"note,omitempty"`
+
+	// Open Обязательно, если день не выходной
+	Open *string `json:"open,omitempty"`
+}
+
+// JWK Публичный ключ для проверки подписи access-токенов
+type JWK struct {
+	Alg JWKAlg `json:"alg"`
+
+	// Crv Кривая OKP-ключа
+	Crv *string `json:"crv,omitempty"`
+
+	// E Экспонента RSA-ключа (base64url)
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty JWKKty  `json:"kty"`
+
+	// N Модуль RSA-ключа (base64url)
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+
+	// X Публичный OKP-ключ (base64url)
+	X *string `json:"x,omitempty"`
+}
+
+// JWKAlg defines model for JWK.Alg.
+type JWKAlg string
+
+// JWKKty defines model for JWK.Kty.
+type JWKKty string
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NearbyPVZ defines model for NearbyPVZ.
+type NearbyPVZ struct {
+	// DistanceKm Расстояние по поверхности Земли до точки поиска
+	DistanceKm float64 `json:"distanceKm"`
+	Pvz        PVZ     `json:"pvz"`
+}
+
 // PVZ defines model for PVZ.
 type PVZ struct {
-	City             PVZCity             `json:"city"`
-	Id               *openapi_types.UUID `json:"id,omitempty"`
-	RegistrationDate *time.Time          `json:"registrationDate,omitempty"`
+	// City Название города из справочника, город должен быть активен
+	City string              `json:"city"`
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+
+	// Profile Адрес, координаты, часы работы и контакты ПВЗ. При изменении через PATCH переданные поля заменяют текущие, остальные не меняются.
+	Profile          *PVZProfile `json:"profile,omitempty"`
+	RegistrationDate *time.Time  `json:"registrationDate,omitempty"`
+
+	// Status active — работает; suspended — временно не принимает приемки и товары; closed — закрыт окончательно.
+	Status          *PVZStatus `json:"status,omitempty"`
+	StatusChangedAt *time.Time `json:"statusChangedAt,omitempty"`
+
+	// StatusReason Причина последней смены состояния
+	StatusReason *string `json:"statusReason,omitempty"`
+}
+
+// PVZProfile Адрес, координаты, часы работы и контакты ПВЗ. При изменении через PATCH переданные поля заменяют текущие, остальные не меняются.
+type PVZProfile struct {
+	Address  *string   `json:"address,omitempty"`
+	Location *GeoPoint `json:"location,omitempty"`
+
+	// Phone Телефон в формате E.164, пустая строка удаляет телефон
+	Phone *string `json:"phone,omitempty"`
+
+	// Timezone Часовой пояс IANA, в нем заданы часы работы
+	Timezone     *string       `json:"timezone,omitempty"`
+	WorkingHours *WorkingHours `json:"workingHours,omitempty"`
+}
+
+// PVZProfileChange defines model for PVZProfileChange.
+type PVZProfileChange struct {
+	// After Адрес, координаты, часы работы и контакты ПВЗ. При изменении через PATCH переданные поля заменяют текущие, остальные не меняются.
+	After PVZProfile `json:"after"`
+
+	// Before Адрес, координаты, часы работы и контакты ПВЗ. При изменении через PATCH переданные поля заменяют текущие, остальные не меняются.
+	Before    PVZProfile          `json:"before"`
+	ChangedBy *openapi_types.UUID `json:"changedBy,omitempty"`
+	CreatedAt time.Time           `json:"createdAt"`
+	Id        openapi_types.UUID  `json:"id"`
+	PvzId     openapi_types.UUID  `json:"pvzId"`
 }
 
-// PVZCity defines model for PVZ.City.
-type PVZCity string
+// PVZStatus active — работает; suspended — временно не принимает приемки и товары; closed — закрыт окончательно.
+type PVZStatus string
+
+// PVZStatusReason defines model for PVZStatusReason.
+type PVZStatusReason struct {
+	Reason string `json:"reason"`
+}
 
 // Product defines model for Product.
 type Product struct {
-	DateTime    *time.Time          `json:"dateTime,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	ReceptionId openapi_types.UUID  `json:"receptionId"`
-	Type        ProductType         `json:"type"`
+	Barcode  *string             `json:"barcode,omitempty"`
+	DateTime *time.Time          `json:"dateTime,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+
+	// Metadata Произвольные пары ключ-значение от отправителя, не больше 20 ключей длиной до 64 символов и значений до 256 символов.
+	Metadata *ProductMetadata `json:"metadata,omitempty"`
+
+	// OrderId Номер заказа во внешней системе
+	OrderId     *string            `json:"orderId,omitempty"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+
+	// Sku Артикул
+	Sku *string `json:"sku,omitempty"`
+
+	// Type Название типа из справочника
+	Type string `json:"type"`
+
+	// TypeCode Код типа из справочника
+	TypeCode   string  `json:"typeCode"`
+	VoidReason *string `json:"voidReason,omitempty"`
+
+	// VoidedAt Время аннулирования, только у аннулированного товара
+	VoidedAt *time.Time `json:"voidedAt,omitempty"`
+}
+
+// ProductBatchError defines model for ProductBatchError.
+type ProductBatchError struct {
+	// Errors Ошибки отдельных товаров, если они есть
+	Errors  *[]ProductBatchItemError `json:"errors,omitempty"`
+	Message string                   `json:"message"`
+}
+
+// ProductBatchItem defines model for ProductBatchItem.
+type ProductBatchItem struct {
+	// Barcode Штрихкод товара. Пока товар находится в ПВЗ, повторно с тем же штрихкодом его принять нельзя.
+	Barcode *string `json:"barcode,omitempty"`
+
+	// Metadata Произвольные пары ключ-значение от отправителя, не больше 20 ключей длиной до 64 символов и значений до 256 символов.
+	Metadata *ProductMetadata `json:"metadata,omitempty"`
+
+	// OrderId Номер заказа во внешней системе
+	OrderId *string `json:"orderId,omitempty"`
+
+	// Sku Артикул
+	Sku *string `json:"sku,omitempty"`
+
+	// Type Код активного типа из справочника или его русское название
+	Type string `json:"type"`
+}
+
+// ProductBatchItemError defines model for ProductBatchItemError.
+type ProductBatchItemError struct {
+	// Index Номер товара в запросе, с нуля
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+
+	// ReceptionId Для BarcodeAlreadyReceived — приемка, где товар уже принят
+	ReceptionId *openapi_types.UUID `json:"receptionId,omitempty"`
+}
+
+// ProductConflict defines model for ProductConflict.
+type ProductConflict struct {
+	Barcode string `json:"barcode"`
+	Message string `json:"message"`
+
+	// ReceptionId Приемка, в которой товар с этим штрихкодом уже принят
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+// ProductMetadata Произвольные пары ключ-значение от отправителя, не больше 20 ключей длиной до 64 символов и значений до 256 символов.
+type ProductMetadata map[string]string
+
+// ProductType defines model for ProductType.
+type ProductType struct {
+	// Active Товары неактивного типа не принимаются
+	Active    bool               `json:"active"`
+	Code      string             `json:"code"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	NameEn    string             `json:"nameEn"`
+	NameRu    string             `json:"nameRu"`
+
+	// RequiresAgeCheck Выдача товара требует проверки возраста
+	RequiresAgeCheck bool `json:"requiresAgeCheck"`
+
+	// RequiresSerial Товару нужен серийный номер
+	RequiresSerial bool `json:"requiresSerial"`
+}
+
+// ProductTypeInput defines model for ProductTypeInput.
+type ProductTypeInput struct {
+	Code             string `json:"code"`
+	NameEn           string `json:"nameEn"`
+	NameRu           string `json:"nameRu"`
+	RequiresAgeCheck *bool  `json:"requiresAgeCheck,omitempty"`
+	RequiresSerial   *bool  `json:"requiresSerial,omitempty"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// ProductTypeUpdate defines model for ProductTypeUpdate.
+type ProductTypeUpdate struct {
+	Active           bool   `json:"active"`
+	NameEn           string `json:"nameEn"`
+	NameRu           string `json:"nameRu"`
+	RequiresAgeCheck bool   `json:"requiresAgeCheck"`
+	RequiresSerial   bool   `json:"requiresSerial"`
+}
+
+// ProductVoidRequest Задается ровно одно из productId и barcode.
+type ProductVoidRequest struct {
+	Barcode   *string             `json:"barcode,omitempty"`
+	ProductId *openapi_types.UUID `json:"productId,omitempty"`
+	Reason    string              `json:"reason"`
+}
 
 // Reception defines model for Reception.
 type Reception struct {
-	DateTime time.Time           `json:"dateTime"`
-	Id       *openapi_types.UUID `json:"id,omitempty"`
-	PvzId    openapi_types.UUID  `json:"pvzId"`
-	Status   ReceptionStatus     `json:"status"`
+	// CloseReason closed_by_system — приемка закрыта автоматически после простоя
+	CloseReason *ReceptionCloseReason `json:"closeReason,omitempty"`
+	ClosedAt    *time.Time            `json:"closedAt,omitempty"`
+	DateTime    time.Time             `json:"dateTime"`
+	Id          *openapi_types.UUID   `json:"id,omitempty"`
+	PvzId       openapi_types.UUID    `json:"pvzId"`
+
+	// Status corrected — приемка закрыта повторно после исправления модератором
+	Status ReceptionStatus `json:"status"`
 }
 
-// ReceptionStatus defines model for Reception.Status.
+// ReceptionCloseReason closed_by_system — приемка закрыта автоматически после простоя
+type ReceptionCloseReason string
+
+// ReceptionStatus corrected — приемка закрыта повторно после исправления модератором
 type ReceptionStatus string
 
+// ReceptionReconciliation Сверка закрытой приемки с манифестом
+type ReceptionReconciliation struct {
+	AsnId       openapi_types.UUID `json:"asnId"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+
+	// Shortages Строки манифеста, по которым товаров принято меньше
+	Shortages []ASNDiscrepancy `json:"shortages"`
+
+	// Surpluses Строки с типом, по которым товаров принято больше
+	Surpluses []ASNDiscrepancy `json:"surpluses"`
+
+	// Unexpected Принятые товары, которых нет в манифесте
+	Unexpected []UnexpectedProduct `json:"unexpected"`
+}
+
 // Token defines model for Token.
 type Token = string
 
+// TokenPair defines model for TokenPair.
+type TokenPair struct {
+	AccessToken Token `json:"accessToken"`
+
+	// ExpiresAt Время истечения access-токена
+	ExpiresAt    time.Time `json:"expiresAt"`
+	RefreshToken string    `json:"refreshToken"`
+}
+
+// UnexpectedProduct defines model for UnexpectedProduct.
+type UnexpectedProduct struct {
+	Barcode   *string            `json:"barcode,omitempty"`
+	ProductId openapi_types.UUID `json:"productId"`
+	Type      string             `json:"type"`
+}
+
 // User defines model for User.
 type User struct {
-	Email openapi_types.Email `json:"email"`
-	Id    *openapi_types.UUID `json:"id,omitempty"`
-	Role  UserRole            `json:"role"`
+	Active *bool               `json:"active,omitempty"`
+	Email  openapi_types.Email `json:"email"`
+	Id     *openapi_types.UUID `json:"id,omitempty"`
+	Role   UserRole            `json:"role"`
 }
 
 // UserRole defines model for User.Role.
 type UserRole string
 
+// WorkingHours defines model for WorkingHours.
+type WorkingHours struct {
+	// Exceptions Особые дни, заменяют расписание дня недели
+	Exceptions *[]HoursException `json:"exceptions,omitempty"`
+
+	// Weekly Интервалы работы по дням недели, в день может быть несколько интервалов
+	Weekly []DayHours `json:"weekly"`
+}
+
+// CityId defines model for CityId.
+type CityId = openapi_types.UUID
+
+// ProductTypeCode defines model for ProductTypeCode.
+type ProductTypeCode = string
+
+// UserId defines model for UserId.
+type UserId = openapi_types.UUID
+
+// PostApiKeysJSONBody defines parameters for PostApiKeys.
+type PostApiKeysJSONBody struct {
+	ExpiresAt *time.Time                  `json:"expiresAt,omitempty"`
+	Name      string                      `json:"name"`
+	PvzId     *openapi_types.UUID         `json:"pvzId,omitempty"`
+	Role      PostApiKeysJSONBodyRole     `json:"role"`
+	Scopes    []PostApiKeysJSONBodyScopes `json:"scopes"`
+}
+
+// PostApiKeysJSONBodyRole defines parameters for PostApiKeys.
+type PostApiKeysJSONBodyRole string
+
+// PostApiKeysJSONBodyScopes defines parameters for PostApiKeys.
+type PostApiKeysJSONBodyScopes string
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	EntityType *GetAuditParamsEntityType `form:"entityType,omitempty" json:"entityType,omitempty"`
+	EntityId   *openapi_types.UUID       `form:"entityId,omitempty" json:"entityId,omitempty"`
+	ActorId    *openapi_types.UUID       `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// From Начало диапазона (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец диапазона (не включительно)
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAuditParamsEntityType defines parameters for GetAudit.
+type GetAuditParamsEntityType string
+
+// PostCitiesJSONBody defines parameters for PostCities.
+type PostCitiesJSONBody struct {
+	Name string `json:"name"`
+}
+
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
 	Role PostDummyLoginJSONBodyRole `json:"role"`
@@ -137,14 +668,32 @@ type PostLoginJSONBody struct {
 	Password string              `json:"password"`
 }
 
+// PostPasswordForgotJSONBody defines parameters for PostPasswordForgot.
+type PostPasswordForgotJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// PostPasswordResetJSONBody defines parameters for PostPasswordReset.
+type PostPasswordResetJSONBody struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
-}
+	// Barcode Штрихкод товара. Пока товар находится в ПВЗ, повторно с тем же штрихкодом его принять нельзя.
+	Barcode *string `json:"barcode,omitempty"`
+
+	// OrderId Номер заказа во внешней системе
+	OrderId *string            `json:"orderId,omitempty"`
+	PvzId   openapi_types.UUID `json:"pvzId"`
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+	// Sku Артикул
+	Sku *string `json:"sku,omitempty"`
+
+	// Type Код активного типа из справочника. Для совместимости принимается и русское название типа.
+	Type string `json:"type"`
+}
 
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
@@ -159,6 +708,29 @@ type GetPvzParams struct {
 
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Status Только ПВЗ в этом состоянии, по умолчанию все
+	Status *PVZStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetPvzNearestParams defines parameters for GetPvzNearest.
+type GetPvzNearestParams struct {
+	Lat      float64  `form:"lat" json:"lat"`
+	Lon      float64  `form:"lon" json:"lon"`
+	RadiusKm *float64 `form:"radiusKm,omitempty" json:"radiusKm,omitempty"`
+	Limit    *int     `form:"limit,omitempty" json:"limit,omitempty"`
+	OpenNow  *bool    `form:"openNow,omitempty" json:"openNow,omitempty"`
+}
+
+// PostPvzPvzIdAsnJSONBody defines parameters for PostPvzPvzIdAsn.
+type PostPvzPvzIdAsnJSONBody struct {
+	Lines     []ASNLine `json:"lines"`
+	Reference *string   `json:"reference,omitempty"`
+}
+
+// PostPvzPvzIdProductsBatchJSONBody defines parameters for PostPvzPvzIdProductsBatch.
+type PostPvzPvzIdProductsBatchJSONBody struct {
+	Products []ProductBatchItem `json:"products"`
 }
 
 // PostReceptionsJSONBody defines parameters for PostReceptions.
@@ -166,6 +738,11 @@ type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
+// PostReceptionsReceptionIdReopenJSONBody defines parameters for PostReceptionsReceptionIdReopen.
+type PostReceptionsReceptionIdReopenJSONBody struct {
+	Justification string `json:"justification"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
 	Email    openapi_types.Email      `json:"email"`
@@ -176,32 +753,154 @@ type PostRegisterJSONBody struct {
 // PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
+// PostTokenRefreshJSONBody defines parameters for PostTokenRefresh.
+type PostTokenRefreshJSONBody struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	// Email Часть email
+	Email *string             `form:"email,omitempty" json:"email,omitempty"`
+	Role  *GetUsersParamsRole `form:"role,omitempty" json:"role,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersParamsRole defines parameters for GetUsers.
+type GetUsersParamsRole string
+
+// PostUsersUserIdRoleJSONBody defines parameters for PostUsersUserIdRole.
+type PostUsersUserIdRoleJSONBody struct {
+	Role PostUsersUserIdRoleJSONBodyRole `json:"role"`
+}
+
+// PostUsersUserIdRoleJSONBodyRole defines parameters for PostUsersUserIdRole.
+type PostUsersUserIdRoleJSONBodyRole string
+
+// PostApiKeysJSONRequestBody defines body for PostApiKeys for application/json ContentType.
+type PostApiKeysJSONRequestBody PostApiKeysJSONBody
+
+// PostCitiesJSONRequestBody defines body for PostCities for application/json ContentType.
+type PostCitiesJSONRequestBody PostCitiesJSONBody
+
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostPasswordForgotJSONRequestBody defines body for PostPasswordForgot for application/json ContentType.
+type PostPasswordForgotJSONRequestBody PostPasswordForgotJSONBody
+
+// PostPasswordResetJSONRequestBody defines body for PostPasswordReset for application/json ContentType.
+type PostPasswordResetJSONRequestBody PostPasswordResetJSONBody
+
+// PostProductTypesJSONRequestBody defines body for PostProductTypes for application/json ContentType.
+type PostProductTypesJSONRequestBody = ProductTypeInput
+
+// PutProductTypesCodeJSONRequestBody defines body for PutProductTypesCode for application/json ContentType.
+type PutProductTypesCodeJSONRequestBody = ProductTypeUpdate
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PatchPvzPvzIdJSONRequestBody defines body for PatchPvzPvzId for application/json ContentType.
+type PatchPvzPvzIdJSONRequestBody = PVZProfile
+
+// PostPvzPvzIdAsnJSONRequestBody defines body for PostPvzPvzIdAsn for application/json ContentType.
+type PostPvzPvzIdAsnJSONRequestBody PostPvzPvzIdAsnJSONBody
+
+// PostPvzPvzIdCloseJSONRequestBody defines body for PostPvzPvzIdClose for application/json ContentType.
+type PostPvzPvzIdCloseJSONRequestBody = PVZStatusReason
+
+// PostPvzPvzIdProductsVoidJSONRequestBody defines body for PostPvzPvzIdProductsVoid for application/json ContentType.
+type PostPvzPvzIdProductsVoidJSONRequestBody = ProductVoidRequest
+
+// PostPvzPvzIdProductsBatchJSONRequestBody defines body for PostPvzPvzIdProductsBatch for application/json ContentType.
+type PostPvzPvzIdProductsBatchJSONRequestBody PostPvzPvzIdProductsBatchJSONBody
+
+// PostPvzPvzIdReopenJSONRequestBody defines body for PostPvzPvzIdReopen for application/json ContentType.
+type PostPvzPvzIdReopenJSONRequestBody = PVZStatusReason
+
+// PostPvzPvzIdSuspendJSONRequestBody defines body for PostPvzPvzIdSuspend for application/json ContentType.
+type PostPvzPvzIdSuspendJSONRequestBody = PVZStatusReason
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
+// PostReceptionsReceptionIdReopenJSONRequestBody defines body for PostReceptionsReceptionIdReopen for application/json ContentType.
+type PostReceptionsReceptionIdReopenJSONRequestBody PostReceptionsReceptionIdReopenJSONBody
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
 
+// PostTokenRefreshJSONRequestBody defines body for PostTokenRefresh for application/json ContentType.
+type PostTokenRefreshJSONRequestBody PostTokenRefreshJSONBody
+
+// PostUsersUserIdRoleJSONRequestBody defines body for PostUsersUserIdRole for application/json ContentType.
+type PostUsersUserIdRoleJSONRequestBody PostUsersUserIdRoleJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Публичные ключи для проверки access-токенов
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request)
+	// Список API-ключей (только для модераторов)
+	// (GET /api-keys)
+	GetApiKeys(w http.ResponseWriter, r *http.Request)
+	// Выпуск API-ключа для интеграций (только для модераторов)
+	// (POST /api-keys)
+	PostApiKeys(w http.ResponseWriter, r *http.Request)
+	// Отзыв API-ключа (только для модераторов)
+	// (DELETE /api-keys/{keyId})
+	DeleteApiKeysKeyId(w http.ResponseWriter, r *http.Request, keyId openapi_types.UUID)
+	// Журнал аудита изменений (только для модераторов)
+	// (GET /audit)
+	GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams)
+	// Справочник городов
+	// (GET /cities)
+	GetCities(w http.ResponseWriter, r *http.Request)
+	// Добавление города (только для модераторов)
+	// (POST /cities)
+	PostCities(w http.ResponseWriter, r *http.Request)
+	// Включение города (только для модераторов)
+	// (POST /cities/{cityId}/activate)
+	PostCitiesCityIdActivate(w http.ResponseWriter, r *http.Request, cityId CityId)
+	// Отключение города (только для модераторов)
+	// (POST /cities/{cityId}/deactivate)
+	PostCitiesCityIdDeactivate(w http.ResponseWriter, r *http.Request, cityId CityId)
 	// Получение тестового токена
 	// (POST /dummyLogin)
 	PostDummyLogin(w http.ResponseWriter, r *http.Request)
 	// Авторизация пользователя
 	// (POST /login)
 	PostLogin(w http.ResponseWriter, r *http.Request)
+	// Завершение сессии и отзыв ее токенов
+	// (POST /logout)
+	PostLogout(w http.ResponseWriter, r *http.Request)
+	// Запрос на восстановление пароля
+	// (POST /password/forgot)
+	PostPasswordForgot(w http.ResponseWriter, r *http.Request)
+	// Установка нового пароля по токену восстановления
+	// (POST /password/reset)
+	PostPasswordReset(w http.ResponseWriter, r *http.Request)
+	// Справочник типов товаров
+	// (GET /product-types)
+	GetProductTypes(w http.ResponseWriter, r *http.Request)
+	// Добавление типа товара (только для модераторов)
+	// (POST /product-types)
+	PostProductTypes(w http.ResponseWriter, r *http.Request)
+	// Изменение типа товара (только для модераторов)
+	// (PUT /product-types/{code})
+	PutProductTypesCode(w http.ResponseWriter, r *http.Request, code ProductTypeCode)
 	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 	// (POST /products)
 	PostProducts(w http.ResponseWriter, r *http.Request)
@@ -211,18 +910,78 @@ type ServerInterface interface {
 	// Создание ПВЗ (только для модераторов)
 	// (POST /pvz)
 	PostPvz(w http.ResponseWriter, r *http.Request)
+	// Ближайшие к точке ПВЗ
+	// (GET /pvz/nearest)
+	GetPvzNearest(w http.ResponseWriter, r *http.Request, params GetPvzNearestParams)
+	// Изменение профиля ПВЗ (только для модераторов)
+	// (PATCH /pvz/{pvzId})
+	PatchPvzPvzId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Манифест ожидаемой поставки в ПВЗ
+	// (POST /pvz/{pvzId}/asn)
+	PostPvzPvzIdAsn(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Окончательное закрытие ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/close)
+	PostPvzPvzIdClose(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
 	// Закрытие последней открытой приемки товаров в рамках ПВЗ
 	// (POST /pvz/{pvzId}/close_last_reception)
 	PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
 	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/delete_last_product)
 	PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// История изменений профиля ПВЗ (только для модераторов)
+	// (GET /pvz/{pvzId}/history)
+	GetPvzPvzIdHistory(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Аннулирование товара текущей приемки по ID или штрихкоду (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/products/void)
+	PostPvzPvzIdProductsVoid(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Добавление нескольких товаров в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/products:batch)
+	PostPvzPvzIdProductsBatch(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Возобновление работы приостановленного ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/reopen)
+	PostPvzPvzIdReopen(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Список сотрудников, закрепленных за ПВЗ (только для модераторов)
+	// (GET /pvz/{pvzId}/staff)
+	GetPvzPvzIdStaff(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Открепление сотрудника от ПВЗ (только для модераторов)
+	// (DELETE /pvz/{pvzId}/staff/{userId})
+	DeletePvzPvzIdStaffUserId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, userId openapi_types.UUID)
+	// Закрепление сотрудника за ПВЗ (только для модераторов)
+	// (PUT /pvz/{pvzId}/staff/{userId})
+	PutPvzPvzIdStaffUserId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, userId openapi_types.UUID)
+	// Приостановка работы ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/suspend)
+	PostPvzPvzIdSuspend(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
 	// Создание новой приемки товаров (только для сотрудников ПВЗ)
 	// (POST /receptions)
 	PostReceptions(w http.ResponseWriter, r *http.Request)
+	// Последняя сверка приемки с манифестом
+	// (GET /receptions/{receptionId}/reconciliation)
+	GetReceptionsReceptionIdReconciliation(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Открытие закрытой приемки для исправления (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
 	// Регистрация пользователя
 	// (POST /register)
 	PostRegister(w http.ResponseWriter, r *http.Request)
+	// Обновление пары токенов по refresh-токену
+	// (POST /token/refresh)
+	PostTokenRefresh(w http.ResponseWriter, r *http.Request)
+	// Список пользователей с поиском по email и пагинацией (только для модераторов)
+	// (GET /users)
+	GetUsers(w http.ResponseWriter, r *http.Request, params GetUsersParams)
+	// Активация пользователя (только для модераторов)
+	// (POST /users/{userId}/activate)
+	PostUsersUserIdActivate(w http.ResponseWriter, r *http.Request, userId UserId)
+	// Деактивация пользователя, все его сессии отзываются (только для модераторов)
+	// (POST /users/{userId}/deactivate)
+	PostUsersUserIdDeactivate(w http.ResponseWriter, r *http.Request, userId UserId)
+	// Принудительный сброс пароля пользователя (только для модераторов)
+	// (POST /users/{userId}/password/reset)
+	PostUsersUserIdPasswordReset(w http.ResponseWriter, r *http.Request, userId UserId)
+	// Изменение роли пользователя (только для модераторов)
+	// (POST /users/{userId}/role)
+	PostUsersUserIdRole(w http.ResponseWriter, r *http.Request, userId UserId)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -234,11 +993,11 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// PostDummyLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
+// GetWellKnownJwksJson operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostDummyLogin(w, r)
+		siw.Handler.GetWellKnownJwksJson(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -248,11 +1007,17 @@ func (siw *ServerInterfaceWrapper) PostDummyLogin(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
+// GetApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostLogin(w, r)
+		siw.Handler.GetApiKeys(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -262,8 +1027,8 @@ func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostProducts operation middleware
-func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.Request) {
+// PostApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostApiKeys(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -272,7 +1037,7 @@ func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostProducts(w, r)
+		siw.Handler.PostApiKeys(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -282,34 +1047,89 @@ func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetPvz operation middleware
-func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request) {
+// DeleteApiKeysKeyId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiKeysKeyId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "keyId" -------------
+	var keyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", r.PathValue("keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPvzParams
-
-	// ------------- Optional query parameter "startDate" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiKeysKeyId(w, r, keyId)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "endDate" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
-		return
+// GetAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+
+	// ------------- Optional query parameter "entityType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityType", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entityId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityId", r.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actorId", r.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actorId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
 	}
 
 	// ------------- Optional query parameter "page" -------------
@@ -329,7 +1149,7 @@ func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPvz(w, r, params)
+		siw.Handler.GetAudit(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -339,8 +1159,8 @@ func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-// PostPvz operation middleware
-func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Request) {
+// GetCities operation middleware
+func (siw *ServerInterfaceWrapper) GetCities(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -349,7 +1169,7 @@ func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Reques
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPvz(w, r)
+		siw.Handler.GetCities(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -359,19 +1179,8 @@ func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
-		return
-	}
+// PostCities operation middleware
+func (siw *ServerInterfaceWrapper) PostCities(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -380,7 +1189,7 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.Respons
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPvzPvzIdCloseLastReception(w, r, pvzId)
+		siw.Handler.PostCities(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -390,17 +1199,17 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request) {
+// PostCitiesCityIdActivate operation middleware
+func (siw *ServerInterfaceWrapper) PostCitiesCityIdActivate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	// ------------- Path parameter "cityId" -------------
+	var cityId CityId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "cityId", r.PathValue("cityId"), &cityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cityId", Err: err})
 		return
 	}
 
@@ -411,7 +1220,7 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.Response
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPvzPvzIdDeleteLastProduct(w, r, pvzId)
+		siw.Handler.PostCitiesCityIdActivate(w, r, cityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -421,8 +1230,19 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// PostReceptions operation middleware
-func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http.Request) {
+// PostCitiesCityIdDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostCitiesCityIdDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cityId" -------------
+	var cityId CityId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cityId", r.PathValue("cityId"), &cityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cityId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -431,7 +1251,7 @@ func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostReceptions(w, r)
+		siw.Handler.PostCitiesCityIdDeactivate(w, r, cityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -441,11 +1261,11 @@ func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(w http.ResponseWriter, r *http.Request) {
+// PostDummyLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostRegister(w, r)
+		siw.Handler.PostDummyLogin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -455,479 +1275,3753 @@ func (siw *ServerInterfaceWrapper) PostRegister(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLogin(w, r)
+	}))
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+	handler.ServeHTTP(w, r)
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+// PostLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostLogout(w http.ResponseWriter, r *http.Request) {
 
-type RequiredParamError struct {
-	ParamName string
-}
+	ctx := r.Context()
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLogout(w, r)
+	}))
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// PostPasswordForgot operation middleware
+func (siw *ServerInterfaceWrapper) PostPasswordForgot(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPasswordForgot(w, r)
+	}))
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	handler.ServeHTTP(w, r)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+// PostPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostPasswordReset(w http.ResponseWriter, r *http.Request) {
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPasswordReset(w, r)
+	}))
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
+	handler.ServeHTTP(w, r)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+// GetProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetProductTypes(w http.ResponseWriter, r *http.Request) {
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	ctx := r.Context()
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	r = r.WithContext(ctx)
 
-	m.HandleFunc("POST "+options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
-	m.HandleFunc("POST "+options.BaseURL+"/login", wrapper.PostLogin)
-	m.HandleFunc("POST "+options.BaseURL+"/products", wrapper.PostProducts)
-	m.HandleFunc("GET "+options.BaseURL+"/pvz", wrapper.GetPvz)
-	m.HandleFunc("POST "+options.BaseURL+"/pvz", wrapper.PostPvz)
-	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
-	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
-	m.HandleFunc("POST "+options.BaseURL+"/receptions", wrapper.PostReceptions)
-	m.HandleFunc("POST "+options.BaseURL+"/register", wrapper.PostRegister)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductTypes(w, r)
+	}))
 
-	return m
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostDummyLoginRequestObject struct {
-	Body *PostDummyLoginJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PostDummyLoginResponseObject interface {
-	VisitPostDummyLoginResponse(w http.ResponseWriter) error
-}
+// PostProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) PostProductTypes(w http.ResponseWriter, r *http.Request) {
 
-type PostDummyLogin200JSONResponse Token
+	ctx := r.Context()
 
-func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostDummyLogin400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductTypes(w, r)
+	}))
 
-func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
-}
+// PutProductTypesCode operation middleware
+func (siw *ServerInterfaceWrapper) PutProductTypesCode(w http.ResponseWriter, r *http.Request) {
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type PostLogin200JSONResponse Token
+	// ------------- Path parameter "code" -------------
+	var code ProductTypeCode
 
-func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "code", r.PathValue("code"), &code, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostLogin401JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutProductTypesCode(w, r, code)
+	}))
 
-type PostProductsRequestObject struct {
-	Body *PostProductsJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostProductsResponseObject interface {
-	VisitPostProductsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PostProducts201JSONResponse Product
+// PostProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.Request) {
 
-func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostProducts400JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProducts(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostProducts403JSONResponse Error
+	handler.ServeHTTP(w, r)
+}
 
-func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// GetPvz operation middleware
+func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetPvzRequestObject struct {
-	Params GetPvzParams
-}
+	ctx := r.Context()
 
-type GetPvzResponseObject interface {
-	VisitGetPvzResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetPvz200JSONResponse []struct {
-	Pvz        *PVZ `json:"pvz,omitempty"`
-	Receptions *[]struct {
-		Products  *[]Product `json:"products,omitempty"`
-		Reception *Reception `json:"reception,omitempty"`
-	} `json:"receptions,omitempty"`
-}
+	r = r.WithContext(ctx)
 
-func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "startDate" -------------
 
-type PostPvzRequestObject struct {
-	Body *PostPvzJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
 
-type PostPvzResponseObject interface {
-	VisitPostPvzResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "endDate" -------------
 
-type PostPvz201JSONResponse PVZ
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
 
-func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional query parameter "page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type PostPvz400JSONResponse Error
+	// ------------- Optional query parameter "limit" -------------
 
-func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "status" -------------
 
-type PostPvz403JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvz(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostPvzPvzIdCloseLastReceptionRequestObject struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
+	handler.ServeHTTP(w, r)
 }
 
-type PostPvzPvzIdCloseLastReceptionResponseObject interface {
-	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
-}
+// PostPvz operation middleware
+func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Request) {
 
-type PostPvzPvzIdCloseLastReception200JSONResponse Reception
+	ctx := r.Context()
 
-func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostPvzPvzIdCloseLastReception400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvz(w, r)
+	}))
 
-func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPvzPvzIdCloseLastReception403JSONResponse Error
-
-func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// GetPvzNearest operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzNearest(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostPvzPvzIdDeleteLastProductRequestObject struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
-}
+	ctx := r.Context()
 
-type PostPvzPvzIdDeleteLastProductResponseObject interface {
-	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostPvzPvzIdDeleteLastProduct200Response struct {
-}
+	r = r.WithContext(ctx)
 
-func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzNearestParams
 
-type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+	// ------------- Required query parameter "lat" -------------
 
-func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	if paramValue := r.URL.Query().Get("lat"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lat"})
+		return
+	}
 
-type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, true, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
 
-func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Required query parameter "lon" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("lon"); paramValue != "" {
 
-type PostReceptionsRequestObject struct {
-	Body *PostReceptionsJSONRequestBody
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lon"})
+		return
+	}
 
-type PostReceptionsResponseObject interface {
-	VisitPostReceptionsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, true, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
 
-type PostReceptions201JSONResponse Reception
+	// ------------- Optional query parameter "radiusKm" -------------
 
-func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindQueryParameter("form", true, false, "radiusKm", r.URL.Query(), &params.RadiusKm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "radiusKm", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PostReceptions400JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "openNow" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "openNow", r.URL.Query(), &params.OpenNow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "openNow", Err: err})
+		return
+	}
 
-type PostReceptions403JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzNearest(w, r, params)
+	}))
 
-func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostRegisterRequestObject struct {
-	Body *PostRegisterJSONRequestBody
-}
+// PatchPvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) PatchPvzPvzId(w http.ResponseWriter, r *http.Request) {
 
-type PostRegisterResponseObject interface {
-	VisitPostRegisterResponse(w http.ResponseWriter) error
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchPvzPvzId(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdAsn operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdAsn(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdAsn(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdClose(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdClose(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdCloseLastReception(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdDeleteLastProduct(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvzPvzIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzPvzIdHistory(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdProductsVoid operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdProductsVoid(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdProductsVoid(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdProductsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdProductsBatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdProductsBatch(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdReopen(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdReopen(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvzPvzIdStaff operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdStaff(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzPvzIdStaff(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePvzPvzIdStaffUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePvzPvzIdStaffUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePvzPvzIdStaffUserId(w, r, pvzId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutPvzPvzIdStaffUserId operation middleware
+func (siw *ServerInterfaceWrapper) PutPvzPvzIdStaffUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutPvzPvzIdStaffUserId(w, r, pvzId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdSuspend operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdSuspend(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdSuspend(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptions operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionIdReconciliation operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionIdReconciliation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", r.PathValue("receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionsReceptionIdReconciliation(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", r.PathValue("receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptionsReceptionIdReopen(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTokenRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostTokenRefresh(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTokenRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersParams
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", r.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "email", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersUserIdActivate operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdActivate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersUserIdActivate(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersUserIdDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersUserIdDeactivate(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersUserIdPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdPasswordReset(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersUserIdPasswordReset(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersUserIdRole operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersUserIdRole(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	m.HandleFunc("GET "+options.BaseURL+"/api-keys", wrapper.GetApiKeys)
+	m.HandleFunc("POST "+options.BaseURL+"/api-keys", wrapper.PostApiKeys)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api-keys/{keyId}", wrapper.DeleteApiKeysKeyId)
+	m.HandleFunc("GET "+options.BaseURL+"/audit", wrapper.GetAudit)
+	m.HandleFunc("GET "+options.BaseURL+"/cities", wrapper.GetCities)
+	m.HandleFunc("POST "+options.BaseURL+"/cities", wrapper.PostCities)
+	m.HandleFunc("POST "+options.BaseURL+"/cities/{cityId}/activate", wrapper.PostCitiesCityIdActivate)
+	m.HandleFunc("POST "+options.BaseURL+"/cities/{cityId}/deactivate", wrapper.PostCitiesCityIdDeactivate)
+	m.HandleFunc("POST "+options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
+	m.HandleFunc("POST "+options.BaseURL+"/login", wrapper.PostLogin)
+	m.HandleFunc("POST "+options.BaseURL+"/logout", wrapper.PostLogout)
+	m.HandleFunc("POST "+options.BaseURL+"/password/forgot", wrapper.PostPasswordForgot)
+	m.HandleFunc("POST "+options.BaseURL+"/password/reset", wrapper.PostPasswordReset)
+	m.HandleFunc("GET "+options.BaseURL+"/product-types", wrapper.GetProductTypes)
+	m.HandleFunc("POST "+options.BaseURL+"/product-types", wrapper.PostProductTypes)
+	m.HandleFunc("PUT "+options.BaseURL+"/product-types/{code}", wrapper.PutProductTypesCode)
+	m.HandleFunc("POST "+options.BaseURL+"/products", wrapper.PostProducts)
+	m.HandleFunc("GET "+options.BaseURL+"/pvz", wrapper.GetPvz)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz", wrapper.PostPvz)
+	m.HandleFunc("GET "+options.BaseURL+"/pvz/nearest", wrapper.GetPvzNearest)
+	m.HandleFunc("PATCH "+options.BaseURL+"/pvz/{pvzId}", wrapper.PatchPvzPvzId)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/asn", wrapper.PostPvzPvzIdAsn)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/close", wrapper.PostPvzPvzIdClose)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+	m.HandleFunc("GET "+options.BaseURL+"/pvz/{pvzId}/history", wrapper.GetPvzPvzIdHistory)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/products/void", wrapper.PostPvzPvzIdProductsVoid)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/products:batch", wrapper.PostPvzPvzIdProductsBatch)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/reopen", wrapper.PostPvzPvzIdReopen)
+	m.HandleFunc("GET "+options.BaseURL+"/pvz/{pvzId}/staff", wrapper.GetPvzPvzIdStaff)
+	m.HandleFunc("DELETE "+options.BaseURL+"/pvz/{pvzId}/staff/{userId}", wrapper.DeletePvzPvzIdStaffUserId)
+	m.HandleFunc("PUT "+options.BaseURL+"/pvz/{pvzId}/staff/{userId}", wrapper.PutPvzPvzIdStaffUserId)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/suspend", wrapper.PostPvzPvzIdSuspend)
+	m.HandleFunc("POST "+options.BaseURL+"/receptions", wrapper.PostReceptions)
+	m.HandleFunc("GET "+options.BaseURL+"/receptions/{receptionId}/reconciliation", wrapper.GetReceptionsReceptionIdReconciliation)
+	m.HandleFunc("POST "+options.BaseURL+"/receptions/{receptionId}/reopen", wrapper.PostReceptionsReceptionIdReopen)
+	m.HandleFunc("POST "+options.BaseURL+"/register", wrapper.PostRegister)
+	m.HandleFunc("POST "+options.BaseURL+"/token/refresh", wrapper.PostTokenRefresh)
+	m.HandleFunc("GET "+options.BaseURL+"/users", wrapper.GetUsers)
+	m.HandleFunc("POST "+options.BaseURL+"/users/{userId}/activate", wrapper.PostUsersUserIdActivate)
+	m.HandleFunc("POST "+options.BaseURL+"/users/{userId}/deactivate", wrapper.PostUsersUserIdDeactivate)
+	m.HandleFunc("POST "+options.BaseURL+"/users/{userId}/password/reset", wrapper.PostUsersUserIdPasswordReset)
+	m.HandleFunc("POST "+options.BaseURL+"/users/{userId}/role", wrapper.PostUsersUserIdRole)
+
+	return m
+}
+
+type GetWellKnownJwksJsonRequestObject struct {
+}
+
+type GetWellKnownJwksJsonResponseObject interface {
+	VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error
+}
+
+type GetWellKnownJwksJson200JSONResponse JWKSet
+
+func (response GetWellKnownJwksJson200JSONResponse) VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiKeysRequestObject struct {
+}
+
+type GetApiKeysResponseObject interface {
+	VisitGetApiKeysResponse(w http.ResponseWriter) error
+}
+
+type GetApiKeys200JSONResponse []APIKey
+
+func (response GetApiKeys200JSONResponse) VisitGetApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiKeys403JSONResponse Error
+
+func (response GetApiKeys403JSONResponse) VisitGetApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiKeysRequestObject struct {
+	Body *PostApiKeysJSONRequestBody
+}
+
+type PostApiKeysResponseObject interface {
+	VisitPostApiKeysResponse(w http.ResponseWriter) error
+}
+
+type PostApiKeys201JSONResponse struct {
+	ApiKey APIKey `json:"apiKey"`
+	Key    string `json:"key"`
+}
+
+func (response PostApiKeys201JSONResponse) VisitPostApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiKeys400JSONResponse Error
+
+func (response PostApiKeys400JSONResponse) VisitPostApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiKeys403JSONResponse Error
+
+func (response PostApiKeys403JSONResponse) VisitPostApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiKeysKeyIdRequestObject struct {
+	KeyId openapi_types.UUID `json:"keyId"`
+}
+
+type DeleteApiKeysKeyIdResponseObject interface {
+	VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiKeysKeyId204Response struct {
+}
+
+func (response DeleteApiKeysKeyId204Response) VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiKeysKeyId403JSONResponse Error
+
+func (response DeleteApiKeysKeyId403JSONResponse) VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiKeysKeyId404JSONResponse Error
+
+func (response DeleteApiKeysKeyId404JSONResponse) VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditRequestObject struct {
+	Params GetAuditParams
+}
+
+type GetAuditResponseObject interface {
+	VisitGetAuditResponse(w http.ResponseWriter) error
+}
+
+type GetAudit200JSONResponse []AuditEntry
+
+func (response GetAudit200JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit400JSONResponse Error
+
+func (response GetAudit400JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit403JSONResponse Error
+
+func (response GetAudit403JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCitiesRequestObject struct {
+}
+
+type GetCitiesResponseObject interface {
+	VisitGetCitiesResponse(w http.ResponseWriter) error
+}
+
+type GetCities200JSONResponse []City
+
+func (response GetCities200JSONResponse) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCities403JSONResponse Error
+
+func (response GetCities403JSONResponse) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCitiesRequestObject struct {
+	Body *PostCitiesJSONRequestBody
+}
+
+type PostCitiesResponseObject interface {
+	VisitPostCitiesResponse(w http.ResponseWriter) error
+}
+
+type PostCities201JSONResponse City
+
+func (response PostCities201JSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities400JSONResponse Error
+
+func (response PostCities400JSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities403JSONResponse Error
+
+func (response PostCities403JSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities409JSONResponse Error
+
+func (response PostCities409JSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCitiesCityIdActivateRequestObject struct {
+	CityId CityId `json:"cityId"`
+}
+
+type PostCitiesCityIdActivateResponseObject interface {
+	VisitPostCitiesCityIdActivateResponse(w http.ResponseWriter) error
+}
+
+type PostCitiesCityIdActivate200JSONResponse City
+
+func (response PostCitiesCityIdActivate200JSONResponse) VisitPostCitiesCityIdActivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCitiesCityIdActivate403JSONResponse Error
+
+func (response PostCitiesCityIdActivate403JSONResponse) VisitPostCitiesCityIdActivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCitiesCityIdActivate404JSONResponse Error
+
+func (response PostCitiesCityIdActivate404JSONResponse) VisitPostCitiesCityIdActivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCitiesCityIdDeactivateRequestObject struct {
+	CityId CityId `json:"cityId"`
+}
+
+type PostCitiesCityIdDeactivateResponseObject interface {
+	VisitPostCitiesCityIdDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PostCitiesCityIdDeactivate200JSONResponse City
+
+func (response PostCitiesCityIdDeactivate200JSONResponse) VisitPostCitiesCityIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCitiesCityIdDeactivate403JSONResponse Error
+
+func (response PostCitiesCityIdDeactivate403JSONResponse) VisitPostCitiesCityIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCitiesCityIdDeactivate404JSONResponse Error
+
+func (response PostCitiesCityIdDeactivate404JSONResponse) VisitPostCitiesCityIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLoginRequestObject struct {
+	Body *PostDummyLoginJSONRequestBody
+}
+
+type PostDummyLoginResponseObject interface {
+	VisitPostDummyLoginResponse(w http.ResponseWriter) error
+}
+
+type PostDummyLogin200JSONResponse Token
+
+func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin400JSONResponse Error
+
+func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin404JSONResponse Error
+
+func (response PostDummyLogin404JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
+}
+
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
+}
+
+type PostLogin200JSONResponse TokenPair
+
+func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin401JSONResponse Error
+
+func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostLogin429JSONResponse struct {
+	Body    Error
+	Headers PostLogin429ResponseHeaders
+}
+
+func (response PostLogin429JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostLogoutRequestObject struct {
+}
+
+type PostLogoutResponseObject interface {
+	VisitPostLogoutResponse(w http.ResponseWriter) error
+}
+
+type PostLogout204Response struct {
+}
+
+func (response PostLogout204Response) VisitPostLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostLogout401JSONResponse Error
+
+func (response PostLogout401JSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPasswordForgotRequestObject struct {
+	Body *PostPasswordForgotJSONRequestBody
+}
+
+type PostPasswordForgotResponseObject interface {
+	VisitPostPasswordForgotResponse(w http.ResponseWriter) error
+}
+
+type PostPasswordForgot202Response struct {
+}
+
+func (response PostPasswordForgot202Response) VisitPostPasswordForgotResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type PostPasswordForgot400JSONResponse Error
+
+func (response PostPasswordForgot400JSONResponse) VisitPostPasswordForgotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPasswordResetRequestObject struct {
+	Body *PostPasswordResetJSONRequestBody
+}
+
+type PostPasswordResetResponseObject interface {
+	VisitPostPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostPasswordReset204Response struct {
+}
+
+func (response PostPasswordReset204Response) VisitPostPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostPasswordReset400JSONResponse Error
+
+func (response PostPasswordReset400JSONResponse) VisitPostPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypesRequestObject struct {
+}
+
+type GetProductTypesResponseObject interface {
+	VisitGetProductTypesResponse(w http.ResponseWriter) error
+}
+
+type GetProductTypes200JSONResponse []ProductType
+
+func (response GetProductTypes200JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypes403JSONResponse Error
+
+func (response GetProductTypes403JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypesRequestObject struct {
+	Body *PostProductTypesJSONRequestBody
+}
+
+type PostProductTypesResponseObject interface {
+	VisitPostProductTypesResponse(w http.ResponseWriter) error
+}
+
+type PostProductTypes201JSONResponse ProductType
+
+func (response PostProductTypes201JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes400JSONResponse Error
+
+func (response PostProductTypes400JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes403JSONResponse Error
+
+func (response PostProductTypes403JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes409JSONResponse Error
+
+func (response PostProductTypes409JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesCodeRequestObject struct {
+	Code ProductTypeCode `json:"code"`
+	Body *PutProductTypesCodeJSONRequestBody
+}
+
+type PutProductTypesCodeResponseObject interface {
+	VisitPutProductTypesCodeResponse(w http.ResponseWriter) error
+}
+
+type PutProductTypesCode200JSONResponse ProductType
+
+func (response PutProductTypesCode200JSONResponse) VisitPutProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesCode400JSONResponse Error
+
+func (response PutProductTypesCode400JSONResponse) VisitPutProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesCode403JSONResponse Error
+
+func (response PutProductTypesCode403JSONResponse) VisitPutProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesCode404JSONResponse Error
+
+func (response PutProductTypesCode404JSONResponse) VisitPutProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsRequestObject struct {
+	Body *PostProductsJSONRequestBody
+}
+
+type PostProductsResponseObject interface {
+	VisitPostProductsResponse(w http.ResponseWriter) error
+}
+
+type PostProducts201JSONResponse Product
+
+func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts400JSONResponse Error
+
+func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts403JSONResponse Error
+
+func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts409JSONResponse ProductConflict
+
+func (response PostProducts409JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzRequestObject struct {
+	Params GetPvzParams
+}
+
+type GetPvzResponseObject interface {
+	VisitGetPvzResponse(w http.ResponseWriter) error
+}
+
+type GetPvz200JSONResponse []struct {
+	Pvz        *PVZ `json:"pvz,omitempty"`
+	Receptions *[]struct {
+		Products  *[]Product `json:"products,omitempty"`
+		Reception *Reception `json:"reception,omitempty"`
+	} `json:"receptions,omitempty"`
+}
+
+func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzRequestObject struct {
+	Body *PostPvzJSONRequestBody
+}
+
+type PostPvzResponseObject interface {
+	VisitPostPvzResponse(w http.ResponseWriter) error
+}
+
+type PostPvz201JSONResponse PVZ
+
+func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz400JSONResponse Error
+
+func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz403JSONResponse Error
+
+func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzNearestRequestObject struct {
+	Params GetPvzNearestParams
+}
+
+type GetPvzNearestResponseObject interface {
+	VisitGetPvzNearestResponse(w http.ResponseWriter) error
+}
+
+type GetPvzNearest200JSONResponse []NearbyPVZ
+
+func (response GetPvzNearest200JSONResponse) VisitGetPvzNearestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzNearest400JSONResponse Error
+
+func (response GetPvzNearest400JSONResponse) VisitGetPvzNearestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzNearest403JSONResponse Error
+
+func (response GetPvzNearest403JSONResponse) VisitGetPvzNearestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzIdRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PatchPvzPvzIdJSONRequestBody
+}
+
+type PatchPvzPvzIdResponseObject interface {
+	VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error
+}
+
+type PatchPvzPvzId200JSONResponse PVZ
+
+func (response PatchPvzPvzId200JSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId400JSONResponse Error
+
+func (response PatchPvzPvzId400JSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId403JSONResponse Error
+
+func (response PatchPvzPvzId403JSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId404JSONResponse Error
+
+func (response PatchPvzPvzId404JSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdAsnRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdAsnJSONRequestBody
+}
+
+type PostPvzPvzIdAsnResponseObject interface {
+	VisitPostPvzPvzIdAsnResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdAsn201JSONResponse ASN
+
+func (response PostPvzPvzIdAsn201JSONResponse) VisitPostPvzPvzIdAsnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdAsn400JSONResponse Error
+
+func (response PostPvzPvzIdAsn400JSONResponse) VisitPostPvzPvzIdAsnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdAsn403JSONResponse Error
+
+func (response PostPvzPvzIdAsn403JSONResponse) VisitPostPvzPvzIdAsnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdAsn404JSONResponse Error
+
+func (response PostPvzPvzIdAsn404JSONResponse) VisitPostPvzPvzIdAsnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdCloseJSONRequestBody
+}
+
+type PostPvzPvzIdCloseResponseObject interface {
+	VisitPostPvzPvzIdCloseResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdClose200JSONResponse PVZ
+
+func (response PostPvzPvzIdClose200JSONResponse) VisitPostPvzPvzIdCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdClose400JSONResponse Error
+
+func (response PostPvzPvzIdClose400JSONResponse) VisitPostPvzPvzIdCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdClose403JSONResponse Error
+
+func (response PostPvzPvzIdClose403JSONResponse) VisitPostPvzPvzIdCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdClose404JSONResponse Error
+
+func (response PostPvzPvzIdClose404JSONResponse) VisitPostPvzPvzIdCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdClose409JSONResponse Error
+
+func (response PostPvzPvzIdClose409JSONResponse) VisitPostPvzPvzIdCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReceptionRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdCloseLastReceptionResponseObject interface {
+	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCloseLastReception200JSONResponse ClosedReception
+
+func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception400JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception403JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProductRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdDeleteLastProductResponseObject interface {
+	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdDeleteLastProduct200Response struct {
+}
+
+func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdHistoryRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type GetPvzPvzIdHistoryResponseObject interface {
+	VisitGetPvzPvzIdHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdHistory200JSONResponse []PVZProfileChange
+
+func (response GetPvzPvzIdHistory200JSONResponse) VisitGetPvzPvzIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdHistory403JSONResponse Error
+
+func (response GetPvzPvzIdHistory403JSONResponse) VisitGetPvzPvzIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdHistory404JSONResponse Error
+
+func (response GetPvzPvzIdHistory404JSONResponse) VisitGetPvzPvzIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdProductsVoidRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdProductsVoidJSONRequestBody
+}
+
+type PostPvzPvzIdProductsVoidResponseObject interface {
+	VisitPostPvzPvzIdProductsVoidResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdProductsVoid200JSONResponse Product
+
+func (response PostPvzPvzIdProductsVoid200JSONResponse) VisitPostPvzPvzIdProductsVoidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdProductsVoid400JSONResponse Error
+
+func (response PostPvzPvzIdProductsVoid400JSONResponse) VisitPostPvzPvzIdProductsVoidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdProductsVoid403JSONResponse Error
+
+func (response PostPvzPvzIdProductsVoid403JSONResponse) VisitPostPvzPvzIdProductsVoidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdProductsVoid404JSONResponse Error
+
+func (response PostPvzPvzIdProductsVoid404JSONResponse) VisitPostPvzPvzIdProductsVoidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdProductsBatchRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdProductsBatchJSONRequestBody
+}
+
+type PostPvzPvzIdProductsBatchResponseObject interface {
+	VisitPostPvzPvzIdProductsBatchResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdProductsBatch201JSONResponse []Product
+
+func (response PostPvzPvzIdProductsBatch201JSONResponse) VisitPostPvzPvzIdProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdProductsBatch400JSONResponse ProductBatchError
+
+func (response PostPvzPvzIdProductsBatch400JSONResponse) VisitPostPvzPvzIdProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdProductsBatch403JSONResponse Error
+
+func (response PostPvzPvzIdProductsBatch403JSONResponse) VisitPostPvzPvzIdProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdReopenRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdReopenJSONRequestBody
+}
+
+type PostPvzPvzIdReopenResponseObject interface {
+	VisitPostPvzPvzIdReopenResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdReopen200JSONResponse PVZ
+
+func (response PostPvzPvzIdReopen200JSONResponse) VisitPostPvzPvzIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdReopen400JSONResponse Error
+
+func (response PostPvzPvzIdReopen400JSONResponse) VisitPostPvzPvzIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdReopen403JSONResponse Error
+
+func (response PostPvzPvzIdReopen403JSONResponse) VisitPostPvzPvzIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdReopen404JSONResponse Error
+
+func (response PostPvzPvzIdReopen404JSONResponse) VisitPostPvzPvzIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdReopen409JSONResponse Error
+
+func (response PostPvzPvzIdReopen409JSONResponse) VisitPostPvzPvzIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStaffRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type GetPvzPvzIdStaffResponseObject interface {
+	VisitGetPvzPvzIdStaffResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdStaff200JSONResponse []User
+
+func (response GetPvzPvzIdStaff200JSONResponse) VisitGetPvzPvzIdStaffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStaff403JSONResponse Error
+
+func (response GetPvzPvzIdStaff403JSONResponse) VisitGetPvzPvzIdStaffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStaff404JSONResponse Error
+
+func (response GetPvzPvzIdStaff404JSONResponse) VisitGetPvzPvzIdStaffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzIdStaffUserIdRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type DeletePvzPvzIdStaffUserIdResponseObject interface {
+	VisitDeletePvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error
+}
+
+type DeletePvzPvzIdStaffUserId204Response struct {
+}
+
+func (response DeletePvzPvzIdStaffUserId204Response) VisitDeletePvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePvzPvzIdStaffUserId403JSONResponse Error
+
+func (response DeletePvzPvzIdStaffUserId403JSONResponse) VisitDeletePvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzIdStaffUserId404JSONResponse Error
+
+func (response DeletePvzPvzIdStaffUserId404JSONResponse) VisitDeletePvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdStaffUserIdRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type PutPvzPvzIdStaffUserIdResponseObject interface {
+	VisitPutPvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error
+}
+
+type PutPvzPvzIdStaffUserId204Response struct {
+}
+
+func (response PutPvzPvzIdStaffUserId204Response) VisitPutPvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutPvzPvzIdStaffUserId400JSONResponse Error
+
+func (response PutPvzPvzIdStaffUserId400JSONResponse) VisitPutPvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdStaffUserId403JSONResponse Error
+
+func (response PutPvzPvzIdStaffUserId403JSONResponse) VisitPutPvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdStaffUserId404JSONResponse Error
+
+func (response PutPvzPvzIdStaffUserId404JSONResponse) VisitPutPvzPvzIdStaffUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdSuspendRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdSuspendJSONRequestBody
+}
+
+type PostPvzPvzIdSuspendResponseObject interface {
+	VisitPostPvzPvzIdSuspendResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdSuspend200JSONResponse PVZ
+
+func (response PostPvzPvzIdSuspend200JSONResponse) VisitPostPvzPvzIdSuspendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdSuspend400JSONResponse Error
+
+func (response PostPvzPvzIdSuspend400JSONResponse) VisitPostPvzPvzIdSuspendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdSuspend403JSONResponse Error
+
+func (response PostPvzPvzIdSuspend403JSONResponse) VisitPostPvzPvzIdSuspendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdSuspend404JSONResponse Error
+
+func (response PostPvzPvzIdSuspend404JSONResponse) VisitPostPvzPvzIdSuspendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdSuspend409JSONResponse Error
+
+func (response PostPvzPvzIdSuspend409JSONResponse) VisitPostPvzPvzIdSuspendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsRequestObject struct {
+	Body *PostReceptionsJSONRequestBody
+}
+
+type PostReceptionsResponseObject interface {
+	VisitPostReceptionsResponse(w http.ResponseWriter) error
+}
+
+type PostReceptions201JSONResponse Reception
+
+func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions400JSONResponse Error
+
+func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions403JSONResponse Error
+
+func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdReconciliationRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdReconciliationResponseObject interface {
+	VisitGetReceptionsReceptionIdReconciliationResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionIdReconciliation200JSONResponse ReceptionReconciliation
+
+func (response GetReceptionsReceptionIdReconciliation200JSONResponse) VisitGetReceptionsReceptionIdReconciliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdReconciliation403JSONResponse Error
+
+func (response GetReceptionsReceptionIdReconciliation403JSONResponse) VisitGetReceptionsReceptionIdReconciliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdReconciliation404JSONResponse Error
+
+func (response GetReceptionsReceptionIdReconciliation404JSONResponse) VisitGetReceptionsReceptionIdReconciliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopenRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdReopenJSONRequestBody
+}
+
+type PostReceptionsReceptionIdReopenResponseObject interface {
+	VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdReopen200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdReopen200JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen400JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen400JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen403JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen403JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen404JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen404JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen409JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen409JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterRequestObject struct {
+	Body *PostRegisterJSONRequestBody
+}
+
+type PostRegisterResponseObject interface {
+	VisitPostRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostRegister201JSONResponse User
+
+func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister400JSONResponse Error
+
+func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTokenRefreshRequestObject struct {
+	Body *PostTokenRefreshJSONRequestBody
+}
+
+type PostTokenRefreshResponseObject interface {
+	VisitPostTokenRefreshResponse(w http.ResponseWriter) error
+}
+
+type PostTokenRefresh200JSONResponse TokenPair
+
+func (response PostTokenRefresh200JSONResponse) VisitPostTokenRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTokenRefresh401JSONResponse Error
+
+func (response PostTokenRefresh401JSONResponse) VisitPostTokenRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersRequestObject struct {
+	Params GetUsersParams
+}
+
+type GetUsersResponseObject interface {
+	VisitGetUsersResponse(w http.ResponseWriter) error
+}
+
+type GetUsers200JSONResponse []User
+
+func (response GetUsers200JSONResponse) VisitGetUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsers403JSONResponse Error
+
+func (response GetUsers403JSONResponse) VisitGetUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdActivateRequestObject struct {
+	UserId UserId `json:"userId"`
+}
+
+type PostUsersUserIdActivateResponseObject interface {
+	VisitPostUsersUserIdActivateResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdActivate200JSONResponse User
+
+func (response PostUsersUserIdActivate200JSONResponse) VisitPostUsersUserIdActivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdActivate400JSONResponse Error
+
+func (response PostUsersUserIdActivate400JSONResponse) VisitPostUsersUserIdActivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdActivate403JSONResponse Error
+
+func (response PostUsersUserIdActivate403JSONResponse) VisitPostUsersUserIdActivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdActivate404JSONResponse Error
+
+func (response PostUsersUserIdActivate404JSONResponse) VisitPostUsersUserIdActivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdDeactivateRequestObject struct {
+	UserId UserId `json:"userId"`
+}
+
+type PostUsersUserIdDeactivateResponseObject interface {
+	VisitPostUsersUserIdDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdDeactivate200JSONResponse User
+
+func (response PostUsersUserIdDeactivate200JSONResponse) VisitPostUsersUserIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdDeactivate400JSONResponse Error
+
+func (response PostUsersUserIdDeactivate400JSONResponse) VisitPostUsersUserIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdDeactivate403JSONResponse Error
+
+func (response PostUsersUserIdDeactivate403JSONResponse) VisitPostUsersUserIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdDeactivate404JSONResponse Error
+
+func (response PostUsersUserIdDeactivate404JSONResponse) VisitPostUsersUserIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdPasswordResetRequestObject struct {
+	UserId UserId `json:"userId"`
+}
+
+type PostUsersUserIdPasswordResetResponseObject interface {
+	VisitPostUsersUserIdPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdPasswordReset200JSONResponse struct {
+	TemporaryPassword string `json:"temporaryPassword"`
+}
+
+func (response PostUsersUserIdPasswordReset200JSONResponse) VisitPostUsersUserIdPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdPasswordReset403JSONResponse Error
+
+func (response PostUsersUserIdPasswordReset403JSONResponse) VisitPostUsersUserIdPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdPasswordReset404JSONResponse Error
+
+func (response PostUsersUserIdPasswordReset404JSONResponse) VisitPostUsersUserIdPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdRoleRequestObject struct {
+	UserId UserId `json:"userId"`
+	Body   *PostUsersUserIdRoleJSONRequestBody
+}
+
+type PostUsersUserIdRoleResponseObject interface {
+	VisitPostUsersUserIdRoleResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdRole200JSONResponse User
+
+func (response PostUsersUserIdRole200JSONResponse) VisitPostUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdRole400JSONResponse Error
+
+func (response PostUsersUserIdRole400JSONResponse) VisitPostUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdRole403JSONResponse Error
+
+func (response PostUsersUserIdRole403JSONResponse) VisitPostUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdRole404JSONResponse Error
+
+func (response PostUsersUserIdRole404JSONResponse) VisitPostUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Публичные ключи для проверки access-токенов
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx context.Context, request GetWellKnownJwksJsonRequestObject) (GetWellKnownJwksJsonResponseObject, error)
+	// Список API-ключей (только для модераторов)
+	// (GET /api-keys)
+	GetApiKeys(ctx context.Context, request GetApiKeysRequestObject) (GetApiKeysResponseObject, error)
+	// Выпуск API-ключа для интеграций (только для модераторов)
+	// (POST /api-keys)
+	PostApiKeys(ctx context.Context, request PostApiKeysRequestObject) (PostApiKeysResponseObject, error)
+	// Отзыв API-ключа (только для модераторов)
+	// (DELETE /api-keys/{keyId})
+	DeleteApiKeysKeyId(ctx context.Context, request DeleteApiKeysKeyIdRequestObject) (DeleteApiKeysKeyIdResponseObject, error)
+	// Журнал аудита изменений (только для модераторов)
+	// (GET /audit)
+	GetAudit(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error)
+	// Справочник городов
+	// (GET /cities)
+	GetCities(ctx context.Context, request GetCitiesRequestObject) (GetCitiesResponseObject, error)
+	// Добавление города (только для модераторов)
+	// (POST /cities)
+	PostCities(ctx context.Context, request PostCitiesRequestObject) (PostCitiesResponseObject, error)
+	// Включение города (только для модераторов)
+	// (POST /cities/{cityId}/activate)
+	PostCitiesCityIdActivate(ctx context.Context, request PostCitiesCityIdActivateRequestObject) (PostCitiesCityIdActivateResponseObject, error)
+	// Отключение города (только для модераторов)
+	// (POST /cities/{cityId}/deactivate)
+	PostCitiesCityIdDeactivate(ctx context.Context, request PostCitiesCityIdDeactivateRequestObject) (PostCitiesCityIdDeactivateResponseObject, error)
+	// Получение тестового токена
+	// (POST /dummyLogin)
+	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
+	// Авторизация пользователя
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// Завершение сессии и отзыв ее токенов
+	// (POST /logout)
+	PostLogout(ctx context.Context, request PostLogoutRequestObject) (PostLogoutResponseObject, error)
+	// Запрос на восстановление пароля
+	// (POST /password/forgot)
+	PostPasswordForgot(ctx context.Context, request PostPasswordForgotRequestObject) (PostPasswordForgotResponseObject, error)
+	// Установка нового пароля по токену восстановления
+	// (POST /password/reset)
+	PostPasswordReset(ctx context.Context, request PostPasswordResetRequestObject) (PostPasswordResetResponseObject, error)
+	// Справочник типов товаров
+	// (GET /product-types)
+	GetProductTypes(ctx context.Context, request GetProductTypesRequestObject) (GetProductTypesResponseObject, error)
+	// Добавление типа товара (только для модераторов)
+	// (POST /product-types)
+	PostProductTypes(ctx context.Context, request PostProductTypesRequestObject) (PostProductTypesResponseObject, error)
+	// Изменение типа товара (только для модераторов)
+	// (PUT /product-types/{code})
+	PutProductTypesCode(ctx context.Context, request PutProductTypesCodeRequestObject) (PutProductTypesCodeResponseObject, error)
+	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products)
+	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
+	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
+	// (GET /pvz)
+	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
+	// Создание ПВЗ (только для модераторов)
+	// (POST /pvz)
+	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
+	// Ближайшие к точке ПВЗ
+	// (GET /pvz/nearest)
+	GetPvzNearest(ctx context.Context, request GetPvzNearestRequestObject) (GetPvzNearestResponseObject, error)
+	// Изменение профиля ПВЗ (только для модераторов)
+	// (PATCH /pvz/{pvzId})
+	PatchPvzPvzId(ctx context.Context, request PatchPvzPvzIdRequestObject) (PatchPvzPvzIdResponseObject, error)
+	// Манифест ожидаемой поставки в ПВЗ
+	// (POST /pvz/{pvzId}/asn)
+	PostPvzPvzIdAsn(ctx context.Context, request PostPvzPvzIdAsnRequestObject) (PostPvzPvzIdAsnResponseObject, error)
+	// Окончательное закрытие ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/close)
+	PostPvzPvzIdClose(ctx context.Context, request PostPvzPvzIdCloseRequestObject) (PostPvzPvzIdCloseResponseObject, error)
+	// Закрытие последней открытой приемки товаров в рамках ПВЗ
+	// (POST /pvz/{pvzId}/close_last_reception)
+	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
+	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/delete_last_product)
+	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
+	// История изменений профиля ПВЗ (только для модераторов)
+	// (GET /pvz/{pvzId}/history)
+	GetPvzPvzIdHistory(ctx context.Context, request GetPvzPvzIdHistoryRequestObject) (GetPvzPvzIdHistoryResponseObject, error)
+	// Аннулирование товара текущей приемки по ID или штрихкоду (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/products/void)
+	PostPvzPvzIdProductsVoid(ctx context.Context, request PostPvzPvzIdProductsVoidRequestObject) (PostPvzPvzIdProductsVoidResponseObject, error)
+	// Добавление нескольких товаров в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/products:batch)
+	PostPvzPvzIdProductsBatch(ctx context.Context, request PostPvzPvzIdProductsBatchRequestObject) (PostPvzPvzIdProductsBatchResponseObject, error)
+	// Возобновление работы приостановленного ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/reopen)
+	PostPvzPvzIdReopen(ctx context.Context, request PostPvzPvzIdReopenRequestObject) (PostPvzPvzIdReopenResponseObject, error)
+	// Список сотрудников, закрепленных за ПВЗ (только для модераторов)
+	// (GET /pvz/{pvzId}/staff)
+	GetPvzPvzIdStaff(ctx context.Context, request GetPvzPvzIdStaffRequestObject) (GetPvzPvzIdStaffResponseObject, error)
+	// Открепление сотрудника от ПВЗ (только для модераторов)
+	// (DELETE /pvz/{pvzId}/staff/{userId})
+	DeletePvzPvzIdStaffUserId(ctx context.Context, request DeletePvzPvzIdStaffUserIdRequestObject) (DeletePvzPvzIdStaffUserIdResponseObject, error)
+	// Закрепление сотрудника за ПВЗ (только для модераторов)
+	// (PUT /pvz/{pvzId}/staff/{userId})
+	PutPvzPvzIdStaffUserId(ctx context.Context, request PutPvzPvzIdStaffUserIdRequestObject) (PutPvzPvzIdStaffUserIdResponseObject, error)
+	// Приостановка работы ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/suspend)
+	PostPvzPvzIdSuspend(ctx context.Context, request PostPvzPvzIdSuspendRequestObject) (PostPvzPvzIdSuspendResponseObject, error)
+	// Создание новой приемки товаров (только для сотрудников ПВЗ)
+	// (POST /receptions)
+	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
+	// Последняя сверка приемки с манифестом
+	// (GET /receptions/{receptionId}/reconciliation)
+	GetReceptionsReceptionIdReconciliation(ctx context.Context, request GetReceptionsReceptionIdReconciliationRequestObject) (GetReceptionsReceptionIdReconciliationResponseObject, error)
+	// Открытие закрытой приемки для исправления (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error)
+	// Регистрация пользователя
+	// (POST /register)
+	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+	// Обновление пары токенов по refresh-токену
+	// (POST /token/refresh)
+	PostTokenRefresh(ctx context.Context, request PostTokenRefreshRequestObject) (PostTokenRefreshResponseObject, error)
+	// Список пользователей с поиском по email и пагинацией (только для модераторов)
+	// (GET /users)
+	GetUsers(ctx context.Context, request GetUsersRequestObject) (GetUsersResponseObject, error)
+	// Активация пользователя (только для модераторов)
+	// (POST /users/{userId}/activate)
+	PostUsersUserIdActivate(ctx context.Context, request PostUsersUserIdActivateRequestObject) (PostUsersUserIdActivateResponseObject, error)
+	// Деактивация пользователя, все его сессии отзываются (только для модераторов)
+	// (POST /users/{userId}/deactivate)
+	PostUsersUserIdDeactivate(ctx context.Context, request PostUsersUserIdDeactivateRequestObject) (PostUsersUserIdDeactivateResponseObject, error)
+	// Принудительный сброс пароля пользователя (только для модераторов)
+	// (POST /users/{userId}/password/reset)
+	PostUsersUserIdPasswordReset(ctx context.Context, request PostUsersUserIdPasswordResetRequestObject) (PostUsersUserIdPasswordResetResponseObject, error)
+	// Изменение роли пользователя (только для модераторов)
+	// (POST /users/{userId}/role)
+	PostUsersUserIdRole(ctx context.Context, request PostUsersUserIdRoleRequestObject) (PostUsersUserIdRoleResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetWellKnownJwksJson operation middleware
+func (sh *strictHandler) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+	var request GetWellKnownJwksJsonRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWellKnownJwksJson(ctx, request.(GetWellKnownJwksJsonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWellKnownJwksJson")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWellKnownJwksJsonResponseObject); ok {
+		if err := validResponse.VisitGetWellKnownJwksJsonResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiKeys operation middleware
+func (sh *strictHandler) GetApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request GetApiKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiKeys(ctx, request.(GetApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiKeysResponseObject); ok {
+		if err := validResponse.VisitGetApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiKeys operation middleware
+func (sh *strictHandler) PostApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request PostApiKeysRequestObject
+
+	var body PostApiKeysJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiKeys(ctx, request.(PostApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiKeysResponseObject); ok {
+		if err := validResponse.VisitPostApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiKeysKeyId operation middleware
+func (sh *strictHandler) DeleteApiKeysKeyId(w http.ResponseWriter, r *http.Request, keyId openapi_types.UUID) {
+	var request DeleteApiKeysKeyIdRequestObject
+
+	request.KeyId = keyId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiKeysKeyId(ctx, request.(DeleteApiKeysKeyIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiKeysKeyId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiKeysKeyIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiKeysKeyIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAudit operation middleware
+func (sh *strictHandler) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	var request GetAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAudit(ctx, request.(GetAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAudit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditResponseObject); ok {
+		if err := validResponse.VisitGetAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCities operation middleware
+func (sh *strictHandler) GetCities(w http.ResponseWriter, r *http.Request) {
+	var request GetCitiesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCities(ctx, request.(GetCitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCities")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCitiesResponseObject); ok {
+		if err := validResponse.VisitGetCitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCities operation middleware
+func (sh *strictHandler) PostCities(w http.ResponseWriter, r *http.Request) {
+	var request PostCitiesRequestObject
+
+	var body PostCitiesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCities(ctx, request.(PostCitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCities")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostCitiesResponseObject); ok {
+		if err := validResponse.VisitPostCitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCitiesCityIdActivate operation middleware
+func (sh *strictHandler) PostCitiesCityIdActivate(w http.ResponseWriter, r *http.Request, cityId CityId) {
+	var request PostCitiesCityIdActivateRequestObject
+
+	request.CityId = cityId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCitiesCityIdActivate(ctx, request.(PostCitiesCityIdActivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCitiesCityIdActivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostCitiesCityIdActivateResponseObject); ok {
+		if err := validResponse.VisitPostCitiesCityIdActivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCitiesCityIdDeactivate operation middleware
+func (sh *strictHandler) PostCitiesCityIdDeactivate(w http.ResponseWriter, r *http.Request, cityId CityId) {
+	var request PostCitiesCityIdDeactivateRequestObject
+
+	request.CityId = cityId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCitiesCityIdDeactivate(ctx, request.(PostCitiesCityIdDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCitiesCityIdDeactivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostCitiesCityIdDeactivateResponseObject); ok {
+		if err := validResponse.VisitPostCitiesCityIdDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDummyLogin operation middleware
+func (sh *strictHandler) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostDummyLoginRequestObject
+
+	var body PostDummyLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDummyLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
+		if err := validResponse.VisitPostDummyLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostLoginRequestObject
+
+	var body PostLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		if err := validResponse.VisitPostLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLogout operation middleware
+func (sh *strictHandler) PostLogout(w http.ResponseWriter, r *http.Request) {
+	var request PostLogoutRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogout(ctx, request.(PostLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostLogoutResponseObject); ok {
+		if err := validResponse.VisitPostLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPasswordForgot operation middleware
+func (sh *strictHandler) PostPasswordForgot(w http.ResponseWriter, r *http.Request) {
+	var request PostPasswordForgotRequestObject
+
+	var body PostPasswordForgotJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPasswordForgot(ctx, request.(PostPasswordForgotRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPasswordForgot")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPasswordForgotResponseObject); ok {
+		if err := validResponse.VisitPostPasswordForgotResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPasswordReset operation middleware
+func (sh *strictHandler) PostPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var request PostPasswordResetRequestObject
+
+	var body PostPasswordResetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPasswordReset(ctx, request.(PostPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPasswordReset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostPasswordResetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductTypes operation middleware
+func (sh *strictHandler) GetProductTypes(w http.ResponseWriter, r *http.Request) {
+	var request GetProductTypesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductTypes(ctx, request.(GetProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductTypes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProductTypesResponseObject); ok {
+		if err := validResponse.VisitGetProductTypesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductTypes operation middleware
+func (sh *strictHandler) PostProductTypes(w http.ResponseWriter, r *http.Request) {
+	var request PostProductTypesRequestObject
+
+	var body PostProductTypesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductTypes(ctx, request.(PostProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductTypes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductTypesResponseObject); ok {
+		if err := validResponse.VisitPostProductTypesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutProductTypesCode operation middleware
+func (sh *strictHandler) PutProductTypesCode(w http.ResponseWriter, r *http.Request, code ProductTypeCode) {
+	var request PutProductTypesCodeRequestObject
+
+	request.Code = code
+
+	var body PutProductTypesCodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProductTypesCode(ctx, request.(PutProductTypesCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProductTypesCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutProductTypesCodeResponseObject); ok {
+		if err := validResponse.VisitPutProductTypesCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProducts operation middleware
+func (sh *strictHandler) PostProducts(w http.ResponseWriter, r *http.Request) {
+	var request PostProductsRequestObject
+
+	var body PostProductsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
+		if err := validResponse.VisitPostProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegister201JSONResponse User
+// GetPvz operation middleware
+func (sh *strictHandler) GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams) {
+	var request GetPvzRequestObject
 
-func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvz")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
+		if err := validResponse.VisitGetPvzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegister400JSONResponse Error
+// PostPvz operation middleware
+func (sh *strictHandler) PostPvz(w http.ResponseWriter, r *http.Request) {
+	var request PostPvzRequestObject
 
-func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var body PostPvzJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvz")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
+		if err := validResponse.VisitPostPvzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получение тестового токена
-	// (POST /dummyLogin)
-	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
-	// Авторизация пользователя
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
-	// (POST /products)
-	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
-	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
-	// (GET /pvz)
-	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
-	// Создание ПВЗ (только для модераторов)
-	// (POST /pvz)
-	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
-	// Закрытие последней открытой приемки товаров в рамках ПВЗ
-	// (POST /pvz/{pvzId}/close_last_reception)
-	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
-	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
-	// (POST /pvz/{pvzId}/delete_last_product)
-	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
-	// Создание новой приемки товаров (только для сотрудников ПВЗ)
-	// (POST /receptions)
-	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
-	// Регистрация пользователя
-	// (POST /register)
-	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+// GetPvzNearest operation middleware
+func (sh *strictHandler) GetPvzNearest(w http.ResponseWriter, r *http.Request, params GetPvzNearestParams) {
+	var request GetPvzNearestRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzNearest(ctx, request.(GetPvzNearestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzNearest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPvzNearestResponseObject); ok {
+		if err := validResponse.VisitGetPvzNearestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// PatchPvzPvzId operation middleware
+func (sh *strictHandler) PatchPvzPvzId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PatchPvzPvzIdRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	request.PvzId = pvzId
+
+	var body PatchPvzPvzIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchPvzPvzId(ctx, request.(PatchPvzPvzIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchPvzPvzId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchPvzPvzIdResponseObject); ok {
+		if err := validResponse.VisitPatchPvzPvzIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// PostPvzPvzIdAsn operation middleware
+func (sh *strictHandler) PostPvzPvzIdAsn(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdAsnRequestObject
+
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdAsnJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdAsn(ctx, request.(PostPvzPvzIdAsnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdAsn")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzPvzIdAsnResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdAsnResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// PostPvzPvzIdClose operation middleware
+func (sh *strictHandler) PostPvzPvzIdClose(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdCloseRequestObject
+
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdCloseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdClose(ctx, request.(PostPvzPvzIdCloseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdClose")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzPvzIdCloseResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdCloseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (sh *strictHandler) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdCloseLastReceptionRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx, request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdDeleteLastProductRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx, request.(PostPvzPvzIdDeleteLastProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetPvzPvzIdHistory operation middleware
+func (sh *strictHandler) GetPvzPvzIdHistory(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request GetPvzPvzIdHistoryRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdHistory(ctx, request.(GetPvzPvzIdHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPvzPvzIdHistoryResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostDummyLogin operation middleware
-func (sh *strictHandler) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
-	var request PostDummyLoginRequestObject
+// PostPvzPvzIdProductsVoid operation middleware
+func (sh *strictHandler) PostPvzPvzIdProductsVoid(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdProductsVoidRequestObject
 
-	var body PostDummyLoginJSONRequestBody
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdProductsVoidJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -935,18 +5029,18 @@ func (sh *strictHandler) PostDummyLogin(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+		return sh.ssi.PostPvzPvzIdProductsVoid(ctx, request.(PostPvzPvzIdProductsVoidRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostDummyLogin")
+		handler = middleware(handler, "PostPvzPvzIdProductsVoid")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
-		if err := validResponse.VisitPostDummyLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostPvzPvzIdProductsVoidResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdProductsVoidResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -954,11 +5048,13 @@ func (sh *strictHandler) PostDummyLogin(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
-	var request PostLoginRequestObject
+// PostPvzPvzIdProductsBatch operation middleware
+func (sh *strictHandler) PostPvzPvzIdProductsBatch(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdProductsBatchRequestObject
 
-	var body PostLoginJSONRequestBody
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdProductsBatchJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -966,18 +5062,18 @@ func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+		return sh.ssi.PostPvzPvzIdProductsBatch(ctx, request.(PostPvzPvzIdProductsBatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "PostPvzPvzIdProductsBatch")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		if err := validResponse.VisitPostLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostPvzPvzIdProductsBatchResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdProductsBatchResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -985,11 +5081,13 @@ func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostProducts operation middleware
-func (sh *strictHandler) PostProducts(w http.ResponseWriter, r *http.Request) {
-	var request PostProductsRequestObject
+// PostPvzPvzIdReopen operation middleware
+func (sh *strictHandler) PostPvzPvzIdReopen(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdReopenRequestObject
 
-	var body PostProductsJSONRequestBody
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdReopenJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -997,18 +5095,18 @@ func (sh *strictHandler) PostProducts(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+		return sh.ssi.PostPvzPvzIdReopen(ctx, request.(PostPvzPvzIdReopenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProducts")
+		handler = middleware(handler, "PostPvzPvzIdReopen")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
-		if err := validResponse.VisitPostProductsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostPvzPvzIdReopenResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdReopenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1016,25 +5114,25 @@ func (sh *strictHandler) PostProducts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetPvz operation middleware
-func (sh *strictHandler) GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams) {
-	var request GetPvzRequestObject
+// GetPvzPvzIdStaff operation middleware
+func (sh *strictHandler) GetPvzPvzIdStaff(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request GetPvzPvzIdStaffRequestObject
 
-	request.Params = params
+	request.PvzId = pvzId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
+		return sh.ssi.GetPvzPvzIdStaff(ctx, request.(GetPvzPvzIdStaffRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPvz")
+		handler = middleware(handler, "GetPvzPvzIdStaff")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
-		if err := validResponse.VisitGetPvzResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPvzPvzIdStaffResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdStaffResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1042,30 +5140,26 @@ func (sh *strictHandler) GetPvz(w http.ResponseWriter, r *http.Request, params G
 	}
 }
 
-// PostPvz operation middleware
-func (sh *strictHandler) PostPvz(w http.ResponseWriter, r *http.Request) {
-	var request PostPvzRequestObject
+// DeletePvzPvzIdStaffUserId operation middleware
+func (sh *strictHandler) DeletePvzPvzIdStaffUserId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, userId openapi_types.UUID) {
+	var request DeletePvzPvzIdStaffUserIdRequestObject
 
-	var body PostPvzJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.PvzId = pvzId
+	request.UserId = userId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
+		return sh.ssi.DeletePvzPvzIdStaffUserId(ctx, request.(DeletePvzPvzIdStaffUserIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvz")
+		handler = middleware(handler, "DeletePvzPvzIdStaffUserId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
-		if err := validResponse.VisitPostPvzResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePvzPvzIdStaffUserIdResponseObject); ok {
+		if err := validResponse.VisitDeletePvzPvzIdStaffUserIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1073,25 +5167,26 @@ func (sh *strictHandler) PostPvz(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (sh *strictHandler) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
-	var request PostPvzPvzIdCloseLastReceptionRequestObject
+// PutPvzPvzIdStaffUserId operation middleware
+func (sh *strictHandler) PutPvzPvzIdStaffUserId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, userId openapi_types.UUID) {
+	var request PutPvzPvzIdStaffUserIdRequestObject
 
 	request.PvzId = pvzId
+	request.UserId = userId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx, request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+		return sh.ssi.PutPvzPvzIdStaffUserId(ctx, request.(PutPvzPvzIdStaffUserIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+		handler = middleware(handler, "PutPvzPvzIdStaffUserId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
-		if err := validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutPvzPvzIdStaffUserIdResponseObject); ok {
+		if err := validResponse.VisitPutPvzPvzIdStaffUserIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1099,25 +5194,32 @@ func (sh *strictHandler) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r
 	}
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
-	var request PostPvzPvzIdDeleteLastProductRequestObject
+// PostPvzPvzIdSuspend operation middleware
+func (sh *strictHandler) PostPvzPvzIdSuspend(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdSuspendRequestObject
 
 	request.PvzId = pvzId
 
+	var body PostPvzPvzIdSuspendJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx, request.(PostPvzPvzIdDeleteLastProductRequestObject))
+		return sh.ssi.PostPvzPvzIdSuspend(ctx, request.(PostPvzPvzIdSuspendRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+		handler = middleware(handler, "PostPvzPvzIdSuspend")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
-		if err := validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostPvzPvzIdSuspendResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdSuspendResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1156,6 +5258,65 @@ func (sh *strictHandler) PostReceptions(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// GetReceptionsReceptionIdReconciliation operation middleware
+func (sh *strictHandler) GetReceptionsReceptionIdReconciliation(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request GetReceptionsReceptionIdReconciliationRequestObject
+
+	request.ReceptionId = receptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionIdReconciliation(ctx, request.(GetReceptionsReceptionIdReconciliationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionIdReconciliation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdReconciliationResponseObject); ok {
+		if err := validResponse.VisitGetReceptionsReceptionIdReconciliationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request PostReceptionsReceptionIdReopenRequestObject
+
+	request.ReceptionId = receptionId
+
+	var body PostReceptionsReceptionIdReopenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdReopen(ctx, request.(PostReceptionsReceptionIdReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdReopen")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdReopenResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdReopenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostRegister operation middleware
 func (sh *strictHandler) PostRegister(w http.ResponseWriter, r *http.Request) {
 	var request PostRegisterRequestObject
@@ -1187,37 +5348,322 @@ func (sh *strictHandler) PostRegister(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// PostTokenRefresh operation middleware
+func (sh *strictHandler) PostTokenRefresh(w http.ResponseWriter, r *http.Request) {
+	var request PostTokenRefreshRequestObject
+
+	var body PostTokenRefreshJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTokenRefresh(ctx, request.(PostTokenRefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTokenRefresh")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTokenRefreshResponseObject); ok {
+		if err := validResponse.VisitPostTokenRefreshResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsers operation middleware
+func (sh *strictHandler) GetUsers(w http.ResponseWriter, r *http.Request, params GetUsersParams) {
+	var request GetUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsers(ctx, request.(GetUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersResponseObject); ok {
+		if err := validResponse.VisitGetUsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersUserIdActivate operation middleware
+func (sh *strictHandler) PostUsersUserIdActivate(w http.ResponseWriter, r *http.Request, userId UserId) {
+	var request PostUsersUserIdActivateRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersUserIdActivate(ctx, request.(PostUsersUserIdActivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersUserIdActivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersUserIdActivateResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdActivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersUserIdDeactivate operation middleware
+func (sh *strictHandler) PostUsersUserIdDeactivate(w http.ResponseWriter, r *http.Request, userId UserId) {
+	var request PostUsersUserIdDeactivateRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersUserIdDeactivate(ctx, request.(PostUsersUserIdDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersUserIdDeactivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersUserIdDeactivateResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersUserIdPasswordReset operation middleware
+func (sh *strictHandler) PostUsersUserIdPasswordReset(w http.ResponseWriter, r *http.Request, userId UserId) {
+	var request PostUsersUserIdPasswordResetRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersUserIdPasswordReset(ctx, request.(PostUsersUserIdPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersUserIdPasswordReset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersUserIdPasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdPasswordResetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersUserIdRole operation middleware
+func (sh *strictHandler) PostUsersUserIdRole(w http.ResponseWriter, r *http.Request, userId UserId) {
+	var request PostUsersUserIdRoleRequestObject
+
+	request.UserId = userId
+
+	var body PostUsersUserIdRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersUserIdRole(ctx, request.(PostUsersUserIdRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersUserIdRole")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersUserIdRoleResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdRoleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZ724TSRJ/lVHffeCkASfHffK3u+M4cUK6iONYCRShwe44A54/9LSza5Cl2N6FRWSX",
-	"1QoJCS1iWV5gcGJinHjyCtVvtKrqGXvGHsdOYmUNn2zPVHdXVf+q6lflx6zkOb7nclcGrPiYBaVN7lj0",
-	"9V9CeAK/+MLzuZA2p8cODwKrwvGrrPucFVkghe1WWKNhMsEf1mzBy6x4Zyi4biaC3r37vCRZw2Rrt25P",
-	"7lyyZR0/uVtzcAP4BSLVhD50IGQmg3cQwgD6qnUR3kJXtaCrtuGDaqtt2MX3ryGEfZRRO6lDE+1MZpdx",
-	"9w1POJZkRVar2WWWIyZ4xQ6ksKTtuVcsyTOLypbkF6Xt8MmVY+aTNbm2C69cK8lJ+3Hvm7j1nAeewKIS",
-	"99Gca/PJ6weji1A/wAF00fNqGyIYQA/6+koi2IMufIS95OcH1YZOrv/H3ENvs6rlOetG8v4c3eVvPZrT",
-	"UYG0ZC1Iu8p27/rCqwgeBMxkpaoX8Nm+GFqSnD3cOc8lN70H3M0JP5P9P+A5Acsdy65mzNFPzoAnr5rB",
-	"B3f8qlfnqL/jlbmwpCdmW51oQbtNGoru5aWasGX9f5iUtDH3uCW4+HtNbo5+XU30/c9XN9F1JM2K8duR",
-	"AZtS+qyBG9vuhkcQ4kFJ2DG+MMFgRulATzUN2IMD9cJQbThS2xBCh0JgAD31woC38DO8MqBn0MsedOEQ",
-	"+hDBJ0O1IMJ8RYHSwbNtWSVlrNID7paNgIstu4Su2uIi0AevXlq5tIKO9XzuWr7NiuwyPTKZb8lNMrxQ",
-	"rjlO/bpXsXUoeAFlELxoKwlttuYF8spITvubB/IfXpkya8lzJXdpoeX7VbtESwv3Ax1fOvlPImgx9z3t",
-	"njNiUtQ4PQh8zw308X9dWTmR8n8WfIMV2Z8Ko9JWiOtaQQcPHTp2+e9VE46gq76HAYR4ySF08Dbpgvch",
-	"VE/w7vGW/rZAfXSRzdPnDXShQ4AcqOfwyaDShnCLVFNHR81xLFFH2bcQwYFqq6caotA1qDo2YzRGsAuR",
-	"hmafJELaoFCdjabFAukEqci3guBrT5Rn84xki+GKLwNjq+eOsa6hIaRa8U8s6zDQP8Yh91Oe5gYcERJ3",
-	"YD9Ogy3oYh7VePM18wmOh9xaIrUo1M1fz8+R+GilTgfVxUEj4aJ54PgtqWSIgwg+jIrgciRBA3pwgDV4",
-	"gJDFWOqrFvSgAwMqxZna3NM6Xz4HnV+icqqFzGGkb1c9055L0RpWvJMlNHfWG+uZIHuZ9XuS2ROGERrQ",
-	"oUwPfdVWz1Rb/ZixWrWNCySOEdmHaEhqmhAhpFUb9mJQR9CJac1f4ljdeoQuqPCcKP03l2tbjyjlCsvh",
-	"kouAbJm4vFA9hZBOj/PdHqWEEL/00DPUsUVUkZCVsSJ7WOOizkzmWo4OIktI6sLM1MXM145NKPSajuqq",
-	"p6dWh7vlRSnzBiI4RGgbhJZtSrU99UQ9n3K2j810+uAy37BqVcmKqyZzbNd2MGmtDs+2XckrXEz1xAH0",
-	"KNsjS+ggP9DJ7hCRpkGGoRWOqQfdKepVbceWU/RbMZljfaMVvLwyQ9v1M5ZmW3InyK0CM7PhrduZdjk4",
-	"brtULRuKzJVqhyZbQlj1zIGz9hh1w41GTmeY3XcOicnk9Q6OsP1BphjngxOmrBwq2oz37GOk6dZJNQ31",
-	"LeZvtaPBhfwBupS1dZ4i6jCWw3XHBSHsQo9COF5EfHE6n6BUdVoqMRMv51ywkyPH7i1xK0Swr2nbsnQq",
-	"n2HZfTfyIiE49m5uLYVDzQUJxJoQR9AZFdHCY2J6jQLNgu5WrUDezcT7scBdw7X/xJXXrUCOwn+i9FJG",
-	"9i25maoX8SgpC87cypXPh8+ciedNZTlwToV9qK+zr7bVc6zWS8Y+jzKqqjZ8RMTkaPyZBcGrlAUUBEc0",
-	"jUeKgKSRcnWkWkOZSco9Ngwjsoo8gjylvkvXl0yklHmVyzhU/NSwfGagXKGFGClJsf1D42RqP0W8O1ym",
-	"Xsqcs4sa67nGL3g4Mx2aN5pnfGbwf5+2IQ/+u7oGZBu0QXrWNmzSerCfatNimpNx64Xr167+1zRO26xl",
-	"Gev0QLkxkjvv4crYFGQ5xh8nKUJpbrV0RYi6OLVDcZmtPTSUSxvyZTCyQTzYnlVzLpw+pCp2IOP/044J",
-	"qFhquSbki/6LbniUeZZ/cRYXt/RHZ34blDd+3lnKxig7T/+VSkovGbbMnqc3Gr8HAAD//82HIsO8IQAA",
+	"H4sIAAAAAAAC/+x9W3PcxpXwX0Hhy4P9LXiTJSVinmhJSWTZMouS46o4WhU00yRhzgwmAIYSpWIVL1Yk",
+	"FxXT6zjr1FZ8zUP2ZatGFMccUZzhX+j+C/tLtvp0N9DdaGAwF44omy+2iGk0uk+fc/rcz0O75Ffrfg3V",
+	"otCefWjX3cCtoggF8NdlL1q7Vqb/8mr2rF13o2XbsWtuFdmzdon96NgB+lPDC1DZno2CBnLssLSMqi59",
+	"a9EPqm5kz9qNhkdHRmt1+mYYBV5tyV5fd+z5wC83StGttTq67JdR1qfoT3kfSk/8QYiCzKU32I/DLH1d",
+	"DAZAzc1fu47W6L/qgV9HQeQheF4KkBuh8lykTFl2IzQReVWUntcRr7y9VmAVjo3u170Ahf18wCsXmrni",
+	"htEHYX9rZ+B9mP6hvvqAHUYZhaXAq0eeT08Ff4u/wF85Fj608CHuki3cJRu4i4/ItoW7+DnZwE3cwW3y",
+	"GLdwh455ST4jjyct/DeyiV/itoU7uGXhA9zE+3SoE4+x8D5u4Rdkk2zhPbKNW2SLPnpJdi28RzZxizyy",
+	"2Ocnbac3NAK06q/0B4zArwAwUK1RtWc/slG1XvHXEB1b9csocCM/sG8bXgxLfp2hjxehaijPUV99MBsg",
+	"ly6R/vNe4EWMMEoIYBrGj+qMsMJ4uPibDTB9lz9wg8BdAxpKqOMjG8AC58t3Fq9TRllHwvjkE/7dj1Ep",
+	"op+Yu3nDgAX/4Kf8CW7R86JH/yNuw4m28BHu4hcWPsZd+htu4j18iNvWG2551a2VkBUue/UqqkVWzY+8",
+	"EnrTdsZNg0UJyqtpp/qLAC3as/b/m0p48BRnKlNzN2+869VQ+lwkYiqAtRwvzMRHNnAbIHyIm45FNlUi",
+	"fGGRTbyHW2SDEd+Rekq/tvAx2ab/wl0HzodOox9UUzlMskU2yW4xeltEAaqVkGHdX1MOQdeVxgrgG2QL",
+	"HwPn2MNtsoVblOiNl08KvxlkxVEVQOYrXlgKUN2tlQzM/64blPidZuLbqBShsvSjV4vQEgrEuXmrWb+y",
+	"Jw97bCj+gjRdxi4A0dJw/go35WOzACv2cAd36aHus3+08YHFN2rhtkWnn7Tw92QLRlMEIJu4a5En8KRN",
+	"HlEcw/v0CDXUYJO2cceiKIX3cJNsUKxUpqK/tQHZjqz/3fjS+lPDrUVetCa9Q/9vkb/Ag+e4K95oWvgZ",
+	"buGD9FKabBc6guPm5B9rKW4iHWrVvf8uqi1Fy/bsxfMGHBZrM4D2S7iIpL2188AE9xV+DkudodeHe9+r",
+	"0gthZnp6etqxq16N/+3koIu2hP+i89Od82u0iQ/wHoMAbklAo6AhmzFFdcljGHKImzZFY7dap9ecjSqo",
+	"FAV+zSuFRlpL412j7EVXa1FgoBy3xFb5UPpAzMsmSxU/NLJttxT5QYac0cUvyVN8wJGEcYWnYvtz89cm",
+	"JOHiG0D4bbJllB4oiLoAgyPcITvkkSJq4DZ+Yb0BPPM5bvMTbpI/4zbZfbOQqAG7WODCg/nXW/xIhVhA",
+	"5Vnbsd26d2cF0Vu43KhW6f/dml9bq/oNeibhWhihqvHmdxcjFADgy2WPwsytzEsHwmRjDaTfc9bbJbsC",
+	"ZyjMPsUd/kObs2f8kopobXxAmTaV14DIdm0DTtxFi36ATmAh+7hbcAkDSAsIqLzglcwG6wdYX30gi3GJ",
+	"vGY74nCpupU8vwMTO7Yb1qSDvz24dEKvDhRG18q9bxaYIMFDR5CrsjkJLL3u0ctelMEDVk2M6wsQ+nET",
+	"HwKTgguJcsnnXG7Zxy02Agie7DKJ4JBskB2yRWmejt8jO/gFVwAScNz1/QpyawPiQUFICyUpYW34H0An",
+	"h5Q1FRNUuCDOYdQTwJRhlhdi7KLQrVTeX7RnP8oXQpNX1h39fAJU8mslr+K5Ys5CMy2orxmuhtvrjn3F",
+	"Xfud32BGCE2ap3sxYQWw3COyy7RBftyUyh3r3PnZ6WkqXhzQe448FvLGIfDxFvkzZRAd4AfJoZybmZ2e",
+	"Np1f2V2TSbcKmB816DHcA3ErWm5QPh94tmOHLiXhsFEz0qZfR9otN33J+FUNB+gS+NsOh4jp3K8GgR+k",
+	"QVhFYeguFRAhxUDT3L9F/rzv1aL09BVXoxm/cbeCZLHlkiyzTFyajqevNap3mdBS8Ws9Jpn5lTLLzK/S",
+	"02jbqcBZ0JlNGwJ8u3pfopFiiPcNfkZ28UEiVFD+4lhUhmSiFRUOOozvtKgst0MeCfEZvzBqn0Cu0vFI",
+	"bIkyoBRHMnIZP9K5zPdcvt0CKbnDOCBfnZ2DnSe84RRuw4Y4EEwn9c6H143a7DZ+RhdAHou9SaYgkNyO",
+	"uQZDVdpDLp/gfXxM5TTcttxSCYXhBCgOh3QPdHBKAXArSzL5L9w8d+Gi7dhXy1duzhmJvBSsmsRvUL6p",
+	"LLprvX99PhY/TTeAY5sQ73/wIZXLGQ/DHXqs1sLNOWkm6427bogunm8ElTdNs654ZaOMuRKtqVucsx37",
+	"/evzxu3VjAadLt4n2yBi97ekRmgWe+8XOnEZkPlf0pBuBWSrFbhbG6Bc0GPOQL6byMD1VtBaceMOxeBe",
+	"BjeY0LSCG8gN7q7N//4P6UWUvTCiRrHrVQO4vsNNsqlJyhR94D+MKsgjWWj+CmxDL4X8DC8+TginDSJL",
+	"03YMXDrFz6mA2wModEc6EJhcLG3LBBAjKEpmxftrTc+VpMZcXdeRRgI48Ev8I7OLPRNyZSKRtnBHkSXy",
+	"BbzCkmM98Be9CioAyHk+EsC55IVRAOLWFdPtkSnPhpEbNcICX7vJBsavXF52a0v9yc7sxQXkhn4ty1RJ",
+	"HlPrEG5KeiXcKi1mqgTVjuzQfyoKYQHjHyBLBmrNJ0DXFvU53qdCJ9l0mChJ0WOfLZFskR3HogyPbNIl",
+	"UXx6RvUQskOtZEzyBLvlIXvGPBEW22hKVaXmocfcEntgzc/duvw7CgT2ABwfdOecnvFLIQQziOySz8iW",
+	"BZf1IejFbdxyrNhwChc4exnua+klavUz2cDccjlAYaiKF5TdT1r4B85JgDdQ4RvvT1q/NNrD/VIh1SGW",
+	"NCkFLPtGU+UPVBLBLfIJhatF7X+fwHEcMSnFujo5c/G8k1ism6r1rWmRbYDjS7ILqgHZkidUaPnffnn+",
+	"0oWZc2+dv3DRuC2K4Q/Mq/wXRQfgtbFLY5dsWtfmbsw5dNFw3EeSO4vsmHFIWdDVBj2cqff8sOTfM63o",
+	"nh+seLWlWKHKA/aH8tj19VySYGRu0NyFNak4k0oMP8XfKTEuU9BJc3L6fFF3TJ7Dge9fWOJ6KfQJz00h",
+	"GTMJgG08wRim8P7aChthHdXKqAy/4z2mMwOPAWM+MIBjkEyZfZMryseSp4hyJ8naTnZ+bTFZnc0pKd8W",
+	"7nJG91hVFhhPEeJlbMSIV2cS/xN4x5tPbgvdMiGeSzfwd9yV2IH9cA/Sp2lLYMZp8TmNh8EtdXn+n2Qh",
+	"5y9Oc+bxq0sZ5oUI3fKqaOR4WkWRW3YjtyeVsf28J4ZTTTAoI7NhXfLFwcmDeNW0KIuzwDTXIk+SKxrs",
+	"4QzlCrgre+4oXGmYrmWyAWLYIb2R7Ew/d0/RsG8XCPkLiCSH/FpJhhhXIMJdjG6Z0bpfHHvV98oJuRh/",
+	"Frwx267GxIxt0LiYIs3sCbsO4weUug+porCdNbaTuOSEs07VH7LRXKNH+FUCpIo8OWT6thuVljMMY4g+",
+	"Dk0mD/IEt/Ezxv0oP90XzAz8P6rvUTaFABZYzJ8IRpZC6qG81GsRqrLlGiIBRmLJ07+Wy8g0wPy36q5U",
+	"DpZKs0K6ih+Dp5Hbg9rcr4z3uPzLwwj2eAgCvZKYxxekoh9xK8M/2gKkEhcX2RVm/tgFwC+cTCaseXLr",
+	"bhShgG7w3z+anrg0N/EHd+LB5J2J2///F68FY+3lmC7GN3vNku9XVt0zqh8+m6fF/mh2pGQD5PVNOOxW",
+	"ykvdlwM6xT2K0EIGo/BqZXQ/99BkQgAEp4cI9kfqy2fBNsAfZdlDctpLlJ1s8Vpt1a14ZSlmsv+gHxZ5",
+	"8Daj6LkKjQ5bW+DxIUyCO1bDgiDuoCWTMNkGWpTprbdfW5d+AYJOEcZ02a8tVrweApaBKA0ANG976Mgp",
+	"vJeKnJKgtcmCUdr4yMy+RgFOsVsnhknhC/E9iXuZve4G6Brg0aVkDaqtZFE4ZipCbIqfiF1wLWGA65Kt",
+	"jJgthyskz9ic5AluWeem47mA91HTPoUaQB3MlBfPA0PER2wtEA1EOYr6YT743IWLqdHaZfHQDhv1esVD",
+	"AXN+fIO/sfB3QOhN8gQEsfVs6ApHf0Hf9g+JWmXycqtsNK2tCaON2aOd0kV6CI0n6wK/qmloV/MXQ19Z",
+	"aKivFBe4Oa2Ec0vo8jIqrRgF3R1qdAEnhcK9YfYWfibCgFJeJIo5B3QoMy0ZgS8WcBMFnlvJPfltdjMw",
+	"6zJEKtMTfiGcWh1xxxi+YzIxcG7A4RfDPrUkA5AKBxhIyH6tVm8YWHUa+dxKyV/2K7YqbrkTD27T/0xP",
+	"XLpz++GM89bM+i/yMSgHUwqhQZGz6gHnDBD3gNQHdeHMzWIO6aWdqm0PhVI5wPk9KKsQjjRMVCqPlLpW",
+	"puyfX4uTefGcJn8Lm6FgBJXB7AShhPiQa8qxJsyYNwgMWxK3UaXuC9PTQ5illHAfQyhDlq8Ffizfubt2",
+	"hwUMGoRCNcSmSYV90Nm4uR3SNchm7CkUIYBc/GWeGckAqH/R7EWHQf3cRSdmSCsefx9mGGlLfhBAcHYR",
+	"4OoqsRJVKalRL4Ux0wJD5z5cHM0kp0aCuFe7Uw/8JXDicNDaTrIq+3YvvIthm5iw+V5zUXEhFTOWiuUU",
+	"96oGB+YwUczQVIXSQ7bZNjV+Gha1Jw4g8PRtslz2g8hdQqFp81I0eDoY3eHO+ljRIDtUeVDD3mUlgg5m",
+	"zjwQnYsan7SsBoPVKWwE9Uoj7LkHJVp/oOVLkv/olt+oyckXRs0OFgAajOzocJTV07jvDpMI99LHVXi9",
+	"H8SrEX6EXoEhMso5HLtlxJIPSNltLynulr+CzLIF/DLveoFJWqFBU/GreVtlg/SkxWx7MzdqCZ2N7Boi",
+	"tIqajyGjKEDhctYmNSDL29LelddvAmP6RGcfnpjQUSwXKJnTybZ90YzZ/qRRVHW9irJM9mSIKPTBcia1",
+	"7YpVwGymrX6oecTVLSMRBGp2BVAP/jNm3qDSZtsxBFuAMsgDC+NQI4gxZmo9+A9wuyiT0GJTDUztHkIr",
+	"FVPA098hzoTeqXsQ36AHowBThpXhI2VtzKwVx3IeQbIWsLtnSSB9i9tmhesHt9XP8QjKQpuMY757MUC+",
+	"1/TB0rsJlRqBF63dpJNyYkNugIK5RrSc/PUbgYjvfHjL1s1Z73x4y5QYZL3BLUgQ4ZPoH5tWffXByh1I",
+	"7oG9AJXAZxIUX46iOssa92qLvlHyYUBrk00Rr0q2TbIdeErAoiWLQ6rRkYM98iLQQu66pRVUK1shCla9",
+	"ErIdexUFIfvwzOT05LSI9nXrnj1rvwWPQC9fBghOTd5DlcrESs2/V5v6+N5KOPkxVx2WWCgkpR5XSEH2",
+	"b1H0IapUrtPh79xbCd8JfcZEw7pf42LDuelpZhaoRYgFkbvUyMYigqbE9Ek6fo9oShqSCdA1+HQpqm8o",
+	"Dgl2eR+r4aP0kWRZZNjUqFbdYM0UbNpKRrczA4zNEcV05im37k2ImNEsKM7Vvet0yJCwKyY3sQoGadpb",
+	"NyRcMcbWxYcJEABkjn1++q2RnSt3expW8CXTIymBJJ4VHs6hsAHIcJEZwEe3128r5ypvRqZ2sC6/ofi1",
+	"xSmbtKu9N+Hu9jNMFprNOwmHZsZDiMRpkk9ltqJ8OU5oPWK2copiW8yPJPEN8igumLBlAa4+IU9jX9oj",
+	"6jKctB0Nz+b9UEE0sLu87ZfX+jpH/QbtuypF79IRJyU8nNKCC8ZaC4Y7b12vYrKeYhczQxylC6hRnHus",
+	"sME9wu0hSZVPbd5TyqvM7mBI3Dsm24LWKceZHgPH+Rq3OF/nZnjJn/ta8r0vOBw3db7XjPkcl+Xwc5HB",
+	"3DdHlC+6qYcraO1aeZ3xxwpipm+VF12B55wbXUcseVQuSfSRsZzPChq2ENHtFM2cN4Y2cCQEI4CIRDgt",
+	"x09XcX4Mq4ih0BEhGS+YmtAnBn5DtvAB2cF7Ov4NgGO0lkCuJAUDzLj0pwYK1hJkUnKYE1ideKb2upO3",
+	"nmtlZTU9owPMc4kCCX1OlRaqyWOm3VmQ8NCk/miorUCzM97Ae/FhtuWwX5qGZVrVYuBXzUvKjQc0Rh7F",
+	"6bzpVbFsxP6WFvmjWJgUFsTrQYCM9meyk/HZOovlSD5cRotuoxJBhY+8ah8ZUHkpfDIgOnYt7jg/4nmD",
+	"XZZ70NSWx4yYhuVVvKoXmdd3YVotT9JjubfHotoklUaKqDdf4SbXCdoW/pFsg9uFYnvTSWoHtET+Df3j",
+	"CLfPJJGBJZH/TEBs4SYk4rSZ50vPghpE/Ch5QpLNuhsusxHjwESodNG3ip2kKIL14PVUsvVYT8O2hPac",
+	"1k+lIxqFepqha5oUsHEoXL0xxnA6f1WzUZ8lZsIzVqRLxZfGsIrkPEQ8Jw/6B+ONKdi51ScRfakeczp9",
+	"eVDmOPWQlXJdnwJnjwhK6kGNrDbsnHgjJVubwJgMmWKv20NLAMMSz55k8PvZqXISHIZU5r6QwXgSqFlG",
+	"JuRMRR+AYUiUbSOfsWzn2GkiL4kb60UqfzN23ImUSUq8qsSXqiOVJJjYTg9CuYLc151UYPtnxLI/EsvH",
+	"iMkFCv696y95tRzykODIavaonxFZa2SLk1CX16cR8X3gbzwQ1Qw13snzXFjC7ye4jZ+DXA+Dab1e8DST",
+	"bdjFS/KYK5mfMYuuNg94Ni1UW52FKM43oa4Ad6El2buPJXdJqeJ6VQugwOL309R4JQHRqATJ0YQrZIQp",
+	"FBE0R8cAeHCOAe//CZEMLN0sGxtOk9Q5Dm7wQ0Ea0ZgmbqYcy0AP2xIrUOhPSpg9lCaYqui0nkb40eJ6",
+	"H8E+dTcM7/lBgVKSYor4jVNBBhDpNiwpzIydFFoWQyOyxf+US8PAos6NQxP6nnme4SY4oreWyFwC4+w2",
+	"T7IRQRi4i495hO8hvQtYanDTduxl5JZ5H4oFFAVrE3OirkiqrIqojKNFI0HZZJrO2qGXNotg6sQFr8TB",
+	"MWEuWcgh2bYNvSUSo+W6RsGfmyn/2FhzeDcmX78R9aRfOqaQa+p7YBmb7MsHuMmr8DwRLGOcGKkSRVd4",
+	"yfoSkb7StsDK+opNsiIkuBt7kHALt2QmGYfZCMYytegHS36UIxx9I6UgijJAIqEEHh/EZWOTD0H8Bq+q",
+	"xupCK7FaVJgBFseKSrOwDalnxR4zM/MMSEuULHdEBeOWWoAahLOYxBkHOhDWavI0S/CZ5zD4DQPB2C8E",
+	"I88fjNGfy0gI4te/mkR7SiSS9RRix6vt4GYOBsUJtKyml4bQAQpRDwYiDn4Bho7q3HNud8eOioVXs2FD",
+	"3/vnjaXeBcSeah4Eh1fS1xiJxi3JzvgQR1JqRORtXEZelCCDdYv6DociMFUYGdtkU79ocEfHuX8q2HXI",
+	"cocT4VJGMhYQnDA4sp2DoDFOMk/3BD3BXFeLlP04HoeL9MFCfpcfaLoK2WEASEJpfyo+lzgdZ8+wwxw+",
+	"oh/bYGyk4EGxDOIx+1kURMlCjDNXy6lwtbCzYNlltDIOraxhqKchii4N72WJSy4odQH6N9IpfHLqIU0B",
+	"gnA4njHfoxKYXJ1T2L24XKkbE7WXoFY3XRFtSULXL1f1M+e6GQzaDYUP8Kpb/Vmy9W5/67dPnJvwLPsx",
+	"mxEKshNFPDljJq/Cqs9PYkiL/t+1SJWR84ywh6QvRo1KyD8r+ZZb8u3V1G3ro/DAqa3wNmnFzc8AQY64",
+	"kYPWL0r6RuklaBnStHsVhItXoJ9+38XhBLBfdfxPnBSeoT1yCjuVcqkT+92h2IlWjVhqPQd/qeikV3v4",
+	"acq4eoW7/FMeqJLcSKRfpZRhUkmeRlgo50S2zTccpXW6XrD+M0bQja8AcdGtPsg1HUDcvSZuZgWmk6fC",
+	"PLoPV2rTEBGeEdscRm4QXWFhGiOLSH888HJQrTyqxfyMotBn5Cj0t6b7Xu0PMgpzHialYOodJiBZPju8",
+	"YTNzC7xmjlOUXSStNkYXOa8Zdwv2aZFTIPOmk6TXfgx2phIHgVzQqmDXOlNlxlTWZa8RPcKzAT/65LMG",
+	"p78oFwHmWYZzlOHTSBpaAiduIwqioyjbwLpbpOvz42Ou7HeSl3rY+FYfDKE89O7rM16p6fd/MJ6bACsk",
+	"7+0nyXtnSvdABuYYinKQ5QAq7uqDqRr9Uijn8Ol1TMinTE6MSSPdcweqsLSBVW9AlcA2JLi2Eu8q61/l",
+	"WKyUAw37xC+gtruez0N7WFt+HdVu+PdSSfqizKoWPycUWjlMdIe7m/AL1siFU26bVo2HB3TNSkkWkxeX",
+	"yT83OIwKpTGyZocFMmIHaM+YlV5Y8WuDfrNQN0fzVwO37DXC69VsUQDdL1UaobeK3hMfYCvLWc85JXWt",
+	"DxAUFEouFBBKTPNzlDR/YdGthMhQFXYsWXZJW7oiN+jXialNBCvxe/SMHw/Ij/8jzdIOE6bXkgUVYLkP",
+	"wcLBHBC03r3R0tPEP4Jyme7gzRhbV9QcSXwSYNxrc/l4g/nYGVyZLGMKlqffn199MM9rSfZO+RdVJ4dM",
+	"+T8RaSfuUTVmn0OW0CPB/umZz+EU+Bwkq9hIfQ4qmQ0nkHHuMOWGeRkFvF2h0nEbKn+y5ow8BaeVLt4q",
+	"YJDng6TTsMCc3UTi2rSYzMTKh27wtkLgAqbhnW1Qiljez5GxPuysiLXhDRBpKQCHkYWIW021OOOW+/R8",
+	"vDzvMatJTVcJ3K+bxPppzcgzY/Q485sLa6ef/2n9vr0aKq7gz9288a5Xg2icqnv/GntlZpqLW+Jvk/a/",
+	"iAJUK4FDQnJczPQuks0W+Kot+XM3bxiZwT9UlFIu1VfGotMNMk2onxjwee8P9ktHBMlCmIbc6uuMzw/A",
+	"53UESfgcb2nIW4nCoUB8Xzvxr6YZetzHPoOlf50wxyRRDB8LNNiLWXec3yzpu4A3aj3zSQtiXhOF+EU8",
+	"Q7/5lIJJXuYFy19vMVFpYnlaZEV2MNKlNVb2Qw3o3GMuJQKKkqtn7GN/vIF237LEGha7wZ36SRtpXlYy",
+	"1W2bETIzmfGqwf1nyJobx+KWgpvDmx0Vpnin4obRHcXNkGsvT3jRu24YLcglwcbFmE4qG5xuqiz7Ucxq",
+	"ZVbTikkL/020wNyXdREonP0yJUykLjBHFaPlchos1Qta5z1lafqxnhC3hQCFgIrap0K9jQUltc+H8NYr",
+	"kHstLU9f6SSZ7s+vCAmmZh5aIwhuwgdIkUccfdJ0ywpJMsKtS9X2zaLND3JIWkvr+c5DjPCBBREQB9Dv",
+	"oal1siXb8VCmiSYBXHLUCr+xuvgF9Ixo4kP+g0U77eYLN6wGJuUo83FhwVfITzJBKKB3quKNCsUSaZFH",
+	"OurFxdfj7SVJva8ZYf5T3oOJMJ+zu1IN+jF1aTbc/imwvvHutd+8rxeV6CsAKKbrZS+M/GCtR0AQ0Mvv",
+	"+NDX49YtFgQR25AvL7u1pWKpS5o5kOxq5sCexQvPBOs+7a+Ji4PfHFqxwpMwx4qAmil6kRS76oRgJbtn",
+	"4IYDD07qxoLwkT3WhYdv5yi39zvuaK2axO3KiqzS/Gzt6zLbaEFAcG5dmtj2oMpPqt2hl2FVRMjT5oKv",
+	"uekg3SXx1WS39ApKNuLMaRIV8s0Nr2+A8jh46Be5wkBLEq6a+NAgUPTJbD838x89PDlfQIHYm2tXxMHq",
+	"MdRDRi+nePTsXeFWz2DSX7BsdMXRJLWQkdxe0Kw6qfXAg/wkwU0ey4tWgA2e+xGYjskDAWehkgTl2Bnt",
+	"o3nQqiON6+A2m7iNOyzLh64P7/OyLaquzlM7jpNOrRaPfOIv8dIw6bb4moDa1lqpGxQ2SZApegVAd//X",
+	"zcs2aCQtbJa61VSP24UeDjdzA7nxeNGGCxTOu5MogWnazujLPciAf/WJM5Ah/ATI+FDUzNkXFlWowZTq",
+	"wcmtvo+B03AgUWJFdDMhXJ0QyB8XdUoBFXdj49trpjEbi+zqfe7aBqidZF5MfLMEyK+jgobpBTb2zEt2",
+	"gmHkHYYDKRI9c5qdOc3G7zT7At6m/EsvI6W1/ATG1DVU9Ikr9Y3CVBFG7uJiEQveTRj4E7LfQSfdYnlE",
+	"OvtvK3HQZ2Q7eDNH893qxP423MLHAu1BDsIHuDlCzJ96SNtBFWp4phDCB/BW0aqP2hZjo1iyu58bJhlg",
+	"0pHcrAlg5AMfpIC3PFcSDq98mlspB8Oq8TBExzhvI0HCITgtr2uUriM0QnxPHev4ZK9vjQVen3KtbRfv",
+	"KZYKIz860uv5ZWznZ3cRiIiJPBAPcVF8hZuFCXh010IjrKNaOTf2MnHQ6Sm9qpmwk4zRjHdaAqJBFZUr",
+	"IUvBJ+RpfmDCTb78M43yxOIuMyXzM4XyTKEcv0L5rQEdDxVzB2VFg7JGtYBEtkFrIRk3MmN6wUJauhX8",
+	"VJSE6icsUy51gJtj5SLDWrfj9AKKx0qMZCrF4KdRPkGUZO4VGzmwJTkhuKmH8b+ZWbnk10pexXNF3HOW",
+	"ySahxYVkggX19SLygfT5UxsEHe9Q258JL77LjUn+2V2ZCg9KXZ1qaIWU5Ul77NJGBuAtNqRu9l9kJ/El",
+	"k12yG38qHU7Uzv1mHunoHpn8nlvCUSR3/urArpmrnpfnhYuTXexJBl7sG09llcb6gX7/GgKxTXm43KPP",
+	"J29LlRtfqtGEpoB/NbyLRzt3aaQ/1D3rWokity16dlk895bqJ5tWyQ8CVIpQOcuDn8F2Cju4RspuRiGA",
+	"fNwII2+Rj6YPklKV+F8cHZ6mfIxASJBBwQ1LrFwUHFASFNNUK3uy3Np8wUZdzqvuwdRX3okSBPgK1aQu",
+	"4DwQUUeOTzrj/RrvH6PiZFiKIkg6MXvNZHqpxhtJAmyqzy+EwEInIoECKbb5x9pgluYktUbegCm1gTN+",
+	"83YGUdKWvJC33cpT0fio09VszhlRg8ZUlzpnmJ6No9MEmZ+xD8v0aax8p7aq+Q4MGm0hDxXqpQbNhKYC",
+	"tBigcDkfUaG93wIfOSpk5V++Vaz1kTL6dLc7/FriYkmArdJjbUx95fiRTSSf77tjEkgKXVEtXMe7bwxR",
+	"E/hY1KFRNs3CiIPUisg2Q0fqO8vtgvQBDOhVzDgRAwX7MdYH5r+l2hWmHHzai8DA5Pf6ZpE/5wLD5+Ra",
+	"fjPTvYr5nbaoE6l6rZGzinKxr2PTqwJbE9HovN4uuGEpTQMxZZXPHUB4Ak4Qx4FMmRrYp68o4A7MKT43",
+	"aMd49vrJdozvW/iQApVPXT7Qz8+pdTJ+9c/jI+4lug1PTmXUN0FdQe5Pi6T2od3uGVn9xMnqS+WYe5FW",
+	"3Oc0cQ5L7U7jpslSvtfQtJjdlVaD0F9ZSjEEFGyIvsZytjFHomOli6uyavFOupNrBjzMYS0SW9D75L4a",
+	"zqAqlRGq1v3ADdbmCzfTT79iVi9zGuaSTfyM/kGeJIRzRr7Dku+3vMjpNuuAlmRf4RcJxDfTfXhP5t4U",
+	"NrFCN+YCUxOHoIiRWFhGYsYbwmY3hjv+O2PP6tMStHHGCkbDCgyNIjeY5WJkFL++/n8DAGjWmfrV5QAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
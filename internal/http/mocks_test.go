@@ -7,10 +7,233 @@ package httpserver
 import (
 	"avito_pvz/internal/models/domain"
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockAuthenticator creates a new instance of MockAuthenticator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthenticator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthenticator {
+	mock := &MockAuthenticator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuthenticator is an autogenerated mock type for the Authenticator type
+type MockAuthenticator struct {
+	mock.Mock
+}
+
+type MockAuthenticator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthenticator) EXPECT() *MockAuthenticator_Expecter {
+	return &MockAuthenticator_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function for the type MockAuthenticator
+func (_mock *MockAuthenticator) Authenticate(ctx context.Context, token string) (*domain.Identity, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *domain.Identity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Identity, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Identity); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Identity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthenticator_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockAuthenticator_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockAuthenticator_Expecter) Authenticate(ctx interface{}, token interface{}) *MockAuthenticator_Authenticate_Call {
+	return &MockAuthenticator_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, token)}
+}
+
+func (_c *MockAuthenticator_Authenticate_Call) Run(run func(ctx context.Context, token string)) *MockAuthenticator_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthenticator_Authenticate_Call) Return(identity *domain.Identity, err error) *MockAuthenticator_Authenticate_Call {
+	_c.Call.Return(identity, err)
+	return _c
+}
+
+func (_c *MockAuthenticator_Authenticate_Call) RunAndReturn(run func(ctx context.Context, token string) (*domain.Identity, error)) *MockAuthenticator_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRateLimiter creates a new instance of MockRateLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRateLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRateLimiter {
+	mock := &MockRateLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRateLimiter is an autogenerated mock type for the RateLimiter type
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+type MockRateLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRateLimiter) EXPECT() *MockRateLimiter_Expecter {
+	return &MockRateLimiter_Expecter{mock: &_m.Mock}
+}
+
+// Allow provides a mock function for the type MockRateLimiter
+func (_mock *MockRateLimiter) Allow(operation string, identity *domain.Identity, ip string) (time.Duration, bool) {
+	ret := _mock.Called(operation, identity, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Allow")
+	}
+
+	var r0 time.Duration
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(string, *domain.Identity, string) (time.Duration, bool)); ok {
+		return returnFunc(operation, identity, ip)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, *domain.Identity, string) time.Duration); ok {
+		r0 = returnFunc(operation, identity, ip)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, *domain.Identity, string) bool); ok {
+		r1 = returnFunc(operation, identity, ip)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockRateLimiter_Allow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Allow'
+type MockRateLimiter_Allow_Call struct {
+	*mock.Call
+}
+
+// Allow is a helper method to define mock.On call
+//   - operation
+//   - identity
+//   - ip
+func (_e *MockRateLimiter_Expecter) Allow(operation interface{}, identity interface{}, ip interface{}) *MockRateLimiter_Allow_Call {
+	return &MockRateLimiter_Allow_Call{Call: _e.mock.On("Allow", operation, identity, ip)}
+}
+
+func (_c *MockRateLimiter_Allow_Call) Run(run func(operation string, identity *domain.Identity, ip string)) *MockRateLimiter_Allow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*domain.Identity), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRateLimiter_Allow_Call) Return(duration time.Duration, b bool) *MockRateLimiter_Allow_Call {
+	_c.Call.Return(duration, b)
+	return _c
+}
+
+func (_c *MockRateLimiter_Allow_Call) RunAndReturn(run func(operation string, identity *domain.Identity, ip string) (time.Duration, bool)) *MockRateLimiter_Allow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AllowIP provides a mock function for the type MockRateLimiter
+func (_mock *MockRateLimiter) AllowIP(ip string) (time.Duration, bool) {
+	ret := _mock.Called(ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllowIP")
+	}
+
+	var r0 time.Duration
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(string) (time.Duration, bool)); ok {
+		return returnFunc(ip)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) time.Duration); ok {
+		r0 = returnFunc(ip)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(ip)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockRateLimiter_AllowIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllowIP'
+type MockRateLimiter_AllowIP_Call struct {
+	*mock.Call
+}
+
+// AllowIP is a helper method to define mock.On call
+//   - ip
+func (_e *MockRateLimiter_Expecter) AllowIP(ip interface{}) *MockRateLimiter_AllowIP_Call {
+	return &MockRateLimiter_AllowIP_Call{Call: _e.mock.On("AllowIP", ip)}
+}
+
+func (_c *MockRateLimiter_AllowIP_Call) Run(run func(ip string)) *MockRateLimiter_AllowIP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRateLimiter_AllowIP_Call) Return(duration time.Duration, b bool) *MockRateLimiter_AllowIP_Call {
+	_c.Call.Return(duration, b)
+	return _c
+}
+
+func (_c *MockRateLimiter_AllowIP_Call) RunAndReturn(run func(ip string) (time.Duration, bool)) *MockRateLimiter_AllowIP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockJWTGenerator creates a new instance of MockJWTGenerator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockJWTGenerator(t interface {
@@ -38,57 +261,129 @@ func (_m *MockJWTGenerator) EXPECT() *MockJWTGenerator_Expecter {
 	return &MockJWTGenerator_Expecter{mock: &_m.Mock}
 }
 
-// GenerateToken provides a mock function for the type MockJWTGenerator
-func (_mock *MockJWTGenerator) GenerateToken(email string, role string) (string, error) {
-	ret := _mock.Called(email, role)
+// GenerateDummyToken provides a mock function for the type MockJWTGenerator
+func (_mock *MockJWTGenerator) GenerateDummyToken(role string) (string, error) {
+	ret := _mock.Called(role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateToken")
+		panic("no return value specified for GenerateDummyToken")
 	}
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) (string, error)); ok {
-		return returnFunc(email, role)
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(role)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = returnFunc(email, role)
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(role)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(email, role)
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(role)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockJWTGenerator_GenerateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateToken'
-type MockJWTGenerator_GenerateToken_Call struct {
+// MockJWTGenerator_GenerateDummyToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateDummyToken'
+type MockJWTGenerator_GenerateDummyToken_Call struct {
 	*mock.Call
 }
 
-// GenerateToken is a helper method to define mock.On call
-//   - email
+// GenerateDummyToken is a helper method to define mock.On call
 //   - role
-func (_e *MockJWTGenerator_Expecter) GenerateToken(email interface{}, role interface{}) *MockJWTGenerator_GenerateToken_Call {
-	return &MockJWTGenerator_GenerateToken_Call{Call: _e.mock.On("GenerateToken", email, role)}
+func (_e *MockJWTGenerator_Expecter) GenerateDummyToken(role interface{}) *MockJWTGenerator_GenerateDummyToken_Call {
+	return &MockJWTGenerator_GenerateDummyToken_Call{Call: _e.mock.On("GenerateDummyToken", role)}
 }
 
-func (_c *MockJWTGenerator_GenerateToken_Call) Run(run func(email string, role string)) *MockJWTGenerator_GenerateToken_Call {
+func (_c *MockJWTGenerator_GenerateDummyToken_Call) Run(run func(role string)) *MockJWTGenerator_GenerateDummyToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockJWTGenerator_GenerateToken_Call) Return(s string, err error) *MockJWTGenerator_GenerateToken_Call {
+func (_c *MockJWTGenerator_GenerateDummyToken_Call) Return(s string, err error) *MockJWTGenerator_GenerateDummyToken_Call {
 	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockJWTGenerator_GenerateToken_Call) RunAndReturn(run func(email string, role string) (string, error)) *MockJWTGenerator_GenerateToken_Call {
+func (_c *MockJWTGenerator_GenerateDummyToken_Call) RunAndReturn(run func(role string) (string, error)) *MockJWTGenerator_GenerateDummyToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockKeySetProvider creates a new instance of MockKeySetProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockKeySetProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockKeySetProvider {
+	mock := &MockKeySetProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockKeySetProvider is an autogenerated mock type for the KeySetProvider type
+type MockKeySetProvider struct {
+	mock.Mock
+}
+
+type MockKeySetProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockKeySetProvider) EXPECT() *MockKeySetProvider_Expecter {
+	return &MockKeySetProvider_Expecter{mock: &_m.Mock}
+}
+
+// JWKS provides a mock function for the type MockKeySetProvider
+func (_mock *MockKeySetProvider) JWKS() domain.JWKSet {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JWKS")
+	}
+
+	var r0 domain.JWKSet
+	if returnFunc, ok := ret.Get(0).(func() domain.JWKSet); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.JWKSet)
+		}
+	}
+	return r0
+}
+
+// MockKeySetProvider_JWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JWKS'
+type MockKeySetProvider_JWKS_Call struct {
+	*mock.Call
+}
+
+// JWKS is a helper method to define mock.On call
+func (_e *MockKeySetProvider_Expecter) JWKS() *MockKeySetProvider_JWKS_Call {
+	return &MockKeySetProvider_JWKS_Call{Call: _e.mock.On("JWKS")}
+}
+
+func (_c *MockKeySetProvider_JWKS_Call) Run(run func()) *MockKeySetProvider_JWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockKeySetProvider_JWKS_Call) Return(jWKSet domain.JWKSet) *MockKeySetProvider_JWKS_Call {
+	_c.Call.Return(jWKSet)
+	return _c
+}
+
+func (_c *MockKeySetProvider_JWKS_Call) RunAndReturn(run func() domain.JWKSet) *MockKeySetProvider_JWKS_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -112,236 +407,2133 @@ type MockUserProvider struct {
 	mock.Mock
 }
 
-type MockUserProvider_Expecter struct {
+type MockUserProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserProvider) EXPECT() *MockUserProvider_Expecter {
+	return &MockUserProvider_Expecter{mock: &_m.Mock}
+}
+
+// Auth provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) Auth(ctx context.Context, email string, password string) (*domain.TokenPair, error) {
+	ret := _mock.Called(ctx, email, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Auth")
+	}
+
+	var r0 *domain.TokenPair
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.TokenPair, error)); ok {
+		return returnFunc(ctx, email, password)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.TokenPair); ok {
+		r0 = returnFunc(ctx, email, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TokenPair)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, email, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserProvider_Auth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Auth'
+type MockUserProvider_Auth_Call struct {
+	*mock.Call
+}
+
+// Auth is a helper method to define mock.On call
+//   - ctx
+//   - email
+//   - password
+func (_e *MockUserProvider_Expecter) Auth(ctx interface{}, email interface{}, password interface{}) *MockUserProvider_Auth_Call {
+	return &MockUserProvider_Auth_Call{Call: _e.mock.On("Auth", ctx, email, password)}
+}
+
+func (_c *MockUserProvider_Auth_Call) Run(run func(ctx context.Context, email string, password string)) *MockUserProvider_Auth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_Auth_Call) Return(tokenPair *domain.TokenPair, err error) *MockUserProvider_Auth_Call {
+	_c.Call.Return(tokenPair, err)
+	return _c
+}
+
+func (_c *MockUserProvider_Auth_Call) RunAndReturn(run func(ctx context.Context, email string, password string) (*domain.TokenPair, error)) *MockUserProvider_Auth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeRole provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) ChangeRole(ctx context.Context, id uuid.UUID, role domain.Role) (*domain.User, error) {
+	ret := _mock.Called(ctx, id, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeRole")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Role) (*domain.User, error)); ok {
+		return returnFunc(ctx, id, role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Role) *domain.User); ok {
+		r0 = returnFunc(ctx, id, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, domain.Role) error); ok {
+		r1 = returnFunc(ctx, id, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserProvider_ChangeRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeRole'
+type MockUserProvider_ChangeRole_Call struct {
+	*mock.Call
+}
+
+// ChangeRole is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - role
+func (_e *MockUserProvider_Expecter) ChangeRole(ctx interface{}, id interface{}, role interface{}) *MockUserProvider_ChangeRole_Call {
+	return &MockUserProvider_ChangeRole_Call{Call: _e.mock.On("ChangeRole", ctx, id, role)}
+}
+
+func (_c *MockUserProvider_ChangeRole_Call) Run(run func(ctx context.Context, id uuid.UUID, role domain.Role)) *MockUserProvider_ChangeRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_ChangeRole_Call) Return(user *domain.User, err error) *MockUserProvider_ChangeRole_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserProvider_ChangeRole_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, role domain.Role) (*domain.User, error)) *MockUserProvider_ChangeRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) Create(ctx context.Context, email domain.Email, password string, role domain.Role) (*domain.User, error) {
+	ret := _mock.Called(ctx, email, password, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Email, string, domain.Role) (*domain.User, error)); ok {
+		return returnFunc(ctx, email, password, role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Email, string, domain.Role) *domain.User); ok {
+		r0 = returnFunc(ctx, email, password, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Email, string, domain.Role) error); ok {
+		r1 = returnFunc(ctx, email, password, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - email
+//   - password
+//   - role
+func (_e *MockUserProvider_Expecter) Create(ctx interface{}, email interface{}, password interface{}, role interface{}) *MockUserProvider_Create_Call {
+	return &MockUserProvider_Create_Call{Call: _e.mock.On("Create", ctx, email, password, role)}
+}
+
+func (_c *MockUserProvider_Create_Call) Run(run func(ctx context.Context, email domain.Email, password string, role domain.Role)) *MockUserProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Email), args[2].(string), args[3].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_Create_Call) Return(user *domain.User, err error) *MockUserProvider_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserProvider_Create_Call) RunAndReturn(run func(ctx context.Context, email domain.Email, password string, role domain.Role) (*domain.User, error)) *MockUserProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserFilter) ([]domain.User, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserFilter) []domain.User); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUserProvider_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - filter
+func (_e *MockUserProvider_Expecter) List(ctx interface{}, filter interface{}) *MockUserProvider_List_Call {
+	return &MockUserProvider_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockUserProvider_List_Call) Run(run func(ctx context.Context, filter domain.UserFilter)) *MockUserProvider_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserFilter))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_List_Call) Return(users []domain.User, err error) *MockUserProvider_List_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockUserProvider_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)) *MockUserProvider_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) ResetPassword(ctx context.Context, id uuid.UUID) (string, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserProvider_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type MockUserProvider_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserProvider_Expecter) ResetPassword(ctx interface{}, id interface{}) *MockUserProvider_ResetPassword_Call {
+	return &MockUserProvider_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, id)}
+}
+
+func (_c *MockUserProvider_ResetPassword_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockUserProvider_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_ResetPassword_Call) Return(s string, err error) *MockUserProvider_ResetPassword_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockUserProvider_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (string, error)) *MockUserProvider_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetActive provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) SetActive(ctx context.Context, id uuid.UUID, active bool) (*domain.User, error) {
+	ret := _mock.Called(ctx, id, active)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetActive")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) (*domain.User, error)); ok {
+		return returnFunc(ctx, id, active)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) *domain.User); ok {
+		r0 = returnFunc(ctx, id, active)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
+		r1 = returnFunc(ctx, id, active)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserProvider_SetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetActive'
+type MockUserProvider_SetActive_Call struct {
+	*mock.Call
+}
+
+// SetActive is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - active
+func (_e *MockUserProvider_Expecter) SetActive(ctx interface{}, id interface{}, active interface{}) *MockUserProvider_SetActive_Call {
+	return &MockUserProvider_SetActive_Call{Call: _e.mock.On("SetActive", ctx, id, active)}
+}
+
+func (_c *MockUserProvider_SetActive_Call) Run(run func(ctx context.Context, id uuid.UUID, active bool)) *MockUserProvider_SetActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_SetActive_Call) Return(user *domain.User, err error) *MockUserProvider_SetActive_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserProvider_SetActive_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, active bool) (*domain.User, error)) *MockUserProvider_SetActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionProvider creates a new instance of MockSessionProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionProvider {
+	mock := &MockSessionProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionProvider is an autogenerated mock type for the SessionProvider type
+type MockSessionProvider struct {
+	mock.Mock
+}
+
+type MockSessionProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionProvider) EXPECT() *MockSessionProvider_Expecter {
+	return &MockSessionProvider_Expecter{mock: &_m.Mock}
+}
+
+// Logout provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) Logout(ctx context.Context, sessionID uuid.UUID) error {
+	ret := _mock.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionProvider_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockSessionProvider_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx
+//   - sessionID
+func (_e *MockSessionProvider_Expecter) Logout(ctx interface{}, sessionID interface{}) *MockSessionProvider_Logout_Call {
+	return &MockSessionProvider_Logout_Call{Call: _e.mock.On("Logout", ctx, sessionID)}
+}
+
+func (_c *MockSessionProvider_Logout_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *MockSessionProvider_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_Logout_Call) Return(err error) *MockSessionProvider_Logout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionProvider_Logout_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID) error) *MockSessionProvider_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	ret := _mock.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *domain.TokenPair
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.TokenPair, error)); ok {
+		return returnFunc(ctx, refreshToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.TokenPair); ok {
+		r0 = returnFunc(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TokenPair)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionProvider_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockSessionProvider_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx
+//   - refreshToken
+func (_e *MockSessionProvider_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *MockSessionProvider_Refresh_Call {
+	return &MockSessionProvider_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+}
+
+func (_c *MockSessionProvider_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *MockSessionProvider_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_Refresh_Call) Return(tokenPair *domain.TokenPair, err error) *MockSessionProvider_Refresh_Call {
+	_c.Call.Return(tokenPair, err)
+	return _c
+}
+
+func (_c *MockSessionProvider_Refresh_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) (*domain.TokenPair, error)) *MockSessionProvider_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPVZProvider creates a new instance of MockPVZProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPVZProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPVZProvider {
+	mock := &MockPVZProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPVZProvider is an autogenerated mock type for the PVZProvider type
+type MockPVZProvider struct {
+	mock.Mock
+}
+
+type MockPVZProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPVZProvider) EXPECT() *MockPVZProvider_Expecter {
+	return &MockPVZProvider_Expecter{mock: &_m.Mock}
+}
+
+// ChangeStatus provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) ChangeStatus(ctx context.Context, id domain.PVZID, to domain.PVZStatus, reason string) (*domain.PVZ, error) {
+	ret := _mock.Called(ctx, id, to, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeStatus")
+	}
+
+	var r0 *domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID, domain.PVZStatus, string) (*domain.PVZ, error)); ok {
+		return returnFunc(ctx, id, to, reason)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID, domain.PVZStatus, string) *domain.PVZ); ok {
+		r0 = returnFunc(ctx, id, to, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PVZID, domain.PVZStatus, string) error); ok {
+		r1 = returnFunc(ctx, id, to, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_ChangeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeStatus'
+type MockPVZProvider_ChangeStatus_Call struct {
+	*mock.Call
+}
+
+// ChangeStatus is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - to
+//   - reason
+func (_e *MockPVZProvider_Expecter) ChangeStatus(ctx interface{}, id interface{}, to interface{}, reason interface{}) *MockPVZProvider_ChangeStatus_Call {
+	return &MockPVZProvider_ChangeStatus_Call{Call: _e.mock.On("ChangeStatus", ctx, id, to, reason)}
+}
+
+func (_c *MockPVZProvider_ChangeStatus_Call) Run(run func(ctx context.Context, id domain.PVZID, to domain.PVZStatus, reason string)) *MockPVZProvider_ChangeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PVZID), args[2].(domain.PVZStatus), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_ChangeStatus_Call) Return(pVZ *domain.PVZ, err error) *MockPVZProvider_ChangeStatus_Call {
+	_c.Call.Return(pVZ, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_ChangeStatus_Call) RunAndReturn(run func(ctx context.Context, id domain.PVZID, to domain.PVZStatus, reason string) (*domain.PVZ, error)) *MockPVZProvider_ChangeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) Create(ctx context.Context, city domain.PvzCity, profile domain.PVZProfileUpdate) (*domain.PVZ, error) {
+	ret := _mock.Called(ctx, city, profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PvzCity, domain.PVZProfileUpdate) (*domain.PVZ, error)); ok {
+		return returnFunc(ctx, city, profile)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PvzCity, domain.PVZProfileUpdate) *domain.PVZ); ok {
+		r0 = returnFunc(ctx, city, profile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PvzCity, domain.PVZProfileUpdate) error); ok {
+		r1 = returnFunc(ctx, city, profile)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPVZProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - city
+//   - profile
+func (_e *MockPVZProvider_Expecter) Create(ctx interface{}, city interface{}, profile interface{}) *MockPVZProvider_Create_Call {
+	return &MockPVZProvider_Create_Call{Call: _e.mock.On("Create", ctx, city, profile)}
+}
+
+func (_c *MockPVZProvider_Create_Call) Run(run func(ctx context.Context, city domain.PvzCity, profile domain.PVZProfileUpdate)) *MockPVZProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PvzCity), args[2].(domain.PVZProfileUpdate))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_Create_Call) Return(pVZ *domain.PVZ, err error) *MockPVZProvider_Create_Call {
+	_c.Call.Return(pVZ, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_Create_Call) RunAndReturn(run func(ctx context.Context, city domain.PvzCity, profile domain.PVZProfileUpdate) (*domain.PVZ, error)) *MockPVZProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// History provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) History(ctx context.Context, id domain.PVZID) ([]domain.PVZProfileChange, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []domain.PVZProfileChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) ([]domain.PVZProfileChange, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) []domain.PVZProfileChange); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZProfileChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PVZID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type MockPVZProvider_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockPVZProvider_Expecter) History(ctx interface{}, id interface{}) *MockPVZProvider_History_Call {
+	return &MockPVZProvider_History_Call{Call: _e.mock.On("History", ctx, id)}
+}
+
+func (_c *MockPVZProvider_History_Call) Run(run func(ctx context.Context, id domain.PVZID)) *MockPVZProvider_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PVZID))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_History_Call) Return(pVZProfileChanges []domain.PVZProfileChange, err error) *MockPVZProvider_History_Call {
+	_c.Call.Return(pVZProfileChanges, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_History_Call) RunAndReturn(run func(ctx context.Context, id domain.PVZID) ([]domain.PVZProfileChange, error)) *MockPVZProvider_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) List(ctx context.Context, params domain.Params) (*[]domain.PVZAgregate, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *[]domain.PVZAgregate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) (*[]domain.PVZAgregate, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) *[]domain.PVZAgregate); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.PVZAgregate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Params) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockPVZProvider_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - params
+func (_e *MockPVZProvider_Expecter) List(ctx interface{}, params interface{}) *MockPVZProvider_List_Call {
+	return &MockPVZProvider_List_Call{Call: _e.mock.On("List", ctx, params)}
+}
+
+func (_c *MockPVZProvider_List_Call) Run(run func(ctx context.Context, params domain.Params)) *MockPVZProvider_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Params))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_List_Call) Return(pVZAgregates *[]domain.PVZAgregate, err error) *MockPVZProvider_List_Call {
+	_c.Call.Return(pVZAgregates, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_List_Call) RunAndReturn(run func(ctx context.Context, params domain.Params) (*[]domain.PVZAgregate, error)) *MockPVZProvider_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Nearest provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) Nearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Nearest")
+	}
+
+	var r0 []domain.NearbyPVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NearbyQuery) ([]domain.NearbyPVZ, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NearbyQuery) []domain.NearbyPVZ); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NearbyPVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.NearbyQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_Nearest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Nearest'
+type MockPVZProvider_Nearest_Call struct {
+	*mock.Call
+}
+
+// Nearest is a helper method to define mock.On call
+//   - ctx
+//   - query
+func (_e *MockPVZProvider_Expecter) Nearest(ctx interface{}, query interface{}) *MockPVZProvider_Nearest_Call {
+	return &MockPVZProvider_Nearest_Call{Call: _e.mock.On("Nearest", ctx, query)}
+}
+
+func (_c *MockPVZProvider_Nearest_Call) Run(run func(ctx context.Context, query domain.NearbyQuery)) *MockPVZProvider_Nearest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NearbyQuery))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_Nearest_Call) Return(nearbyPVZs []domain.NearbyPVZ, err error) *MockPVZProvider_Nearest_Call {
+	_c.Call.Return(nearbyPVZs, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_Nearest_Call) RunAndReturn(run func(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error)) *MockPVZProvider_Nearest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) UpdateProfile(ctx context.Context, id domain.PVZID, profile domain.PVZProfileUpdate) (*domain.PVZ, error) {
+	ret := _mock.Called(ctx, id, profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID, domain.PVZProfileUpdate) (*domain.PVZ, error)); ok {
+		return returnFunc(ctx, id, profile)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID, domain.PVZProfileUpdate) *domain.PVZ); ok {
+		r0 = returnFunc(ctx, id, profile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PVZID, domain.PVZProfileUpdate) error); ok {
+		r1 = returnFunc(ctx, id, profile)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockPVZProvider_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - profile
+func (_e *MockPVZProvider_Expecter) UpdateProfile(ctx interface{}, id interface{}, profile interface{}) *MockPVZProvider_UpdateProfile_Call {
+	return &MockPVZProvider_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, id, profile)}
+}
+
+func (_c *MockPVZProvider_UpdateProfile_Call) Run(run func(ctx context.Context, id domain.PVZID, profile domain.PVZProfileUpdate)) *MockPVZProvider_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PVZID), args[2].(domain.PVZProfileUpdate))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_UpdateProfile_Call) Return(pVZ *domain.PVZ, err error) *MockPVZProvider_UpdateProfile_Call {
+	_c.Call.Return(pVZ, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_UpdateProfile_Call) RunAndReturn(run func(ctx context.Context, id domain.PVZID, profile domain.PVZProfileUpdate) (*domain.PVZ, error)) *MockPVZProvider_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReceptionProvider creates a new instance of MockReceptionProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReceptionProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReceptionProvider {
+	mock := &MockReceptionProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReceptionProvider is an autogenerated mock type for the ReceptionProvider type
+type MockReceptionProvider struct {
+	mock.Mock
+}
+
+type MockReceptionProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReceptionProvider) EXPECT() *MockReceptionProvider_Expecter {
+	return &MockReceptionProvider_Expecter{mock: &_m.Mock}
+}
+
+// CloseLastReception provides a mock function for the type MockReceptionProvider
+func (_mock *MockReceptionProvider) CloseLastReception(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, *domain.ReceptionReconciliation, error) {
+	ret := _mock.Called(ctx, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseLastReception")
+	}
+
+	var r0 *domain.Reception
+	var r1 *domain.ReceptionReconciliation
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) (*domain.Reception, *domain.ReceptionReconciliation, error)); ok {
+		return returnFunc(ctx, pvzID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, pvzID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PVZID) *domain.ReceptionReconciliation); ok {
+		r1 = returnFunc(ctx, pvzID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.ReceptionReconciliation)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.PVZID) error); ok {
+		r2 = returnFunc(ctx, pvzID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockReceptionProvider_CloseLastReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseLastReception'
+type MockReceptionProvider_CloseLastReception_Call struct {
+	*mock.Call
+}
+
+// CloseLastReception is a helper method to define mock.On call
+//   - ctx
+//   - pvzID
+func (_e *MockReceptionProvider_Expecter) CloseLastReception(ctx interface{}, pvzID interface{}) *MockReceptionProvider_CloseLastReception_Call {
+	return &MockReceptionProvider_CloseLastReception_Call{Call: _e.mock.On("CloseLastReception", ctx, pvzID)}
+}
+
+func (_c *MockReceptionProvider_CloseLastReception_Call) Run(run func(ctx context.Context, pvzID domain.PVZID)) *MockReceptionProvider_CloseLastReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PVZID))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProvider_CloseLastReception_Call) Return(reception *domain.Reception, receptionReconciliation *domain.ReceptionReconciliation, err error) *MockReceptionProvider_CloseLastReception_Call {
+	_c.Call.Return(reception, receptionReconciliation, err)
+	return _c
+}
+
+func (_c *MockReceptionProvider_CloseLastReception_Call) RunAndReturn(run func(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, *domain.ReceptionReconciliation, error)) *MockReceptionProvider_CloseLastReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockReceptionProvider
+func (_mock *MockReceptionProvider) Create(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, pvzID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, pvzID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PVZID) error); ok {
+		r1 = returnFunc(ctx, pvzID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReceptionProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - pvzID
+func (_e *MockReceptionProvider_Expecter) Create(ctx interface{}, pvzID interface{}) *MockReceptionProvider_Create_Call {
+	return &MockReceptionProvider_Create_Call{Call: _e.mock.On("Create", ctx, pvzID)}
+}
+
+func (_c *MockReceptionProvider_Create_Call) Run(run func(ctx context.Context, pvzID domain.PVZID)) *MockReceptionProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PVZID))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProvider_Create_Call) Return(reception *domain.Reception, err error) *MockReceptionProvider_Create_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockReceptionProvider_Create_Call) RunAndReturn(run func(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error)) *MockReceptionProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reopen provides a mock function for the type MockReceptionProvider
+func (_mock *MockReceptionProvider) Reopen(ctx context.Context, id uuid.UUID, justification string) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, id, justification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reopen")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, id, justification)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *domain.Reception); ok {
+		r0 = returnFunc(ctx, id, justification)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, id, justification)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionProvider_Reopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reopen'
+type MockReceptionProvider_Reopen_Call struct {
+	*mock.Call
+}
+
+// Reopen is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - justification
+func (_e *MockReceptionProvider_Expecter) Reopen(ctx interface{}, id interface{}, justification interface{}) *MockReceptionProvider_Reopen_Call {
+	return &MockReceptionProvider_Reopen_Call{Call: _e.mock.On("Reopen", ctx, id, justification)}
+}
+
+func (_c *MockReceptionProvider_Reopen_Call) Run(run func(ctx context.Context, id uuid.UUID, justification string)) *MockReceptionProvider_Reopen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProvider_Reopen_Call) Return(reception *domain.Reception, err error) *MockReceptionProvider_Reopen_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockReceptionProvider_Reopen_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, justification string) (*domain.Reception, error)) *MockReceptionProvider_Reopen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductProvider creates a new instance of MockProductProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductProvider {
+	mock := &MockProductProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductProvider is an autogenerated mock type for the ProductProvider type
+type MockProductProvider struct {
+	mock.Mock
+}
+
+type MockProductProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductProvider) EXPECT() *MockProductProvider_Expecter {
+	return &MockProductProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockProductProvider
+func (_mock *MockProductProvider) Create(ctx context.Context, protduct domain.ProductToAdd) (*domain.Product, error) {
+	ret := _mock.Called(ctx, protduct)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductToAdd) (*domain.Product, error)); ok {
+		return returnFunc(ctx, protduct)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductToAdd) *domain.Product); ok {
+		r0 = returnFunc(ctx, protduct)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductToAdd) error); ok {
+		r1 = returnFunc(ctx, protduct)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProductProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - protduct
+func (_e *MockProductProvider_Expecter) Create(ctx interface{}, protduct interface{}) *MockProductProvider_Create_Call {
+	return &MockProductProvider_Create_Call{Call: _e.mock.On("Create", ctx, protduct)}
+}
+
+func (_c *MockProductProvider_Create_Call) Run(run func(ctx context.Context, protduct domain.ProductToAdd)) *MockProductProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProductToAdd))
+	})
+	return _c
+}
+
+func (_c *MockProductProvider_Create_Call) Return(product *domain.Product, err error) *MockProductProvider_Create_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductProvider_Create_Call) RunAndReturn(run func(ctx context.Context, protduct domain.ProductToAdd) (*domain.Product, error)) *MockProductProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatch provides a mock function for the type MockProductProvider
+func (_mock *MockProductProvider) CreateBatch(ctx context.Context, batch domain.ProductBatch) ([]*domain.Product, error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 []*domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductBatch) ([]*domain.Product, error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductBatch) []*domain.Product); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductBatch) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductProvider_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type MockProductProvider_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx
+//   - batch
+func (_e *MockProductProvider_Expecter) CreateBatch(ctx interface{}, batch interface{}) *MockProductProvider_CreateBatch_Call {
+	return &MockProductProvider_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, batch)}
+}
+
+func (_c *MockProductProvider_CreateBatch_Call) Run(run func(ctx context.Context, batch domain.ProductBatch)) *MockProductProvider_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProductBatch))
+	})
+	return _c
+}
+
+func (_c *MockProductProvider_CreateBatch_Call) Return(products []*domain.Product, err error) *MockProductProvider_CreateBatch_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockProductProvider_CreateBatch_Call) RunAndReturn(run func(ctx context.Context, batch domain.ProductBatch) ([]*domain.Product, error)) *MockProductProvider_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLast provides a mock function for the type MockProductProvider
+func (_mock *MockProductProvider) DeleteLast(ctx context.Context, pvzID domain.PVZID) error {
+	ret := _mock.Called(ctx, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLast")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) error); ok {
+		r0 = returnFunc(ctx, pvzID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductProvider_DeleteLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLast'
+type MockProductProvider_DeleteLast_Call struct {
+	*mock.Call
+}
+
+// DeleteLast is a helper method to define mock.On call
+//   - ctx
+//   - pvzID
+func (_e *MockProductProvider_Expecter) DeleteLast(ctx interface{}, pvzID interface{}) *MockProductProvider_DeleteLast_Call {
+	return &MockProductProvider_DeleteLast_Call{Call: _e.mock.On("DeleteLast", ctx, pvzID)}
+}
+
+func (_c *MockProductProvider_DeleteLast_Call) Run(run func(ctx context.Context, pvzID domain.PVZID)) *MockProductProvider_DeleteLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PVZID))
+	})
+	return _c
+}
+
+func (_c *MockProductProvider_DeleteLast_Call) Return(err error) *MockProductProvider_DeleteLast_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductProvider_DeleteLast_Call) RunAndReturn(run func(ctx context.Context, pvzID domain.PVZID) error) *MockProductProvider_DeleteLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Void provides a mock function for the type MockProductProvider
+func (_mock *MockProductProvider) Void(ctx context.Context, request domain.ProductVoidRequest) (*domain.Product, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Void")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductVoidRequest) (*domain.Product, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductVoidRequest) *domain.Product); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductVoidRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductProvider_Void_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Void'
+type MockProductProvider_Void_Call struct {
+	*mock.Call
+}
+
+// Void is a helper method to define mock.On call
+//   - ctx
+//   - request
+func (_e *MockProductProvider_Expecter) Void(ctx interface{}, request interface{}) *MockProductProvider_Void_Call {
+	return &MockProductProvider_Void_Call{Call: _e.mock.On("Void", ctx, request)}
+}
+
+func (_c *MockProductProvider_Void_Call) Run(run func(ctx context.Context, request domain.ProductVoidRequest)) *MockProductProvider_Void_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProductVoidRequest))
+	})
+	return _c
+}
+
+func (_c *MockProductProvider_Void_Call) Return(product *domain.Product, err error) *MockProductProvider_Void_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductProvider_Void_Call) RunAndReturn(run func(ctx context.Context, request domain.ProductVoidRequest) (*domain.Product, error)) *MockProductProvider_Void_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPasswordResetProvider creates a new instance of MockPasswordResetProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPasswordResetProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPasswordResetProvider {
+	mock := &MockPasswordResetProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPasswordResetProvider is an autogenerated mock type for the PasswordResetProvider type
+type MockPasswordResetProvider struct {
+	mock.Mock
+}
+
+type MockPasswordResetProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPasswordResetProvider) EXPECT() *MockPasswordResetProvider_Expecter {
+	return &MockPasswordResetProvider_Expecter{mock: &_m.Mock}
+}
+
+// Forgot provides a mock function for the type MockPasswordResetProvider
+func (_mock *MockPasswordResetProvider) Forgot(ctx context.Context, email string) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Forgot")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetProvider_Forgot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Forgot'
+type MockPasswordResetProvider_Forgot_Call struct {
+	*mock.Call
+}
+
+// Forgot is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockPasswordResetProvider_Expecter) Forgot(ctx interface{}, email interface{}) *MockPasswordResetProvider_Forgot_Call {
+	return &MockPasswordResetProvider_Forgot_Call{Call: _e.mock.On("Forgot", ctx, email)}
+}
+
+func (_c *MockPasswordResetProvider_Forgot_Call) Run(run func(ctx context.Context, email string)) *MockPasswordResetProvider_Forgot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_Forgot_Call) Return(err error) *MockPasswordResetProvider_Forgot_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_Forgot_Call) RunAndReturn(run func(ctx context.Context, email string) error) *MockPasswordResetProvider_Forgot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function for the type MockPasswordResetProvider
+func (_mock *MockPasswordResetProvider) Reset(ctx context.Context, token string, password string) error {
+	ret := _mock.Called(ctx, token, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, token, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetProvider_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type MockPasswordResetProvider_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - ctx
+//   - token
+//   - password
+func (_e *MockPasswordResetProvider_Expecter) Reset(ctx interface{}, token interface{}, password interface{}) *MockPasswordResetProvider_Reset_Call {
+	return &MockPasswordResetProvider_Reset_Call{Call: _e.mock.On("Reset", ctx, token, password)}
+}
+
+func (_c *MockPasswordResetProvider_Reset_Call) Run(run func(ctx context.Context, token string, password string)) *MockPasswordResetProvider_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_Reset_Call) Return(err error) *MockPasswordResetProvider_Reset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_Reset_Call) RunAndReturn(run func(ctx context.Context, token string, password string) error) *MockPasswordResetProvider_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStaffProvider creates a new instance of MockStaffProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStaffProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStaffProvider {
+	mock := &MockStaffProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStaffProvider is an autogenerated mock type for the StaffProvider type
+type MockStaffProvider struct {
+	mock.Mock
+}
+
+type MockStaffProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStaffProvider) EXPECT() *MockStaffProvider_Expecter {
+	return &MockStaffProvider_Expecter{mock: &_m.Mock}
+}
+
+// Assign provides a mock function for the type MockStaffProvider
+func (_mock *MockStaffProvider) Assign(ctx context.Context, pvzID domain.PVZID, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, pvzID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Assign")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, pvzID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStaffProvider_Assign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Assign'
+type MockStaffProvider_Assign_Call struct {
+	*mock.Call
+}
+
+// Assign is a helper method to define mock.On call
+//   - ctx
+//   - pvzID
+//   - userID
+func (_e *MockStaffProvider_Expecter) Assign(ctx interface{}, pvzID interface{}, userID interface{}) *MockStaffProvider_Assign_Call {
+	return &MockStaffProvider_Assign_Call{Call: _e.mock.On("Assign", ctx, pvzID, userID)}
+}
+
+func (_c *MockStaffProvider_Assign_Call) Run(run func(ctx context.Context, pvzID domain.PVZID, userID uuid.UUID)) *MockStaffProvider_Assign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PVZID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStaffProvider_Assign_Call) Return(err error) *MockStaffProvider_Assign_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStaffProvider_Assign_Call) RunAndReturn(run func(ctx context.Context, pvzID domain.PVZID, userID uuid.UUID) error) *MockStaffProvider_Assign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockStaffProvider
+func (_mock *MockStaffProvider) List(ctx context.Context, pvzID domain.PVZID) ([]domain.User, error) {
+	ret := _mock.Called(ctx, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) ([]domain.User, error)); ok {
+		return returnFunc(ctx, pvzID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) []domain.User); ok {
+		r0 = returnFunc(ctx, pvzID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PVZID) error); ok {
+		r1 = returnFunc(ctx, pvzID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStaffProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockStaffProvider_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - pvzID
+func (_e *MockStaffProvider_Expecter) List(ctx interface{}, pvzID interface{}) *MockStaffProvider_List_Call {
+	return &MockStaffProvider_List_Call{Call: _e.mock.On("List", ctx, pvzID)}
+}
+
+func (_c *MockStaffProvider_List_Call) Run(run func(ctx context.Context, pvzID domain.PVZID)) *MockStaffProvider_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PVZID))
+	})
+	return _c
+}
+
+func (_c *MockStaffProvider_List_Call) Return(users []domain.User, err error) *MockStaffProvider_List_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockStaffProvider_List_Call) RunAndReturn(run func(ctx context.Context, pvzID domain.PVZID) ([]domain.User, error)) *MockStaffProvider_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unassign provides a mock function for the type MockStaffProvider
+func (_mock *MockStaffProvider) Unassign(ctx context.Context, pvzID domain.PVZID, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, pvzID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unassign")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, pvzID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStaffProvider_Unassign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unassign'
+type MockStaffProvider_Unassign_Call struct {
+	*mock.Call
+}
+
+// Unassign is a helper method to define mock.On call
+//   - ctx
+//   - pvzID
+//   - userID
+func (_e *MockStaffProvider_Expecter) Unassign(ctx interface{}, pvzID interface{}, userID interface{}) *MockStaffProvider_Unassign_Call {
+	return &MockStaffProvider_Unassign_Call{Call: _e.mock.On("Unassign", ctx, pvzID, userID)}
+}
+
+func (_c *MockStaffProvider_Unassign_Call) Run(run func(ctx context.Context, pvzID domain.PVZID, userID uuid.UUID)) *MockStaffProvider_Unassign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PVZID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStaffProvider_Unassign_Call) Return(err error) *MockStaffProvider_Unassign_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStaffProvider_Unassign_Call) RunAndReturn(run func(ctx context.Context, pvzID domain.PVZID, userID uuid.UUID) error) *MockStaffProvider_Unassign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPIKeyProvider creates a new instance of MockAPIKeyProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyProvider {
+	mock := &MockAPIKeyProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAPIKeyProvider is an autogenerated mock type for the APIKeyProvider type
+type MockAPIKeyProvider struct {
+	mock.Mock
+}
+
+type MockAPIKeyProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyProvider) EXPECT() *MockAPIKeyProvider_Expecter {
+	return &MockAPIKeyProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockAPIKeyProvider
+func (_mock *MockAPIKeyProvider) Create(ctx context.Context, params domain.APIKeyToCreate) (*domain.APIKey, string, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.APIKey
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.APIKeyToCreate) (*domain.APIKey, string, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.APIKeyToCreate) *domain.APIKey); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.APIKeyToCreate) string); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.APIKeyToCreate) error); ok {
+		r2 = returnFunc(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAPIKeyProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAPIKeyProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - params
+func (_e *MockAPIKeyProvider_Expecter) Create(ctx interface{}, params interface{}) *MockAPIKeyProvider_Create_Call {
+	return &MockAPIKeyProvider_Create_Call{Call: _e.mock.On("Create", ctx, params)}
+}
+
+func (_c *MockAPIKeyProvider_Create_Call) Run(run func(ctx context.Context, params domain.APIKeyToCreate)) *MockAPIKeyProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.APIKeyToCreate))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyProvider_Create_Call) Return(aPIKey *domain.APIKey, s string, err error) *MockAPIKeyProvider_Create_Call {
+	_c.Call.Return(aPIKey, s, err)
+	return _c
+}
+
+func (_c *MockAPIKeyProvider_Create_Call) RunAndReturn(run func(ctx context.Context, params domain.APIKeyToCreate) (*domain.APIKey, string, error)) *MockAPIKeyProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockAPIKeyProvider
+func (_mock *MockAPIKeyProvider) List(ctx context.Context) ([]domain.APIKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.APIKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.APIKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAPIKeyProvider_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+func (_e *MockAPIKeyProvider_Expecter) List(ctx interface{}) *MockAPIKeyProvider_List_Call {
+	return &MockAPIKeyProvider_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockAPIKeyProvider_List_Call) Run(run func(ctx context.Context)) *MockAPIKeyProvider_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyProvider_List_Call) Return(aPIKeys []domain.APIKey, err error) *MockAPIKeyProvider_List_Call {
+	_c.Call.Return(aPIKeys, err)
+	return _c
+}
+
+func (_c *MockAPIKeyProvider_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.APIKey, error)) *MockAPIKeyProvider_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function for the type MockAPIKeyProvider
+func (_mock *MockAPIKeyProvider) Revoke(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPIKeyProvider_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockAPIKeyProvider_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockAPIKeyProvider_Expecter) Revoke(ctx interface{}, id interface{}) *MockAPIKeyProvider_Revoke_Call {
+	return &MockAPIKeyProvider_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
+}
+
+func (_c *MockAPIKeyProvider_Revoke_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyProvider_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyProvider_Revoke_Call) Return(err error) *MockAPIKeyProvider_Revoke_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPIKeyProvider_Revoke_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockAPIKeyProvider_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuditProvider creates a new instance of MockAuditProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditProvider {
+	mock := &MockAuditProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuditProvider is an autogenerated mock type for the AuditProvider type
+type MockAuditProvider struct {
+	mock.Mock
+}
+
+type MockAuditProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditProvider) EXPECT() *MockAuditProvider_Expecter {
+	return &MockAuditProvider_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function for the type MockAuditProvider
+func (_mock *MockAuditProvider) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.AuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuditFilter) ([]domain.AuditEntry, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuditFilter) []domain.AuditEntry); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuditFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAuditProvider_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - filter
+func (_e *MockAuditProvider_Expecter) List(ctx interface{}, filter interface{}) *MockAuditProvider_List_Call {
+	return &MockAuditProvider_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockAuditProvider_List_Call) Run(run func(ctx context.Context, filter domain.AuditFilter)) *MockAuditProvider_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuditFilter))
+	})
+	return _c
+}
+
+func (_c *MockAuditProvider_List_Call) Return(auditEntrys []domain.AuditEntry, err error) *MockAuditProvider_List_Call {
+	_c.Call.Return(auditEntrys, err)
+	return _c
+}
+
+func (_c *MockAuditProvider_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)) *MockAuditProvider_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCityProvider creates a new instance of MockCityProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCityProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCityProvider {
+	mock := &MockCityProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCityProvider is an autogenerated mock type for the CityProvider type
+type MockCityProvider struct {
+	mock.Mock
+}
+
+type MockCityProvider_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockUserProvider) EXPECT() *MockUserProvider_Expecter {
-	return &MockUserProvider_Expecter{mock: &_m.Mock}
+func (_m *MockCityProvider) EXPECT() *MockCityProvider_Expecter {
+	return &MockCityProvider_Expecter{mock: &_m.Mock}
 }
 
-// Auth provides a mock function for the type MockUserProvider
-func (_mock *MockUserProvider) Auth(ctx context.Context, email string, password string) (*string, error) {
-	ret := _mock.Called(ctx, email, password)
+// Create provides a mock function for the type MockCityProvider
+func (_mock *MockCityProvider) Create(ctx context.Context, name string) (*domain.City, error) {
+	ret := _mock.Called(ctx, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Auth")
+		panic("no return value specified for Create")
 	}
 
-	var r0 *string
+	var r0 *domain.City
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*string, error)); ok {
-		return returnFunc(ctx, email, password)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.City, error)); ok {
+		return returnFunc(ctx, name)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *string); ok {
-		r0 = returnFunc(ctx, email, password)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.City); ok {
+		r0 = returnFunc(ctx, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*string)
+			r0 = ret.Get(0).(*domain.City)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, email, password)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserProvider_Auth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Auth'
-type MockUserProvider_Auth_Call struct {
+// MockCityProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCityProvider_Create_Call struct {
 	*mock.Call
 }
 
-// Auth is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx
-//   - email
-//   - password
-func (_e *MockUserProvider_Expecter) Auth(ctx interface{}, email interface{}, password interface{}) *MockUserProvider_Auth_Call {
-	return &MockUserProvider_Auth_Call{Call: _e.mock.On("Auth", ctx, email, password)}
+//   - name
+func (_e *MockCityProvider_Expecter) Create(ctx interface{}, name interface{}) *MockCityProvider_Create_Call {
+	return &MockCityProvider_Create_Call{Call: _e.mock.On("Create", ctx, name)}
 }
 
-func (_c *MockUserProvider_Auth_Call) Run(run func(ctx context.Context, email string, password string)) *MockUserProvider_Auth_Call {
+func (_c *MockCityProvider_Create_Call) Run(run func(ctx context.Context, name string)) *MockCityProvider_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockUserProvider_Auth_Call) Return(s *string, err error) *MockUserProvider_Auth_Call {
-	_c.Call.Return(s, err)
+func (_c *MockCityProvider_Create_Call) Return(city *domain.City, err error) *MockCityProvider_Create_Call {
+	_c.Call.Return(city, err)
 	return _c
 }
 
-func (_c *MockUserProvider_Auth_Call) RunAndReturn(run func(ctx context.Context, email string, password string) (*string, error)) *MockUserProvider_Auth_Call {
+func (_c *MockCityProvider_Create_Call) RunAndReturn(run func(ctx context.Context, name string) (*domain.City, error)) *MockCityProvider_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockUserProvider
-func (_mock *MockUserProvider) Create(ctx context.Context, email domain.Email, password string, role domain.Role) (*domain.User, error) {
-	ret := _mock.Called(ctx, email, password, role)
+// List provides a mock function for the type MockCityProvider
+func (_mock *MockCityProvider) List(ctx context.Context) ([]domain.City, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for List")
 	}
 
-	var r0 *domain.User
+	var r0 []domain.City
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Email, string, domain.Role) (*domain.User, error)); ok {
-		return returnFunc(ctx, email, password, role)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.City, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Email, string, domain.Role) *domain.User); ok {
-		r0 = returnFunc(ctx, email, password, role)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.City); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).([]domain.City)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Email, string, domain.Role) error); ok {
-		r1 = returnFunc(ctx, email, password, role)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockUserProvider_Create_Call struct {
+// MockCityProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockCityProvider_List_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// List is a helper method to define mock.On call
 //   - ctx
-//   - email
-//   - password
-//   - role
-func (_e *MockUserProvider_Expecter) Create(ctx interface{}, email interface{}, password interface{}, role interface{}) *MockUserProvider_Create_Call {
-	return &MockUserProvider_Create_Call{Call: _e.mock.On("Create", ctx, email, password, role)}
+func (_e *MockCityProvider_Expecter) List(ctx interface{}) *MockCityProvider_List_Call {
+	return &MockCityProvider_List_Call{Call: _e.mock.On("List", ctx)}
 }
 
-func (_c *MockUserProvider_Create_Call) Run(run func(ctx context.Context, email domain.Email, password string, role domain.Role)) *MockUserProvider_Create_Call {
+func (_c *MockCityProvider_List_Call) Run(run func(ctx context.Context)) *MockCityProvider_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Email), args[2].(string), args[3].(domain.Role))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockUserProvider_Create_Call) Return(user *domain.User, err error) *MockUserProvider_Create_Call {
-	_c.Call.Return(user, err)
+func (_c *MockCityProvider_List_Call) Return(citys []domain.City, err error) *MockCityProvider_List_Call {
+	_c.Call.Return(citys, err)
 	return _c
 }
 
-func (_c *MockUserProvider_Create_Call) RunAndReturn(run func(ctx context.Context, email domain.Email, password string, role domain.Role) (*domain.User, error)) *MockUserProvider_Create_Call {
+func (_c *MockCityProvider_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.City, error)) *MockCityProvider_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPVZProvider creates a new instance of MockPVZProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPVZProvider(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPVZProvider {
-	mock := &MockPVZProvider{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockPVZProvider is an autogenerated mock type for the PVZProvider type
-type MockPVZProvider struct {
-	mock.Mock
-}
-
-type MockPVZProvider_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockPVZProvider) EXPECT() *MockPVZProvider_Expecter {
-	return &MockPVZProvider_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockPVZProvider
-func (_mock *MockPVZProvider) Create(ctx context.Context, city domain.PvzCity) (*domain.PVZ, error) {
-	ret := _mock.Called(ctx, city)
+// SetActive provides a mock function for the type MockCityProvider
+func (_mock *MockCityProvider) SetActive(ctx context.Context, id uuid.UUID, active bool) (*domain.City, error) {
+	ret := _mock.Called(ctx, id, active)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for SetActive")
 	}
 
-	var r0 *domain.PVZ
+	var r0 *domain.City
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PvzCity) (*domain.PVZ, error)); ok {
-		return returnFunc(ctx, city)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) (*domain.City, error)); ok {
+		return returnFunc(ctx, id, active)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PvzCity) *domain.PVZ); ok {
-		r0 = returnFunc(ctx, city)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) *domain.City); ok {
+		r0 = returnFunc(ctx, id, active)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.PVZ)
+			r0 = ret.Get(0).(*domain.City)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PvzCity) error); ok {
-		r1 = returnFunc(ctx, city)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
+		r1 = returnFunc(ctx, id, active)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPVZProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockPVZProvider_Create_Call struct {
+// MockCityProvider_SetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetActive'
+type MockCityProvider_SetActive_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// SetActive is a helper method to define mock.On call
 //   - ctx
-//   - city
-func (_e *MockPVZProvider_Expecter) Create(ctx interface{}, city interface{}) *MockPVZProvider_Create_Call {
-	return &MockPVZProvider_Create_Call{Call: _e.mock.On("Create", ctx, city)}
+//   - id
+//   - active
+func (_e *MockCityProvider_Expecter) SetActive(ctx interface{}, id interface{}, active interface{}) *MockCityProvider_SetActive_Call {
+	return &MockCityProvider_SetActive_Call{Call: _e.mock.On("SetActive", ctx, id, active)}
 }
 
-func (_c *MockPVZProvider_Create_Call) Run(run func(ctx context.Context, city domain.PvzCity)) *MockPVZProvider_Create_Call {
+func (_c *MockCityProvider_SetActive_Call) Run(run func(ctx context.Context, id uuid.UUID, active bool)) *MockCityProvider_SetActive_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.PvzCity))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
 	})
 	return _c
 }
 
-func (_c *MockPVZProvider_Create_Call) Return(pVZ *domain.PVZ, err error) *MockPVZProvider_Create_Call {
-	_c.Call.Return(pVZ, err)
+func (_c *MockCityProvider_SetActive_Call) Return(city *domain.City, err error) *MockCityProvider_SetActive_Call {
+	_c.Call.Return(city, err)
 	return _c
 }
 
-func (_c *MockPVZProvider_Create_Call) RunAndReturn(run func(ctx context.Context, city domain.PvzCity) (*domain.PVZ, error)) *MockPVZProvider_Create_Call {
+func (_c *MockCityProvider_SetActive_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, active bool) (*domain.City, error)) *MockCityProvider_SetActive_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockPVZProvider
-func (_mock *MockPVZProvider) List(ctx context.Context, params domain.Params) (*[]domain.PVZAgregate, error) {
+// NewMockProductTypeProvider creates a new instance of MockProductTypeProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductTypeProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductTypeProvider {
+	mock := &MockProductTypeProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductTypeProvider is an autogenerated mock type for the ProductTypeProvider type
+type MockProductTypeProvider struct {
+	mock.Mock
+}
+
+type MockProductTypeProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductTypeProvider) EXPECT() *MockProductTypeProvider_Expecter {
+	return &MockProductTypeProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockProductTypeProvider
+func (_mock *MockProductTypeProvider) Create(ctx context.Context, params domain.ProductTypeToCreate) (*domain.ProductTypeInfo, error) {
 	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for Create")
 	}
 
-	var r0 *[]domain.PVZAgregate
+	var r0 *domain.ProductTypeInfo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) (*[]domain.PVZAgregate, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductTypeToCreate) (*domain.ProductTypeInfo, error)); ok {
 		return returnFunc(ctx, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) *[]domain.PVZAgregate); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductTypeToCreate) *domain.ProductTypeInfo); ok {
 		r0 = returnFunc(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*[]domain.PVZAgregate)
+			r0 = ret.Get(0).(*domain.ProductTypeInfo)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Params) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductTypeToCreate) error); ok {
 		r1 = returnFunc(ctx, params)
 	} else {
 		r1 = ret.Error(1)
@@ -349,183 +2541,156 @@ func (_mock *MockPVZProvider) List(ctx context.Context, params domain.Params) (*
 	return r0, r1
 }
 
-// MockPVZProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockPVZProvider_List_Call struct {
+// MockProductTypeProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProductTypeProvider_Create_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx
 //   - params
-func (_e *MockPVZProvider_Expecter) List(ctx interface{}, params interface{}) *MockPVZProvider_List_Call {
-	return &MockPVZProvider_List_Call{Call: _e.mock.On("List", ctx, params)}
+func (_e *MockProductTypeProvider_Expecter) Create(ctx interface{}, params interface{}) *MockProductTypeProvider_Create_Call {
+	return &MockProductTypeProvider_Create_Call{Call: _e.mock.On("Create", ctx, params)}
 }
 
-func (_c *MockPVZProvider_List_Call) Run(run func(ctx context.Context, params domain.Params)) *MockPVZProvider_List_Call {
+func (_c *MockProductTypeProvider_Create_Call) Run(run func(ctx context.Context, params domain.ProductTypeToCreate)) *MockProductTypeProvider_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Params))
+		run(args[0].(context.Context), args[1].(domain.ProductTypeToCreate))
 	})
 	return _c
 }
 
-func (_c *MockPVZProvider_List_Call) Return(pVZAgregates *[]domain.PVZAgregate, err error) *MockPVZProvider_List_Call {
-	_c.Call.Return(pVZAgregates, err)
+func (_c *MockProductTypeProvider_Create_Call) Return(productTypeInfo *domain.ProductTypeInfo, err error) *MockProductTypeProvider_Create_Call {
+	_c.Call.Return(productTypeInfo, err)
 	return _c
 }
 
-func (_c *MockPVZProvider_List_Call) RunAndReturn(run func(ctx context.Context, params domain.Params) (*[]domain.PVZAgregate, error)) *MockPVZProvider_List_Call {
+func (_c *MockProductTypeProvider_Create_Call) RunAndReturn(run func(ctx context.Context, params domain.ProductTypeToCreate) (*domain.ProductTypeInfo, error)) *MockProductTypeProvider_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockReceptionProvider creates a new instance of MockReceptionProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockReceptionProvider(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockReceptionProvider {
-	mock := &MockReceptionProvider{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockReceptionProvider is an autogenerated mock type for the ReceptionProvider type
-type MockReceptionProvider struct {
-	mock.Mock
-}
-
-type MockReceptionProvider_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockReceptionProvider) EXPECT() *MockReceptionProvider_Expecter {
-	return &MockReceptionProvider_Expecter{mock: &_m.Mock}
-}
-
-// CloseLastReception provides a mock function for the type MockReceptionProvider
-func (_mock *MockReceptionProvider) CloseLastReception(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error) {
-	ret := _mock.Called(ctx, pvzID)
+// List provides a mock function for the type MockProductTypeProvider
+func (_mock *MockProductTypeProvider) List(ctx context.Context) ([]domain.ProductTypeInfo, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CloseLastReception")
+		panic("no return value specified for List")
 	}
 
-	var r0 *domain.Reception
+	var r0 []domain.ProductTypeInfo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) (*domain.Reception, error)); ok {
-		return returnFunc(ctx, pvzID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.ProductTypeInfo, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) *domain.Reception); ok {
-		r0 = returnFunc(ctx, pvzID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.ProductTypeInfo); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Reception)
+			r0 = ret.Get(0).([]domain.ProductTypeInfo)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PVZID) error); ok {
-		r1 = returnFunc(ctx, pvzID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockReceptionProvider_CloseLastReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseLastReception'
-type MockReceptionProvider_CloseLastReception_Call struct {
+// MockProductTypeProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockProductTypeProvider_List_Call struct {
 	*mock.Call
 }
 
-// CloseLastReception is a helper method to define mock.On call
+// List is a helper method to define mock.On call
 //   - ctx
-//   - pvzID
-func (_e *MockReceptionProvider_Expecter) CloseLastReception(ctx interface{}, pvzID interface{}) *MockReceptionProvider_CloseLastReception_Call {
-	return &MockReceptionProvider_CloseLastReception_Call{Call: _e.mock.On("CloseLastReception", ctx, pvzID)}
+func (_e *MockProductTypeProvider_Expecter) List(ctx interface{}) *MockProductTypeProvider_List_Call {
+	return &MockProductTypeProvider_List_Call{Call: _e.mock.On("List", ctx)}
 }
 
-func (_c *MockReceptionProvider_CloseLastReception_Call) Run(run func(ctx context.Context, pvzID domain.PVZID)) *MockReceptionProvider_CloseLastReception_Call {
+func (_c *MockProductTypeProvider_List_Call) Run(run func(ctx context.Context)) *MockProductTypeProvider_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.PVZID))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockReceptionProvider_CloseLastReception_Call) Return(reception *domain.Reception, err error) *MockReceptionProvider_CloseLastReception_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockProductTypeProvider_List_Call) Return(productTypeInfos []domain.ProductTypeInfo, err error) *MockProductTypeProvider_List_Call {
+	_c.Call.Return(productTypeInfos, err)
 	return _c
 }
 
-func (_c *MockReceptionProvider_CloseLastReception_Call) RunAndReturn(run func(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error)) *MockReceptionProvider_CloseLastReception_Call {
+func (_c *MockProductTypeProvider_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.ProductTypeInfo, error)) *MockProductTypeProvider_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockReceptionProvider
-func (_mock *MockReceptionProvider) Create(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error) {
-	ret := _mock.Called(ctx, pvzID)
+// Update provides a mock function for the type MockProductTypeProvider
+func (_mock *MockProductTypeProvider) Update(ctx context.Context, code domain.ProductType, params domain.ProductTypeToUpdate) (*domain.ProductTypeInfo, error) {
+	ret := _mock.Called(ctx, code, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Update")
 	}
 
-	var r0 *domain.Reception
+	var r0 *domain.ProductTypeInfo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) (*domain.Reception, error)); ok {
-		return returnFunc(ctx, pvzID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductType, domain.ProductTypeToUpdate) (*domain.ProductTypeInfo, error)); ok {
+		return returnFunc(ctx, code, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) *domain.Reception); ok {
-		r0 = returnFunc(ctx, pvzID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductType, domain.ProductTypeToUpdate) *domain.ProductTypeInfo); ok {
+		r0 = returnFunc(ctx, code, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Reception)
+			r0 = ret.Get(0).(*domain.ProductTypeInfo)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PVZID) error); ok {
-		r1 = returnFunc(ctx, pvzID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductType, domain.ProductTypeToUpdate) error); ok {
+		r1 = returnFunc(ctx, code, params)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockReceptionProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockReceptionProvider_Create_Call struct {
+// MockProductTypeProvider_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProductTypeProvider_Update_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Update is a helper method to define mock.On call
 //   - ctx
-//   - pvzID
-func (_e *MockReceptionProvider_Expecter) Create(ctx interface{}, pvzID interface{}) *MockReceptionProvider_Create_Call {
-	return &MockReceptionProvider_Create_Call{Call: _e.mock.On("Create", ctx, pvzID)}
+//   - code
+//   - params
+func (_e *MockProductTypeProvider_Expecter) Update(ctx interface{}, code interface{}, params interface{}) *MockProductTypeProvider_Update_Call {
+	return &MockProductTypeProvider_Update_Call{Call: _e.mock.On("Update", ctx, code, params)}
 }
 
-func (_c *MockReceptionProvider_Create_Call) Run(run func(ctx context.Context, pvzID domain.PVZID)) *MockReceptionProvider_Create_Call {
+func (_c *MockProductTypeProvider_Update_Call) Run(run func(ctx context.Context, code domain.ProductType, params domain.ProductTypeToUpdate)) *MockProductTypeProvider_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.PVZID))
+		run(args[0].(context.Context), args[1].(domain.ProductType), args[2].(domain.ProductTypeToUpdate))
 	})
 	return _c
 }
 
-func (_c *MockReceptionProvider_Create_Call) Return(reception *domain.Reception, err error) *MockReceptionProvider_Create_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockProductTypeProvider_Update_Call) Return(productTypeInfo *domain.ProductTypeInfo, err error) *MockProductTypeProvider_Update_Call {
+	_c.Call.Return(productTypeInfo, err)
 	return _c
 }
 
-func (_c *MockReceptionProvider_Create_Call) RunAndReturn(run func(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error)) *MockReceptionProvider_Create_Call {
+func (_c *MockProductTypeProvider_Update_Call) RunAndReturn(run func(ctx context.Context, code domain.ProductType, params domain.ProductTypeToUpdate) (*domain.ProductTypeInfo, error)) *MockProductTypeProvider_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProductProvider creates a new instance of MockProductProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockASNProvider creates a new instance of MockASNProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProductProvider(t interface {
+func NewMockASNProvider(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProductProvider {
-	mock := &MockProductProvider{}
+}) *MockASNProvider {
+	mock := &MockASNProvider{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -533,118 +2698,129 @@ func NewMockProductProvider(t interface {
 	return mock
 }
 
-// MockProductProvider is an autogenerated mock type for the ProductProvider type
-type MockProductProvider struct {
+// MockASNProvider is an autogenerated mock type for the ASNProvider type
+type MockASNProvider struct {
 	mock.Mock
 }
 
-type MockProductProvider_Expecter struct {
+type MockASNProvider_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProductProvider) EXPECT() *MockProductProvider_Expecter {
-	return &MockProductProvider_Expecter{mock: &_m.Mock}
+func (_m *MockASNProvider) EXPECT() *MockASNProvider_Expecter {
+	return &MockASNProvider_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockProductProvider
-func (_mock *MockProductProvider) Create(ctx context.Context, protduct domain.ProductToAdd) (*domain.Product, error) {
-	ret := _mock.Called(ctx, protduct)
+// Create provides a mock function for the type MockASNProvider
+func (_mock *MockASNProvider) Create(ctx context.Context, req domain.ASNRequest) (*domain.ASN, error) {
+	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
-	var r0 *domain.Product
+	var r0 *domain.ASN
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductToAdd) (*domain.Product, error)); ok {
-		return returnFunc(ctx, protduct)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ASNRequest) (*domain.ASN, error)); ok {
+		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductToAdd) *domain.Product); ok {
-		r0 = returnFunc(ctx, protduct)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ASNRequest) *domain.ASN); ok {
+		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Product)
+			r0 = ret.Get(0).(*domain.ASN)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductToAdd) error); ok {
-		r1 = returnFunc(ctx, protduct)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ASNRequest) error); ok {
+		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProductProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockProductProvider_Create_Call struct {
+// MockASNProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockASNProvider_Create_Call struct {
 	*mock.Call
 }
 
 // Create is a helper method to define mock.On call
 //   - ctx
-//   - protduct
-func (_e *MockProductProvider_Expecter) Create(ctx interface{}, protduct interface{}) *MockProductProvider_Create_Call {
-	return &MockProductProvider_Create_Call{Call: _e.mock.On("Create", ctx, protduct)}
+//   - req
+func (_e *MockASNProvider_Expecter) Create(ctx interface{}, req interface{}) *MockASNProvider_Create_Call {
+	return &MockASNProvider_Create_Call{Call: _e.mock.On("Create", ctx, req)}
 }
 
-func (_c *MockProductProvider_Create_Call) Run(run func(ctx context.Context, protduct domain.ProductToAdd)) *MockProductProvider_Create_Call {
+func (_c *MockASNProvider_Create_Call) Run(run func(ctx context.Context, req domain.ASNRequest)) *MockASNProvider_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ProductToAdd))
+		run(args[0].(context.Context), args[1].(domain.ASNRequest))
 	})
 	return _c
 }
 
-func (_c *MockProductProvider_Create_Call) Return(product *domain.Product, err error) *MockProductProvider_Create_Call {
-	_c.Call.Return(product, err)
+func (_c *MockASNProvider_Create_Call) Return(aSN *domain.ASN, err error) *MockASNProvider_Create_Call {
+	_c.Call.Return(aSN, err)
 	return _c
 }
 
-func (_c *MockProductProvider_Create_Call) RunAndReturn(run func(ctx context.Context, protduct domain.ProductToAdd) (*domain.Product, error)) *MockProductProvider_Create_Call {
+func (_c *MockASNProvider_Create_Call) RunAndReturn(run func(ctx context.Context, req domain.ASNRequest) (*domain.ASN, error)) *MockASNProvider_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteLast provides a mock function for the type MockProductProvider
-func (_mock *MockProductProvider) DeleteLast(ctx context.Context, pvzID domain.PVZID) error {
-	ret := _mock.Called(ctx, pvzID)
+// Reconciliation provides a mock function for the type MockASNProvider
+func (_mock *MockASNProvider) Reconciliation(ctx context.Context, receptionID uuid.UUID) (*domain.ReceptionReconciliation, error) {
+	ret := _mock.Called(ctx, receptionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteLast")
+		panic("no return value specified for Reconciliation")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PVZID) error); ok {
-		r0 = returnFunc(ctx, pvzID)
+	var r0 *domain.ReceptionReconciliation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ReceptionReconciliation, error)); ok {
+		return returnFunc(ctx, receptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ReceptionReconciliation); ok {
+		r0 = returnFunc(ctx, receptionID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReceptionReconciliation)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockProductProvider_DeleteLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLast'
-type MockProductProvider_DeleteLast_Call struct {
+// MockASNProvider_Reconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconciliation'
+type MockASNProvider_Reconciliation_Call struct {
 	*mock.Call
 }
 
-// DeleteLast is a helper method to define mock.On call
+// Reconciliation is a helper method to define mock.On call
 //   - ctx
-//   - pvzID
-func (_e *MockProductProvider_Expecter) DeleteLast(ctx interface{}, pvzID interface{}) *MockProductProvider_DeleteLast_Call {
-	return &MockProductProvider_DeleteLast_Call{Call: _e.mock.On("DeleteLast", ctx, pvzID)}
+//   - receptionID
+func (_e *MockASNProvider_Expecter) Reconciliation(ctx interface{}, receptionID interface{}) *MockASNProvider_Reconciliation_Call {
+	return &MockASNProvider_Reconciliation_Call{Call: _e.mock.On("Reconciliation", ctx, receptionID)}
 }
 
-func (_c *MockProductProvider_DeleteLast_Call) Run(run func(ctx context.Context, pvzID domain.PVZID)) *MockProductProvider_DeleteLast_Call {
+func (_c *MockASNProvider_Reconciliation_Call) Run(run func(ctx context.Context, receptionID uuid.UUID)) *MockASNProvider_Reconciliation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.PVZID))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockProductProvider_DeleteLast_Call) Return(err error) *MockProductProvider_DeleteLast_Call {
-	_c.Call.Return(err)
+func (_c *MockASNProvider_Reconciliation_Call) Return(receptionReconciliation *domain.ReceptionReconciliation, err error) *MockASNProvider_Reconciliation_Call {
+	_c.Call.Return(receptionReconciliation, err)
 	return _c
 }
 
-func (_c *MockProductProvider_DeleteLast_Call) RunAndReturn(run func(ctx context.Context, pvzID domain.PVZID) error) *MockProductProvider_DeleteLast_Call {
+func (_c *MockASNProvider_Reconciliation_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID) (*domain.ReceptionReconciliation, error)) *MockASNProvider_Reconciliation_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,9 +1,17 @@
 package httpserver
 
 import (
+	"avito_pvz/internal/http/gen"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/ratelimit"
 	"context"
+	"encoding/json"
+	"errors"
 	"log/slog"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -32,6 +40,7 @@ func LoggingMiddleware(logger *slog.Logger) func(http.Handler) http.Handler {
 			// Add logger and request ID to context
 			ctx := context.WithValue(r.Context(), loggerKey, childLogger)
 			ctx = context.WithValue(ctx, requestIDKey, requestID)
+			ctx = domain.WithRequestID(ctx, requestID)
 			r = r.WithContext(ctx)
 
 			// Log request start
@@ -52,8 +61,17 @@ func LoggingMiddleware(logger *slog.Logger) func(http.Handler) http.Handler {
 	}
 }
 
-// AuthMiddleware checks for valid JWT token in Authorization header.
-func AuthMiddleware(exceptPaths map[string]bool) func(http.Handler) http.Handler {
+// Authenticator validates access tokens and resolves the caller identity.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*domain.Identity, error)
+}
+
+// AuthMiddleware checks for valid JWT token in Authorization header
+// and puts the user identity into the request context.
+func AuthMiddleware(
+	authenticator Authenticator,
+	exceptPaths map[string]bool,
+) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if exceptPaths[r.URL.Path] {
@@ -67,16 +85,154 @@ func AuthMiddleware(exceptPaths map[string]bool) func(http.Handler) http.Handler
 				logger = slog.Default()
 			}
 
-			token := r.Header.Get("Authorization")
-			if token == "" {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
 				logger.Error("missing authorization token")
-				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				writeError(w, http.StatusUnauthorized, domain.ErrUnauthorized)
+
+				return
+			}
+
+			identity, err := authenticator.Authenticate(r.Context(), token)
+			if err != nil {
+				logger.Error("invalid authorization token", slog.Any("error", err))
+				writeError(w, http.StatusUnauthorized, domain.ErrUnauthorized)
 
 				return
 			}
 
-			// TODO: Validate JWT token here
-			// For now, we'll just pass it through
+			ctx := domain.WithIdentity(r.Context(), *identity)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// AccessMiddleware checks the caller role against the policy
+// for the operation being served.
+func AccessMiddleware(policy domain.AccessPolicy) gen.StrictMiddlewareFunc {
+	return func(f gen.StrictHandlerFunc, operationID string) gen.StrictHandlerFunc {
+		return func(
+			ctx context.Context,
+			w http.ResponseWriter,
+			r *http.Request,
+			request any,
+		) (any, error) {
+			identity, _ := domain.IdentityFromCtx(ctx)
+
+			err := policy.Check(operationID, identity)
+			if errors.Is(err, domain.ErrUnauthorized) {
+				writeError(w, http.StatusUnauthorized, err)
+
+				return nil, nil
+			}
+
+			if err != nil {
+				writeError(w, http.StatusForbidden, err)
+
+				return nil, nil
+			}
+
+			return f(ctx, w, r, request)
+		}
+	}
+}
+
+// RateLimiter decides whether the caller may invoke the operation now.
+// AllowIP applies the per-address limit shared by all operations.
+type RateLimiter interface {
+	Allow(operation string, identity *domain.Identity, ip string) (time.Duration, bool)
+	AllowIP(ip string) (time.Duration, bool)
+}
+
+// RateLimitMiddleware throttles callers by user, or by client IP for
+// anonymous requests, and answers 429 with Retry-After when the limit
+// for the operation is exhausted.
+func RateLimitMiddleware(limiter RateLimiter) gen.StrictMiddlewareFunc {
+	return func(f gen.StrictHandlerFunc, operationID string) gen.StrictHandlerFunc {
+		return func(
+			ctx context.Context,
+			w http.ResponseWriter,
+			r *http.Request,
+			request any,
+		) (any, error) {
+			identity, _ := domain.IdentityFromCtx(ctx)
+
+			wait, ok := limiter.Allow(operationID, identity, domain.ClientIPFromCtx(ctx))
+			if !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(ratelimit.RetryAfter(wait)))
+				writeError(w, http.StatusTooManyRequests, domain.ErrRateLimited)
+
+				return nil, nil
+			}
+
+			return f(ctx, w, r, request)
+		}
+	}
+}
+
+// StrictErrorOptions answers errors that escape the strict handlers in the
+// format described by the OpenAPI schema. Handlers return typed responses
+// for expected failures with a nil error; anything that reaches
+// ResponseErrorHandlerFunc is unexpected and is logged without exposing
+// its text to the client.
+func StrictErrorOptions() gen.StrictHTTPServerOptions {
+	return gen.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeError(w, http.StatusBadRequest, err)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			logger, ok := r.Context().Value(loggerKey).(*slog.Logger)
+			if !ok {
+				logger = slog.Default()
+			}
+
+			logger.Error("request failed", slog.Any("error", err))
+			writeError(w, http.StatusInternalServerError, domain.ErrInternal)
+		},
+	}
+}
+
+// writeError writes an error in the format described by the OpenAPI schema.
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(gen.Error{Message: err.Error()})
+}
+
+// ClientIPMiddleware puts the client address into the request context.
+// X-Forwarded-For is honoured only when the connection comes from one of
+// the trusted proxies: otherwise the header can be spoofed by the client
+// and must not affect rate limits or login lockouts.
+func ClientIPMiddleware(proxies domain.TrustedProxies) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			peer, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				peer = r.RemoteAddr
+			}
+
+			ip := proxies.ClientIP(peer, r.Header.Values("X-Forwarded-For"))
+
+			next.ServeHTTP(w, r.WithContext(domain.WithClientIP(r.Context(), ip)))
+		})
+	}
+}
+
+// IPRateLimitMiddleware throttles every request by client IP before
+// authentication, so requests with missing or forged tokens are limited
+// too. It must run after ClientIPMiddleware.
+func IPRateLimitMiddleware(limiter RateLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			wait, ok := limiter.AllowIP(domain.ClientIPFromCtx(r.Context()))
+			if !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(ratelimit.RetryAfter(wait)))
+				writeError(w, http.StatusTooManyRequests, domain.ErrRateLimited)
+
+				return
+			}
 
 			next.ServeHTTP(w, r)
 		})
@@ -0,0 +1,483 @@
+package httpserver_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	httpserver "avito_pvz/internal/http"
+	"avito_pvz/internal/http/gen"
+	"avito_pvz/internal/models/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name         string
+		path         string
+		header       string
+		setupMocks   func(v *httpserver.MockAuthenticator)
+		wantCode     int
+		wantIdentity *domain.Identity
+	}{
+		{
+			name:     "except_path_without_token",
+			path:     "/login",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "missing_token",
+			path:     "/pvz",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "not_bearer_token",
+			path:     "/pvz",
+			header:   "Basic abc",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "invalid_token",
+			path:   "/pvz",
+			header: "Bearer bad",
+			setupMocks: func(v *httpserver.MockAuthenticator) {
+				v.On("Authenticate", mock.Anything, "bad").Return(nil, errors.New("bad token"))
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "revoked_session",
+			path:   "/pvz",
+			header: "Bearer revoked",
+			setupMocks: func(v *httpserver.MockAuthenticator) {
+				v.On("Authenticate", mock.Anything, "revoked").
+					Return(nil, errors.New("session revoked"))
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "valid_token",
+			path:   "/pvz",
+			header: "Bearer token",
+			setupMocks: func(v *httpserver.MockAuthenticator) {
+				v.On("Authenticate", mock.Anything, "token").
+					Return(&domain.Identity{UserID: userID, Role: domain.RoleEmploye}, nil)
+			},
+			wantCode:     http.StatusOK,
+			wantIdentity: &domain.Identity{UserID: userID, Role: domain.RoleEmploye},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authenticator := httpserver.NewMockAuthenticator(t)
+			if tt.setupMocks != nil {
+				tt.setupMocks(authenticator)
+			}
+
+			var gotIdentity *domain.Identity
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotIdentity, _ = domain.IdentityFromCtx(r.Context())
+			})
+
+			h := httpserver.AuthMiddleware(authenticator, map[string]bool{"/login": true})(next)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantCode, rec.Code)
+			require.Equal(t, tt.wantIdentity, gotIdentity)
+		})
+	}
+}
+
+func TestAccessMiddleware(t *testing.T) {
+	t.Parallel()
+
+	apiKeyID := uuid.New()
+
+	tests := []struct {
+		name      string
+		operation string
+		identity  *domain.Identity
+		wantCode  int
+	}{
+		{
+			name:      "public_operation",
+			operation: "PostLogin",
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "no_identity",
+			operation: "GetPvz",
+			wantCode:  http.StatusUnauthorized,
+		},
+		{
+			name:      "moderator_creates_pvz",
+			operation: "PostPvz",
+			identity:  &domain.Identity{Role: domain.RoleModerator},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "employee_creates_pvz",
+			operation: "PostPvz",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "moderator_adds_product",
+			operation: "PostProducts",
+			identity:  &domain.Identity{Role: domain.RoleModerator},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "employee_lists_pvz",
+			operation: "GetPvz",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "api_key_with_scope",
+			operation: "PostReceptions",
+			identity: &domain.Identity{
+				Role:     domain.RoleEmploye,
+				Actor:    domain.ActorAPIKey,
+				APIKeyID: apiKeyID,
+				Scopes:   []domain.Scope{domain.ScopeReceptionsWrite},
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "api_key_without_scope",
+			operation: "PostProducts",
+			identity: &domain.Identity{
+				Role:     domain.RoleEmploye,
+				Actor:    domain.ActorAPIKey,
+				APIKeyID: apiKeyID,
+				Scopes:   []domain.Scope{domain.ScopeReceptionsWrite},
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:      "api_key_scope_does_not_override_role",
+			operation: "PostPvz",
+			identity: &domain.Identity{
+				Role:     domain.RoleEmploye,
+				Actor:    domain.ActorAPIKey,
+				APIKeyID: apiKeyID,
+				Scopes:   []domain.Scope{domain.ScopePVZWrite},
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:      "api_key_on_operation_without_scope",
+			operation: "GetUsers",
+			identity: &domain.Identity{
+				Role:     domain.RoleModerator,
+				Actor:    domain.ActorAPIKey,
+				APIKeyID: apiKeyID,
+				Scopes:   []domain.Scope{domain.ScopePVZRead, domain.ScopePVZWrite},
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:      "moderator_reads_audit",
+			operation: "GetAudit",
+			identity:  &domain.Identity{Role: domain.RoleModerator},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "employee_reads_audit",
+			operation: "GetAudit",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "employee_lists_cities",
+			operation: "GetCities",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "employee_creates_city",
+			operation: "PostCities",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "api_key_lists_product_types",
+			operation: "GetProductTypes",
+			identity: &domain.Identity{
+				Role:     domain.RoleEmploye,
+				Actor:    domain.ActorAPIKey,
+				APIKeyID: apiKeyID,
+				Scopes:   []domain.Scope{domain.ScopeProductsRead},
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "api_key_write_scope_does_not_read_product_types",
+			operation: "GetProductTypes",
+			identity: &domain.Identity{
+				Role:     domain.RoleEmploye,
+				Actor:    domain.ActorAPIKey,
+				APIKeyID: apiKeyID,
+				Scopes:   []domain.Scope{domain.ScopeProductsWrite},
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:      "employee_updates_product_type",
+			operation: "PutProductTypesCode",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "employee_edits_pvz_profile",
+			operation: "PatchPvzPvzId",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "employee_suspends_pvz",
+			operation: "PostPvzPvzIdSuspend",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "moderator_closes_pvz",
+			operation: "PostPvzPvzIdClose",
+			identity:  &domain.Identity{Role: domain.RoleModerator},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "moderator_adds_product_batch",
+			operation: "PostPvzPvzIdProductsBatch",
+			identity:  &domain.Identity{Role: domain.RoleModerator},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "employee_voids_product",
+			operation: "PostPvzPvzIdProductsVoid",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "employee_reopens_reception",
+			operation: "PostReceptionsReceptionIdReopen",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "moderator_reopens_reception",
+			operation: "PostReceptionsReceptionIdReopen",
+			identity:  &domain.Identity{Role: domain.RoleModerator},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "employee_creates_asn",
+			operation: "PostPvzPvzIdAsn",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "moderator_creates_asn",
+			operation: "PostPvzPvzIdAsn",
+			identity:  &domain.Identity{Role: domain.RoleModerator},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "employee_reads_reconciliation",
+			operation: "GetReceptionsReceptionIdReconciliation",
+			identity:  &domain.Identity{Role: domain.RoleEmploye},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "unknown_operation",
+			operation: "DeleteEverything",
+			identity:  &domain.Identity{Role: domain.RoleModerator},
+			wantCode:  http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(
+				ctx context.Context,
+				w http.ResponseWriter,
+				r *http.Request,
+				request any,
+			) (any, error) {
+				w.WriteHeader(http.StatusOK)
+
+				return nil, nil
+			}
+
+			mw := httpserver.AccessMiddleware(httpserver.AccessPolicy)
+			h := mw(gen.StrictHandlerFunc(handler), tt.operation)
+
+			ctx := context.Background()
+			if tt.identity != nil {
+				ctx = domain.WithIdentity(ctx, *tt.identity)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil).WithContext(ctx)
+			rec := httptest.NewRecorder()
+
+			_, err := h(ctx, rec, req, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	identity := domain.Identity{UserID: uuid.New(), Role: domain.RoleEmploye}
+
+	tests := []struct {
+		name       string
+		identity   *domain.Identity
+		setupMocks func(l *httpserver.MockRateLimiter)
+		wantCode   int
+		wantRetry  string
+	}{
+		{
+			name: "anonymous_allowed",
+			setupMocks: func(l *httpserver.MockRateLimiter) {
+				l.On("Allow", "PostProducts", (*domain.Identity)(nil), "10.0.0.7").
+					Return(time.Duration(0), true)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "user_throttled",
+			identity: &identity,
+			setupMocks: func(l *httpserver.MockRateLimiter) {
+				l.On("Allow", "PostProducts", &identity, "10.0.0.7").
+					Return(200*time.Millisecond, false)
+			},
+			wantCode:  http.StatusTooManyRequests,
+			wantRetry: "1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := httpserver.NewMockRateLimiter(t)
+			tt.setupMocks(limiter)
+
+			handler := func(
+				ctx context.Context,
+				w http.ResponseWriter,
+				r *http.Request,
+				request any,
+			) (any, error) {
+				w.WriteHeader(http.StatusOK)
+
+				return nil, nil
+			}
+
+			mw := httpserver.RateLimitMiddleware(limiter)
+			h := mw(gen.StrictHandlerFunc(handler), "PostProducts")
+
+			ctx := domain.WithClientIP(context.Background(), "10.0.0.7")
+			if tt.identity != nil {
+				ctx = domain.WithIdentity(ctx, *tt.identity)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/products", nil).WithContext(ctx)
+			rec := httptest.NewRecorder()
+
+			_, err := h(ctx, rec, req, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCode, rec.Code)
+			require.Equal(t, tt.wantRetry, rec.Header().Get("Retry-After"))
+		})
+	}
+}
+
+func TestIPRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	proxies, err := domain.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		setupMocks func(l *httpserver.MockRateLimiter)
+		wantCode   int
+		wantRetry  string
+	}{
+		{
+			name:       "forwarded_by_trusted_proxy",
+			remoteAddr: "10.0.0.7:51000",
+			forwarded:  "203.0.113.9, 10.0.0.8",
+			setupMocks: func(l *httpserver.MockRateLimiter) {
+				l.On("AllowIP", "203.0.113.9").Return(time.Duration(0), true)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:       "spoofed_header_from_untrusted_peer",
+			remoteAddr: "198.51.100.4:51000",
+			forwarded:  "203.0.113.9",
+			setupMocks: func(l *httpserver.MockRateLimiter) {
+				l.On("AllowIP", "198.51.100.4").Return(1500*time.Millisecond, false)
+			},
+			wantCode:  http.StatusTooManyRequests,
+			wantRetry: "2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := httpserver.NewMockRateLimiter(t)
+			tt.setupMocks(limiter)
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			h := httpserver.ClientIPMiddleware(proxies)(
+				httpserver.IPRateLimitMiddleware(limiter)(next),
+			)
+
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			req.RemoteAddr = tt.remoteAddr
+			req.Header.Set("X-Forwarded-For", tt.forwarded)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantCode, rec.Code)
+			require.Equal(t, tt.wantRetry, rec.Header().Get("Retry-After"))
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package httpserver
+
+import "avito_pvz/internal/models/domain"
+
+// AccessPolicy describes which roles may call each OpenAPI operation
+// and which scope an API key needs for it.
+// Operations missing from the table are denied.
+var AccessPolicy = domain.AccessPolicy{
+	"PostDummyLogin":       {Public: true},
+	"PostLogin":            {Public: true},
+	"PostRegister":         {Public: true},
+	"PostTokenRefresh":     {Public: true},
+	"PostPasswordForgot":   {Public: true},
+	"PostPasswordReset":    {Public: true},
+	"GetWellKnownJwksJson": {Public: true},
+	"PostLogout": {
+		Roles: []domain.Role{domain.RoleEmploye, domain.RoleModerator},
+	},
+	"GetPvz": {
+		Roles: []domain.Role{domain.RoleEmploye, domain.RoleModerator},
+		Scope: domain.ScopePVZRead,
+	},
+	"PostPvz": {
+		Roles: []domain.Role{domain.RoleModerator},
+		Scope: domain.ScopePVZWrite,
+	},
+	"GetCities": {
+		Roles: []domain.Role{domain.RoleEmploye, domain.RoleModerator},
+		Scope: domain.ScopePVZRead,
+	},
+	"PostCities": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PostCitiesCityIdActivate": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PostCitiesCityIdDeactivate": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"GetProductTypes": {
+		Roles: []domain.Role{domain.RoleEmploye, domain.RoleModerator},
+		Scope: domain.ScopeProductsRead,
+	},
+	"PostProductTypes": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PutProductTypesCode": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"GetPvzNearest": {
+		Roles: []domain.Role{domain.RoleEmploye, domain.RoleModerator},
+		Scope: domain.ScopePVZRead,
+	},
+	"PatchPvzPvzId": {
+		Roles: []domain.Role{domain.RoleModerator},
+		Scope: domain.ScopePVZWrite,
+	},
+	"PostPvzPvzIdSuspend": {
+		Roles: []domain.Role{domain.RoleModerator},
+		Scope: domain.ScopePVZWrite,
+	},
+	"PostPvzPvzIdReopen": {
+		Roles: []domain.Role{domain.RoleModerator},
+		Scope: domain.ScopePVZWrite,
+	},
+	"PostPvzPvzIdClose": {
+		Roles: []domain.Role{domain.RoleModerator},
+		Scope: domain.ScopePVZWrite,
+	},
+	"GetPvzPvzIdHistory": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"GetPvzPvzIdStaff": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PutPvzPvzIdStaffUserId": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"DeletePvzPvzIdStaffUserId": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"GetUsers": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PostUsersUserIdRole": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PostUsersUserIdActivate": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PostUsersUserIdDeactivate": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PostUsersUserIdPasswordReset": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"GetApiKeys": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PostApiKeys": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"DeleteApiKeysKeyId": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"GetAudit": {
+		Roles: []domain.Role{domain.RoleModerator},
+	},
+	"PostReceptions": {
+		Roles: []domain.Role{domain.RoleEmploye},
+		Scope: domain.ScopeReceptionsWrite,
+	},
+	"PostPvzPvzIdCloseLastReception": {
+		Roles: []domain.Role{domain.RoleEmploye},
+		Scope: domain.ScopeReceptionsWrite,
+	},
+	"PostPvzPvzIdAsn": {
+		Roles: []domain.Role{domain.RoleModerator},
+		Scope: domain.ScopeReceptionsWrite,
+	},
+	"GetReceptionsReceptionIdReconciliation": {
+		Roles: []domain.Role{domain.RoleEmploye, domain.RoleModerator},
+		Scope: domain.ScopePVZRead,
+	},
+	"PostReceptionsReceptionIdReopen": {
+		Roles: []domain.Role{domain.RoleModerator},
+		Scope: domain.ScopeReceptionsWrite,
+	},
+	"PostProducts": {
+		Roles: []domain.Role{domain.RoleEmploye},
+		Scope: domain.ScopeProductsWrite,
+	},
+	"PostPvzPvzIdProductsBatch": {
+		Roles: []domain.Role{domain.RoleEmploye},
+		Scope: domain.ScopeProductsWrite,
+	},
+	"PostPvzPvzIdProductsVoid": {
+		Roles: []domain.Role{domain.RoleEmploye},
+		Scope: domain.ScopeProductsWrite,
+	},
+	"PostPvzPvzIdDeleteLastProduct": {
+		Roles: []domain.Role{domain.RoleEmploye},
+		Scope: domain.ScopeProductsWrite,
+	},
+}
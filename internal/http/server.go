@@ -3,14 +3,22 @@ package httpserver
 
 import (
 	"avito_pvz/internal/http/gen"
+	"avito_pvz/internal/models"
 	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/ratelimit"
 	"context"
+	"errors"
+	"time"
 
-	"github.com/oapi-codegen/runtime/types"
+	"github.com/google/uuid"
 )
 
 type JWTGenerator interface {
-	GenerateToken(email, role string) (string, error)
+	GenerateDummyToken(role string) (string, error)
+}
+
+type KeySetProvider interface {
+	JWKS() domain.JWKSet
 }
 
 type UserProvider interface {
@@ -20,30 +28,116 @@ type UserProvider interface {
 		password string,
 		role domain.Role,
 	) (*domain.User, error)
-	Auth(ctx context.Context, email string, password string) (*string, error)
+	Auth(ctx context.Context, email string, password string) (*domain.TokenPair, error)
+	List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)
+	ChangeRole(ctx context.Context, id uuid.UUID, role domain.Role) (*domain.User, error)
+	SetActive(ctx context.Context, id uuid.UUID, active bool) (*domain.User, error)
+	ResetPassword(ctx context.Context, id uuid.UUID) (string, error)
+}
+
+type SessionProvider interface {
+	Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error)
+	Logout(ctx context.Context, sessionID uuid.UUID) error
 }
 
 type PVZProvider interface {
 	List(ctx context.Context, params domain.Params) (*[]domain.PVZAgregate, error)
-	Create(ctx context.Context, city domain.PvzCity) (*domain.PVZ, error)
+	Create(
+		ctx context.Context,
+		city domain.PvzCity,
+		profile domain.PVZProfileUpdate,
+	) (*domain.PVZ, error)
+	UpdateProfile(
+		ctx context.Context,
+		id domain.PVZID,
+		profile domain.PVZProfileUpdate,
+	) (*domain.PVZ, error)
+	History(ctx context.Context, id domain.PVZID) ([]domain.PVZProfileChange, error)
+	Nearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error)
+	ChangeStatus(
+		ctx context.Context,
+		id domain.PVZID,
+		to domain.PVZStatus,
+		reason string,
+	) (*domain.PVZ, error)
 }
 
 type ReceptionProvider interface {
-	CloseLastReception(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error)
+	CloseLastReception(
+		ctx context.Context,
+		pvzID domain.PVZID,
+	) (*domain.Reception, *domain.ReceptionReconciliation, error)
 	Create(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error)
+	Reopen(ctx context.Context, id uuid.UUID, justification string) (*domain.Reception, error)
 }
 
 type ProductProvider interface {
 	Create(ctx context.Context, protduct domain.ProductToAdd) (*domain.Product, error)
 	DeleteLast(ctx context.Context, pvzID domain.PVZID) error
+	CreateBatch(ctx context.Context, batch domain.ProductBatch) ([]*domain.Product, error)
+	Void(ctx context.Context, request domain.ProductVoidRequest) (*domain.Product, error)
+}
+
+type PasswordResetProvider interface {
+	Forgot(ctx context.Context, email string) error
+	Reset(ctx context.Context, token string, password string) error
+}
+
+type StaffProvider interface {
+	Assign(ctx context.Context, pvzID domain.PVZID, userID uuid.UUID) error
+	Unassign(ctx context.Context, pvzID domain.PVZID, userID uuid.UUID) error
+	List(ctx context.Context, pvzID domain.PVZID) ([]domain.User, error)
+}
+
+type APIKeyProvider interface {
+	Create(ctx context.Context, params domain.APIKeyToCreate) (*domain.APIKey, string, error)
+	List(ctx context.Context) ([]domain.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+}
+
+type AuditProvider interface {
+	List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+}
+
+type CityProvider interface {
+	Create(ctx context.Context, name string) (*domain.City, error)
+	List(ctx context.Context) ([]domain.City, error)
+	SetActive(ctx context.Context, id uuid.UUID, active bool) (*domain.City, error)
+}
+
+type ProductTypeProvider interface {
+	Create(ctx context.Context, params domain.ProductTypeToCreate) (*domain.ProductTypeInfo, error)
+	List(ctx context.Context) ([]domain.ProductTypeInfo, error)
+	Update(
+		ctx context.Context,
+		code domain.ProductType,
+		params domain.ProductTypeToUpdate,
+	) (*domain.ProductTypeInfo, error)
+}
+
+type ASNProvider interface {
+	Create(ctx context.Context, req domain.ASNRequest) (*domain.ASN, error)
+	Reconciliation(ctx context.Context, receptionID uuid.UUID) (*domain.ReceptionReconciliation, error)
 }
 
 type Server struct {
 	jwt       JWTGenerator
+	keys      KeySetProvider
 	user      UserProvider
+	session   SessionProvider
 	pvz       PVZProvider
 	reception ReceptionProvider
 	product   ProductProvider
+	staff     StaffProvider
+	password  PasswordResetProvider
+	apiKeys   APIKeyProvider
+	audit     AuditProvider
+	city      CityProvider
+	types     ProductTypeProvider
+	asn       ASNProvider
+
+	// dummyLogin включает выдачу тестовых токенов через /dummyLogin.
+	dummyLogin bool
 }
 
 // (POST /dummyLogin).
@@ -51,18 +145,24 @@ func (s *Server) PostDummyLogin(
 	ctx context.Context,
 	request gen.PostDummyLoginRequestObject,
 ) (gen.PostDummyLoginResponseObject, error) {
+	if !s.dummyLogin {
+		return gen.PostDummyLogin404JSONResponse{
+			Message: domain.ErrNotFound.Error(),
+		}, nil
+	}
+
 	role := domain.Role(request.Body.Role)
 	if !role.IsValid() {
 		return gen.PostDummyLogin400JSONResponse{
 			Message: ErrInvalidRole.Error(),
-		}, ErrInvalidRole
+		}, nil
 	}
 
-	token, err := s.jwt.GenerateToken("dummy", string(request.Body.Role))
+	token, err := s.jwt.GenerateDummyToken(string(request.Body.Role))
 	if err != nil {
 		return gen.PostDummyLogin400JSONResponse{
 			Message: err.Error(),
-		}, err
+		}, nil
 	}
 
 	response := gen.PostDummyLogin200JSONResponse(token)
@@ -70,6 +170,14 @@ func (s *Server) PostDummyLogin(
 	return response, nil
 }
 
+// (GET /.well-known/jwks.json).
+func (s *Server) GetWellKnownJwksJson(
+	ctx context.Context,
+	request gen.GetWellKnownJwksJsonRequestObject,
+) (gen.GetWellKnownJwksJsonResponseObject, error) {
+	return gen.GetWellKnownJwksJson200JSONResponse(s.keys.JWKS().ToDTO()), nil
+}
+
 // (POST /login).
 func (s *Server) PostLogin(
 	ctx context.Context,
@@ -77,18 +185,94 @@ func (s *Server) PostLogin(
 ) (gen.PostLoginResponseObject, error) {
 	email, password := request.Body.Email, request.Body.Password
 
-	token, err := s.user.Auth(ctx, string(email), password)
+	pair, err := s.user.Auth(ctx, string(email), password)
+
+	var retry *models.RetryError
+	if errors.As(err, &retry) {
+		return gen.PostLogin429JSONResponse{
+			Body:    gen.Error{Message: err.Error()},
+			Headers: gen.PostLogin429ResponseHeaders{RetryAfter: ratelimit.RetryAfter(retry.RetryAfter)},
+		}, nil
+	}
+
 	if err != nil {
 		return gen.PostLogin401JSONResponse{
 			Message: err.Error(),
-		}, err
+		}, nil
 	}
 
-	resp := gen.PostLogin200JSONResponse(*token)
+	resp := gen.PostLogin200JSONResponse(pair.ToDTO())
 
 	return resp, nil
 }
 
+// (POST /password/forgot).
+func (s *Server) PostPasswordForgot(
+	ctx context.Context,
+	request gen.PostPasswordForgotRequestObject,
+) (gen.PostPasswordForgotResponseObject, error) {
+	err := s.password.Forgot(ctx, string(request.Body.Email))
+	if err != nil {
+		return gen.PostPasswordForgot400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostPasswordForgot202Response{}, nil
+}
+
+// (POST /password/reset).
+func (s *Server) PostPasswordReset(
+	ctx context.Context,
+	request gen.PostPasswordResetRequestObject,
+) (gen.PostPasswordResetResponseObject, error) {
+	err := s.password.Reset(ctx, request.Body.Token, request.Body.Password)
+	if err != nil {
+		return gen.PostPasswordReset400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostPasswordReset204Response{}, nil
+}
+
+// (POST /token/refresh).
+func (s *Server) PostTokenRefresh(
+	ctx context.Context,
+	request gen.PostTokenRefreshRequestObject,
+) (gen.PostTokenRefreshResponseObject, error) {
+	pair, err := s.session.Refresh(ctx, request.Body.RefreshToken)
+	if err != nil {
+		return gen.PostTokenRefresh401JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostTokenRefresh200JSONResponse(pair.ToDTO()), nil
+}
+
+// (POST /logout).
+func (s *Server) PostLogout(
+	ctx context.Context,
+	request gen.PostLogoutRequestObject,
+) (gen.PostLogoutResponseObject, error) {
+	identity, ok := domain.IdentityFromCtx(ctx)
+	if !ok {
+		return gen.PostLogout401JSONResponse{
+			Message: domain.ErrUnauthorized.Error(),
+		}, nil
+	}
+
+	err := s.session.Logout(ctx, identity.SessionID)
+	if err != nil {
+		return gen.PostLogout401JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostLogout204Response{}, nil
+}
+
 // (POST /products).
 func (s *Server) PostProducts(
 	ctx context.Context,
@@ -99,21 +283,82 @@ func (s *Server) PostProducts(
 	toAdd := domain.ProductToAdd{
 		UUID: domain.PVZID(pvzId),
 		Type: domain.ProductType(typeName),
+		ProductIdentity: domain.NewProductIdentity(
+			request.Body.Barcode,
+			request.Body.OrderId,
+			request.Body.Sku,
+		),
 	}
 
 	product, err := s.product.Create(ctx, toAdd)
+	if errors.Is(err, models.ErrPVZAccessDenied) {
+		return gen.PostProducts403JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	var duplicate *models.DuplicateBarcodeError
+	if errors.As(err, &duplicate) {
+		return gen.PostProducts409JSONResponse{
+			Message:     err.Error(),
+			Barcode:     duplicate.Barcode,
+			ReceptionId: duplicate.ReceptionID,
+		}, nil
+	}
+
 	if err != nil {
 		return gen.PostProducts400JSONResponse{
 			Message: err.Error(),
-		}, err
+		}, nil
 	}
 
-	return gen.PostProducts201JSONResponse{
-		DateTime:    &product.CreatedAt,
-		Id:          (*types.UUID)(&product.ID),
-		ReceptionId: product.ReceptionID,
-		Type:        gen.ProductType(product.Type),
-	}, nil
+	return gen.PostProducts201JSONResponse(product.ToDto()), nil
+}
+
+// (POST /pvz/{pvzId}/products:batch).
+func (s *Server) PostPvzPvzIdProductsBatch(
+	ctx context.Context,
+	request gen.PostPvzPvzIdProductsBatchRequestObject,
+) (gen.PostPvzPvzIdProductsBatchResponseObject, error) {
+	batch := domain.NewProductBatchFromDTO(request.PvzId, *request.Body)
+
+	products, err := s.product.CreateBatch(ctx, batch)
+	if errors.Is(err, models.ErrPVZAccessDenied) {
+		return gen.PostPvzPvzIdProductsBatch403JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	var batchErr *models.BatchError
+	if errors.As(err, &batchErr) {
+		itemErrs := make([]gen.ProductBatchItemError, 0, len(batchErr.Items))
+		for _, item := range batchErr.Items {
+			itemErr := gen.ProductBatchItemError{
+				Index:   item.Index,
+				Message: item.Err.Error(),
+			}
+
+			var duplicate *models.DuplicateBarcodeError
+			if errors.As(item.Err, &duplicate) {
+				itemErr.ReceptionId = &duplicate.ReceptionID
+			}
+
+			itemErrs = append(itemErrs, itemErr)
+		}
+
+		return gen.PostPvzPvzIdProductsBatch400JSONResponse{
+			Message: err.Error(),
+			Errors:  &itemErrs,
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostPvzPvzIdProductsBatch400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostPvzPvzIdProductsBatch201JSONResponse(domain.ProductsToDTO(products)), nil
 }
 
 // (GET /pvz).
@@ -139,19 +384,183 @@ func (s *Server) PostPvz(
 	request gen.PostPvzRequestObject,
 ) (gen.PostPvzResponseObject, error) {
 	city := request.Body.City
+	profile := domain.NewPVZProfileUpdateFromDTO(request.Body.Profile)
 
-	pvz, err := s.pvz.Create(ctx, domain.PvzCity(city))
+	pvz, err := s.pvz.Create(ctx, domain.PvzCity(city), profile)
 	if err != nil {
 		return gen.PostPvz400JSONResponse{
 			Message: err.Error(),
-		}, err
+		}, nil
 	}
 
-	return gen.PostPvz201JSONResponse{
-		City:             gen.PVZCity(pvz.City),
-		Id:               (*types.UUID)(pvz.ID),
-		RegistrationDate: &pvz.RegistrationDate,
-	}, nil
+	return gen.PostPvz201JSONResponse(pvz.ToDTO()), nil
+}
+
+// (GET /pvz/nearest).
+func (s *Server) GetPvzNearest(
+	ctx context.Context,
+	request gen.GetPvzNearestRequestObject,
+) (gen.GetPvzNearestResponseObject, error) {
+	query := domain.NewNearbyQueryFromDTO(request.Params, time.Now())
+
+	found, err := s.pvz.Nearest(ctx, query)
+	if err != nil {
+		return gen.GetPvzNearest400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := make(gen.GetPvzNearest200JSONResponse, 0, len(found))
+	for _, nearby := range found {
+		resp = append(resp, nearby.ToDTO())
+	}
+
+	return resp, nil
+}
+
+// (PATCH /pvz/{pvzId}).
+func (s *Server) PatchPvzPvzId(
+	ctx context.Context,
+	request gen.PatchPvzPvzIdRequestObject,
+) (gen.PatchPvzPvzIdResponseObject, error) {
+	profile := domain.NewPVZProfileUpdateFromDTO(request.Body)
+
+	pvz, err := s.pvz.UpdateProfile(ctx, domain.PVZID(request.PvzId), profile)
+	if errors.Is(err, models.ErrPVZNotFound) {
+		return gen.PatchPvzPvzId404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PatchPvzPvzId400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PatchPvzPvzId200JSONResponse(pvz.ToDTO()), nil
+}
+
+// (POST /pvz/{pvzId}/suspend).
+func (s *Server) PostPvzPvzIdSuspend(
+	ctx context.Context,
+	request gen.PostPvzPvzIdSuspendRequestObject,
+) (gen.PostPvzPvzIdSuspendResponseObject, error) {
+	pvz, err := s.pvz.ChangeStatus(
+		ctx,
+		domain.PVZID(request.PvzId),
+		domain.PVZStatusSuspended,
+		request.Body.Reason,
+	)
+
+	if errors.Is(err, models.ErrPVZNotFound) {
+		return gen.PostPvzPvzIdSuspend404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if errors.Is(err, models.ErrInvalidStatusChange) ||
+		errors.Is(err, models.ErrPVZHasOpenReception) {
+		return gen.PostPvzPvzIdSuspend409JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostPvzPvzIdSuspend400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostPvzPvzIdSuspend200JSONResponse(pvz.ToDTO()), nil
+}
+
+// (POST /pvz/{pvzId}/reopen).
+func (s *Server) PostPvzPvzIdReopen(
+	ctx context.Context,
+	request gen.PostPvzPvzIdReopenRequestObject,
+) (gen.PostPvzPvzIdReopenResponseObject, error) {
+	pvz, err := s.pvz.ChangeStatus(
+		ctx,
+		domain.PVZID(request.PvzId),
+		domain.PVZStatusActive,
+		request.Body.Reason,
+	)
+
+	if errors.Is(err, models.ErrPVZNotFound) {
+		return gen.PostPvzPvzIdReopen404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if errors.Is(err, models.ErrInvalidStatusChange) ||
+		errors.Is(err, models.ErrPVZHasOpenReception) {
+		return gen.PostPvzPvzIdReopen409JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostPvzPvzIdReopen400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostPvzPvzIdReopen200JSONResponse(pvz.ToDTO()), nil
+}
+
+// (POST /pvz/{pvzId}/close).
+func (s *Server) PostPvzPvzIdClose(
+	ctx context.Context,
+	request gen.PostPvzPvzIdCloseRequestObject,
+) (gen.PostPvzPvzIdCloseResponseObject, error) {
+	pvz, err := s.pvz.ChangeStatus(
+		ctx,
+		domain.PVZID(request.PvzId),
+		domain.PVZStatusClosed,
+		request.Body.Reason,
+	)
+
+	if errors.Is(err, models.ErrPVZNotFound) {
+		return gen.PostPvzPvzIdClose404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if errors.Is(err, models.ErrInvalidStatusChange) ||
+		errors.Is(err, models.ErrPVZHasOpenReception) {
+		return gen.PostPvzPvzIdClose409JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostPvzPvzIdClose400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostPvzPvzIdClose200JSONResponse(pvz.ToDTO()), nil
+}
+
+// (GET /pvz/{pvzId}/history).
+func (s *Server) GetPvzPvzIdHistory(
+	ctx context.Context,
+	request gen.GetPvzPvzIdHistoryRequestObject,
+) (gen.GetPvzPvzIdHistoryResponseObject, error) {
+	history, err := s.pvz.History(ctx, domain.PVZID(request.PvzId))
+	if err != nil {
+		return gen.GetPvzPvzIdHistory404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := make(gen.GetPvzPvzIdHistory200JSONResponse, 0, len(history))
+	for _, change := range history {
+		resp = append(resp, change.ToDTO())
+	}
+
+	return resp, nil
 }
 
 // (POST /pvz/{pvzId}/close_last_reception).
@@ -161,18 +570,102 @@ func (s *Server) PostPvzPvzIdCloseLastReception(
 ) (gen.PostPvzPvzIdCloseLastReceptionResponseObject, error) {
 	recId := request.PvzId
 
-	rec, err := s.reception.CloseLastReception(ctx, domain.PVZID(recId))
+	rec, reconciliation, err := s.reception.CloseLastReception(ctx, domain.PVZID(recId))
+	if errors.Is(err, models.ErrPVZAccessDenied) {
+		return gen.PostPvzPvzIdCloseLastReception403JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
 	if err != nil {
 		return gen.PostPvzPvzIdCloseLastReception400JSONResponse{
 			Message: err.Error(),
-		}, err
+		}, nil
 	}
 
-	r := rec.ToDTO()
+	r := rec.ToClosedDTO(reconciliation)
 
 	return gen.PostPvzPvzIdCloseLastReception200JSONResponse(r), nil
 }
 
+// (POST /pvz/{pvzId}/asn).
+func (s *Server) PostPvzPvzIdAsn(
+	ctx context.Context,
+	request gen.PostPvzPvzIdAsnRequestObject,
+) (gen.PostPvzPvzIdAsnResponseObject, error) {
+	req := domain.NewASNRequestFromDTO(request.PvzId, *request.Body)
+
+	asn, err := s.asn.Create(ctx, req)
+	if errors.Is(err, models.ErrPVZNotFound) {
+		return gen.PostPvzPvzIdAsn404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostPvzPvzIdAsn400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostPvzPvzIdAsn201JSONResponse(asn.ToDTO()), nil
+}
+
+// (GET /receptions/{receptionId}/reconciliation).
+func (s *Server) GetReceptionsReceptionIdReconciliation(
+	ctx context.Context,
+	request gen.GetReceptionsReceptionIdReconciliationRequestObject,
+) (gen.GetReceptionsReceptionIdReconciliationResponseObject, error) {
+	report, err := s.asn.Reconciliation(ctx, request.ReceptionId)
+	if errors.Is(err, models.ErrPVZAccessDenied) {
+		return gen.GetReceptionsReceptionIdReconciliation403JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if errors.Is(err, models.ErrReceptionNotFound) ||
+		errors.Is(err, models.ErrReconciliationNotFound) {
+		return gen.GetReceptionsReceptionIdReconciliation404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.GetReceptionsReceptionIdReconciliation200JSONResponse{}, err
+	}
+
+	return gen.GetReceptionsReceptionIdReconciliation200JSONResponse(report.ToDTO()), nil
+}
+
+// (POST /receptions/{receptionId}/reopen).
+func (s *Server) PostReceptionsReceptionIdReopen(
+	ctx context.Context,
+	request gen.PostReceptionsReceptionIdReopenRequestObject,
+) (gen.PostReceptionsReceptionIdReopenResponseObject, error) {
+	rec, err := s.reception.Reopen(ctx, request.ReceptionId, request.Body.Justification)
+	if errors.Is(err, models.ErrReceptionNotFound) || errors.Is(err, models.ErrPVZNotFound) {
+		return gen.PostReceptionsReceptionIdReopen404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if errors.Is(err, models.ErrReceptionNotClosed) ||
+		errors.Is(err, models.ErrCorrectionExpired) ||
+		errors.Is(err, models.ErrNewerReceptionExists) {
+		return gen.PostReceptionsReceptionIdReopen409JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostReceptionsReceptionIdReopen400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostReceptionsReceptionIdReopen200JSONResponse(rec.ToDTO()), nil
+}
+
 // (POST /pvz/{pvzId}/delete_last_product).
 func (s *Server) PostPvzPvzIdDeleteLastProduct(
 	ctx context.Context,
@@ -181,15 +674,106 @@ func (s *Server) PostPvzPvzIdDeleteLastProduct(
 	pvzId := request.PvzId
 
 	err := s.product.DeleteLast(ctx, domain.PVZID(pvzId))
+	if errors.Is(err, models.ErrPVZAccessDenied) {
+		return gen.PostPvzPvzIdDeleteLastProduct403JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
 	if err != nil {
 		return gen.PostPvzPvzIdDeleteLastProduct400JSONResponse{
 			Message: err.Error(),
-		}, err
+		}, nil
 	}
 
 	return gen.PostPvzPvzIdDeleteLastProduct200Response{}, nil
 }
 
+// (POST /pvz/{pvzId}/products/void).
+func (s *Server) PostPvzPvzIdProductsVoid(
+	ctx context.Context,
+	request gen.PostPvzPvzIdProductsVoidRequestObject,
+) (gen.PostPvzPvzIdProductsVoidResponseObject, error) {
+	void := domain.NewProductVoidRequestFromDTO(request.PvzId, *request.Body)
+
+	product, err := s.product.Void(ctx, void)
+	if errors.Is(err, models.ErrPVZAccessDenied) {
+		return gen.PostPvzPvzIdProductsVoid403JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if errors.Is(err, models.ErrProductNotFound) {
+		return gen.PostPvzPvzIdProductsVoid404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostPvzPvzIdProductsVoid400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostPvzPvzIdProductsVoid200JSONResponse(product.ToDto()), nil
+}
+
+// (GET /pvz/{pvzId}/staff).
+func (s *Server) GetPvzPvzIdStaff(
+	ctx context.Context,
+	request gen.GetPvzPvzIdStaffRequestObject,
+) (gen.GetPvzPvzIdStaffResponseObject, error) {
+	staff, err := s.staff.List(ctx, domain.PVZID(request.PvzId))
+	if err != nil {
+		return gen.GetPvzPvzIdStaff404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := make(gen.GetPvzPvzIdStaff200JSONResponse, 0, len(staff))
+	for _, user := range staff {
+		resp = append(resp, *user.ToDto())
+	}
+
+	return resp, nil
+}
+
+// (PUT /pvz/{pvzId}/staff/{userId}).
+func (s *Server) PutPvzPvzIdStaffUserId(
+	ctx context.Context,
+	request gen.PutPvzPvzIdStaffUserIdRequestObject,
+) (gen.PutPvzPvzIdStaffUserIdResponseObject, error) {
+	err := s.staff.Assign(ctx, domain.PVZID(request.PvzId), request.UserId)
+	if errors.Is(err, models.ErrPVZNotFound) || errors.Is(err, models.ErrUserNotFoud) {
+		return gen.PutPvzPvzIdStaffUserId404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PutPvzPvzIdStaffUserId400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PutPvzPvzIdStaffUserId204Response{}, nil
+}
+
+// (DELETE /pvz/{pvzId}/staff/{userId}).
+func (s *Server) DeletePvzPvzIdStaffUserId(
+	ctx context.Context,
+	request gen.DeletePvzPvzIdStaffUserIdRequestObject,
+) (gen.DeletePvzPvzIdStaffUserIdResponseObject, error) {
+	err := s.staff.Unassign(ctx, domain.PVZID(request.PvzId), request.UserId)
+	if err != nil {
+		return gen.DeletePvzPvzIdStaffUserId404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.DeletePvzPvzIdStaffUserId204Response{}, nil
+}
+
 // (POST /receptions).
 func (s *Server) PostReceptions(
 	ctx context.Context,
@@ -198,10 +782,16 @@ func (s *Server) PostReceptions(
 	pvzId := request.Body.PvzId
 
 	rec, err := s.reception.Create(ctx, domain.PVZID(pvzId))
+	if errors.Is(err, models.ErrPVZAccessDenied) {
+		return gen.PostReceptions403JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
 	if err != nil {
 		return gen.PostReceptions400JSONResponse{
 			Message: err.Error(),
-		}, err
+		}, nil
 	}
 
 	return gen.PostReceptions201JSONResponse(rec.ToDTO()), nil
@@ -220,24 +810,362 @@ func (s *Server) PostRegister(
 	if err != nil {
 		return gen.PostRegister400JSONResponse{
 			Message: err.Error(),
-		}, err
+		}, nil
 	}
 
 	return gen.PostRegister201JSONResponse(*user.ToDto()), nil
 }
 
+// (GET /users).
+func (s *Server) GetUsers(
+	ctx context.Context,
+	request gen.GetUsersRequestObject,
+) (gen.GetUsersResponseObject, error) {
+	filter := domain.NewUserFilterFromDTO(request.Params)
+
+	users, err := s.user.List(ctx, *filter)
+	if err != nil {
+		return gen.GetUsers200JSONResponse{}, err
+	}
+
+	resp := make(gen.GetUsers200JSONResponse, 0, len(users))
+	for _, user := range users {
+		resp = append(resp, *user.ToDto())
+	}
+
+	return resp, nil
+}
+
+// (POST /users/{userId}/role).
+func (s *Server) PostUsersUserIdRole(
+	ctx context.Context,
+	request gen.PostUsersUserIdRoleRequestObject,
+) (gen.PostUsersUserIdRoleResponseObject, error) {
+	user, err := s.user.ChangeRole(ctx, request.UserId, domain.Role(request.Body.Role))
+	if errors.Is(err, models.ErrUserNotFoud) {
+		return gen.PostUsersUserIdRole404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostUsersUserIdRole400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostUsersUserIdRole200JSONResponse(*user.ToDto()), nil
+}
+
+// (POST /users/{userId}/activate).
+func (s *Server) PostUsersUserIdActivate(
+	ctx context.Context,
+	request gen.PostUsersUserIdActivateRequestObject,
+) (gen.PostUsersUserIdActivateResponseObject, error) {
+	user, err := s.user.SetActive(ctx, request.UserId, true)
+	if errors.Is(err, models.ErrUserNotFoud) {
+		return gen.PostUsersUserIdActivate404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostUsersUserIdActivate400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostUsersUserIdActivate200JSONResponse(*user.ToDto()), nil
+}
+
+// (POST /users/{userId}/deactivate).
+func (s *Server) PostUsersUserIdDeactivate(
+	ctx context.Context,
+	request gen.PostUsersUserIdDeactivateRequestObject,
+) (gen.PostUsersUserIdDeactivateResponseObject, error) {
+	user, err := s.user.SetActive(ctx, request.UserId, false)
+	if errors.Is(err, models.ErrUserNotFoud) {
+		return gen.PostUsersUserIdDeactivate404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostUsersUserIdDeactivate400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostUsersUserIdDeactivate200JSONResponse(*user.ToDto()), nil
+}
+
+// (POST /users/{userId}/password/reset).
+func (s *Server) PostUsersUserIdPasswordReset(
+	ctx context.Context,
+	request gen.PostUsersUserIdPasswordResetRequestObject,
+) (gen.PostUsersUserIdPasswordResetResponseObject, error) {
+	password, err := s.user.ResetPassword(ctx, request.UserId)
+	if errors.Is(err, models.ErrUserNotFoud) {
+		return gen.PostUsersUserIdPasswordReset404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostUsersUserIdPasswordReset200JSONResponse{}, err
+	}
+
+	return gen.PostUsersUserIdPasswordReset200JSONResponse{
+		TemporaryPassword: password,
+	}, nil
+}
+
+// (GET /api-keys).
+func (s *Server) GetApiKeys(
+	ctx context.Context,
+	request gen.GetApiKeysRequestObject,
+) (gen.GetApiKeysResponseObject, error) {
+	keys, err := s.apiKeys.List(ctx)
+	if err != nil {
+		return gen.GetApiKeys200JSONResponse{}, err
+	}
+
+	resp := make(gen.GetApiKeys200JSONResponse, 0, len(keys))
+	for _, key := range keys {
+		resp = append(resp, key.ToDTO())
+	}
+
+	return resp, nil
+}
+
+// (POST /api-keys).
+func (s *Server) PostApiKeys(
+	ctx context.Context,
+	request gen.PostApiKeysRequestObject,
+) (gen.PostApiKeysResponseObject, error) {
+	key, raw, err := s.apiKeys.Create(ctx, domain.NewAPIKeyToCreateFromDTO(*request.Body))
+	if err != nil {
+		return gen.PostApiKeys400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostApiKeys201JSONResponse{
+		ApiKey: key.ToDTO(),
+		Key:    raw,
+	}, nil
+}
+
+// (DELETE /api-keys/{keyId}).
+func (s *Server) DeleteApiKeysKeyId(
+	ctx context.Context,
+	request gen.DeleteApiKeysKeyIdRequestObject,
+) (gen.DeleteApiKeysKeyIdResponseObject, error) {
+	err := s.apiKeys.Revoke(ctx, request.KeyId)
+	if errors.Is(err, models.ErrAPIKeyNotFound) {
+		return gen.DeleteApiKeysKeyId404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.DeleteApiKeysKeyId204Response{}, err
+	}
+
+	return gen.DeleteApiKeysKeyId204Response{}, nil
+}
+
+// (GET /audit).
+func (s *Server) GetAudit(
+	ctx context.Context,
+	request gen.GetAuditRequestObject,
+) (gen.GetAuditResponseObject, error) {
+	filter := domain.NewAuditFilterFromDTO(request.Params)
+
+	entries, err := s.audit.List(ctx, *filter)
+	if errors.Is(err, models.ErrInvalidTimeRange) {
+		return gen.GetAudit400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.GetAudit200JSONResponse{}, err
+	}
+
+	resp := make(gen.GetAudit200JSONResponse, 0, len(entries))
+	for _, entry := range entries {
+		resp = append(resp, entry.ToDTO())
+	}
+
+	return resp, nil
+}
+
+// (GET /cities).
+func (s *Server) GetCities(
+	ctx context.Context,
+	request gen.GetCitiesRequestObject,
+) (gen.GetCitiesResponseObject, error) {
+	cities, err := s.city.List(ctx)
+	if err != nil {
+		return gen.GetCities200JSONResponse{}, err
+	}
+
+	resp := make(gen.GetCities200JSONResponse, 0, len(cities))
+	for _, city := range cities {
+		resp = append(resp, city.ToDTO())
+	}
+
+	return resp, nil
+}
+
+// (POST /cities).
+func (s *Server) PostCities(
+	ctx context.Context,
+	request gen.PostCitiesRequestObject,
+) (gen.PostCitiesResponseObject, error) {
+	city, err := s.city.Create(ctx, request.Body.Name)
+	if errors.Is(err, models.ErrCityAlreadyExists) {
+		return gen.PostCities409JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostCities400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostCities201JSONResponse(city.ToDTO()), nil
+}
+
+// (POST /cities/{cityId}/activate).
+func (s *Server) PostCitiesCityIdActivate(
+	ctx context.Context,
+	request gen.PostCitiesCityIdActivateRequestObject,
+) (gen.PostCitiesCityIdActivateResponseObject, error) {
+	city, err := s.city.SetActive(ctx, request.CityId, true)
+	if err != nil {
+		return gen.PostCitiesCityIdActivate404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostCitiesCityIdActivate200JSONResponse(city.ToDTO()), nil
+}
+
+// (POST /cities/{cityId}/deactivate).
+func (s *Server) PostCitiesCityIdDeactivate(
+	ctx context.Context,
+	request gen.PostCitiesCityIdDeactivateRequestObject,
+) (gen.PostCitiesCityIdDeactivateResponseObject, error) {
+	city, err := s.city.SetActive(ctx, request.CityId, false)
+	if err != nil {
+		return gen.PostCitiesCityIdDeactivate404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostCitiesCityIdDeactivate200JSONResponse(city.ToDTO()), nil
+}
+
+// (GET /product-types).
+func (s *Server) GetProductTypes(
+	ctx context.Context,
+	request gen.GetProductTypesRequestObject,
+) (gen.GetProductTypesResponseObject, error) {
+	productTypes, err := s.types.List(ctx)
+	if err != nil {
+		return gen.GetProductTypes200JSONResponse{}, err
+	}
+
+	resp := make(gen.GetProductTypes200JSONResponse, 0, len(productTypes))
+	for _, productType := range productTypes {
+		resp = append(resp, productType.ToDTO())
+	}
+
+	return resp, nil
+}
+
+// (POST /product-types).
+func (s *Server) PostProductTypes(
+	ctx context.Context,
+	request gen.PostProductTypesRequestObject,
+) (gen.PostProductTypesResponseObject, error) {
+	productType, err := s.types.Create(ctx, domain.NewProductTypeToCreateFromDTO(*request.Body))
+	if errors.Is(err, models.ErrProductTypeExists) {
+		return gen.PostProductTypes409JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PostProductTypes400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PostProductTypes201JSONResponse(productType.ToDTO()), nil
+}
+
+// (PUT /product-types/{code}).
+func (s *Server) PutProductTypesCode(
+	ctx context.Context,
+	request gen.PutProductTypesCodeRequestObject,
+) (gen.PutProductTypesCodeResponseObject, error) {
+	productType, err := s.types.Update(
+		ctx,
+		domain.ProductType(request.Code),
+		domain.NewProductTypeToUpdateFromDTO(*request.Body),
+	)
+	if errors.Is(err, models.ErrProductTypeNotFound) {
+		return gen.PutProductTypesCode404JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		return gen.PutProductTypesCode400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return gen.PutProductTypesCode200JSONResponse(productType.ToDTO()), nil
+}
+
 func NewServer(
 	jwt JWTGenerator,
+	keys KeySetProvider,
 	user UserProvider,
+	session SessionProvider,
 	pvz PVZProvider,
 	reception ReceptionProvider,
 	product ProductProvider,
+	staff StaffProvider,
+	password PasswordResetProvider,
+	apiKeys APIKeyProvider,
+	audit AuditProvider,
+	city CityProvider,
+	types ProductTypeProvider,
+	asn ASNProvider,
+	dummyLogin bool,
 ) *Server {
 	return &Server{
-		jwt:       jwt,
-		user:      user,
-		pvz:       pvz,
-		reception: reception,
-		product:   product,
+		jwt:        jwt,
+		keys:       keys,
+		user:       user,
+		session:    session,
+		pvz:        pvz,
+		reception:  reception,
+		product:    product,
+		staff:      staff,
+		password:   password,
+		apiKeys:    apiKeys,
+		audit:      audit,
+		city:       city,
+		types:      types,
+		asn:        asn,
+		dummyLogin: dummyLogin,
 	}
 }
@@ -0,0 +1,267 @@
+package httpserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	httpserver "avito_pvz/internal/http"
+	"avito_pvz/internal/http/gen"
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type serverMocks struct {
+	user      *httpserver.MockUserProvider
+	pvz       *httpserver.MockPVZProvider
+	reception *httpserver.MockReceptionProvider
+	product   *httpserver.MockProductProvider
+	apiKeys   *httpserver.MockAPIKeyProvider
+	asn       *httpserver.MockASNProvider
+}
+
+// newRouter собирает обработчик так же, как приложение, чтобы проверять
+// ответы в том виде, в каком их получает клиент.
+func newRouter(t *testing.T, m serverMocks) http.Handler {
+	t.Helper()
+
+	server := httpserver.NewServer(
+		nil, nil, m.user, nil, m.pvz, m.reception, m.product,
+		nil, nil, m.apiKeys, nil, nil, nil, m.asn, false,
+	)
+
+	return gen.HandlerFromMux(
+		gen.NewStrictHandlerWithOptions(server, nil, httpserver.StrictErrorOptions()),
+		http.NewServeMux(),
+	)
+}
+
+func TestServer_ErrorResponses(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	holderID := uuid.New()
+	userID := uuid.New()
+	receptionID := uuid.New()
+	keyID := uuid.New()
+	employee := domain.Identity{UserID: uuid.New(), Role: domain.RoleEmploye}
+	moderator := domain.Identity{UserID: uuid.New(), Role: domain.RoleModerator}
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		identity    *domain.Identity
+		setupMocks  func(m serverMocks)
+		wantCode    int
+		wantMessage string
+		wantRetry   string
+		check       func(t *testing.T, body map[string]any)
+	}{
+		{
+			name:   "login_invalid_credentials",
+			method: http.MethodPost,
+			path:   "/login",
+			body:   `{"email":"a@b.c","password":"wrong"}`,
+			setupMocks: func(m serverMocks) {
+				m.user.On("Auth", mock.Anything, "a@b.c", "wrong").
+					Return(nil, models.ErrInvalidCredentials)
+			},
+			wantCode:    http.StatusUnauthorized,
+			wantMessage: models.ErrInvalidCredentials.Error(),
+		},
+		{
+			name:   "login_locked",
+			method: http.MethodPost,
+			path:   "/login",
+			body:   `{"email":"a@b.c","password":"wrong"}`,
+			setupMocks: func(m serverMocks) {
+				m.user.On("Auth", mock.Anything, "a@b.c", "wrong").Return(nil, &models.RetryError{
+					Err:        models.ErrLoginLocked,
+					RetryAfter: 90 * time.Second,
+				})
+			},
+			wantCode:    http.StatusTooManyRequests,
+			wantMessage: models.ErrLoginLocked.Error(),
+			wantRetry:   "90",
+		},
+		{
+			name:        "dummy_login_disabled",
+			method:      http.MethodPost,
+			path:        "/dummyLogin",
+			body:        `{"role":"moderator"}`,
+			wantCode:    http.StatusNotFound,
+			wantMessage: domain.ErrNotFound.Error(),
+		},
+		{
+			name:     "reception_pvz_access_denied",
+			method:   http.MethodPost,
+			path:     "/receptions",
+			body:     `{"pvzId":"` + pvzID.String() + `"}`,
+			identity: &employee,
+			setupMocks: func(m serverMocks) {
+				m.reception.On("Create", mock.Anything, domain.PVZID(pvzID)).
+					Return(nil, models.ErrPVZAccessDenied)
+			},
+			wantCode:    http.StatusForbidden,
+			wantMessage: models.ErrPVZAccessDenied.Error(),
+		},
+		{
+			name:     "reconciliation_pvz_access_denied",
+			method:   http.MethodGet,
+			path:     "/receptions/" + receptionID.String() + "/reconciliation",
+			identity: &employee,
+			setupMocks: func(m serverMocks) {
+				m.asn.On("Reconciliation", mock.Anything, receptionID).
+					Return(nil, models.ErrPVZAccessDenied)
+			},
+			wantCode:    http.StatusForbidden,
+			wantMessage: models.ErrPVZAccessDenied.Error(),
+		},
+		{
+			name:     "product_duplicate_barcode",
+			method:   http.MethodPost,
+			path:     "/products",
+			body:     `{"pvzId":"` + pvzID.String() + `","type":"electronics","barcode":"A1"}`,
+			identity: &employee,
+			setupMocks: func(m serverMocks) {
+				m.product.On("Create", mock.Anything, mock.Anything).Return(nil, &models.DuplicateBarcodeError{
+					Barcode:     "A1",
+					ReceptionID: holderID,
+				})
+			},
+			wantCode:    http.StatusConflict,
+			wantMessage: models.ErrBarcodeAlreadyReceived.Error(),
+			check: func(t *testing.T, body map[string]any) {
+				require.Equal(t, holderID.String(), body["receptionId"])
+				require.Equal(t, "A1", body["barcode"])
+			},
+		},
+		{
+			name:     "product_batch_rejected",
+			method:   http.MethodPost,
+			path:     "/pvz/" + pvzID.String() + "/products:batch",
+			body:     `{"products":[{"type":"electronics"},{"type":"unknown"}]}`,
+			identity: &employee,
+			setupMocks: func(m serverMocks) {
+				m.product.On("CreateBatch", mock.Anything, mock.Anything).Return(nil, &models.BatchError{
+					Items: []models.ItemError{{Index: 1, Err: models.ErrInvalidProductType}},
+				})
+			},
+			wantCode:    http.StatusBadRequest,
+			wantMessage: models.ErrInvalidBatch.Error(),
+			check: func(t *testing.T, body map[string]any) {
+				require.Equal(t, []any{map[string]any{
+					"index":   float64(1),
+					"message": models.ErrInvalidProductType.Error(),
+				}}, body["errors"])
+			},
+		},
+		{
+			name:     "password_reset_user_not_found",
+			method:   http.MethodPost,
+			path:     "/users/" + userID.String() + "/password/reset",
+			identity: &moderator,
+			setupMocks: func(m serverMocks) {
+				m.user.On("ResetPassword", mock.Anything, userID).Return("", models.ErrUserNotFoud)
+			},
+			wantCode:    http.StatusNotFound,
+			wantMessage: models.ErrUserNotFoud.Error(),
+		},
+		{
+			name:     "password_reset_failed",
+			method:   http.MethodPost,
+			path:     "/users/" + userID.String() + "/password/reset",
+			identity: &moderator,
+			setupMocks: func(m serverMocks) {
+				m.user.On("ResetPassword", mock.Anything, userID).Return("", models.ErrInternal)
+			},
+			wantCode:    http.StatusInternalServerError,
+			wantMessage: domain.ErrInternal.Error(),
+		},
+		{
+			name:     "api_key_not_found",
+			method:   http.MethodDelete,
+			path:     "/api-keys/" + keyID.String(),
+			identity: &moderator,
+			setupMocks: func(m serverMocks) {
+				m.apiKeys.On("Revoke", mock.Anything, keyID).Return(models.ErrAPIKeyNotFound)
+			},
+			wantCode:    http.StatusNotFound,
+			wantMessage: models.ErrAPIKeyNotFound.Error(),
+		},
+		{
+			name:     "api_key_revoke_failed",
+			method:   http.MethodDelete,
+			path:     "/api-keys/" + keyID.String(),
+			identity: &moderator,
+			setupMocks: func(m serverMocks) {
+				m.apiKeys.On("Revoke", mock.Anything, keyID).Return(models.ErrInternal)
+			},
+			wantCode:    http.StatusInternalServerError,
+			wantMessage: domain.ErrInternal.Error(),
+		},
+		{
+			name:     "unexpected_error_hidden",
+			method:   http.MethodGet,
+			path:     "/pvz",
+			identity: &employee,
+			setupMocks: func(m serverMocks) {
+				m.pvz.On("List", mock.Anything, mock.Anything).Return(nil, domain.ErrInternal)
+			},
+			wantCode:    http.StatusInternalServerError,
+			wantMessage: domain.ErrInternal.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := serverMocks{
+				user:      httpserver.NewMockUserProvider(t),
+				pvz:       httpserver.NewMockPVZProvider(t),
+				reception: httpserver.NewMockReceptionProvider(t),
+				product:   httpserver.NewMockProductProvider(t),
+				apiKeys:   httpserver.NewMockAPIKeyProvider(t),
+				asn:       httpserver.NewMockASNProvider(t),
+			}
+			if tt.setupMocks != nil {
+				tt.setupMocks(m)
+			}
+
+			ctx := context.Background()
+			if tt.identity != nil {
+				ctx = domain.WithIdentity(ctx, *tt.identity)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)).
+				WithContext(ctx)
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			newRouter(t, m).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantCode, rec.Code)
+			require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			require.Equal(t, tt.wantRetry, rec.Header().Get("Retry-After"))
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantMessage, body["message"])
+
+			if tt.check != nil {
+				tt.check(t, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyPrefix отличает API-ключи от JWT в заголовке Authorization.
+const APIKeyPrefix = "pvzk_"
+
+// Scope ограничивает операции, доступные по API-ключу.
+type Scope string
+
+const (
+	ScopePVZRead         Scope = "pvz:read"
+	ScopePVZWrite        Scope = "pvz:write"
+	ScopeReceptionsWrite Scope = "receptions:write"
+	ScopeProductsRead    Scope = "products:read"
+	ScopeProductsWrite   Scope = "products:write"
+)
+
+func (s Scope) IsValid() bool {
+	switch s {
+	case ScopePVZRead, ScopePVZWrite, ScopeReceptionsWrite, ScopeProductsRead, ScopeProductsWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey ключ для интеграций. Как и для сессий, в базе хранится
+// только хеш ключа.
+type APIKey struct {
+	ID      uuid.UUID
+	Name    string
+	KeyHash string
+	Role    Role
+	Scopes  []Scope
+	// PVZID ограничивает ключ одним ПВЗ, nil означает доступ ко всем ПВЗ.
+	PVZID      *uuid.UUID
+	CreatedBy  uuid.UUID
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// APIKeyToCreate параметры выпуска ключа.
+type APIKeyToCreate struct {
+	Name      string
+	Role      Role
+	Scopes    []Scope
+	PVZID     *uuid.UUID
+	ExpiresAt *time.Time
+}
+
+// NewAPIKey выпускает ключ и возвращает его исходное значение,
+// которое показывается модератору один раз.
+func NewAPIKey(params APIKeyToCreate, createdBy uuid.UUID) (*APIKey, string, error) {
+	if strings.TrimSpace(params.Name) == "" {
+		return nil, "", ErrInvalidAPIKeyName
+	}
+
+	if !params.Role.IsValid() {
+		return nil, "", ErrInvalidRole
+	}
+
+	if len(params.Scopes) == 0 {
+		return nil, "", ErrInvalidScope
+	}
+
+	for _, scope := range params.Scopes {
+		if !scope.IsValid() {
+			return nil, "", ErrInvalidScope
+		}
+	}
+
+	now := time.Now()
+
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(now) {
+		return nil, "", ErrInvalidExpiry
+	}
+
+	secret, err := newOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	raw := APIKeyPrefix + secret
+
+	scopes := slices.Clone(params.Scopes)
+	slices.Sort(scopes)
+
+	return &APIKey{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(params.Name),
+		KeyHash:   HashToken(raw),
+		Role:      params.Role,
+		Scopes:    slices.Compact(scopes),
+		PVZID:     params.PVZID,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		ExpiresAt: params.ExpiresAt,
+	}, raw, nil
+}
+
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// Identity возвращает личность, от имени которой выполняются запросы по ключу.
+func (k *APIKey) Identity() Identity {
+	return Identity{
+		Actor:    ActorAPIKey,
+		Role:     k.Role,
+		APIKeyID: k.ID,
+		Scopes:   k.Scopes,
+		PVZID:    k.PVZID,
+	}
+}
+
+func (k *APIKey) ToDTO() gen.APIKey {
+	scopes := make([]gen.APIKeyScopes, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		scopes = append(scopes, gen.APIKeyScopes(scope))
+	}
+
+	return gen.APIKey{
+		Id:         k.ID,
+		Name:       k.Name,
+		Role:       gen.APIKeyRole(k.Role),
+		Scopes:     scopes,
+		PvzId:      k.PVZID,
+		CreatedBy:  k.CreatedBy,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+	}
+}
+
+func NewAPIKeyToCreateFromDTO(body gen.PostApiKeysJSONRequestBody) APIKeyToCreate {
+	scopes := make([]Scope, 0, len(body.Scopes))
+	for _, scope := range body.Scopes {
+		scopes = append(scopes, Scope(scope))
+	}
+
+	return APIKeyToCreate{
+		Name:      body.Name,
+		Role:      Role(body.Role),
+		Scopes:    scopes,
+		PVZID:     body.PvzId,
+		ExpiresAt: body.ExpiresAt,
+	}
+}
@@ -0,0 +1,298 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ASNMaxLines = 1000
+
+	asnLineMaxQuantity    = 10000
+	asnReferenceMaxLength = 100
+)
+
+// ASNLine строка манифеста: либо один товар со штрихкодом Barcode,
+// либо Quantity товаров типа Type, штрихкоды которых заранее не известны.
+// Строки хранятся в JSON, отсюда теги.
+type ASNLine struct {
+	Barcode  string      `json:"barcode,omitempty"`
+	Type     ProductType `json:"type,omitempty"`
+	Quantity int         `json:"quantity"`
+}
+
+// ASN (advance shipment notice) манифест поставки, которую ждет ПВЗ.
+// Пока ReceptionID пуст, манифест ожидает приемку; при закрытии приемки
+// он сверяется с принятыми товарами и привязывается к ней.
+type ASN struct {
+	ID          uuid.UUID
+	PVZID       uuid.UUID
+	Reference   string
+	Lines       []ASNLine
+	CreatedBy   *uuid.UUID
+	CreatedAt   time.Time
+	ReceptionID *uuid.UUID
+}
+
+// ASNRequest манифест в том виде, в каком его прислал клиент.
+type ASNRequest struct {
+	PVZID     PVZID
+	Reference string
+	Lines     []ASNLine
+}
+
+// NewASN проверяет строки манифеста. Типы в строках к этому моменту уже
+// должны быть приведены к кодам справочника, иначе одинаковые типы под
+// разными названиями не будут распознаны как повтор.
+func NewASN(ctx context.Context, req ASNRequest) (*ASN, error) {
+	reference := strings.TrimSpace(req.Reference)
+	lines := req.Lines
+
+	if utf8.RuneCountInString(reference) > asnReferenceMaxLength {
+		return nil, ErrInvalidASNReference
+	}
+
+	if len(lines) == 0 {
+		return nil, ErrEmptyASN
+	}
+
+	if len(lines) > ASNMaxLines {
+		return nil, ErrASNTooLarge
+	}
+
+	barcodes := make(map[string]struct{}, len(lines))
+	types := make(map[ProductType]struct{}, len(lines))
+
+	for i, line := range lines {
+		switch {
+		case line.Barcode != "" && line.Type == "":
+			if line.Quantity == 0 {
+				lines[i].Quantity = 1
+			} else if line.Quantity != 1 {
+				return nil, ErrInvalidASNLine
+			}
+
+			if (ProductIdentity{Barcode: line.Barcode}).Validate() != nil {
+				return nil, ErrInvalidASNLine
+			}
+
+			if _, ok := barcodes[line.Barcode]; ok {
+				return nil, ErrInvalidASNLine
+			}
+
+			barcodes[line.Barcode] = struct{}{}
+		case line.Type != "" && line.Barcode == "":
+			if line.Quantity < 1 || line.Quantity > asnLineMaxQuantity {
+				return nil, ErrInvalidASNLine
+			}
+
+			if _, ok := types[line.Type]; ok {
+				return nil, ErrInvalidASNLine
+			}
+
+			types[line.Type] = struct{}{}
+		default:
+			return nil, ErrInvalidASNLine
+		}
+	}
+
+	asn := &ASN{
+		ID:        uuid.New(),
+		PVZID:     uuid.UUID(req.PVZID),
+		Reference: reference,
+		Lines:     lines,
+		CreatedAt: time.Now(),
+	}
+
+	if identity, ok := IdentityFromCtx(ctx); ok {
+		actorID := identity.ActorID()
+		asn.CreatedBy = &actorID
+	}
+
+	return asn, nil
+}
+
+// Reconcile сверяет принятые товары с манифестом. Товар со штрихкодом из
+// манифеста закрывает свою строку; остальные считаются по строкам с типом,
+// а товары типов, которых нет в манифесте, попадают в Unexpected.
+func (a *ASN) Reconcile(receptionID uuid.UUID, products []Product) *ReceptionReconciliation {
+	expected := make(map[string]struct{}, len(a.Lines))
+	counts := make(map[ProductType]int, len(a.Lines))
+
+	for _, line := range a.Lines {
+		if line.Barcode != "" {
+			expected[line.Barcode] = struct{}{}
+		} else {
+			counts[line.Type] = 0
+		}
+	}
+
+	report := &ReceptionReconciliation{
+		ReceptionID: receptionID,
+		ASNID:       a.ID,
+		Shortages:   make([]ASNDiscrepancy, 0),
+		Surpluses:   make([]ASNDiscrepancy, 0),
+		Unexpected:  make([]UnexpectedProduct, 0),
+		CreatedAt:   time.Now(),
+	}
+
+	received := make(map[string]struct{}, len(products))
+
+	for _, product := range products {
+		if _, ok := expected[product.Barcode]; ok {
+			received[product.Barcode] = struct{}{}
+			continue
+		}
+
+		if _, ok := counts[product.Type]; ok {
+			counts[product.Type]++
+			continue
+		}
+
+		report.Unexpected = append(report.Unexpected, UnexpectedProduct{
+			ProductID: product.ID,
+			Type:      product.Type,
+			Barcode:   product.Barcode,
+		})
+	}
+
+	for _, line := range a.Lines {
+		if line.Barcode != "" {
+			if _, ok := received[line.Barcode]; !ok {
+				report.Shortages = append(report.Shortages, ASNDiscrepancy{
+					Barcode:  line.Barcode,
+					Expected: 1,
+				})
+			}
+
+			continue
+		}
+
+		discrepancy := ASNDiscrepancy{
+			Type:     line.Type,
+			Expected: line.Quantity,
+			Received: counts[line.Type],
+		}
+
+		switch {
+		case discrepancy.Received < discrepancy.Expected:
+			report.Shortages = append(report.Shortages, discrepancy)
+		case discrepancy.Received > discrepancy.Expected:
+			report.Surpluses = append(report.Surpluses, discrepancy)
+		}
+	}
+
+	return report
+}
+
+func (a *ASN) ToDTO() gen.ASN {
+	lines := make([]gen.ASNLine, 0, len(a.Lines))
+	for _, line := range a.Lines {
+		quantity := line.Quantity
+
+		lines = append(lines, gen.ASNLine{
+			Barcode:  optional(line.Barcode),
+			Type:     optional(string(line.Type)),
+			Quantity: &quantity,
+		})
+	}
+
+	return gen.ASN{
+		Id:          a.ID,
+		PvzId:       a.PVZID,
+		Reference:   optional(a.Reference),
+		Lines:       lines,
+		CreatedBy:   a.CreatedBy,
+		CreatedAt:   a.CreatedAt,
+		ReceptionId: a.ReceptionID,
+	}
+}
+
+// NewASNRequestFromDTO переносит строки как есть: тип еще не приведен
+// к коду справочника, проверка строк в NewASN.
+func NewASNRequestFromDTO(pvzID uuid.UUID, dto gen.PostPvzPvzIdAsnJSONRequestBody) ASNRequest {
+	lines := make([]ASNLine, 0, len(dto.Lines))
+	for _, line := range dto.Lines {
+		var quantity int
+		if line.Quantity != nil {
+			quantity = *line.Quantity
+		}
+
+		lines = append(lines, ASNLine{
+			Barcode:  strings.TrimSpace(deref(line.Barcode)),
+			Type:     ProductType(strings.TrimSpace(deref(line.Type))),
+			Quantity: quantity,
+		})
+	}
+
+	return ASNRequest{
+		PVZID:     PVZID(pvzID),
+		Reference: deref(dto.Reference),
+		Lines:     lines,
+	}
+}
+
+// ASNDiscrepancy расхождение по строке манифеста: по штрихкоду
+// или по типу товара.
+type ASNDiscrepancy struct {
+	Barcode  string      `json:"barcode,omitempty"`
+	Type     ProductType `json:"type,omitempty"`
+	Expected int         `json:"expected"`
+	Received int         `json:"received"`
+}
+
+// UnexpectedProduct принятый товар, которого нет в манифесте.
+type UnexpectedProduct struct {
+	ProductID uuid.UUID   `json:"productId"`
+	Type      ProductType `json:"type"`
+	Barcode   string      `json:"barcode,omitempty"`
+}
+
+// ReceptionReconciliation результат сверки закрытой приемки с манифестом.
+type ReceptionReconciliation struct {
+	ReceptionID uuid.UUID
+	ASNID       uuid.UUID
+	Shortages   []ASNDiscrepancy
+	Surpluses   []ASNDiscrepancy
+	Unexpected  []UnexpectedProduct
+	CreatedAt   time.Time
+}
+
+func (r *ReceptionReconciliation) ToDTO() gen.ReceptionReconciliation {
+	unexpected := make([]gen.UnexpectedProduct, 0, len(r.Unexpected))
+	for _, product := range r.Unexpected {
+		unexpected = append(unexpected, gen.UnexpectedProduct{
+			ProductId: product.ProductID,
+			Type:      string(product.Type),
+			Barcode:   optional(product.Barcode),
+		})
+	}
+
+	return gen.ReceptionReconciliation{
+		ReceptionId: r.ReceptionID,
+		AsnId:       r.ASNID,
+		Shortages:   discrepanciesToDTO(r.Shortages),
+		Surpluses:   discrepanciesToDTO(r.Surpluses),
+		Unexpected:  unexpected,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+func discrepanciesToDTO(discrepancies []ASNDiscrepancy) []gen.ASNDiscrepancy {
+	dto := make([]gen.ASNDiscrepancy, 0, len(discrepancies))
+	for _, discrepancy := range discrepancies {
+		dto = append(dto, gen.ASNDiscrepancy{
+			Barcode:  optional(discrepancy.Barcode),
+			Type:     optional(string(discrepancy.Type)),
+			Expected: discrepancy.Expected,
+			Received: discrepancy.Received,
+		})
+	}
+
+	return dto
+}
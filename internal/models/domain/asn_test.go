@@ -0,0 +1,161 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewASN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		lines   []domain.ASNLine
+		wantErr error
+	}{
+		{
+			name: "barcodes and counts",
+			lines: []domain.ASNLine{
+				{Barcode: "4601234567890"},
+				{Type: "electronics", Quantity: 3},
+			},
+		},
+		{
+			name:    "empty",
+			wantErr: domain.ErrEmptyASN,
+		},
+		{
+			name:    "too large",
+			lines:   make([]domain.ASNLine, domain.ASNMaxLines+1),
+			wantErr: domain.ErrASNTooLarge,
+		},
+		{
+			name:    "barcode and type in one line",
+			lines:   []domain.ASNLine{{Barcode: "4601234567890", Type: "electronics", Quantity: 1}},
+			wantErr: domain.ErrInvalidASNLine,
+		},
+		{
+			name:    "neither barcode nor type",
+			lines:   []domain.ASNLine{{Quantity: 1}},
+			wantErr: domain.ErrInvalidASNLine,
+		},
+		{
+			name:    "barcode with quantity",
+			lines:   []domain.ASNLine{{Barcode: "4601234567890", Quantity: 2}},
+			wantErr: domain.ErrInvalidASNLine,
+		},
+		{
+			name:    "invalid barcode",
+			lines:   []domain.ASNLine{{Barcode: "46 01"}},
+			wantErr: domain.ErrInvalidASNLine,
+		},
+		{
+			name:    "duplicate barcode",
+			lines:   []domain.ASNLine{{Barcode: "A1"}, {Barcode: "A1"}},
+			wantErr: domain.ErrInvalidASNLine,
+		},
+		{
+			name:    "type without quantity",
+			lines:   []domain.ASNLine{{Type: "shoes"}},
+			wantErr: domain.ErrInvalidASNLine,
+		},
+		{
+			name:    "duplicate type",
+			lines:   []domain.ASNLine{{Type: "shoes", Quantity: 1}, {Type: "shoes", Quantity: 2}},
+			wantErr: domain.ErrInvalidASNLine,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			asn, err := domain.NewASN(context.Background(), domain.ASNRequest{
+				PVZID: domain.PVZID(uuid.New()),
+				Lines: tt.lines,
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			for _, line := range asn.Lines {
+				assert.Positive(t, line.Quantity)
+			}
+		})
+	}
+}
+
+func TestASN_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	asn, err := domain.NewASN(context.Background(), domain.ASNRequest{
+		PVZID: domain.PVZID(uuid.New()),
+		Lines: []domain.ASNLine{
+			{Barcode: "A1"},
+			{Barcode: "A2"},
+			{Type: "electronics", Quantity: 2},
+			{Type: "shoes", Quantity: 1},
+		},
+	})
+	require.NoError(t, err)
+
+	receptionID := uuid.New()
+	stray := domain.Product{ID: uuid.New(), Type: "clothing", ProductIdentity: domain.ProductIdentity{Barcode: "Z9"}}
+
+	products := []domain.Product{
+		// A1 пришел, A2 нет.
+		{ID: uuid.New(), Type: "electronics", ProductIdentity: domain.ProductIdentity{Barcode: "A1"}},
+		// Штрихкода нет в манифесте, товар считается по типу.
+		{ID: uuid.New(), Type: "electronics", ProductIdentity: domain.ProductIdentity{Barcode: "B7"}},
+		{ID: uuid.New(), Type: "shoes"},
+		{ID: uuid.New(), Type: "shoes"},
+		stray,
+	}
+
+	report := asn.Reconcile(receptionID, products)
+
+	assert.Equal(t, receptionID, report.ReceptionID)
+	assert.Equal(t, asn.ID, report.ASNID)
+	assert.Equal(t, []domain.ASNDiscrepancy{
+		{Barcode: "A2", Expected: 1, Received: 0},
+		{Type: "electronics", Expected: 2, Received: 1},
+	}, report.Shortages)
+	assert.Equal(t, []domain.ASNDiscrepancy{
+		{Type: "shoes", Expected: 1, Received: 2},
+	}, report.Surpluses)
+	assert.Equal(t, []domain.UnexpectedProduct{
+		{ProductID: stray.ID, Type: "clothing", Barcode: "Z9"},
+	}, report.Unexpected)
+}
+
+func TestASN_ReconcileMatched(t *testing.T) {
+	t.Parallel()
+
+	asn, err := domain.NewASN(context.Background(), domain.ASNRequest{
+		PVZID: domain.PVZID(uuid.New()),
+		Lines: []domain.ASNLine{{Barcode: "A1"}, {Type: "shoes", Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	report := asn.Reconcile(uuid.New(), []domain.Product{
+		{ID: uuid.New(), Type: "shoes", ProductIdentity: domain.ProductIdentity{Barcode: "A1"}},
+		{ID: uuid.New(), Type: "shoes"},
+	})
+
+	// Пустые списки, а не nil: в базе и в ответе они хранятся как [].
+	assert.NotNil(t, report.Shortages)
+	assert.Empty(t, report.Shortages)
+	assert.NotNil(t, report.Surpluses)
+	assert.Empty(t, report.Surpluses)
+	assert.NotNil(t, report.Unexpected)
+	assert.Empty(t, report.Unexpected)
+}
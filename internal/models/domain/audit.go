@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	auditDefaultLimit = 50
+	auditMaxLimit     = 100
+)
+
+type AuditAction string
+
+const (
+	AuditPVZCreate         AuditAction = "pvz.create"
+	AuditPVZUpdate         AuditAction = "pvz.update"
+	AuditPVZStatusChange   AuditAction = "pvz.status_change"
+	AuditReceptionCreate   AuditAction = "reception.create"
+	AuditReceptionClose    AuditAction = "reception.close"
+	AuditReceptionReopen   AuditAction = "reception.reopen"
+	AuditProductCreate     AuditAction = "product.create"
+	AuditProductBatch      AuditAction = "product.batch_create"
+	AuditProductDelete     AuditAction = "product.delete"
+	AuditProductVoid       AuditAction = "product.void"
+	AuditUserCreate        AuditAction = "user.create"
+	AuditUserRoleChange    AuditAction = "user.role_change"
+	AuditUserActivate      AuditAction = "user.activate"
+	AuditUserDeactivate    AuditAction = "user.deactivate"
+	AuditUserPasswordReset AuditAction = "user.password_reset"
+	AuditCityCreate        AuditAction = "city.create"
+	AuditCityActivate      AuditAction = "city.activate"
+	AuditCityDeactivate    AuditAction = "city.deactivate"
+	AuditProductTypeCreate AuditAction = "product_type.create"
+	AuditProductTypeUpdate AuditAction = "product_type.update"
+	AuditASNCreate         AuditAction = "asn.create"
+	AuditStaffAssign       AuditAction = "staff.assign"
+	AuditStaffUnassign     AuditAction = "staff.unassign"
+	AuditAPIKeyCreate      AuditAction = "api_key.create"
+	AuditAPIKeyRevoke      AuditAction = "api_key.revoke"
+)
+
+type AuditEntity string
+
+const (
+	AuditEntityPVZ         AuditEntity = "pvz"
+	AuditEntityReception   AuditEntity = "reception"
+	AuditEntityProduct     AuditEntity = "product"
+	AuditEntityUser        AuditEntity = "user"
+	AuditEntityCity        AuditEntity = "city"
+	AuditEntityProductType AuditEntity = "product_type"
+	AuditEntityASN         AuditEntity = "asn"
+	AuditEntityAPIKey      AuditEntity = "api_key"
+)
+
+// ActorType показывает, кто выполнил действие.
+type ActorType string
+
+const (
+	ActorUser      ActorType = "user"
+	ActorAPIKey    ActorType = "api_key"
+	ActorDummy     ActorType = "dummy"
+	ActorAnonymous ActorType = "anonymous"
+	// ActorSystem фоновые задачи сервиса, например автозакрытие приемок.
+	ActorSystem ActorType = "system"
+)
+
+type systemActorKey struct{}
+
+// WithSystemActor помечает контекст фоновой задачи: ее изменения пишутся
+// в журнал от имени системы, а не анонимно.
+func WithSystemActor(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemActorKey{}, true)
+}
+
+// AuditEvent описывает изменение, которое сервис передает в журнал.
+// Before и After сериализуются в JSON, поэтому в них передаются DTO,
+// а не доменные модели: так в журнал не попадут хеши паролей.
+type AuditEvent struct {
+	Action   AuditAction
+	Entity   AuditEntity
+	EntityID uuid.UUID
+	Before   any
+	After    any
+}
+
+// AuditEntry запись журнала аудита. Записи только добавляются.
+type AuditEntry struct {
+	ID        uuid.UUID
+	ActorID   *uuid.UUID
+	ActorRole Role
+	ActorType ActorType
+	Action    AuditAction
+	Entity    AuditEntity
+	EntityID  uuid.UUID
+	Before    json.RawMessage
+	After     json.RawMessage
+	RequestID string
+	CreatedAt time.Time
+}
+
+// NewAuditEntry дополняет событие данными об исполнителе и запросе из контекста.
+func NewAuditEntry(ctx context.Context, event AuditEvent) (*AuditEntry, error) {
+	before, err := marshalSnapshot(event.Before)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := marshalSnapshot(event.After)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &AuditEntry{
+		ID:        uuid.New(),
+		ActorType: ActorAnonymous,
+		Action:    event.Action,
+		Entity:    event.Entity,
+		EntityID:  event.EntityID,
+		Before:    before,
+		After:     after,
+		RequestID: RequestIDFromCtx(ctx),
+		CreatedAt: time.Now(),
+	}
+
+	if system, _ := ctx.Value(systemActorKey{}).(bool); system {
+		entry.ActorType = ActorSystem
+	}
+
+	if identity, ok := IdentityFromCtx(ctx); ok {
+		actorID := identity.ActorID()
+		entry.ActorID = &actorID
+		entry.ActorRole = identity.Role
+		entry.ActorType = identity.Actor
+	}
+
+	return entry, nil
+}
+
+func marshalSnapshot(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	return data, nil
+}
+
+func (e *AuditEntry) ToDTO() gen.AuditEntry {
+	dto := gen.AuditEntry{
+		Id:         e.ID,
+		ActorId:    e.ActorID,
+		ActorType:  gen.AuditEntryActorType(e.ActorType),
+		Action:     string(e.Action),
+		EntityType: gen.AuditEntryEntityType(e.Entity),
+		EntityId:   e.EntityID,
+		Before:     unmarshalSnapshot(e.Before),
+		After:      unmarshalSnapshot(e.After),
+		CreatedAt:  e.CreatedAt,
+	}
+
+	if e.ActorRole != "" {
+		role := string(e.ActorRole)
+		dto.ActorRole = &role
+	}
+
+	if e.RequestID != "" {
+		dto.RequestId = &e.RequestID
+	}
+
+	return dto
+}
+
+func unmarshalSnapshot(data json.RawMessage) *map[string]any {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var snapshot map[string]any
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil
+	}
+
+	return &snapshot
+}
+
+// AuditFilter параметры поиска по журналу аудита.
+type AuditFilter struct {
+	Entity   *AuditEntity
+	EntityID *uuid.UUID
+	ActorID  *uuid.UUID
+
+	// From начало диапазона, включительно
+	From *time.Time
+
+	// To конец диапазона, не включительно
+	To *time.Time
+
+	// Page Номер страницы
+	Page int
+
+	// Limit Количество элементов на странице
+	Limit int
+}
+
+func NewAuditFilterFromDTO(p gen.GetAuditParams) *AuditFilter {
+	filter := &AuditFilter{
+		Entity:   (*AuditEntity)(p.EntityType),
+		EntityID: p.EntityId,
+		ActorID:  p.ActorId,
+		From:     p.From,
+		To:       p.To,
+		Page:     1,
+		Limit:    auditDefaultLimit,
+	}
+
+	if p.Page != nil && *p.Page > 0 {
+		filter.Page = *p.Page
+	}
+
+	if p.Limit != nil && *p.Limit > 0 {
+		filter.Limit = min(*p.Limit, auditMaxLimit)
+	}
+
+	return filter
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const cityNameMaxLength = 100
+
+// City город из справочника. ПВЗ можно открыть только в активном городе,
+// отключение города не затрагивает уже открытые ПВЗ.
+type City struct {
+	ID        uuid.UUID
+	Name      PvzCity
+	Active    bool
+	CreatedAt time.Time
+}
+
+func NewCity(name string) (*City, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > cityNameMaxLength {
+		return nil, ErrInvalidCityName
+	}
+
+	return &City{
+		ID:        uuid.New(),
+		Name:      PvzCity(name),
+		Active:    true,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (c *City) ToDTO() gen.City {
+	return gen.City{
+		Id:        c.ID,
+		Name:      string(c.Name),
+		Active:    c.Active,
+		CreatedAt: c.CreatedAt,
+	}
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+type clientIPKey struct{}
+
+// WithClientIP сохраняет в контексте адрес клиента, выполняющего запрос.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+func ClientIPFromCtx(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+
+	return ip
+}
+
+type requestIDKey struct{}
+
+// WithRequestID сохраняет в контексте идентификатор запроса,
+// чтобы связать записи аудита с логами.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func RequestIDFromCtx(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+
+	return id
+}
+
+// TrustedProxies адреса обратных прокси, которым сервис доверяет заголовок
+// X-Forwarded-For. Без них адресом клиента считается адрес соединения:
+// заголовок подделывается клиентом и не должен влиять на лимиты и блокировки.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies разбирает подсети в нотации CIDR и отдельные адреса.
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(values))
+
+	for _, value := range values {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(value)
+			if addrErr != nil {
+				return nil, fmt.Errorf("trusted proxy %q: %w", value, err)
+			}
+
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+
+		proxies = append(proxies, prefix.Masked())
+	}
+
+	return proxies, nil
+}
+
+// ClientIP возвращает адрес клиента. Если соединение пришло от доверенного
+// прокси, X-Forwarded-For читается справа налево и берется первый адрес,
+// который не принадлежит доверенным прокси: левые значения мог дописать
+// сам клиент.
+func (t TrustedProxies) ClientIP(peer string, forwardedFor []string) string {
+	if !t.trusts(peer) {
+		return peer
+	}
+
+	hops := strings.Split(strings.Join(forwardedFor, ","), ",")
+
+	ip := peer
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+
+		ip = addr.Unmap().String()
+
+		if !t.trusts(ip) {
+			break
+		}
+	}
+
+	return ip
+}
+
+func (t TrustedProxies) trusts(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,68 @@
+package domain_test
+
+import (
+	"testing"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustedProxies_ClientIP(t *testing.T) {
+	t.Parallel()
+
+	proxies, err := domain.ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.5"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		peer         string
+		forwardedFor []string
+		want         string
+	}{
+		{
+			name:         "untrusted_peer_header_ignored",
+			peer:         "203.0.113.7",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "trusted_peer_uses_header",
+			peer:         "10.0.0.2",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "spoofed_left_values_skipped",
+			peer:         "10.0.0.2",
+			forwardedFor: []string{"1.1.1.1, 198.51.100.1", "192.168.1.5"},
+			want:         "198.51.100.1",
+		},
+		{
+			name: "trusted_peer_without_header",
+			peer: "10.0.0.2",
+			want: "10.0.0.2",
+		},
+		{
+			name:         "malformed_hop_stops_walk",
+			peer:         "10.0.0.2",
+			forwardedFor: []string{"198.51.100.1, garbage"},
+			want:         "10.0.0.2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, proxies.ClientIP(tt.peer, tt.forwardedFor))
+		})
+	}
+}
+
+func TestParseTrustedProxies_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := domain.ParseTrustedProxies([]string{"not-an-ip"})
+	require.Error(t, err)
+}
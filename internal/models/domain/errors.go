@@ -9,4 +9,52 @@ var (
 	ErrInternal      = errors.New("InternalError")
 	ErrInvalidRole   = errors.New("InvalidRole")
 	ErrAlreadyExists = errors.New("UserAlreadyExist")
+	ErrUnauthorized  = errors.New("Unauthorized")
+	ErrForbidden     = errors.New("Forbidden")
+	ErrWeakPassword  = errors.New("WeakPassword")
+	ErrRateLimited   = errors.New("TooManyRequests")
+
+	ErrInvalidScope      = errors.New("InvalidScope")
+	ErrInvalidAPIKeyName = errors.New("InvalidAPIKeyName")
+	ErrInvalidExpiry     = errors.New("InvalidExpiry")
+	ErrInvalidCityName   = errors.New("InvalidCityName")
+
+	ErrInvalidProductTypeCode = errors.New("InvalidProductTypeCode")
+	ErrInvalidProductTypeName = errors.New("InvalidProductTypeName")
+
+	ErrInvalidAddress      = errors.New("InvalidAddress")
+	ErrInvalidLocation     = errors.New("InvalidLocation")
+	ErrInvalidTimezone     = errors.New("InvalidTimezone")
+	ErrInvalidWorkingHours = errors.New("InvalidWorkingHours")
+	ErrInvalidPhone        = errors.New("InvalidPhone")
+	ErrInvalidRadius       = errors.New("InvalidRadius")
+	ErrInvalidLimit        = errors.New("InvalidLimit")
+
+	ErrInvalidStatusReason     = errors.New("InvalidStatusReason")
+	ErrInvalidStatusTransition = errors.New("InvalidStatusTransition")
+	ErrStatusChanged           = errors.New("StatusChanged")
+	ErrHasOpenReception        = errors.New("HasOpenReception")
+
+	ErrEmptyBatch         = errors.New("EmptyBatch")
+	ErrBatchTooLarge      = errors.New("BatchTooLarge")
+	ErrInvalidMetadata    = errors.New("InvalidMetadata")
+	ErrReceptionNotActive = errors.New("ReceptionNotActive")
+
+	ErrInvalidBarcode = errors.New("InvalidBarcode")
+	ErrInvalidOrderID = errors.New("InvalidOrderID")
+	ErrInvalidSKU     = errors.New("InvalidSKU")
+
+	ErrInvalidProductSelector = errors.New("InvalidProductSelector")
+	ErrInvalidVoidReason      = errors.New("InvalidVoidReason")
+
+	ErrInvalidJustification    = errors.New("InvalidJustification")
+	ErrReceptionNotClosed      = errors.New("ReceptionNotClosed")
+	ErrCorrectionWindowExpired = errors.New("CorrectionWindowExpired")
+
+	ErrLocked = errors.New("Locked")
+
+	ErrEmptyASN            = errors.New("EmptyASN")
+	ErrASNTooLarge         = errors.New("ASNTooLarge")
+	ErrInvalidASNLine      = errors.New("InvalidASNLine")
+	ErrInvalidASNReference = errors.New("InvalidASNReference")
 )
@@ -0,0 +1,64 @@
+package domain
+
+import "strings"
+
+// ExternalIdentity пользователь, подтвержденный внешним провайдером (OIDC).
+// Пара Issuer и Subject однозначно определяет учетную запись провайдера.
+type ExternalIdentity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Claims        map[string]any
+}
+
+// RoleMapping сопоставляет значения claim внешнего провайдера с ролями.
+// Claim задается путем через точку (например, realm_access.roles) и может
+// быть строкой или списком строк.
+type RoleMapping struct {
+	Claim string
+	Roles map[string]Role
+}
+
+// Resolve возвращает роль по claim. Если подходят несколько значений,
+// выбирается роль модератора.
+func (m RoleMapping) Resolve(claims map[string]any) (Role, bool) {
+	var value any = claims
+
+	for _, part := range strings.Split(m.Claim, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return "", false
+		}
+
+		value = object[part]
+	}
+
+	var values []string
+
+	switch v := value.(type) {
+	case string:
+		values = []string{v}
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	var role Role
+
+	for _, v := range values {
+		mapped, ok := m.Roles[v]
+		if !ok || !mapped.IsValid() {
+			continue
+		}
+
+		if role == "" || mapped == RoleModerator {
+			role = mapped
+		}
+	}
+
+	return role, role != ""
+}
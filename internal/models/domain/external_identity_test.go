@@ -0,0 +1,68 @@
+package domain_test
+
+import (
+	"testing"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleMapping_Resolve(t *testing.T) {
+	t.Parallel()
+
+	mapping := domain.RoleMapping{
+		Claim: "realm_access.roles",
+		Roles: map[string]domain.Role{
+			"staff": domain.RoleEmploye,
+			"admin": domain.RoleModerator,
+			"owner": "owner",
+		},
+	}
+
+	tests := []struct {
+		name   string
+		claims map[string]any
+		want   domain.Role
+		wantOK bool
+	}{
+		{
+			name:   "string_value",
+			claims: map[string]any{"realm_access": map[string]any{"roles": "staff"}},
+			want:   domain.RoleEmploye,
+			wantOK: true,
+		},
+		{
+			name: "moderator_wins",
+			claims: map[string]any{
+				"realm_access": map[string]any{"roles": []any{"admin", "staff"}},
+			},
+			want:   domain.RoleModerator,
+			wantOK: true,
+		},
+		{
+			name: "unknown_roles_ignored",
+			claims: map[string]any{
+				"realm_access": map[string]any{"roles": []any{"owner", 42, "viewer"}},
+			},
+		},
+		{
+			name:   "missing_claim",
+			claims: map[string]any{"roles": "staff"},
+		},
+		{
+			name:   "claim_is_not_an_object",
+			claims: map[string]any{"realm_access": "staff"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := mapping.Resolve(tt.claims)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
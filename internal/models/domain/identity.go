@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type identityKey struct{}
+
+// Identity описывает того, кто выполняет запрос. Actor задает, кто за ним
+// стоит: у пользователя UserID, у API-ключа APIKeyID, а Scopes и PVZID
+// дополнительно ограничивают доступ по ключу. У тестовых токенов /dummyLogin
+// UserID случайный: за ними нет пользователя.
+// SessionID пустой для токенов, выданных вне сессии.
+type Identity struct {
+	Actor     ActorType
+	UserID    uuid.UUID
+	Role      Role
+	SessionID uuid.UUID
+
+	APIKeyID uuid.UUID
+	Scopes   []Scope
+	PVZID    *uuid.UUID
+}
+
+func (i *Identity) IsAPIKey() bool {
+	return i.Actor == ActorAPIKey
+}
+
+func (i *Identity) IsDummy() bool {
+	return i.Actor == ActorDummy
+}
+
+// ActorID возвращает ID того, кто выполняет запрос: пользователя или ключа.
+func (i *Identity) ActorID() uuid.UUID {
+	if i.IsAPIKey() {
+		return i.APIKeyID
+	}
+
+	return i.UserID
+}
+
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+func IdentityFromCtx(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	if !ok {
+		return nil, false
+	}
+
+	return &identity, true
+}
+
+// Permission описывает, кому разрешена операция.
+// Public операции доступны без токена, остальные только перечисленным ролям.
+// По API-ключу операция доступна, только если у ключа есть Scope;
+// операции без Scope по ключам недоступны.
+type Permission struct {
+	Public bool
+	Roles  []Role
+	Scope  Scope
+}
+
+// AccessPolicy сопоставляет операции (operation ID для HTTP,
+// полное имя метода для gRPC) с правами доступа.
+type AccessPolicy map[string]Permission
+
+// Check возвращает ErrUnauthorized, если операция требует аутентификации,
+// и ErrForbidden, если роль или scope ключа не подходят
+// или операция не описана в политике.
+func (p AccessPolicy) Check(operation string, identity *Identity) error {
+	perm, ok := p[operation]
+	if !ok {
+		return ErrForbidden
+	}
+
+	if perm.Public {
+		return nil
+	}
+
+	if identity == nil {
+		return ErrUnauthorized
+	}
+
+	if !slices.Contains(perm.Roles, identity.Role) {
+		return ErrForbidden
+	}
+
+	if identity.IsAPIKey() &&
+		(perm.Scope == "" || !slices.Contains(identity.Scopes, perm.Scope)) {
+		return ErrForbidden
+	}
+
+	return nil
+}
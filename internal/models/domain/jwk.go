@@ -0,0 +1,47 @@
+package domain
+
+import "avito_pvz/internal/http/gen"
+
+// JWK описывает публичный ключ подписи в формате RFC 7517.
+// Для RSA заполняются N и E, для Ed25519 — Crv и X.
+type JWK struct {
+	Kty string
+	Kid string
+	Alg string
+	N   string
+	E   string
+	Crv string
+	X   string
+}
+
+type JWKSet []JWK
+
+func (k JWK) ToDTO() gen.JWK {
+	dto := gen.JWK{
+		Kty: gen.JWKKty(k.Kty),
+		Kid: k.Kid,
+		Use: "sig",
+		Alg: gen.JWKAlg(k.Alg),
+	}
+
+	if k.N != "" {
+		dto.N = &k.N
+		dto.E = &k.E
+	}
+
+	if k.X != "" {
+		dto.Crv = &k.Crv
+		dto.X = &k.X
+	}
+
+	return dto
+}
+
+func (s JWKSet) ToDTO() gen.JWKSet {
+	keys := make([]gen.JWK, 0, len(s))
+	for _, k := range s {
+		keys = append(keys, k.ToDTO())
+	}
+
+	return gen.JWKSet{Keys: keys}
+}
@@ -0,0 +1,67 @@
+package domain
+
+import "time"
+
+// LoginAttempt счетчик неудачных попыток входа по ключу
+// (учетная запись или IP-адрес).
+type LoginAttempt struct {
+	Key         string
+	Failures    int
+	LockedUntil *time.Time
+	LastFailure time.Time
+}
+
+// LockoutPolicy задает порог неудачных попыток и экспоненциальную
+// задержку блокировки после его превышения.
+type LockoutPolicy struct {
+	Threshold int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Window время, после которого счетчик без новых ошибок сбрасывается
+	Window time.Duration
+}
+
+func AccountAttemptKey(email string) string {
+	return "account:" + email
+}
+
+func IPAttemptKey(ip string) string {
+	return "ip:" + ip
+}
+
+// RetryAfter возвращает оставшееся время блокировки.
+func (a *LoginAttempt) RetryAfter(now time.Time) time.Duration {
+	if a == nil || a.LockedUntil == nil || !now.Before(*a.LockedUntil) {
+		return 0
+	}
+
+	return a.LockedUntil.Sub(now)
+}
+
+// WindowStart возвращает момент, раньше которого последняя ошибка уже не
+// учитывается и счетчик начинается заново. Без окна счетчик не сбрасывается.
+func (p LockoutPolicy) WindowStart(now time.Time) time.Time {
+	if p.Window <= 0 {
+		return time.Time{}
+	}
+
+	return now.Add(-p.Window)
+}
+
+// Lock блокирует ключ, если уже учтенных ошибок Failures не меньше порога,
+// и возвращает true, если блокировка установлена.
+func (a *LoginAttempt) Lock(policy LockoutPolicy, now time.Time) bool {
+	if policy.Threshold <= 0 || a.Failures < policy.Threshold {
+		return false
+	}
+
+	delay := policy.BaseDelay << (a.Failures - policy.Threshold)
+	if delay <= 0 || delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+
+	until := now.Add(delay)
+	a.LockedUntil = &until
+
+	return true
+}
@@ -0,0 +1,46 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginAttempt_Lock(t *testing.T) {
+	t.Parallel()
+
+	policy := domain.LockoutPolicy{
+		Threshold: 3,
+		BaseDelay: time.Second,
+		MaxDelay:  10 * time.Second,
+	}
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	attempt := &domain.LoginAttempt{
+		Key:      domain.AccountAttemptKey("user@example.com"),
+		Failures: 2,
+	}
+
+	require.False(t, attempt.Lock(policy, now))
+	require.Zero(t, attempt.RetryAfter(now))
+
+	// Блокировка растет экспоненциально и ограничена MaxDelay.
+	wantDelays := []time.Duration{
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		10 * time.Second,
+		10 * time.Second,
+	}
+	for _, want := range wantDelays {
+		attempt.Failures++
+		require.True(t, attempt.Lock(policy, now))
+		require.Equal(t, want, attempt.RetryAfter(now))
+	}
+
+	require.Zero(t, attempt.RetryAfter(now.Add(time.Minute)))
+}
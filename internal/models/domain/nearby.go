@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"time"
+)
+
+const (
+	defaultNearbyRadiusKm = 10
+	maxNearbyRadiusKm     = 200
+	defaultNearbyLimit    = 10
+	maxNearbyLimit        = 50
+)
+
+// NearbyQuery поиск ближайших к точке ПВЗ. Если OpenAt задан,
+// подходят только ПВЗ, открытые в этот момент.
+type NearbyQuery struct {
+	Point    GeoPoint
+	RadiusKm float64
+	Limit    int
+	OpenAt   *time.Time
+}
+
+// NearbyPVZ найденный ПВЗ и расстояние до точки поиска.
+type NearbyPVZ struct {
+	PVZ        PVZ
+	DistanceKm float64
+}
+
+// NewNearbyQuery поиск с радиусом и лимитом по умолчанию.
+func NewNearbyQuery(point GeoPoint) NearbyQuery {
+	return NearbyQuery{
+		Point:    point,
+		RadiusKm: defaultNearbyRadiusKm,
+		Limit:    defaultNearbyLimit,
+	}
+}
+
+func NewNearbyQueryFromDTO(params gen.GetPvzNearestParams, now time.Time) NearbyQuery {
+	query := NewNearbyQuery(GeoPoint{Lat: params.Lat, Lon: params.Lon})
+
+	if params.RadiusKm != nil {
+		query.RadiusKm = *params.RadiusKm
+	}
+
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+
+	if params.OpenNow != nil && *params.OpenNow {
+		query.OpenAt = &now
+	}
+
+	return query
+}
+
+func (q NearbyQuery) Validate() error {
+	if !q.Point.valid() {
+		return ErrInvalidLocation
+	}
+
+	if !(q.RadiusKm > 0 && q.RadiusKm <= maxNearbyRadiusKm) {
+		return ErrInvalidRadius
+	}
+
+	if q.Limit < 1 || q.Limit > maxNearbyLimit {
+		return ErrInvalidLimit
+	}
+
+	return nil
+}
+
+func (n *NearbyPVZ) ToDTO() gen.NearbyPVZ {
+	return gen.NearbyPVZ{
+		Pvz:        n.PVZ.ToDTO(),
+		DistanceKm: n.DistanceKm,
+	}
+}
@@ -16,6 +16,9 @@ type Params struct {
 
 	// Limit Количество элементов на странице
 	Limit *int
+
+	// Status Только ПВЗ в этом состоянии, по умолчанию все
+	Status *PVZStatus
 }
 
 func NewParamsFromDTO(p gen.GetPvzParams) *Params {
@@ -24,5 +27,6 @@ func NewParamsFromDTO(p gen.GetPvzParams) *Params {
 		EndDate:   p.EndDate,
 		Page:      p.Page,
 		Limit:     p.Limit,
+		Status:    (*PVZStatus)(p.Status),
 	}
 }
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxPasswordLength ограничивает длину пароля, чтобы хеширование
+// длинных строк нельзя было использовать для нагрузки на сервер.
+const maxPasswordLength = 128
+
+// PasswordPolicy требования к паролям, которые задают пользователи.
+type PasswordPolicy struct {
+	MinLength      int
+	RequireUpper   bool
+	RequireLower   bool
+	RequireDigit   bool
+	RequireSpecial bool
+
+	// Denylist распространенные пароли в нижнем регистре.
+	Denylist map[string]struct{}
+}
+
+// PasswordPolicyError перечисляет все нарушенные требования,
+// чтобы пользователь мог исправить пароль за одну попытку.
+type PasswordPolicyError struct {
+	Violations []string
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return ErrWeakPassword.Error() + ": " + strings.Join(e.Violations, "; ")
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrWeakPassword
+}
+
+// Validate проверяет пароль и возвращает *PasswordPolicyError,
+// если он не удовлетворяет политике.
+func (p PasswordPolicy) Validate(password string) error {
+	var violations []string
+
+	// Пустой пароль не допускается даже без ограничения длины.
+	minLength := max(p.MinLength, 1)
+
+	length := utf8.RuneCountInString(password)
+	if length < minLength {
+		violations = append(violations,
+			"password must be at least "+strconv.Itoa(minLength)+" characters long")
+	}
+
+	if length > maxPasswordLength {
+		violations = append(violations,
+			"password must be at most "+strconv.Itoa(maxPasswordLength)+" characters long")
+	}
+
+	var upper, lower, digit, special bool
+
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r):
+			special = true
+		}
+	}
+
+	if p.RequireUpper && !upper {
+		violations = append(violations, "password must contain an uppercase letter")
+	}
+
+	if p.RequireLower && !lower {
+		violations = append(violations, "password must contain a lowercase letter")
+	}
+
+	if p.RequireDigit && !digit {
+		violations = append(violations, "password must contain a digit")
+	}
+
+	if p.RequireSpecial && !special {
+		violations = append(violations, "password must contain a special character")
+	}
+
+	if _, ok := p.Denylist[strings.ToLower(password)]; ok {
+		violations = append(violations, "password is too common")
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+
+	return nil
+}
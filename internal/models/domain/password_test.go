@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	policy := domain.PasswordPolicy{
+		MinLength:      10,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		RequireSpecial: true,
+		Denylist: map[string]struct{}{
+			"p@ssword123456": {},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		password   string
+		violations []string
+	}{
+		{
+			name:     "strong_password",
+			password: "Correct-Horse-42",
+		},
+		{
+			name:     "unicode_letters",
+			password: "Пароль-надежный-7",
+		},
+		{
+			name:     "empty_password",
+			password: "",
+			violations: []string{
+				"password must be at least 10 characters long",
+				"password must contain an uppercase letter",
+				"password must contain a lowercase letter",
+				"password must contain a digit",
+				"password must contain a special character",
+			},
+		},
+		{
+			name:     "too_short",
+			password: "Sh0rt!",
+			violations: []string{
+				"password must be at least 10 characters long",
+			},
+		},
+		{
+			name:     "too_long",
+			password: "Aa1!" + strings.Repeat("x", 200),
+			violations: []string{
+				"password must be at most 128 characters long",
+			},
+		},
+		{
+			name:     "missing_classes",
+			password: "onlylowercaseletters",
+			violations: []string{
+				"password must contain an uppercase letter",
+				"password must contain a digit",
+				"password must contain a special character",
+			},
+		},
+		{
+			name:     "denylisted_case_insensitive",
+			password: "P@ssword123456",
+			violations: []string{
+				"password is too common",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := policy.Validate(tt.password)
+			if tt.violations == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, domain.ErrWeakPassword)
+
+			var policyErr *domain.PasswordPolicyError
+			require.ErrorAs(t, err, &policyErr)
+			require.Equal(t, tt.violations, policyErr.Violations)
+		})
+	}
+}
+
+func TestPasswordPolicy_ValidateZeroValue(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, domain.PasswordPolicy{}.Validate("x"))
+	require.ErrorIs(t, domain.PasswordPolicy{}.Validate(""), domain.ErrWeakPassword)
+}
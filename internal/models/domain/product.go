@@ -8,44 +8,66 @@ import (
 	"github.com/oapi-codegen/runtime/types"
 )
 
+// ProductType код типа товара из справочника, например electronics.
 type ProductType string
 
-func (p ProductType) IsValid() bool {
-	return ((p == ProductTypeClothing || p == ProductTypeElectronics) || p == ProductTypeShoes)
-}
-
-const (
-	ProductTypeElectronics ProductType = "электроника"
-	ProductTypeClothing    ProductType = "одежда"
-	ProductTypeShoes       ProductType = "обувь"
-)
-
+// Product принятый товар. TypeName русское название типа из справочника,
+// оно заполняется при чтении и не хранится в строке товара.
 type Product struct {
 	ID          uuid.UUID
 	ReceptionID uuid.UUID
 	Type        ProductType
+	TypeName    string
+	Metadata    ProductMetadata
 	CreatedAt   time.Time
+
+	ProductIdentity
+
+	// VoidedAt заполнено у аннулированного товара, строка остается в базе.
+	VoidedAt   *time.Time
+	VoidReason string
 }
 
 func (p *Product) ToDto() gen.Product {
+	name := p.TypeName
+	if name == "" {
+		name = string(p.Type)
+	}
+
+	var metadata *gen.ProductMetadata
+	if len(p.Metadata) > 0 {
+		m := gen.ProductMetadata(p.Metadata)
+		metadata = &m
+	}
+
 	return gen.Product{
 		DateTime:    &p.CreatedAt,
 		Id:          &p.ID,
 		ReceptionId: types.UUID(p.ReceptionID),
-		Type:        gen.ProductType(p.Type),
+		Type:        name,
+		TypeCode:    string(p.Type),
+		Metadata:    metadata,
+		Barcode:     optional(p.Barcode),
+		OrderId:     optional(p.OrderID),
+		Sku:         optional(p.SKU),
+		VoidedAt:    p.VoidedAt,
+		VoidReason:  optional(p.VoidReason),
 	}
 }
 
 type ProductToAdd struct {
 	UUID PVZID
 	Type ProductType
+
+	ProductIdentity
 }
 
-func NewProduct(intake uuid.UUID, productType ProductType) *Product {
+func NewProduct(intake uuid.UUID, productType *ProductTypeInfo) *Product {
 	return &Product{
 		ID:          uuid.New(),
 		ReceptionID: intake,
-		Type:        productType,
+		Type:        productType.Code,
+		TypeName:    productType.NameRu,
 		CreatedAt:   time.Now(),
 	}
 }
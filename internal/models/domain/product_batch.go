@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ProductBatchMaxSize = 500
+
+	metadataMaxKeys        = 20
+	metadataMaxKeyLength   = 64
+	metadataMaxValueLength = 256
+)
+
+// ProductMetadata пары ключ-значение, которые отправитель передает с товаром.
+type ProductMetadata map[string]string
+
+func (m ProductMetadata) Validate() error {
+	if len(m) > metadataMaxKeys {
+		return ErrInvalidMetadata
+	}
+
+	for key, value := range m {
+		if key == "" || utf8.RuneCountInString(key) > metadataMaxKeyLength {
+			return ErrInvalidMetadata
+		}
+
+		if utf8.RuneCountInString(value) > metadataMaxValueLength {
+			return ErrInvalidMetadata
+		}
+	}
+
+	return nil
+}
+
+type ProductBatchItem struct {
+	Type     ProductType
+	Metadata ProductMetadata
+
+	ProductIdentity
+}
+
+// ProductBatch товары для текущей приемки ПВЗ в порядке добавления.
+type ProductBatch struct {
+	PVZID PVZID
+	Items []ProductBatchItem
+}
+
+func (b ProductBatch) Validate() error {
+	if len(b.Items) == 0 {
+		return ErrEmptyBatch
+	}
+
+	if len(b.Items) > ProductBatchMaxSize {
+		return ErrBatchTooLarge
+	}
+
+	return nil
+}
+
+func NewProductBatchFromDTO(
+	pvzID uuid.UUID,
+	body gen.PostPvzPvzIdProductsBatchJSONRequestBody,
+) ProductBatch {
+	items := make([]ProductBatchItem, 0, len(body.Products))
+	for _, item := range body.Products {
+		var metadata ProductMetadata
+		if item.Metadata != nil {
+			metadata = ProductMetadata(*item.Metadata)
+		}
+
+		items = append(items, ProductBatchItem{
+			Type:            ProductType(item.Type),
+			Metadata:        metadata,
+			ProductIdentity: NewProductIdentity(item.Barcode, item.OrderId, item.Sku),
+		})
+	}
+
+	return ProductBatch{
+		PVZID: PVZID(pvzID),
+		Items: items,
+	}
+}
+
+func ProductsToDTO(products []*Product) []gen.Product {
+	out := make([]gen.Product, 0, len(products))
+	for _, product := range products {
+		out = append(out, product.ToDto())
+	}
+
+	return out
+}
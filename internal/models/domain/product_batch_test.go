@@ -0,0 +1,48 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductMetadata_Validate(t *testing.T) {
+	t.Parallel()
+
+	tooMany := domain.ProductMetadata{}
+	for i := range 21 {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+
+	tests := []struct {
+		name     string
+		metadata domain.ProductMetadata
+		wantErr  error
+	}{
+		{name: "nil", metadata: nil},
+		{name: "valid", metadata: domain.ProductMetadata{"supplier": "ООО Ромашка"}},
+		{name: "too_many_keys", metadata: tooMany, wantErr: domain.ErrInvalidMetadata},
+		{name: "empty_key", metadata: domain.ProductMetadata{"": "v"}, wantErr: domain.ErrInvalidMetadata},
+		{
+			name:     "long_key",
+			metadata: domain.ProductMetadata{strings.Repeat("я", 65): "v"},
+			wantErr:  domain.ErrInvalidMetadata,
+		},
+		{
+			name:     "long_value",
+			metadata: domain.ProductMetadata{"note": strings.Repeat("я", 257)},
+			wantErr:  domain.ErrInvalidMetadata,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, tt.metadata.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	barcodeMaxLength   = 64
+	orderIDMaxLength   = 64
+	skuMaxLength       = 64
+	barcodeExtraSymbol = "-_."
+)
+
+// ProductIdentity отличает один принятый товар от другого. Все поля
+// необязательны, пустая строка означает, что значение не передано.
+type ProductIdentity struct {
+	Barcode string
+	OrderID string
+	SKU     string
+}
+
+// NewProductIdentity убирает пробелы по краям, проверяет значения Validate.
+func NewProductIdentity(barcode, orderID, sku *string) ProductIdentity {
+	return ProductIdentity{
+		Barcode: strings.TrimSpace(deref(barcode)),
+		OrderID: strings.TrimSpace(deref(orderID)),
+		SKU:     strings.TrimSpace(deref(sku)),
+	}
+}
+
+func (i ProductIdentity) Validate() error {
+	if utf8.RuneCountInString(i.Barcode) > barcodeMaxLength {
+		return ErrInvalidBarcode
+	}
+
+	for _, r := range i.Barcode {
+		if !isBarcodeRune(r) {
+			return ErrInvalidBarcode
+		}
+	}
+
+	if utf8.RuneCountInString(i.OrderID) > orderIDMaxLength {
+		return ErrInvalidOrderID
+	}
+
+	if utf8.RuneCountInString(i.SKU) > skuMaxLength {
+		return ErrInvalidSKU
+	}
+
+	return nil
+}
+
+func isBarcodeRune(r rune) bool {
+	return r >= '0' && r <= '9' ||
+		r >= 'A' && r <= 'Z' ||
+		r >= 'a' && r <= 'z' ||
+		strings.ContainsRune(barcodeExtraSymbol, r)
+}
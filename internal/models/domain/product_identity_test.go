@@ -0,0 +1,57 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProductIdentity(t *testing.T) {
+	t.Parallel()
+
+	barcode, sku := "  4601234567890 ", "SKU-1"
+	identity := domain.NewProductIdentity(&barcode, nil, &sku)
+
+	assert.Equal(t, domain.ProductIdentity{Barcode: "4601234567890", SKU: "SKU-1"}, identity)
+}
+
+func TestProductIdentity_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		identity domain.ProductIdentity
+		wantErr  error
+	}{
+		{name: "empty", identity: domain.ProductIdentity{}},
+		{name: "full", identity: domain.ProductIdentity{Barcode: "AB-12_3.4", OrderID: "order 7", SKU: "sku"}},
+		{name: "barcode_space", identity: domain.ProductIdentity{Barcode: "12 34"}, wantErr: domain.ErrInvalidBarcode},
+		{name: "barcode_cyrillic", identity: domain.ProductIdentity{Barcode: "ЕАН13"}, wantErr: domain.ErrInvalidBarcode},
+		{
+			name:     "barcode_too_long",
+			identity: domain.ProductIdentity{Barcode: strings.Repeat("1", 65)},
+			wantErr:  domain.ErrInvalidBarcode,
+		},
+		{
+			name:     "order_too_long",
+			identity: domain.ProductIdentity{OrderID: strings.Repeat("1", 65)},
+			wantErr:  domain.ErrInvalidOrderID,
+		},
+		{
+			name:     "sku_too_long",
+			identity: domain.ProductIdentity{SKU: strings.Repeat("1", 65)},
+			wantErr:  domain.ErrInvalidSKU,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, tt.identity.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const productTypeNameMaxLength = 100
+
+var productTypeCodeRe = regexp.MustCompile(`^[a-z][a-z0-9_]{1,31}$`)
+
+// ProductTypeAttributes дополнительные требования к товарам типа.
+type ProductTypeAttributes struct {
+	RequiresSerial   bool
+	RequiresAgeCheck bool
+}
+
+// ProductTypeInfo тип товара из справочника. Товары ссылаются на тип по коду,
+// в ответах API показывается русское название. Отключенный тип остается
+// у принятых товаров, но новые товары этого типа не принимаются.
+type ProductTypeInfo struct {
+	ID         uuid.UUID
+	Code       ProductType
+	NameRu     string
+	NameEn     string
+	Attributes ProductTypeAttributes
+	Active     bool
+	CreatedAt  time.Time
+}
+
+type ProductTypeToCreate struct {
+	Code       string
+	NameRu     string
+	NameEn     string
+	Attributes ProductTypeAttributes
+}
+
+type ProductTypeToUpdate struct {
+	NameRu     string
+	NameEn     string
+	Attributes ProductTypeAttributes
+	Active     bool
+}
+
+func NewProductTypeInfo(params ProductTypeToCreate) (*ProductTypeInfo, error) {
+	code := strings.TrimSpace(params.Code)
+	if !productTypeCodeRe.MatchString(code) {
+		return nil, ErrInvalidProductTypeCode
+	}
+
+	nameRu, nameEn, err := productTypeNames(params.NameRu, params.NameEn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProductTypeInfo{
+		ID:         uuid.New(),
+		Code:       ProductType(code),
+		NameRu:     nameRu,
+		NameEn:     nameEn,
+		Attributes: params.Attributes,
+		Active:     true,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// Apply возвращает тип с новыми названиями, атрибутами и активностью.
+func (p ProductTypeInfo) Apply(params ProductTypeToUpdate) (*ProductTypeInfo, error) {
+	nameRu, nameEn, err := productTypeNames(params.NameRu, params.NameEn)
+	if err != nil {
+		return nil, err
+	}
+
+	p.NameRu = nameRu
+	p.NameEn = nameEn
+	p.Attributes = params.Attributes
+	p.Active = params.Active
+
+	return &p, nil
+}
+
+func productTypeNames(ru, en string) (string, string, error) {
+	ru, en = strings.TrimSpace(ru), strings.TrimSpace(en)
+
+	for _, name := range []string{ru, en} {
+		if name == "" || utf8.RuneCountInString(name) > productTypeNameMaxLength {
+			return "", "", ErrInvalidProductTypeName
+		}
+	}
+
+	return ru, en, nil
+}
+
+func NewProductTypeToCreateFromDTO(dto gen.ProductTypeInput) ProductTypeToCreate {
+	params := ProductTypeToCreate{
+		Code:   dto.Code,
+		NameRu: dto.NameRu,
+		NameEn: dto.NameEn,
+	}
+
+	if dto.RequiresSerial != nil {
+		params.Attributes.RequiresSerial = *dto.RequiresSerial
+	}
+
+	if dto.RequiresAgeCheck != nil {
+		params.Attributes.RequiresAgeCheck = *dto.RequiresAgeCheck
+	}
+
+	return params
+}
+
+func NewProductTypeToUpdateFromDTO(dto gen.ProductTypeUpdate) ProductTypeToUpdate {
+	return ProductTypeToUpdate{
+		NameRu: dto.NameRu,
+		NameEn: dto.NameEn,
+		Attributes: ProductTypeAttributes{
+			RequiresSerial:   dto.RequiresSerial,
+			RequiresAgeCheck: dto.RequiresAgeCheck,
+		},
+		Active: dto.Active,
+	}
+}
+
+func (p *ProductTypeInfo) ToDTO() gen.ProductType {
+	return gen.ProductType{
+		Id:               p.ID,
+		Code:             string(p.Code),
+		NameRu:           p.NameRu,
+		NameEn:           p.NameEn,
+		RequiresSerial:   p.Attributes.RequiresSerial,
+		RequiresAgeCheck: p.Attributes.RequiresAgeCheck,
+		Active:           p.Active,
+		CreatedAt:        p.CreatedAt,
+	}
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const voidReasonMaxLength = 500
+
+// DeleteLastVoidReason причина, с которой аннулируется товар при удалении
+// последнего товара приемки.
+const DeleteLastVoidReason = "Удален последний добавленный товар"
+
+// ProductVoid аннулирует один товар открытой приемки по ID или штрихкоду.
+// Строка товара не удаляется, а помечается с причиной и автором.
+type ProductVoid struct {
+	ReceptionID uuid.UUID
+	ProductID   *uuid.UUID
+	Barcode     string
+	Reason      string
+	VoidedBy    *uuid.UUID
+	VoidedAt    time.Time
+}
+
+// ProductVoidRequest что и почему аннулировать, задается ровно одно из
+// ProductID и Barcode.
+type ProductVoidRequest struct {
+	PVZID     PVZID
+	ProductID *uuid.UUID
+	Barcode   string
+	Reason    string
+}
+
+func (r ProductVoidRequest) Validate() error {
+	if (r.ProductID == nil) == (strings.TrimSpace(r.Barcode) == "") {
+		return ErrInvalidProductSelector
+	}
+
+	reason := strings.TrimSpace(r.Reason)
+	if reason == "" || utf8.RuneCountInString(reason) > voidReasonMaxLength {
+		return ErrInvalidVoidReason
+	}
+
+	return nil
+}
+
+func NewProductVoid(ctx context.Context, receptionID uuid.UUID, request ProductVoidRequest) *ProductVoid {
+	void := &ProductVoid{
+		ReceptionID: receptionID,
+		ProductID:   request.ProductID,
+		Barcode:     strings.TrimSpace(request.Barcode),
+		Reason:      strings.TrimSpace(request.Reason),
+		VoidedAt:    time.Now(),
+	}
+
+	if identity, ok := IdentityFromCtx(ctx); ok {
+		actorID := identity.ActorID()
+		void.VoidedBy = &actorID
+	}
+
+	return void
+}
+
+func NewProductVoidRequestFromDTO(pvzID uuid.UUID, body gen.ProductVoidRequest) ProductVoidRequest {
+	return ProductVoidRequest{
+		PVZID:     PVZID(pvzID),
+		ProductID: body.ProductId,
+		Barcode:   deref(body.Barcode),
+		Reason:    body.Reason,
+	}
+}
@@ -8,39 +8,45 @@ import (
 	"github.com/oapi-codegen/runtime/types"
 )
 
-const (
-	Kazan       PvzCity = "Казань"
-	Moscow      PvzCity = "Москва"
-	StPeterburg PvzCity = "Санкт-Петербург"
-)
-
-func NewPVZ(city PvzCity) *PVZ {
+func NewPVZ(city PvzCity, profile PVZProfile) *PVZ {
 	uid := uuid.New()
 
 	return &PVZ{
 		ID:               (*PVZID)(&uid),
 		City:             city,
 		RegistrationDate: time.Now(),
+		Profile:          profile,
+		Status:           PVZStatusActive,
 	}
 }
 
+// PvzCity название города из справочника городов.
 type PvzCity string
 
-func (p PvzCity) IsValid() bool {
-	return p == Kazan || p == Moscow || p == StPeterburg
-}
-
 type PVZ struct {
 	ID               *PVZID
 	RegistrationDate time.Time
 	City             PvzCity
+	Profile          PVZProfile
+
+	Status          PVZStatus
+	StatusReason    string
+	StatusChangedAt *time.Time
 }
 
 func (p *PVZ) ToDTO() gen.PVZ {
+	profile := p.Profile.ToDTO()
+
+	status := gen.PVZStatus(p.Status)
+
 	return gen.PVZ{
-		City:             gen.PVZCity(p.City),
+		City:             string(p.City),
 		Id:               (*types.UUID)(p.ID),
 		RegistrationDate: &p.RegistrationDate,
+		Profile:          &profile,
+		Status:           &status,
+		StatusReason:     optional(p.StatusReason),
+		StatusChangedAt:  p.StatusChangedAt,
 	}
 }
 
@@ -0,0 +1,407 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"context"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	pvzAddressMaxLength = 300
+	hoursNoteMaxLength  = 200
+
+	minutesInDay = 24 * 60
+	dateLayout   = "2006-01-02"
+)
+
+var phoneRe = regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+
+// Weekday день недели в расписании: mon, tue, ..., sun.
+type Weekday string
+
+var weekdays = map[Weekday]time.Weekday{
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+	"sun": time.Sunday,
+}
+
+// GeoPoint координаты в градусах WGS 84.
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// DayHours интервал работы в день недели. Время задается как ЧЧ:ММ
+// в часовом поясе ПВЗ, Close может быть 24:00.
+type DayHours struct {
+	Day   Weekday `json:"day"`
+	Open  string  `json:"open"`
+	Close string  `json:"close"`
+}
+
+// HoursException особый день (праздник, санитарный день). Заменяет
+// расписание дня недели: либо выходной, либо один интервал Open–Close.
+type HoursException struct {
+	Date   string `json:"date"`
+	Closed bool   `json:"closed"`
+	Open   string `json:"open,omitempty"`
+	Close  string `json:"close,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+type WorkingHours struct {
+	Weekly     []DayHours       `json:"weekly"`
+	Exceptions []HoursException `json:"exceptions,omitempty"`
+}
+
+func (h WorkingHours) IsEmpty() bool {
+	return len(h.Weekly) == 0 && len(h.Exceptions) == 0
+}
+
+// PVZProfile сведения о ПВЗ для сотрудников и маршрутизации доставок.
+// Профиль хранится в истории изменений в JSON, отсюда теги.
+type PVZProfile struct {
+	Address  string       `json:"address,omitempty"`
+	Location *GeoPoint    `json:"location,omitempty"`
+	Timezone string       `json:"timezone,omitempty"`
+	Hours    WorkingHours `json:"workingHours"`
+	Phone    string       `json:"phone,omitempty"`
+}
+
+// PVZProfileUpdate изменение профиля: nil оставляет поле как есть.
+type PVZProfileUpdate struct {
+	Address  *string
+	Location *GeoPoint
+	Timezone *string
+	Hours    *WorkingHours
+	Phone    *string
+}
+
+// Apply возвращает профиль с примененными изменениями и проверяет его целиком:
+// например, часы работы без часового пояса не принимаются.
+func (p PVZProfile) Apply(update PVZProfileUpdate) (PVZProfile, error) {
+	if update.Address != nil {
+		p.Address = strings.TrimSpace(*update.Address)
+	}
+
+	if update.Location != nil {
+		location := *update.Location
+		p.Location = &location
+	}
+
+	if update.Timezone != nil {
+		p.Timezone = strings.TrimSpace(*update.Timezone)
+	}
+
+	if update.Hours != nil {
+		p.Hours = *update.Hours
+	}
+
+	if update.Phone != nil {
+		p.Phone = normalizePhone(*update.Phone)
+	}
+
+	if err := p.Validate(); err != nil {
+		return PVZProfile{}, err
+	}
+
+	return p, nil
+}
+
+func (p PVZProfile) Validate() error {
+	if utf8.RuneCountInString(p.Address) > pvzAddressMaxLength {
+		return ErrInvalidAddress
+	}
+
+	if p.Location != nil && !p.Location.valid() {
+		return ErrInvalidLocation
+	}
+
+	if p.Timezone != "" {
+		if _, err := time.LoadLocation(p.Timezone); err != nil {
+			return ErrInvalidTimezone
+		}
+	}
+
+	if !p.Hours.IsEmpty() && p.Timezone == "" {
+		return ErrInvalidTimezone
+	}
+
+	if err := p.Hours.validate(); err != nil {
+		return err
+	}
+
+	if p.Phone != "" && !phoneRe.MatchString(p.Phone) {
+		return ErrInvalidPhone
+	}
+
+	return nil
+}
+
+func (g GeoPoint) valid() bool {
+	if math.IsNaN(g.Lat) || math.IsNaN(g.Lon) {
+		return false
+	}
+
+	return g.Lat >= -90 && g.Lat <= 90 && g.Lon >= -180 && g.Lon <= 180
+}
+
+func (h WorkingHours) validate() error {
+	intervals := make(map[Weekday][][2]int, len(weekdays))
+
+	for _, day := range h.Weekly {
+		if _, ok := weekdays[day.Day]; !ok {
+			return ErrInvalidWorkingHours
+		}
+
+		open, closeAt, err := parseInterval(day.Open, day.Close)
+		if err != nil {
+			return err
+		}
+
+		intervals[day.Day] = append(intervals[day.Day], [2]int{open, closeAt})
+	}
+
+	for _, day := range intervals {
+		sort.Slice(day, func(i, j int) bool { return day[i][0] < day[j][0] })
+
+		for i := 1; i < len(day); i++ {
+			if day[i][0] < day[i-1][1] {
+				return ErrInvalidWorkingHours
+			}
+		}
+	}
+
+	dates := make(map[string]struct{}, len(h.Exceptions))
+
+	for _, exception := range h.Exceptions {
+		if _, err := time.Parse(dateLayout, exception.Date); err != nil {
+			return ErrInvalidWorkingHours
+		}
+
+		if _, ok := dates[exception.Date]; ok {
+			return ErrInvalidWorkingHours
+		}
+
+		dates[exception.Date] = struct{}{}
+
+		if utf8.RuneCountInString(exception.Note) > hoursNoteMaxLength {
+			return ErrInvalidWorkingHours
+		}
+
+		if exception.Closed {
+			if exception.Open != "" || exception.Close != "" {
+				return ErrInvalidWorkingHours
+			}
+
+			continue
+		}
+
+		if _, _, err := parseInterval(exception.Open, exception.Close); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseInterval переводит ЧЧ:ММ в минуты от начала дня.
+func parseInterval(open, closeAt string) (int, int, error) {
+	from, ok := parseClock(open)
+	if !ok || from == minutesInDay {
+		return 0, 0, ErrInvalidWorkingHours
+	}
+
+	to, ok := parseClock(closeAt)
+	if !ok || to <= from {
+		return 0, 0, ErrInvalidWorkingHours
+	}
+
+	return from, to, nil
+}
+
+func parseClock(value string) (int, bool) {
+	if value == "24:00" {
+		return minutesInDay, true
+	}
+
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, false
+	}
+
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// normalizePhone убирает пробелы, дефисы и скобки: +7 (495) 123-45-67.
+func normalizePhone(phone string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '(', ')':
+			return -1
+		default:
+			return r
+		}
+	}, phone)
+}
+
+// PVZProfileChange запись истории профиля ПВЗ.
+type PVZProfileChange struct {
+	ID        uuid.UUID
+	PVZID     uuid.UUID
+	ChangedBy *uuid.UUID
+	Before    PVZProfile
+	After     PVZProfile
+	CreatedAt time.Time
+}
+
+// NewPVZProfileChange фиксирует изменение от имени пользователя из контекста.
+func NewPVZProfileChange(ctx context.Context, pvzID uuid.UUID, before, after PVZProfile) *PVZProfileChange {
+	change := &PVZProfileChange{
+		ID:        uuid.New(),
+		PVZID:     pvzID,
+		Before:    before,
+		After:     after,
+		CreatedAt: time.Now(),
+	}
+
+	if identity, ok := IdentityFromCtx(ctx); ok {
+		actorID := identity.ActorID()
+		change.ChangedBy = &actorID
+	}
+
+	return change
+}
+
+func (c *PVZProfileChange) ToDTO() gen.PVZProfileChange {
+	return gen.PVZProfileChange{
+		Id:        c.ID,
+		PvzId:     c.PVZID,
+		ChangedBy: c.ChangedBy,
+		Before:    c.Before.ToDTO(),
+		After:     c.After.ToDTO(),
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func NewPVZProfileUpdateFromDTO(dto *gen.PVZProfile) PVZProfileUpdate {
+	if dto == nil {
+		return PVZProfileUpdate{}
+	}
+
+	update := PVZProfileUpdate{
+		Address:  dto.Address,
+		Timezone: dto.Timezone,
+		Phone:    dto.Phone,
+	}
+
+	if dto.Location != nil {
+		update.Location = &GeoPoint{Lat: dto.Location.Lat, Lon: dto.Location.Lon}
+	}
+
+	if dto.WorkingHours != nil {
+		hours := WorkingHours{
+			Weekly: make([]DayHours, 0, len(dto.WorkingHours.Weekly)),
+		}
+
+		for _, day := range dto.WorkingHours.Weekly {
+			hours.Weekly = append(hours.Weekly, DayHours{
+				Day:   Weekday(day.Day),
+				Open:  day.Open,
+				Close: day.Close,
+			})
+		}
+
+		if dto.WorkingHours.Exceptions != nil {
+			for _, exception := range *dto.WorkingHours.Exceptions {
+				hours.Exceptions = append(hours.Exceptions, HoursException{
+					Date:   exception.Date.String(),
+					Closed: exception.Closed,
+					Open:   deref(exception.Open),
+					Close:  deref(exception.Close),
+					Note:   deref(exception.Note),
+				})
+			}
+		}
+
+		update.Hours = &hours
+	}
+
+	return update
+}
+
+func (p PVZProfile) ToDTO() gen.PVZProfile {
+	dto := gen.PVZProfile{
+		Address:  optional(p.Address),
+		Timezone: optional(p.Timezone),
+		Phone:    optional(p.Phone),
+	}
+
+	if p.Location != nil {
+		dto.Location = &gen.GeoPoint{Lat: p.Location.Lat, Lon: p.Location.Lon}
+	}
+
+	if !p.Hours.IsEmpty() {
+		hours := gen.WorkingHours{
+			Weekly: make([]gen.DayHours, 0, len(p.Hours.Weekly)),
+		}
+
+		for _, day := range p.Hours.Weekly {
+			hours.Weekly = append(hours.Weekly, gen.DayHours{
+				Day:   gen.DayHoursDay(day.Day),
+				Open:  day.Open,
+				Close: day.Close,
+			})
+		}
+
+		if len(p.Hours.Exceptions) > 0 {
+			exceptions := make([]gen.HoursException, 0, len(p.Hours.Exceptions))
+
+			for _, exception := range p.Hours.Exceptions {
+				date, _ := time.Parse(dateLayout, exception.Date)
+
+				exceptions = append(exceptions, gen.HoursException{
+					Date:   openapi_types.Date{Time: date},
+					Closed: exception.Closed,
+					Open:   optional(exception.Open),
+					Close:  optional(exception.Close),
+					Note:   optional(exception.Note),
+				})
+			}
+
+			hours.Exceptions = &exceptions
+		}
+
+		dto.WorkingHours = &hours
+	}
+
+	return dto
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func deref(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,176 @@
+package domain_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestPVZProfile_Apply(t *testing.T) {
+	t.Parallel()
+
+	current := domain.PVZProfile{
+		Address:  "ул. Тверская, д. 7",
+		Timezone: "Europe/Moscow",
+		Hours: domain.WorkingHours{
+			Weekly: []domain.DayHours{{Day: "mon", Open: "09:00", Close: "21:00"}},
+		},
+		Phone: "+74951234567",
+	}
+
+	tests := []struct {
+		name    string
+		profile domain.PVZProfile
+		update  domain.PVZProfileUpdate
+		want    func(t *testing.T, got domain.PVZProfile)
+		wantErr error
+	}{
+		{
+			name:    "only_given_fields_change",
+			profile: current,
+			update: domain.PVZProfileUpdate{
+				Location: &domain.GeoPoint{Lat: 55.76, Lon: 37.61},
+				Phone:    ptr("+7 (495) 765-43-21"),
+			},
+			want: func(t *testing.T, got domain.PVZProfile) {
+				require.Equal(t, current.Address, got.Address)
+				require.Equal(t, current.Hours, got.Hours)
+				require.Equal(t, "+74957654321", got.Phone)
+				require.Equal(t, &domain.GeoPoint{Lat: 55.76, Lon: 37.61}, got.Location)
+			},
+		},
+		{
+			name:    "empty_phone_clears_it",
+			profile: current,
+			update:  domain.PVZProfileUpdate{Phone: ptr("")},
+			want: func(t *testing.T, got domain.PVZProfile) {
+				require.Empty(t, got.Phone)
+			},
+		},
+		{
+			name:    "split_day_and_exceptions",
+			profile: domain.PVZProfile{Timezone: "Asia/Yekaterinburg"},
+			update: domain.PVZProfileUpdate{Hours: &domain.WorkingHours{
+				Weekly: []domain.DayHours{
+					{Day: "sat", Open: "14:00", Close: "24:00"},
+					{Day: "sat", Open: "10:00", Close: "13:00"},
+				},
+				Exceptions: []domain.HoursException{
+					{Date: "2025-12-31", Open: "10:00", Close: "16:00"},
+					{Date: "2026-01-01", Closed: true, Note: "Новый год"},
+				},
+			}},
+		},
+		{
+			name:    "address_too_long",
+			profile: current,
+			update:  domain.PVZProfileUpdate{Address: ptr(strings.Repeat("д", 301))},
+			wantErr: domain.ErrInvalidAddress,
+		},
+		{
+			name:    "latitude_out_of_range",
+			profile: current,
+			update:  domain.PVZProfileUpdate{Location: &domain.GeoPoint{Lat: 91, Lon: 0}},
+			wantErr: domain.ErrInvalidLocation,
+		},
+		{
+			name:    "nan_coordinates",
+			profile: current,
+			update:  domain.PVZProfileUpdate{Location: &domain.GeoPoint{Lat: math.NaN()}},
+			wantErr: domain.ErrInvalidLocation,
+		},
+		{
+			name:    "unknown_timezone",
+			profile: current,
+			update:  domain.PVZProfileUpdate{Timezone: ptr("Moscow/Center")},
+			wantErr: domain.ErrInvalidTimezone,
+		},
+		{
+			name:    "hours_without_timezone",
+			profile: current,
+			update:  domain.PVZProfileUpdate{Timezone: ptr("")},
+			wantErr: domain.ErrInvalidTimezone,
+		},
+		{
+			name:    "close_before_open",
+			profile: current,
+			update: domain.PVZProfileUpdate{Hours: &domain.WorkingHours{
+				Weekly: []domain.DayHours{{Day: "tue", Open: "21:00", Close: "09:00"}},
+			}},
+			wantErr: domain.ErrInvalidWorkingHours,
+		},
+		{
+			name:    "overlapping_intervals",
+			profile: current,
+			update: domain.PVZProfileUpdate{Hours: &domain.WorkingHours{
+				Weekly: []domain.DayHours{
+					{Day: "wed", Open: "09:00", Close: "14:00"},
+					{Day: "wed", Open: "13:00", Close: "18:00"},
+				},
+			}},
+			wantErr: domain.ErrInvalidWorkingHours,
+		},
+		{
+			name:    "unknown_day",
+			profile: current,
+			update: domain.PVZProfileUpdate{Hours: &domain.WorkingHours{
+				Weekly: []domain.DayHours{{Day: "monday", Open: "09:00", Close: "18:00"}},
+			}},
+			wantErr: domain.ErrInvalidWorkingHours,
+		},
+		{
+			name:    "duplicate_exception_date",
+			profile: current,
+			update: domain.PVZProfileUpdate{Hours: &domain.WorkingHours{
+				Exceptions: []domain.HoursException{
+					{Date: "2026-01-01", Closed: true},
+					{Date: "2026-01-01", Open: "10:00", Close: "12:00"},
+				},
+			}},
+			wantErr: domain.ErrInvalidWorkingHours,
+		},
+		{
+			name:    "closed_exception_with_hours",
+			profile: current,
+			update: domain.PVZProfileUpdate{Hours: &domain.WorkingHours{
+				Exceptions: []domain.HoursException{
+					{Date: "2026-01-01", Closed: true, Open: "10:00", Close: "12:00"},
+				},
+			}},
+			wantErr: domain.ErrInvalidWorkingHours,
+		},
+		{
+			name:    "invalid_phone",
+			profile: current,
+			update:  domain.PVZProfileUpdate{Phone: ptr("8 800 555-35-35")},
+			wantErr: domain.ErrInvalidPhone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.profile.Apply(tt.update)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tt.want != nil {
+				tt.want(t, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const pvzStatusReasonMaxLength = 500
+
+// PVZStatus состояние ПВЗ. Приемки и товары принимаются только в active,
+// suspended временно выводит ПВЗ из работы, closed окончательно.
+type PVZStatus string
+
+const (
+	PVZStatusActive    PVZStatus = "active"
+	PVZStatusSuspended PVZStatus = "suspended"
+	PVZStatusClosed    PVZStatus = "closed"
+)
+
+var pvzTransitions = map[PVZStatus][]PVZStatus{
+	PVZStatusActive:    {PVZStatusSuspended, PVZStatusClosed},
+	PVZStatusSuspended: {PVZStatusActive, PVZStatusClosed},
+}
+
+func (s PVZStatus) IsValid() bool {
+	return s == PVZStatusActive || s == PVZStatusSuspended || s == PVZStatusClosed
+}
+
+func (s PVZStatus) CanTransitionTo(to PVZStatus) bool {
+	for _, allowed := range pvzTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PVZStatusChange переход ПВЗ из From в To. Репозиторий применяет его,
+// только если ПВЗ все еще в From, а закрытие — если нет открытой приемки.
+type PVZStatusChange struct {
+	PVZID     uuid.UUID
+	From      PVZStatus
+	To        PVZStatus
+	Reason    string
+	ChangedAt time.Time
+}
+
+func NewPVZStatusChange(pvz *PVZ, to PVZStatus, reason string) (*PVZStatusChange, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > pvzStatusReasonMaxLength {
+		return nil, ErrInvalidStatusReason
+	}
+
+	if !pvz.Status.CanTransitionTo(to) {
+		return nil, ErrInvalidStatusTransition
+	}
+
+	return &PVZStatusChange{
+		PVZID:     uuid.UUID(*pvz.ID),
+		From:      pvz.Status,
+		To:        to,
+		Reason:    reason,
+		ChangedAt: time.Now(),
+	}, nil
+}
+
+// Apply переносит изменение на ПВЗ после сохранения.
+func (c *PVZStatusChange) Apply(pvz *PVZ) {
+	pvz.Status = c.To
+	pvz.StatusReason = c.Reason
+	pvz.StatusChangedAt = &c.ChangedAt
+}
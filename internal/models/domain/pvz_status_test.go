@@ -0,0 +1,64 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVZStatus_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from, to domain.PVZStatus
+		want     bool
+	}{
+		{domain.PVZStatusActive, domain.PVZStatusSuspended, true},
+		{domain.PVZStatusActive, domain.PVZStatusClosed, true},
+		{domain.PVZStatusSuspended, domain.PVZStatusActive, true},
+		{domain.PVZStatusSuspended, domain.PVZStatusClosed, true},
+		{domain.PVZStatusActive, domain.PVZStatusActive, false},
+		{domain.PVZStatusClosed, domain.PVZStatusActive, false},
+		{domain.PVZStatusClosed, domain.PVZStatusSuspended, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"_to_"+string(tt.to), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestNewPVZStatusChange(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	pvz := &domain.PVZ{ID: (*domain.PVZID)(&id), Status: domain.PVZStatusActive}
+
+	change, err := domain.NewPVZStatusChange(pvz, domain.PVZStatusSuspended, "  Ремонт  ")
+	require.NoError(t, err)
+	assert.Equal(t, id, change.PVZID)
+	assert.Equal(t, domain.PVZStatusActive, change.From)
+	assert.Equal(t, "Ремонт", change.Reason)
+
+	_, err = domain.NewPVZStatusChange(pvz, domain.PVZStatusSuspended, " ")
+	require.ErrorIs(t, err, domain.ErrInvalidStatusReason)
+
+	_, err = domain.NewPVZStatusChange(pvz, domain.PVZStatusSuspended, strings.Repeat("я", 501))
+	require.ErrorIs(t, err, domain.ErrInvalidStatusReason)
+
+	_, err = domain.NewPVZStatusChange(pvz, domain.PVZStatusActive, "Ремонт")
+	require.ErrorIs(t, err, domain.ErrInvalidStatusTransition)
+
+	change.Apply(pvz)
+	assert.Equal(t, domain.PVZStatusSuspended, pvz.Status)
+	assert.Equal(t, "Ремонт", pvz.StatusReason)
+	assert.NotNil(t, pvz.StatusChangedAt)
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RateLimit параметры token bucket: Rate токенов в секунду
+// и Burst — емкость корзины. Нулевой Rate снимает ограничение.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// Refill время, за которое пустая корзина наполняется полностью.
+func (l RateLimit) Refill() time.Duration {
+	if l.Rate <= 0 {
+		return 0
+	}
+
+	return time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+}
+
+// RateLimitRule ограничение операции; Roles переопределяет его для ролей.
+// Анонимные запросы получают ограничение самого правила.
+type RateLimitRule struct {
+	RateLimit
+	Roles map[Role]RateLimit
+}
+
+// RateLimitPolicy сопоставляет операции (operation ID для HTTP,
+// полное имя метода для gRPC) с ограничениями. Правило операции
+// полностью заменяет Default. PerIP общий лимит адреса на все операции:
+// он проверяется до аутентификации.
+type RateLimitPolicy struct {
+	PerIP      RateLimit
+	Default    RateLimitRule
+	Operations map[string]RateLimitRule
+}
+
+func (p RateLimitPolicy) Limit(operation string, role Role) RateLimit {
+	rule, ok := p.Operations[operation]
+	if !ok {
+		rule = p.Default
+	}
+
+	if limit, ok := rule.Roles[role]; ok {
+		return limit
+	}
+
+	return rule.RateLimit
+}
+
+func UserRateLimitKey(id uuid.UUID) string {
+	return "user:" + id.String()
+}
+
+func IPRateLimitKey(ip string) string {
+	return "ip:" + ip
+}
@@ -10,20 +10,53 @@ import (
 
 type ReceptionStatus string
 
+// ReceptionStatusCorrected приемка закрыта повторно после исправления
+// модератором, см. ReceptionCorrection.
 const (
 	ReceptionStatusInProgress ReceptionStatus = "in_progress"
 	ReceptionStatusClosed     ReceptionStatus = "close"
+	ReceptionStatusCorrected  ReceptionStatus = "corrected"
 )
 
+// ReceptionCloseReason почему приемка закрыта. У закрытых сотрудником
+// причина пустая.
+type ReceptionCloseReason string
+
+const ReceptionClosedBySystem ReceptionCloseReason = "closed_by_system"
+
 type Reception struct {
 	ID        uuid.UUID
 	PvzID     uuid.UUID
 	Status    ReceptionStatus
 	CreatedAt time.Time
+
+	ClosedAt    *time.Time
+	CloseReason ReceptionCloseReason
+	// FirstClosedAt время первого закрытия, при открытии для исправления
+	// не сбрасывается. От него считается срок исправления.
+	FirstClosedAt *time.Time
+	// ReopenedAt время последнего открытия модератором для исправления.
+	ReopenedAt *time.Time
 }
 
 func (r *Reception) Close() {
+	now := time.Now()
+
 	r.Status = ReceptionStatusClosed
+	if r.ReopenedAt != nil {
+		r.Status = ReceptionStatusCorrected
+	}
+
+	r.ClosedAt = &now
+	r.CloseReason = ""
+
+	if r.FirstClosedAt == nil {
+		r.FirstClosedAt = &now
+	}
+}
+
+func (r *Reception) IsClosed() bool {
+	return r.Status == ReceptionStatusClosed || r.Status == ReceptionStatusCorrected
 }
 
 func NewReception(pvz uuid.UUID) *Reception {
@@ -40,12 +73,46 @@ func (r *Reception) IsActive() bool {
 }
 
 func (r Reception) ToDTO() gen.Reception {
-	return gen.Reception{
+	dto := gen.Reception{
 		DateTime: r.CreatedAt,
 		Id:       (*types.UUID)(&r.ID),
 		PvzId:    (types.UUID)(r.PvzID),
 		Status:   gen.ReceptionStatus(r.Status),
+		ClosedAt: r.ClosedAt,
+	}
+
+	if r.CloseReason != "" {
+		reason := gen.ReceptionCloseReason(r.CloseReason)
+		dto.CloseReason = &reason
+	}
+
+	return dto
+}
+
+// ToClosedDTO ответ закрытия приемки, reconciliation nil, если для ПВЗ
+// не было манифеста.
+func (r Reception) ToClosedDTO(reconciliation *ReceptionReconciliation) gen.ClosedReception {
+	reception := r.ToDTO()
+
+	dto := gen.ClosedReception{
+		DateTime: reception.DateTime,
+		Id:       reception.Id,
+		PvzId:    reception.PvzId,
+		Status:   gen.ClosedReceptionStatus(reception.Status),
+		ClosedAt: reception.ClosedAt,
 	}
+
+	if reception.CloseReason != nil {
+		reason := gen.ClosedReceptionCloseReason(*reception.CloseReason)
+		dto.CloseReason = &reason
+	}
+
+	if reconciliation != nil {
+		report := reconciliation.ToDTO()
+		dto.Reconciliation = &report
+	}
+
+	return dto
 }
 
 type ReceptionID uuid.UUID
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const justificationMaxLength = 1000
+
+// ReceptionCorrection открытие закрытой приемки модератором. После
+// исправления сотрудники закрывают ее как обычно, и она получает статус
+// corrected.
+type ReceptionCorrection struct {
+	ID            uuid.UUID
+	ReceptionID   uuid.UUID
+	ReopenedBy    *uuid.UUID
+	Justification string
+	CreatedAt     time.Time
+	// ClosedAfter приемку, впервые закрытую раньше этого времени, уже не
+	// исправить.
+	ClosedAfter time.Time
+}
+
+// NewReceptionCorrection проверяет, что приемку можно открыть: впервые она
+// закрыта не раньше window назад, а обоснование указано. Повторные открытия
+// срок не продлевают.
+func NewReceptionCorrection(
+	ctx context.Context,
+	reception *Reception,
+	justification string,
+	window time.Duration,
+) (*ReceptionCorrection, error) {
+	justification = strings.TrimSpace(justification)
+	if justification == "" || utf8.RuneCountInString(justification) > justificationMaxLength {
+		return nil, ErrInvalidJustification
+	}
+
+	if !reception.IsClosed() {
+		return nil, ErrReceptionNotClosed
+	}
+
+	now := time.Now()
+	closedAfter := now.Add(-window)
+
+	if reception.FirstClosedAt == nil || reception.FirstClosedAt.Before(closedAfter) {
+		return nil, ErrCorrectionWindowExpired
+	}
+
+	correction := &ReceptionCorrection{
+		ID:            uuid.New(),
+		ReceptionID:   reception.ID,
+		Justification: justification,
+		CreatedAt:     now,
+		ClosedAfter:   closedAfter,
+	}
+
+	if identity, ok := IdentityFromCtx(ctx); ok {
+		actorID := identity.ActorID()
+		correction.ReopenedBy = &actorID
+	}
+
+	return correction, nil
+}
+
+// Apply открывает приемку после сохранения исправления.
+func (c *ReceptionCorrection) Apply(reception *Reception) {
+	reception.Status = ReceptionStatusInProgress
+	reception.ReopenedAt = &c.CreatedAt
+	reception.ClosedAt = nil
+	reception.CloseReason = ""
+}
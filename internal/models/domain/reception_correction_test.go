@@ -0,0 +1,132 @@
+package domain_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReceptionCorrection(t *testing.T) {
+	t.Parallel()
+
+	closedAt := func(ago time.Duration) *time.Time {
+		at := time.Now().Add(-ago)
+		return &at
+	}
+
+	tests := []struct {
+		name          string
+		reception     domain.Reception
+		justification string
+		wantErr       error
+	}{
+		{
+			name:          "closed within window",
+			reception:     domain.Reception{Status: domain.ReceptionStatusClosed, FirstClosedAt: closedAt(time.Hour)},
+			justification: "пересчет",
+		},
+		{
+			name:          "corrected can be corrected again",
+			reception:     domain.Reception{Status: domain.ReceptionStatusCorrected, FirstClosedAt: closedAt(time.Hour)},
+			justification: "пересчет",
+		},
+		{
+			name:          "in progress",
+			reception:     domain.Reception{Status: domain.ReceptionStatusInProgress},
+			justification: "пересчет",
+			wantErr:       domain.ErrReceptionNotClosed,
+		},
+		{
+			name:          "window expired",
+			reception:     domain.Reception{Status: domain.ReceptionStatusClosed, FirstClosedAt: closedAt(25 * time.Hour)},
+			justification: "пересчет",
+			wantErr:       domain.ErrCorrectionWindowExpired,
+		},
+		{
+			name: "reopened after window",
+			reception: domain.Reception{
+				Status:        domain.ReceptionStatusCorrected,
+				ClosedAt:      closedAt(time.Hour),
+				FirstClosedAt: closedAt(25 * time.Hour),
+			},
+			justification: "пересчет",
+			wantErr:       domain.ErrCorrectionWindowExpired,
+		},
+		{
+			name:          "closed before closed_at was recorded",
+			reception:     domain.Reception{Status: domain.ReceptionStatusClosed},
+			justification: "пересчет",
+			wantErr:       domain.ErrCorrectionWindowExpired,
+		},
+		{
+			name:          "blank justification",
+			reception:     domain.Reception{Status: domain.ReceptionStatusClosed, FirstClosedAt: closedAt(time.Hour)},
+			justification: " \t",
+			wantErr:       domain.ErrInvalidJustification,
+		},
+		{
+			name:          "justification too long",
+			reception:     domain.Reception{Status: domain.ReceptionStatusClosed, FirstClosedAt: closedAt(time.Hour)},
+			justification: strings.Repeat("я", 1001),
+			wantErr:       domain.ErrInvalidJustification,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			correction, err := domain.NewReceptionCorrection(
+				context.Background(),
+				&tt.reception,
+				tt.justification,
+				24*time.Hour,
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.justification, correction.Justification)
+		})
+	}
+}
+
+func TestReception_CloseAfterCorrection(t *testing.T) {
+	t.Parallel()
+
+	moderatorID := uuid.New()
+	ctx := domain.WithIdentity(context.Background(), domain.Identity{
+		UserID: moderatorID,
+		Role:   domain.RoleModerator,
+	})
+
+	reception := domain.NewReception(uuid.New())
+	reception.Close()
+	assert.Equal(t, domain.ReceptionStatusClosed, reception.Status)
+	require.NotNil(t, reception.ClosedAt)
+
+	correction, err := domain.NewReceptionCorrection(ctx, reception, "пересчет", time.Hour)
+	require.NoError(t, err)
+	require.NotNil(t, correction.ReopenedBy)
+	assert.Equal(t, moderatorID, *correction.ReopenedBy)
+
+	correction.Apply(reception)
+	assert.True(t, reception.IsActive())
+	assert.Nil(t, reception.ClosedAt)
+
+	firstClosedAt := reception.FirstClosedAt
+
+	reception.Close()
+	assert.Equal(t, domain.ReceptionStatusCorrected, reception.Status)
+	assert.True(t, reception.IsClosed())
+	assert.Same(t, firstClosedAt, reception.FirstClosedAt)
+}
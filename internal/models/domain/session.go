@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"avito_pvz/internal/http/gen"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const opaqueTokenBytes = 32
+
+// RefreshToken хранит состояние сессии пользователя.
+// Сам токен в базе не хранится, только его хеш.
+type RefreshToken struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// FamilyID общий для всех токенов, полученных обменом из одного входа.
+	// Повторное предъявление уже обмененного токена отзывает всю цепочку.
+	FamilyID  uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// NewRefreshToken создает сессию и возвращает исходное значение токена,
+// которое нужно отдать клиенту.
+func NewRefreshToken(userID uuid.UUID, ttl time.Duration) (*RefreshToken, string, error) {
+	raw, err := newOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	id := uuid.New()
+
+	return &RefreshToken{
+		ID:        id,
+		UserID:    userID,
+		FamilyID:  id,
+		TokenHash: HashToken(raw),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, raw, nil
+}
+
+// newOpaqueToken генерирует случайный токен, который выдается клиенту.
+func newOpaqueToken() (string, error) {
+	buf := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", ErrInternal
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func HashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *RefreshToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+func (t *TokenPair) ToDTO() gen.TokenPair {
+	return gen.TokenPair{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		ExpiresAt:    t.ExpiresAt,
+	}
+}
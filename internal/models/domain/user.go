@@ -2,14 +2,17 @@ package domain
 
 import (
 	"avito_pvz/internal/http/gen"
+	"crypto/rand"
+	"encoding/base64"
 	"net/mail"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
-	"golang.org/x/crypto/bcrypt"
 )
 
+const temporaryPasswordBytes = 12
+
 type Role string
 
 func (r Role) IsValid() bool {
@@ -26,30 +29,17 @@ type User struct {
 	Email        string
 	PasswordHash string
 	Role         Role
+	Active       bool
 	CreatedAt    time.Time
 }
 
-func HashPassword(password string) (string, error) {
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-
-	return string(hash), err
-}
-
-func (u *User) CheckPasswordHash(password string) bool {
-	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
-}
-
-func NewUser(email string, password string, role string) (*User, error) {
+// NewUser создает пользователя с уже вычисленным хешем пароля.
+func NewUser(email string, passwordHash string, role string) (*User, error) {
 	_, err := mail.ParseAddress(email)
 	if err != nil {
 		return nil, ErrInvalidEmail
 	}
 
-	passwordHash, err := HashPassword(password)
-	if err != nil {
-		return nil, ErrInternal
-	}
-
 	if role != string(RoleModerator) && role != string(RoleEmploye) {
 		return nil, ErrInvalidRole
 	}
@@ -58,15 +48,57 @@ func NewUser(email string, password string, role string) (*User, error) {
 		ID:           uuid.New(),
 		Email:        email,
 		PasswordHash: passwordHash,
-		Role:         RoleModerator,
+		Role:         Role(role),
+		Active:       true,
 		CreatedAt:    time.Now(),
 	}, nil
 }
 
 func (u *User) ToDto() *gen.User {
 	return &gen.User{
-		Email: types.Email(u.Email),
-		Id:    &u.ID,
-		Role:  gen.UserRole(u.Role),
+		Email:  types.Email(u.Email),
+		Id:     &u.ID,
+		Role:   gen.UserRole(u.Role),
+		Active: &u.Active,
+	}
+}
+
+// HasPassword сообщает, может ли пользователь входить по паролю.
+// У пользователей, созданных при входе через внешний провайдер, пароля нет.
+func (u *User) HasPassword() bool {
+	return u.PasswordHash != ""
+}
+
+// NewTemporaryPassword генерирует пароль, который модератор выдает
+// пользователю при принудительном сбросе.
+func NewTemporaryPassword() (string, error) {
+	buf := make([]byte, temporaryPasswordBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", ErrInternal
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// UserFilter параметры поиска пользователей.
+type UserFilter struct {
+	// Email подстрока email без учета регистра
+	Email *string
+
+	Role *Role
+
+	// Page Номер страницы
+	Page *int
+
+	// Limit Количество элементов на странице
+	Limit *int
+}
+
+func NewUserFilterFromDTO(p gen.GetUsersParams) *UserFilter {
+	return &UserFilter{
+		Email: p.Email,
+		Role:  (*Role)(p.Role),
+		Page:  p.Page,
+		Limit: p.Limit,
 	}
 }
@@ -1,12 +1,25 @@
 package models
 
-import "errors"
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 var (
 	ErrCheckUser       = errors.New("ErrCheckUserData")
 	ErrInvalidPassword = errors.New("ErrInvalidPassword")
 	ErrInvalidEmail    = errors.New("ErrInvalidPassword")
 	ErrUserNotFoud     = errors.New("NotFoundUser")
+	ErrUserDeactivated = errors.New("UserDeactivated")
+	// ErrInvalidCredentials единая ошибка неудачного входа, чтобы по ответу
+	// нельзя было узнать, существует ли учетная запись.
+	ErrInvalidCredentials = errors.New("InvalidCredentials")
+	ErrLoginLocked        = errors.New("TooManyLoginAttempts")
+	ErrSelfModify         = errors.New("CannotModifyOwnAccount")
+	ErrInvalidRole        = errors.New("InvalidRole")
+	ErrWeakPassword       = errors.New("WeakPassword")
 )
 
 var (
@@ -14,6 +27,14 @@ var (
 	ErrUnexpectedSignMethod = errors.New("ErrUnexpectedSignMethod")
 	ErrInvalidTokenClaims   = errors.New("ErrInvalidTokenClaims")
 	ErrInternalCodeGen      = errors.New("ErrInternalCodeGen")
+	ErrInvalidRefreshToken  = errors.New("ErrInvalidRefreshToken")
+	ErrSessionRevoked       = errors.New("ErrSessionRevoked")
+	ErrInvalidResetToken    = errors.New("ErrInvalidResetToken")
+	ErrInvalidAPIKey        = errors.New("ErrInvalidAPIKey")
+	ErrAPIKeyNotFound       = errors.New("APIKeyNotFound")
+	ErrInvalidScope         = errors.New("InvalidScope")
+	ErrInvalidAPIKeyName    = errors.New("InvalidAPIKeyName")
+	ErrInvalidExpiry        = errors.New("InvalidExpiry")
 )
 
 var (
@@ -27,4 +48,99 @@ var (
 	ErrInternal               = errors.New("InternalError")
 	ErrInvalidProductType     = errors.New("InvalidProductType")
 	ErrUserAlreadyExist       = errors.New("UserWithThisEmailAlreadyExist")
+	ErrPVZAccessDenied        = errors.New("PVZAccessDenied")
+	ErrUserNotEmployee        = errors.New("UserNotEmployee")
+	ErrStaffAlreadyAssigned   = errors.New("StaffAlreadyAssigned")
+	ErrStaffNotAssigned       = errors.New("StaffNotAssigned")
+	ErrInvalidTimeRange       = errors.New("InvalidTimeRange")
+	ErrCityNotFound           = errors.New("CityNotFound")
+	ErrCityAlreadyExists      = errors.New("CityAlreadyExists")
+	ErrInvalidCityName        = errors.New("InvalidCityName")
+	ErrProductTypeNotFound    = errors.New("ProductTypeNotFound")
+	ErrProductTypeExists      = errors.New("ProductTypeAlreadyExists")
+	ErrInvalidProductTypeCode = errors.New("InvalidProductTypeCode")
+	ErrInvalidProductTypeName = errors.New("InvalidProductTypeName")
+	ErrInvalidAddress         = errors.New("InvalidAddress")
+	ErrInvalidLocation        = errors.New("InvalidLocation")
+	ErrInvalidTimezone        = errors.New("InvalidTimezone")
+	ErrInvalidWorkingHours    = errors.New("InvalidWorkingHours")
+	ErrInvalidPhone           = errors.New("InvalidPhone")
+	ErrInvalidRadius          = errors.New("InvalidRadius")
+	ErrInvalidLimit           = errors.New("InvalidLimit")
+	ErrInvalidPVZStatus       = errors.New("InvalidPVZStatus")
+	ErrInvalidStatusReason    = errors.New("InvalidStatusReason")
+	ErrInvalidStatusChange    = errors.New("InvalidStatusTransition")
+	ErrPVZSuspended           = errors.New("PVZSuspended")
+	ErrPVZClosed              = errors.New("PVZClosed")
+	ErrPVZHasOpenReception    = errors.New("PVZHasOpenReception")
+	ErrEmptyBatch             = errors.New("EmptyBatch")
+	ErrBatchTooLarge          = errors.New("BatchTooLarge")
+	ErrInvalidMetadata        = errors.New("InvalidMetadata")
+	ErrInvalidBatch           = errors.New("InvalidBatch")
+	ErrInvalidBarcode         = errors.New("InvalidBarcode")
+	ErrInvalidOrderID         = errors.New("InvalidOrderID")
+	ErrInvalidSKU             = errors.New("InvalidSKU")
+	ErrBarcodeAlreadyReceived = errors.New("BarcodeAlreadyReceived")
+	ErrDuplicateBarcode       = errors.New("DuplicateBarcodeInBatch")
+	ErrInvalidProductSelector = errors.New("InvalidProductSelector")
+	ErrInvalidVoidReason      = errors.New("InvalidVoidReason")
+	ErrReceptionNotFound      = errors.New("ReceptionNotFound")
+	ErrInvalidJustification   = errors.New("InvalidJustification")
+	ErrReceptionNotClosed     = errors.New("ReceptionNotClosed")
+	ErrCorrectionExpired      = errors.New("CorrectionWindowExpired")
+	ErrNewerReceptionExists   = errors.New("NewerReceptionExists")
+	ErrEmptyASN               = errors.New("EmptyASN")
+	ErrASNTooLarge            = errors.New("ASNTooLarge")
+	ErrInvalidASNLine         = errors.New("InvalidASNLine")
+	ErrInvalidASNReference    = errors.New("InvalidASNReference")
+	ErrReconciliationNotFound = errors.New("ReconciliationNotFound")
 )
+
+// DuplicateBarcodeError сообщает, в какой приемке товар с этим штрихкодом
+// уже принят.
+type DuplicateBarcodeError struct {
+	Barcode     string
+	ReceptionID uuid.UUID
+}
+
+func (e *DuplicateBarcodeError) Error() string {
+	return ErrBarcodeAlreadyReceived.Error()
+}
+
+func (e *DuplicateBarcodeError) Unwrap() error {
+	return ErrBarcodeAlreadyReceived
+}
+
+// ItemError ошибка проверки одного элемента пакета, Index считается с нуля.
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+// BatchError перечисляет ошибки элементов пакета, из-за которых пакет
+// отклонен целиком.
+type BatchError struct {
+	Items []ItemError
+}
+
+func (e *BatchError) Error() string {
+	return ErrInvalidBatch.Error()
+}
+
+func (e *BatchError) Unwrap() error {
+	return ErrInvalidBatch
+}
+
+// RetryError сообщает, через сколько можно повторить запрос.
+type RetryError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
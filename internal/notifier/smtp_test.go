@@ -0,0 +1,165 @@
+package notifier_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/notifier"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTP минимальный SMTP-сервер, который принимает одно письмо.
+type fakeSMTP struct {
+	ln   net.Listener
+	from string
+	to   string
+	data chan string
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeSMTP{ln: ln, data: make(chan string, 1)}
+	t.Cleanup(func() { ln.Close() })
+
+	go s.serve()
+
+	return s
+}
+
+func (s *fakeSMTP) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTP) serve() {
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost fake smtp")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.from = strings.TrimSpace(line[len("MAIL FROM:"):])
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			s.to = strings.TrimSpace(line[len("RCPT TO:"):])
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+
+			var body strings.Builder
+
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				if l == ".\r\n" {
+					break
+				}
+
+				body.WriteString(l)
+			}
+
+			s.data <- body.String()
+
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 Bye")
+
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func TestSMTPNotifier_Send(t *testing.T) {
+	t.Parallel()
+
+	srv := newFakeSMTP(t)
+
+	n := notifier.NewSMTPNotifier("127.0.0.1", srv.port(), "", "", "noreply@pvz.local")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := n.Send(ctx, domain.Message{
+		To:      "user@example.com",
+		Subject: "Восстановление пароля",
+		Body:    "token: abc",
+	})
+	require.NoError(t, err)
+
+	data := <-srv.data
+	require.Equal(t, "<noreply@pvz.local>", srv.from)
+	require.Equal(t, "<user@example.com>", srv.to)
+	require.Contains(t, data, "To: user@example.com\r\n")
+	require.Contains(t, data, "Subject: =?utf-8?q?")
+	require.Contains(t, data, "\r\n\r\ntoken: abc\r\n")
+}
+
+func TestSMTPNotifier_ServerUnavailable(t *testing.T) {
+	t.Parallel()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	port := ln.Addr().(*net.TCPAddr).Port
+	ln.Close()
+
+	n := notifier.NewSMTPNotifier("127.0.0.1", port, "", "", "noreply@pvz.local")
+
+	err = n.Send(context.Background(), domain.Message{To: "user@example.com"})
+	require.ErrorContains(t, err, "smtp dial")
+}
+
+func TestFileNotifier_Send(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "mail.log")
+	n := notifier.NewFileNotifier(path)
+
+	for i := range 2 {
+		err := n.Send(context.Background(), domain.Message{
+			To:      "user@example.com",
+			Subject: "subject " + strconv.Itoa(i),
+			Body:    "body",
+		})
+		require.NoError(t, err)
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "Subject: subject 0")
+	require.Contains(t, string(data), "Subject: subject 1")
+}
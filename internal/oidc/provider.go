@@ -0,0 +1,236 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+
+	// refreshInterval ограничивает повторную загрузку JWKS, когда в токене
+	// встретился неизвестный kid: иначе поддельные токены заставляли бы
+	// ходить к провайдеру на каждый запрос.
+	refreshInterval = time.Minute
+	leeway          = 30 * time.Second
+	maxResponseSize = 1 << 20
+)
+
+var (
+	ErrDiscovery  = errors.New("oidc discovery failed")
+	ErrUnknownKey = errors.New("oidc signing key not found")
+	ErrInvalid    = errors.New("oidc token is invalid")
+	ErrNoAudience = errors.New("oidc audience is required")
+)
+
+var supportedMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// Provider проверяет токены внешнего провайдера OIDC. Адрес JWKS берется
+// из discovery-документа издателя; документ и ключи загружаются при первой
+// проверке и обновляются, когда токен подписан неизвестным ключом.
+// Загрузка идет без блокировки, а параллельные загрузки объединяются,
+// поэтому проверки по уже известным ключам не ждут провайдера.
+type Provider struct {
+	issuer   string
+	audience string
+	client   *http.Client
+	fetches  singleflight.Group
+
+	mu        sync.Mutex
+	jwksURI   string
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewProvider создает провайдера для издателя. Без audience токен, выданный
+// провайдером любому другому клиенту, подошел бы и сервису, поэтому пустой
+// audience считается ошибкой.
+func NewProvider(issuer, audience string, client *http.Client) (*Provider, error) {
+	if audience == "" {
+		return nil, ErrNoAudience
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: 5 * time.Second}
+	}
+
+	return &Provider{
+		issuer:   strings.TrimSuffix(issuer, "/"),
+		audience: audience,
+		client:   client,
+	}, nil
+}
+
+func (p *Provider) Issuer() string {
+	return p.issuer
+}
+
+// Verify проверяет подпись, издателя, получателя и срок действия токена.
+func (p *Provider) Verify(ctx context.Context, raw string) (*domain.ExternalIdentity, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithIssuer(p.issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+		jwt.WithValidMethods(supportedMethods),
+		jwt.WithAudience(p.audience),
+	}
+
+	claims := jwt.MapClaims{}
+
+	_, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		return p.key(ctx, kid)
+	}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", ErrInvalid, err)
+	}
+
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return nil, fmt.Errorf("%w (missing sub)", ErrInvalid)
+	}
+
+	email, _ := claims["email"].(string)
+	verified, _ := claims["email_verified"].(bool)
+
+	return &domain.ExternalIdentity{
+		Issuer:        p.issuer,
+		Subject:       subject,
+		Email:         email,
+		EmailVerified: verified,
+		Claims:        claims,
+	}, nil
+}
+
+func (p *Provider) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok, stale := p.lookup(kid)
+	if ok {
+		return key, nil
+	}
+
+	if !stale {
+		return nil, fmt.Errorf("%w (kid %q)", ErrUnknownKey, kid)
+	}
+
+	// Загрузку разделяют все ждущие ее запросы, поэтому отмена одного
+	// из них не должна ее прерывать; время ограничивает таймаут клиента.
+	_, err, _ := p.fetches.Do("jwks", func() (any, error) {
+		return nil, p.refresh(context.WithoutCancel(ctx))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok, _ = p.lookup(kid)
+	if ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w (kid %q)", ErrUnknownKey, kid)
+}
+
+// lookup ищет ключ среди загруженных. stale показывает, что ключи можно
+// загрузить заново.
+func (p *Provider) lookup(kid string) (crypto.PublicKey, bool, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, true, false
+	}
+
+	// Провайдер с единственным ключом может не указывать kid.
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true, false
+		}
+	}
+
+	return nil, false, p.fetchedAt.IsZero() || time.Since(p.fetchedAt) >= refreshInterval
+}
+
+// refresh загружает ключи. fetchedAt ставится по окончании загрузки, в том
+// числе неудачной: пока загрузка идет, новые запросы присоединяются к ней.
+func (p *Provider) refresh(ctx context.Context) error {
+	p.mu.Lock()
+	jwksURI := p.jwksURI
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		p.fetchedAt = time.Now()
+		p.mu.Unlock()
+	}()
+
+	if jwksURI == "" {
+		var doc struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+
+		if err := p.get(ctx, p.issuer+discoveryPath, &doc); err != nil {
+			return err
+		}
+
+		if strings.TrimSuffix(doc.Issuer, "/") != p.issuer || doc.JWKSURI == "" {
+			return fmt.Errorf("%w (issuer %q, jwks_uri %q)", ErrDiscovery, doc.Issuer, doc.JWKSURI)
+		}
+
+		jwksURI = doc.JWKSURI
+	}
+
+	var set jsonWebKeySet
+	if err := p.get(ctx, jwksURI, &set); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.jwksURI = jwksURI
+	p.keys = set.publicKeys()
+	p.mu.Unlock()
+
+	return nil
+}
+
+func (p *Provider) get(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", ErrDiscovery, err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", ErrDiscovery, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w (%s: %s)", ErrDiscovery, url, resp.Status)
+	}
+
+	err = json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", ErrDiscovery, err)
+	}
+
+	return nil
+}
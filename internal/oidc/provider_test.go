@@ -0,0 +1,315 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/oidc"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// stubIssuer локальный провайдер OIDC: отдает discovery-документ и JWKS.
+type stubIssuer struct {
+	server    *httptest.Server
+	rsaKey    *rsa.PrivateKey
+	ecKey     *ecdsa.PrivateKey
+	jwksCalls atomic.Int32
+	// issuer в discovery-документе, по умолчанию адрес сервера
+	docIssuer string
+}
+
+func newStubIssuer(t *testing.T) *stubIssuer {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	stub := &stubIssuer{rsaKey: rsaKey, ecKey: ecKey}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer := stub.docIssuer
+		if issuer == "" {
+			issuer = stub.server.URL
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer,
+			"jwks_uri": stub.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		stub.jwksCalls.Add(1)
+
+		b64 := base64.RawURLEncoding.EncodeToString
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "rsa-1",
+					"use": "sig",
+					"n":   b64(rsaKey.N.Bytes()),
+					"e":   b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+				},
+				{
+					"kty": "EC",
+					"kid": "ec-1",
+					"crv": "P-256",
+					"x":   b64(ecKey.X.FillBytes(make([]byte, 32))),
+					"y":   b64(ecKey.Y.FillBytes(make([]byte, 32))),
+				},
+				{
+					"kty": "RSA",
+					"kid": "enc-1",
+					"use": "enc",
+					"n":   b64(rsaKey.N.Bytes()),
+					"e":   b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+				},
+			},
+		})
+	})
+
+	stub.server = httptest.NewServer(mux)
+	t.Cleanup(stub.server.Close)
+
+	return stub
+}
+
+func (s *stubIssuer) sign(
+	t *testing.T,
+	method jwt.SigningMethod,
+	kid string,
+	claims jwt.MapClaims,
+) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+
+	var key any = s.rsaKey
+	if method == jwt.SigningMethodES256 {
+		key = s.ecKey
+	}
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func (s *stubIssuer) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            s.server.URL,
+		"sub":            "user-42",
+		"aud":            "pvz-api",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"email":          "staff@example.com",
+		"email_verified": true,
+	}
+}
+
+func TestProvider_Verify(t *testing.T) {
+	t.Parallel()
+
+	stub := newStubIssuer(t)
+
+	tests := []struct {
+		name    string
+		token   func() string
+		wantErr bool
+	}{
+		{
+			name: "rsa_token",
+			token: func() string {
+				return stub.sign(t, jwt.SigningMethodRS256, "rsa-1", stub.claims())
+			},
+		},
+		{
+			name: "ec_token",
+			token: func() string {
+				return stub.sign(t, jwt.SigningMethodES256, "ec-1", stub.claims())
+			},
+		},
+		{
+			name: "wrong_issuer",
+			token: func() string {
+				claims := stub.claims()
+				claims["iss"] = "https://evil.example.com"
+
+				return stub.sign(t, jwt.SigningMethodRS256, "rsa-1", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong_audience",
+			token: func() string {
+				claims := stub.claims()
+				claims["aud"] = "other-api"
+
+				return stub.sign(t, jwt.SigningMethodRS256, "rsa-1", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			token: func() string {
+				claims := stub.claims()
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+
+				return stub.sign(t, jwt.SigningMethodRS256, "rsa-1", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing_subject",
+			token: func() string {
+				claims := stub.claims()
+				delete(claims, "sub")
+
+				return stub.sign(t, jwt.SigningMethodRS256, "rsa-1", claims)
+			},
+			wantErr: true,
+		},
+		{
+			name: "encryption_key",
+			token: func() string {
+				return stub.sign(t, jwt.SigningMethodRS256, "enc-1", stub.claims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "hmac_with_public_key",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, stub.claims())
+				token.Header["kid"] = "rsa-1"
+
+				signed, err := token.SignedString(stub.rsaKey.N.Bytes())
+				require.NoError(t, err)
+
+				return signed
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider, err := oidc.NewProvider(stub.server.URL, "pvz-api", stub.server.Client())
+			require.NoError(t, err)
+
+			got, err := provider.Verify(context.Background(), tt.token())
+			if tt.wantErr {
+				require.ErrorIs(t, err, oidc.ErrInvalid)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, stub.server.URL, got.Issuer)
+			require.Equal(t, "user-42", got.Subject)
+			require.Equal(t, "staff@example.com", got.Email)
+			require.True(t, got.EmailVerified)
+		})
+	}
+}
+
+func TestProvider_UnknownKeyRefreshIsThrottled(t *testing.T) {
+	t.Parallel()
+
+	stub := newStubIssuer(t)
+	provider, err := oidc.NewProvider(stub.server.URL+"/", "pvz-api", stub.server.Client())
+	require.NoError(t, err)
+
+	_, err = provider.Verify(
+		context.Background(),
+		stub.sign(t, jwt.SigningMethodRS256, "rsa-1", stub.claims()),
+	)
+	require.NoError(t, err)
+
+	forged := stub.sign(t, jwt.SigningMethodRS256, "rotated", stub.claims())
+
+	for range 3 {
+		_, err = provider.Verify(context.Background(), forged)
+		require.ErrorIs(t, err, oidc.ErrInvalid)
+	}
+
+	require.Equal(t, int32(1), stub.jwksCalls.Load())
+}
+
+func TestProvider_DiscoveryIssuerMismatch(t *testing.T) {
+	t.Parallel()
+
+	stub := newStubIssuer(t)
+	stub.docIssuer = "https://other.example.com"
+
+	provider, err := oidc.NewProvider(stub.server.URL, "pvz-api", stub.server.Client())
+	require.NoError(t, err)
+
+	_, err = provider.Verify(
+		context.Background(),
+		stub.sign(t, jwt.SigningMethodRS256, "rsa-1", stub.claims()),
+	)
+	require.ErrorIs(t, err, oidc.ErrDiscovery)
+}
+
+func TestProvider_ConcurrentFirstVerifyFetchesOnce(t *testing.T) {
+	t.Parallel()
+
+	stub := newStubIssuer(t)
+
+	provider, err := oidc.NewProvider(stub.server.URL, "pvz-api", stub.server.Client())
+	require.NoError(t, err)
+
+	token := stub.sign(t, jwt.SigningMethodRS256, "rsa-1", stub.claims())
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 8)
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := provider.Verify(context.Background(), token)
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int32(1), stub.jwksCalls.Load())
+}
+
+func TestNewProvider_RequiresAudience(t *testing.T) {
+	t.Parallel()
+
+	_, err := oidc.NewProvider("https://sso.example.com", "", nil)
+	require.ErrorIs(t, err, oidc.ErrNoAudience)
+}
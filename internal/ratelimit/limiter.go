@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+)
+
+// sweepInterval как часто удаляются корзины, которые успели наполниться:
+// они ничем не отличаются от новых, а без очистки карта растет
+// с каждым новым IP-адресом.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	refill time.Duration
+}
+
+// Limiter token bucket, корзины которого хранятся в памяти процесса.
+// Ограничение действует на каждый экземпляр сервиса отдельно.
+type Limiter struct {
+	policy domain.RateLimitPolicy
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func New(policy domain.RateLimitPolicy) *Limiter {
+	return &Limiter{
+		policy:    policy,
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// Allow списывает токен из корзины вызывающего для операции.
+// Аутентифицированные запросы учитываются по пользователю (или API-ключу),
+// анонимные — по IP-адресу. Если токенов нет, возвращает время,
+// через которое запрос можно повторить.
+func (l *Limiter) Allow(
+	operation string,
+	identity *domain.Identity,
+	ip string,
+) (time.Duration, bool) {
+	var role domain.Role
+
+	subject := domain.IPRateLimitKey(ip)
+	if identity != nil {
+		role = identity.Role
+		subject = domain.UserRateLimitKey(identity.ActorID())
+	}
+
+	return l.take(operation+"|"+subject, l.policy.Limit(operation, role))
+}
+
+// AllowIP списывает токен из общей корзины адреса. Она проверяется до
+// аутентификации, поэтому ограничивает и запросы с неверными токенами.
+func (l *Limiter) AllowIP(ip string) (time.Duration, bool) {
+	return l.take("*|"+domain.IPRateLimitKey(ip), l.policy.PerIP)
+}
+
+func (l *Limiter) take(key string, limit domain.RateLimit) (time.Duration, bool) {
+	if limit.Rate <= 0 {
+		return 0, true
+	}
+
+	limit.Burst = max(limit.Burst, 1)
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(
+		float64(limit.Burst),
+		b.tokens+now.Sub(b.last).Seconds()*limit.Rate,
+	)
+	b.last = now
+	b.refill = limit.Refill()
+
+	if b.tokens >= 1 {
+		b.tokens--
+
+		return 0, true
+	}
+
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+
+	return wait, false
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= b.refill {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastSweep = now
+}
+
+// RetryAfter значение заголовка Retry-After в целых секундах, не меньше 1.
+func RetryAfter(wait time.Duration) int {
+	return max(int(math.Ceil(wait.Seconds())), 1)
+}
@@ -0,0 +1,147 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/ratelimit"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Parallel()
+
+	policy := domain.RateLimitPolicy{
+		Default: domain.RateLimitRule{
+			RateLimit: domain.RateLimit{Rate: 1, Burst: 2},
+		},
+		Operations: map[string]domain.RateLimitRule{
+			"PostProducts": {
+				RateLimit: domain.RateLimit{Rate: 1, Burst: 1},
+				Roles: map[domain.Role]domain.RateLimit{
+					domain.RoleEmploye: {Rate: 1, Burst: 3},
+				},
+			},
+			"GetPvz": {},
+		},
+	}
+
+	employee := &domain.Identity{UserID: uuid.New(), Role: domain.RoleEmploye}
+	moderator := &domain.Identity{UserID: uuid.New(), Role: domain.RoleModerator}
+
+	tests := []struct {
+		name      string
+		operation string
+		identity  *domain.Identity
+		ip        string
+		allowed   int
+	}{
+		{
+			name:      "default_rule_by_ip",
+			operation: "PostLogin",
+			ip:        "10.0.0.1",
+			allowed:   2,
+		},
+		{
+			name:      "role_override",
+			operation: "PostProducts",
+			identity:  employee,
+			allowed:   3,
+		},
+		{
+			name:      "operation_rule_without_role_override",
+			operation: "PostProducts",
+			identity:  moderator,
+			allowed:   1,
+		},
+		{
+			name:      "zero_rate_is_unlimited",
+			operation: "GetPvz",
+			ip:        "10.0.0.2",
+			allowed:   100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := ratelimit.New(policy)
+
+			for range tt.allowed {
+				_, ok := limiter.Allow(tt.operation, tt.identity, tt.ip)
+				require.True(t, ok)
+			}
+
+			if tt.allowed == 100 {
+				return
+			}
+
+			wait, ok := limiter.Allow(tt.operation, tt.identity, tt.ip)
+			require.False(t, ok)
+			require.Greater(t, wait, time.Duration(0))
+			require.LessOrEqual(t, wait, time.Second)
+		})
+	}
+}
+
+func TestLimiter_SeparateBuckets(t *testing.T) {
+	t.Parallel()
+
+	limiter := ratelimit.New(domain.RateLimitPolicy{
+		Default: domain.RateLimitRule{
+			RateLimit: domain.RateLimit{Rate: 0.1, Burst: 1},
+		},
+	})
+
+	user := &domain.Identity{UserID: uuid.New(), Role: domain.RoleEmploye}
+
+	_, ok := limiter.Allow("PostReceptions", user, "10.0.0.1")
+	require.True(t, ok)
+
+	_, ok = limiter.Allow("PostReceptions", user, "10.0.0.2")
+	require.False(t, ok, "authenticated calls are keyed by user, not by IP")
+
+	_, ok = limiter.Allow("PostProducts", user, "10.0.0.1")
+	require.True(t, ok, "operations have separate buckets")
+
+	_, ok = limiter.Allow("PostReceptions", nil, "10.0.0.1")
+	require.True(t, ok, "anonymous calls are keyed by IP")
+}
+
+func TestLimiter_AllowIP(t *testing.T) {
+	t.Parallel()
+
+	limiter := ratelimit.New(domain.RateLimitPolicy{
+		Default: domain.RateLimitRule{
+			RateLimit: domain.RateLimit{Rate: 0.1, Burst: 1},
+		},
+		PerIP: domain.RateLimit{Rate: 0.1, Burst: 2},
+	})
+
+	for range 2 {
+		_, ok := limiter.AllowIP("10.0.0.1")
+		require.True(t, ok)
+	}
+
+	wait, ok := limiter.AllowIP("10.0.0.1")
+	require.False(t, ok)
+	require.Greater(t, wait, time.Duration(0))
+
+	_, ok = limiter.AllowIP("10.0.0.2")
+	require.True(t, ok, "addresses have separate buckets")
+
+	_, ok = limiter.Allow("PostLogin", nil, "10.0.0.1")
+	require.True(t, ok, "per-IP bucket is separate from operation buckets")
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 1, ratelimit.RetryAfter(0))
+	require.Equal(t, 1, ratelimit.RetryAfter(300*time.Millisecond))
+	require.Equal(t, 3, ratelimit.RetryAfter(2100*time.Millisecond))
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *domain.APIKey) error
+	GetByHash(ctx context.Context, hash string) (*domain.APIKey, error)
+	List(ctx context.Context) ([]domain.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	Touch(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+type APIKey struct {
+	APIKeyRepository
+}
+
+func NewAPIKey(a APIKeyRepository) *APIKey {
+	return &APIKey{
+		APIKeyRepository: a,
+	}
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ASNRepository interface {
+	Create(ctx context.Context, asn *domain.ASN) error
+	GetForReception(ctx context.Context, reception domain.Reception) (*domain.ASN, error)
+	GetReconciliation(ctx context.Context, receptionID uuid.UUID) (*domain.ReceptionReconciliation, error)
+}
+
+type ASN struct {
+	ASNRepository
+}
+
+func NewASN(a ASNRepository) *ASN {
+	return &ASN{
+		ASNRepository: a,
+	}
+}
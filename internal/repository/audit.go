@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+)
+
+type AuditRepository interface {
+	Create(ctx context.Context, entry *domain.AuditEntry) error
+	List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+}
+
+type Audit struct {
+	AuditRepository
+}
+
+func NewAudit(a AuditRepository) *Audit {
+	return &Audit{
+		AuditRepository: a,
+	}
+}
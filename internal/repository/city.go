@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type CityRepository interface {
+	Create(ctx context.Context, city *domain.City) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.City, error)
+	List(ctx context.Context) ([]domain.City, error)
+	SetActive(ctx context.Context, id uuid.UUID, active bool) error
+}
+
+type City struct {
+	CityRepository
+}
+
+func NewCity(c CityRepository) *City {
+	return &City{
+		CityRepository: c,
+	}
+}
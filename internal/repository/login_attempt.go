@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"time"
+)
+
+type LoginAttemptRepository interface {
+	Get(ctx context.Context, key string) (*domain.LoginAttempt, error)
+	RecordFailure(
+		ctx context.Context,
+		key string,
+		windowStart time.Time,
+		now time.Time,
+	) (*domain.LoginAttempt, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Delete(ctx context.Context, key string) error
+}
+
+type LoginAttempt struct {
+	LoginAttemptRepository
+}
+
+func NewLoginAttempt(l LoginAttemptRepository) *LoginAttempt {
+	return &LoginAttempt{
+		LoginAttemptRepository: l,
+	}
+}
@@ -7,18 +7,19 @@ package repository
 import (
 	"avito_pvz/internal/models/domain"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockProductRepository creates a new instance of MockProductRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAPIKeyRepository creates a new instance of MockAPIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProductRepository(t interface {
+func NewMockAPIKeyRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProductRepository {
-	mock := &MockProductRepository{}
+}) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -26,407 +27,465 @@ func NewMockProductRepository(t interface {
 	return mock
 }
 
-// MockProductRepository is an autogenerated mock type for the ProductRepository type
-type MockProductRepository struct {
+// MockAPIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type MockAPIKeyRepository struct {
 	mock.Mock
 }
 
-type MockProductRepository_Expecter struct {
+type MockAPIKeyRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProductRepository) EXPECT() *MockProductRepository_Expecter {
-	return &MockProductRepository_Expecter{mock: &_m.Mock}
+func (_m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepository_Expecter {
+	return &MockAPIKeyRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockProductRepository
-func (_mock *MockProductRepository) Create(ctx context.Context, product *domain.Product) error {
-	ret := _mock.Called(ctx, product)
+// Create provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) error); ok {
-		r0 = returnFunc(ctx, product)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.APIKey) error); ok {
+		r0 = returnFunc(ctx, key)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockProductRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockProductRepository_Create_Call struct {
+// MockAPIKeyRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAPIKeyRepository_Create_Call struct {
 	*mock.Call
 }
 
 // Create is a helper method to define mock.On call
 //   - ctx
-//   - product
-func (_e *MockProductRepository_Expecter) Create(ctx interface{}, product interface{}) *MockProductRepository_Create_Call {
-	return &MockProductRepository_Create_Call{Call: _e.mock.On("Create", ctx, product)}
+//   - key
+func (_e *MockAPIKeyRepository_Expecter) Create(ctx interface{}, key interface{}) *MockAPIKeyRepository_Create_Call {
+	return &MockAPIKeyRepository_Create_Call{Call: _e.mock.On("Create", ctx, key)}
 }
 
-func (_c *MockProductRepository_Create_Call) Run(run func(ctx context.Context, product *domain.Product)) *MockProductRepository_Create_Call {
+func (_c *MockAPIKeyRepository_Create_Call) Run(run func(ctx context.Context, key *domain.APIKey)) *MockAPIKeyRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.Product))
+		run(args[0].(context.Context), args[1].(*domain.APIKey))
 	})
 	return _c
 }
 
-func (_c *MockProductRepository_Create_Call) Return(err error) *MockProductRepository_Create_Call {
+func (_c *MockAPIKeyRepository_Create_Call) Return(err error) *MockAPIKeyRepository_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProductRepository_Create_Call) RunAndReturn(run func(ctx context.Context, product *domain.Product) error) *MockProductRepository_Create_Call {
+func (_c *MockAPIKeyRepository_Create_Call) RunAndReturn(run func(ctx context.Context, key *domain.APIKey) error) *MockAPIKeyRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockProductRepository
-func (_mock *MockProductRepository) Delete(ctx context.Context, product *domain.Product) error {
-	ret := _mock.Called(ctx, product)
+// GetByHash provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) GetByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	ret := _mock.Called(ctx, hash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for GetByHash")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) error); ok {
-		r0 = returnFunc(ctx, product)
+	var r0 *domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.APIKey, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.APIKey); ok {
+		r0 = returnFunc(ctx, hash)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockProductRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockProductRepository_Delete_Call struct {
+// MockAPIKeyRepository_GetByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHash'
+type MockAPIKeyRepository_GetByHash_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// GetByHash is a helper method to define mock.On call
 //   - ctx
-//   - product
-func (_e *MockProductRepository_Expecter) Delete(ctx interface{}, product interface{}) *MockProductRepository_Delete_Call {
-	return &MockProductRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, product)}
+//   - hash
+func (_e *MockAPIKeyRepository_Expecter) GetByHash(ctx interface{}, hash interface{}) *MockAPIKeyRepository_GetByHash_Call {
+	return &MockAPIKeyRepository_GetByHash_Call{Call: _e.mock.On("GetByHash", ctx, hash)}
 }
 
-func (_c *MockProductRepository_Delete_Call) Run(run func(ctx context.Context, product *domain.Product)) *MockProductRepository_Delete_Call {
+func (_c *MockAPIKeyRepository_GetByHash_Call) Run(run func(ctx context.Context, hash string)) *MockAPIKeyRepository_GetByHash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.Product))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockProductRepository_Delete_Call) Return(err error) *MockProductRepository_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockAPIKeyRepository_GetByHash_Call) Return(aPIKey *domain.APIKey, err error) *MockAPIKeyRepository_GetByHash_Call {
+	_c.Call.Return(aPIKey, err)
 	return _c
 }
 
-func (_c *MockProductRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, product *domain.Product) error) *MockProductRepository_Delete_Call {
+func (_c *MockAPIKeyRepository_GetByHash_Call) RunAndReturn(run func(ctx context.Context, hash string) (*domain.APIKey, error)) *MockAPIKeyRepository_GetByHash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLast provides a mock function for the type MockProductRepository
-func (_mock *MockProductRepository) GetLast(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error) {
-	ret := _mock.Called(ctx, receptionID)
+// List provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLast")
+		panic("no return value specified for List")
 	}
 
-	var r0 *domain.Product
+	var r0 []domain.APIKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Product, error)); ok {
-		return returnFunc(ctx, receptionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.APIKey, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Product); ok {
-		r0 = returnFunc(ctx, receptionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.APIKey); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Product)
+			r0 = ret.Get(0).([]domain.APIKey)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, receptionID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProductRepository_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
-type MockProductRepository_GetLast_Call struct {
+// MockAPIKeyRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAPIKeyRepository_List_Call struct {
 	*mock.Call
 }
 
-// GetLast is a helper method to define mock.On call
+// List is a helper method to define mock.On call
 //   - ctx
-//   - receptionID
-func (_e *MockProductRepository_Expecter) GetLast(ctx interface{}, receptionID interface{}) *MockProductRepository_GetLast_Call {
-	return &MockProductRepository_GetLast_Call{Call: _e.mock.On("GetLast", ctx, receptionID)}
+func (_e *MockAPIKeyRepository_Expecter) List(ctx interface{}) *MockAPIKeyRepository_List_Call {
+	return &MockAPIKeyRepository_List_Call{Call: _e.mock.On("List", ctx)}
 }
 
-func (_c *MockProductRepository_GetLast_Call) Run(run func(ctx context.Context, receptionID uuid.UUID)) *MockProductRepository_GetLast_Call {
+func (_c *MockAPIKeyRepository_List_Call) Run(run func(ctx context.Context)) *MockAPIKeyRepository_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockProductRepository_GetLast_Call) Return(product *domain.Product, err error) *MockProductRepository_GetLast_Call {
-	_c.Call.Return(product, err)
+func (_c *MockAPIKeyRepository_List_Call) Return(aPIKeys []domain.APIKey, err error) *MockAPIKeyRepository_List_Call {
+	_c.Call.Return(aPIKeys, err)
 	return _c
 }
 
-func (_c *MockProductRepository_GetLast_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error)) *MockProductRepository_GetLast_Call {
+func (_c *MockAPIKeyRepository_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.APIKey, error)) *MockAPIKeyRepository_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPVZRepository creates a new instance of MockPVZRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPVZRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPVZRepository {
-	mock := &MockPVZRepository{}
-	mock.Mock.Test(t)
+// Revoke provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
 
-	return mock
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockPVZRepository is an autogenerated mock type for the PVZRepository type
-type MockPVZRepository struct {
-	mock.Mock
+// MockAPIKeyRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockAPIKeyRepository_Revoke_Call struct {
+	*mock.Call
 }
 
-type MockPVZRepository_Expecter struct {
-	mock *mock.Mock
+// Revoke is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockAPIKeyRepository_Expecter) Revoke(ctx interface{}, id interface{}) *MockAPIKeyRepository_Revoke_Call {
+	return &MockAPIKeyRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
 }
 
-func (_m *MockPVZRepository) EXPECT() *MockPVZRepository_Expecter {
-	return &MockPVZRepository_Expecter{mock: &_m.Mock}
+func (_c *MockAPIKeyRepository_Revoke_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
 }
 
-// Create provides a mock function for the type MockPVZRepository
-func (_mock *MockPVZRepository) Create(ctx context.Context, pvz *domain.PVZ) error {
-	ret := _mock.Called(ctx, pvz)
+func (_c *MockAPIKeyRepository_Revoke_Call) Return(err error) *MockAPIKeyRepository_Revoke_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Revoke_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockAPIKeyRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Touch provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	ret := _mock.Called(ctx, id, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Touch")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZ) error); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, at)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPVZRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockPVZRepository_Create_Call struct {
+// MockAPIKeyRepository_Touch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Touch'
+type MockAPIKeyRepository_Touch_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Touch is a helper method to define mock.On call
 //   - ctx
-//   - pvz
-func (_e *MockPVZRepository_Expecter) Create(ctx interface{}, pvz interface{}) *MockPVZRepository_Create_Call {
-	return &MockPVZRepository_Create_Call{Call: _e.mock.On("Create", ctx, pvz)}
+//   - id
+//   - at
+func (_e *MockAPIKeyRepository_Expecter) Touch(ctx interface{}, id interface{}, at interface{}) *MockAPIKeyRepository_Touch_Call {
+	return &MockAPIKeyRepository_Touch_Call{Call: _e.mock.On("Touch", ctx, id, at)}
 }
 
-func (_c *MockPVZRepository_Create_Call) Run(run func(ctx context.Context, pvz *domain.PVZ)) *MockPVZRepository_Create_Call {
+func (_c *MockAPIKeyRepository_Touch_Call) Run(run func(ctx context.Context, id uuid.UUID, at time.Time)) *MockAPIKeyRepository_Touch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.PVZ))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockPVZRepository_Create_Call) Return(err error) *MockPVZRepository_Create_Call {
+func (_c *MockAPIKeyRepository_Touch_Call) Return(err error) *MockAPIKeyRepository_Touch_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPVZRepository_Create_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.PVZ) error) *MockPVZRepository_Create_Call {
+func (_c *MockAPIKeyRepository_Touch_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, at time.Time) error) *MockAPIKeyRepository_Touch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Exist provides a mock function for the type MockPVZRepository
-func (_mock *MockPVZRepository) Exist(ctx context.Context, pvz uuid.UUID) error {
-	ret := _mock.Called(ctx, pvz)
+// NewMockASNRepository creates a new instance of MockASNRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockASNRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockASNRepository {
+	mock := &MockASNRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockASNRepository is an autogenerated mock type for the ASNRepository type
+type MockASNRepository struct {
+	mock.Mock
+}
+
+type MockASNRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockASNRepository) EXPECT() *MockASNRepository_Expecter {
+	return &MockASNRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockASNRepository
+func (_mock *MockASNRepository) Create(ctx context.Context, asn *domain.ASN) error {
+	ret := _mock.Called(ctx, asn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Exist")
+		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ASN) error); ok {
+		r0 = returnFunc(ctx, asn)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPVZRepository_Exist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exist'
-type MockPVZRepository_Exist_Call struct {
+// MockASNRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockASNRepository_Create_Call struct {
 	*mock.Call
 }
 
-// Exist is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx
-//   - pvz
-func (_e *MockPVZRepository_Expecter) Exist(ctx interface{}, pvz interface{}) *MockPVZRepository_Exist_Call {
-	return &MockPVZRepository_Exist_Call{Call: _e.mock.On("Exist", ctx, pvz)}
+//   - asn
+func (_e *MockASNRepository_Expecter) Create(ctx interface{}, asn interface{}) *MockASNRepository_Create_Call {
+	return &MockASNRepository_Create_Call{Call: _e.mock.On("Create", ctx, asn)}
 }
 
-func (_c *MockPVZRepository_Exist_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockPVZRepository_Exist_Call {
+func (_c *MockASNRepository_Create_Call) Run(run func(ctx context.Context, asn *domain.ASN)) *MockASNRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(*domain.ASN))
 	})
 	return _c
 }
 
-func (_c *MockPVZRepository_Exist_Call) Return(err error) *MockPVZRepository_Exist_Call {
+func (_c *MockASNRepository_Create_Call) Return(err error) *MockASNRepository_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPVZRepository_Exist_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) error) *MockPVZRepository_Exist_Call {
+func (_c *MockASNRepository_Create_Call) RunAndReturn(run func(ctx context.Context, asn *domain.ASN) error) *MockASNRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function for the type MockPVZRepository
-func (_mock *MockPVZRepository) GetAll(ctx context.Context) ([]domain.PVZ, error) {
-	ret := _mock.Called(ctx)
+// GetForReception provides a mock function for the type MockASNRepository
+func (_mock *MockASNRepository) GetForReception(ctx context.Context, reception domain.Reception) (*domain.ASN, error) {
+	ret := _mock.Called(ctx, reception)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAll")
+		panic("no return value specified for GetForReception")
 	}
 
-	var r0 []domain.PVZ
+	var r0 *domain.ASN
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.PVZ, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) (*domain.ASN, error)); ok {
+		return returnFunc(ctx, reception)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.PVZ); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) *domain.ASN); ok {
+		r0 = returnFunc(ctx, reception)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.PVZ)
+			r0 = ret.Get(0).(*domain.ASN)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Reception) error); ok {
+		r1 = returnFunc(ctx, reception)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPVZRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
-type MockPVZRepository_GetAll_Call struct {
+// MockASNRepository_GetForReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForReception'
+type MockASNRepository_GetForReception_Call struct {
 	*mock.Call
 }
 
-// GetAll is a helper method to define mock.On call
+// GetForReception is a helper method to define mock.On call
 //   - ctx
-func (_e *MockPVZRepository_Expecter) GetAll(ctx interface{}) *MockPVZRepository_GetAll_Call {
-	return &MockPVZRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+//   - reception
+func (_e *MockASNRepository_Expecter) GetForReception(ctx interface{}, reception interface{}) *MockASNRepository_GetForReception_Call {
+	return &MockASNRepository_GetForReception_Call{Call: _e.mock.On("GetForReception", ctx, reception)}
 }
 
-func (_c *MockPVZRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockPVZRepository_GetAll_Call {
+func (_c *MockASNRepository_GetForReception_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockASNRepository_GetForReception_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(domain.Reception))
 	})
 	return _c
 }
 
-func (_c *MockPVZRepository_GetAll_Call) Return(pVZs []domain.PVZ, err error) *MockPVZRepository_GetAll_Call {
-	_c.Call.Return(pVZs, err)
+func (_c *MockASNRepository_GetForReception_Call) Return(aSN *domain.ASN, err error) *MockASNRepository_GetForReception_Call {
+	_c.Call.Return(aSN, err)
 	return _c
 }
 
-func (_c *MockPVZRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.PVZ, error)) *MockPVZRepository_GetAll_Call {
+func (_c *MockASNRepository_GetForReception_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) (*domain.ASN, error)) *MockASNRepository_GetForReception_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWithParam provides a mock function for the type MockPVZRepository
-func (_mock *MockPVZRepository) GetWithParam(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error) {
-	ret := _mock.Called(ctx, params)
+// GetReconciliation provides a mock function for the type MockASNRepository
+func (_mock *MockASNRepository) GetReconciliation(ctx context.Context, receptionID uuid.UUID) (*domain.ReceptionReconciliation, error) {
+	ret := _mock.Called(ctx, receptionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWithParam")
+		panic("no return value specified for GetReconciliation")
 	}
 
-	var r0 []domain.PVZAgregate
+	var r0 *domain.ReceptionReconciliation
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) ([]domain.PVZAgregate, error)); ok {
-		return returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ReceptionReconciliation, error)); ok {
+		return returnFunc(ctx, receptionID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) []domain.PVZAgregate); ok {
-		r0 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ReceptionReconciliation); ok {
+		r0 = returnFunc(ctx, receptionID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.PVZAgregate)
+			r0 = ret.Get(0).(*domain.ReceptionReconciliation)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Params) error); ok {
-		r1 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPVZRepository_GetWithParam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithParam'
-type MockPVZRepository_GetWithParam_Call struct {
+// MockASNRepository_GetReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliation'
+type MockASNRepository_GetReconciliation_Call struct {
 	*mock.Call
 }
 
-// GetWithParam is a helper method to define mock.On call
+// GetReconciliation is a helper method to define mock.On call
 //   - ctx
-//   - params
-func (_e *MockPVZRepository_Expecter) GetWithParam(ctx interface{}, params interface{}) *MockPVZRepository_GetWithParam_Call {
-	return &MockPVZRepository_GetWithParam_Call{Call: _e.mock.On("GetWithParam", ctx, params)}
+//   - receptionID
+func (_e *MockASNRepository_Expecter) GetReconciliation(ctx interface{}, receptionID interface{}) *MockASNRepository_GetReconciliation_Call {
+	return &MockASNRepository_GetReconciliation_Call{Call: _e.mock.On("GetReconciliation", ctx, receptionID)}
 }
 
-func (_c *MockPVZRepository_GetWithParam_Call) Run(run func(ctx context.Context, params domain.Params)) *MockPVZRepository_GetWithParam_Call {
+func (_c *MockASNRepository_GetReconciliation_Call) Run(run func(ctx context.Context, receptionID uuid.UUID)) *MockASNRepository_GetReconciliation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Params))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockPVZRepository_GetWithParam_Call) Return(pVZAgregates []domain.PVZAgregate, err error) *MockPVZRepository_GetWithParam_Call {
-	_c.Call.Return(pVZAgregates, err)
+func (_c *MockASNRepository_GetReconciliation_Call) Return(receptionReconciliation *domain.ReceptionReconciliation, err error) *MockASNRepository_GetReconciliation_Call {
+	_c.Call.Return(receptionReconciliation, err)
 	return _c
 }
 
-func (_c *MockPVZRepository_GetWithParam_Call) RunAndReturn(run func(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error)) *MockPVZRepository_GetWithParam_Call {
+func (_c *MockASNRepository_GetReconciliation_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID) (*domain.ReceptionReconciliation, error)) *MockASNRepository_GetReconciliation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockReceptionRepository creates a new instance of MockReceptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAuditRepository creates a new instance of MockAuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockReceptionRepository(t interface {
+func NewMockAuditRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockReceptionRepository {
-	mock := &MockReceptionRepository{}
+}) *MockAuditRepository {
+	mock := &MockAuditRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -434,175 +493,362 @@ func NewMockReceptionRepository(t interface {
 	return mock
 }
 
-// MockReceptionRepository is an autogenerated mock type for the ReceptionRepository type
-type MockReceptionRepository struct {
+// MockAuditRepository is an autogenerated mock type for the AuditRepository type
+type MockAuditRepository struct {
 	mock.Mock
 }
 
-type MockReceptionRepository_Expecter struct {
+type MockAuditRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockReceptionRepository) EXPECT() *MockReceptionRepository_Expecter {
-	return &MockReceptionRepository_Expecter{mock: &_m.Mock}
+func (_m *MockAuditRepository) EXPECT() *MockAuditRepository_Expecter {
+	return &MockAuditRepository_Expecter{mock: &_m.Mock}
 }
 
-// Close provides a mock function for the type MockReceptionRepository
-func (_mock *MockReceptionRepository) Close(ctx context.Context, reception domain.Reception) error {
-	ret := _mock.Called(ctx, reception)
+// Create provides a mock function for the type MockAuditRepository
+func (_mock *MockAuditRepository) Create(ctx context.Context, entry *domain.AuditEntry) error {
+	ret := _mock.Called(ctx, entry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Close")
+		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) error); ok {
-		r0 = returnFunc(ctx, reception)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AuditEntry) error); ok {
+		r0 = returnFunc(ctx, entry)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockReceptionRepository_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockReceptionRepository_Close_Call struct {
+// MockAuditRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAuditRepository_Create_Call struct {
 	*mock.Call
 }
 
-// Close is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx
-//   - reception
-func (_e *MockReceptionRepository_Expecter) Close(ctx interface{}, reception interface{}) *MockReceptionRepository_Close_Call {
-	return &MockReceptionRepository_Close_Call{Call: _e.mock.On("Close", ctx, reception)}
+//   - entry
+func (_e *MockAuditRepository_Expecter) Create(ctx interface{}, entry interface{}) *MockAuditRepository_Create_Call {
+	return &MockAuditRepository_Create_Call{Call: _e.mock.On("Create", ctx, entry)}
 }
 
-func (_c *MockReceptionRepository_Close_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockReceptionRepository_Close_Call {
+func (_c *MockAuditRepository_Create_Call) Run(run func(ctx context.Context, entry *domain.AuditEntry)) *MockAuditRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Reception))
+		run(args[0].(context.Context), args[1].(*domain.AuditEntry))
 	})
 	return _c
 }
 
-func (_c *MockReceptionRepository_Close_Call) Return(err error) *MockReceptionRepository_Close_Call {
+func (_c *MockAuditRepository_Create_Call) Return(err error) *MockAuditRepository_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockReceptionRepository_Close_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) error) *MockReceptionRepository_Close_Call {
+func (_c *MockAuditRepository_Create_Call) RunAndReturn(run func(ctx context.Context, entry *domain.AuditEntry) error) *MockAuditRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockReceptionRepository
-func (_mock *MockReceptionRepository) Create(ctx context.Context, reception domain.Reception) error {
-	ret := _mock.Called(ctx, reception)
+// List provides a mock function for the type MockAuditRepository
+func (_mock *MockAuditRepository) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for List")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) error); ok {
-		r0 = returnFunc(ctx, reception)
+	var r0 []domain.AuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuditFilter) ([]domain.AuditEntry, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuditFilter) []domain.AuditEntry); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEntry)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuditFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockReceptionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockReceptionRepository_Create_Call struct {
+// MockAuditRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAuditRepository_List_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// List is a helper method to define mock.On call
 //   - ctx
-//   - reception
-func (_e *MockReceptionRepository_Expecter) Create(ctx interface{}, reception interface{}) *MockReceptionRepository_Create_Call {
-	return &MockReceptionRepository_Create_Call{Call: _e.mock.On("Create", ctx, reception)}
+//   - filter
+func (_e *MockAuditRepository_Expecter) List(ctx interface{}, filter interface{}) *MockAuditRepository_List_Call {
+	return &MockAuditRepository_List_Call{Call: _e.mock.On("List", ctx, filter)}
 }
 
-func (_c *MockReceptionRepository_Create_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockReceptionRepository_Create_Call {
+func (_c *MockAuditRepository_List_Call) Run(run func(ctx context.Context, filter domain.AuditFilter)) *MockAuditRepository_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Reception))
+		run(args[0].(context.Context), args[1].(domain.AuditFilter))
 	})
 	return _c
 }
 
-func (_c *MockReceptionRepository_Create_Call) Return(err error) *MockReceptionRepository_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockAuditRepository_List_Call) Return(auditEntrys []domain.AuditEntry, err error) *MockAuditRepository_List_Call {
+	_c.Call.Return(auditEntrys, err)
 	return _c
 }
 
-func (_c *MockReceptionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) error) *MockReceptionRepository_Create_Call {
+func (_c *MockAuditRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)) *MockAuditRepository_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLast provides a mock function for the type MockReceptionRepository
-func (_mock *MockReceptionRepository) GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error) {
-	ret := _mock.Called(ctx, pvz)
+// NewMockCityRepository creates a new instance of MockCityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCityRepository {
+	mock := &MockCityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCityRepository is an autogenerated mock type for the CityRepository type
+type MockCityRepository struct {
+	mock.Mock
+}
+
+type MockCityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCityRepository) EXPECT() *MockCityRepository_Expecter {
+	return &MockCityRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockCityRepository
+func (_mock *MockCityRepository) Create(ctx context.Context, city *domain.City) error {
+	ret := _mock.Called(ctx, city)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLast")
+		panic("no return value specified for Create")
 	}
 
-	var r0 *domain.Reception
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.City) error); ok {
+		r0 = returnFunc(ctx, city)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCityRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCityRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - city
+func (_e *MockCityRepository_Expecter) Create(ctx interface{}, city interface{}) *MockCityRepository_Create_Call {
+	return &MockCityRepository_Create_Call{Call: _e.mock.On("Create", ctx, city)}
+}
+
+func (_c *MockCityRepository_Create_Call) Run(run func(ctx context.Context, city *domain.City)) *MockCityRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.City))
+	})
+	return _c
+}
+
+func (_c *MockCityRepository_Create_Call) Return(err error) *MockCityRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCityRepository_Create_Call) RunAndReturn(run func(ctx context.Context, city *domain.City) error) *MockCityRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockCityRepository
+func (_mock *MockCityRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.City, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.City
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Reception, error)); ok {
-		return returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.City, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Reception); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.City); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Reception)
+			r0 = ret.Get(0).(*domain.City)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, pvz)
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockReceptionRepository_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
-type MockReceptionRepository_GetLast_Call struct {
+// MockCityRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCityRepository_GetByID_Call struct {
 	*mock.Call
 }
 
-// GetLast is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
 //   - ctx
-//   - pvz
-func (_e *MockReceptionRepository_Expecter) GetLast(ctx interface{}, pvz interface{}) *MockReceptionRepository_GetLast_Call {
-	return &MockReceptionRepository_GetLast_Call{Call: _e.mock.On("GetLast", ctx, pvz)}
+//   - id
+func (_e *MockCityRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockCityRepository_GetByID_Call {
+	return &MockCityRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *MockReceptionRepository_GetLast_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockReceptionRepository_GetLast_Call {
+func (_c *MockCityRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockCityRepository_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockReceptionRepository_GetLast_Call) Return(reception *domain.Reception, err error) *MockReceptionRepository_GetLast_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockCityRepository_GetByID_Call) Return(city *domain.City, err error) *MockCityRepository_GetByID_Call {
+	_c.Call.Return(city, err)
 	return _c
 }
 
-func (_c *MockReceptionRepository_GetLast_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error)) *MockReceptionRepository_GetLast_Call {
+func (_c *MockCityRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.City, error)) *MockCityRepository_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// List provides a mock function for the type MockCityRepository
+func (_mock *MockCityRepository) List(ctx context.Context) ([]domain.City, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.City
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.City, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.City); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.City)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCityRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockCityRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+func (_e *MockCityRepository_Expecter) List(ctx interface{}) *MockCityRepository_List_Call {
+	return &MockCityRepository_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockCityRepository_List_Call) Run(run func(ctx context.Context)) *MockCityRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCityRepository_List_Call) Return(citys []domain.City, err error) *MockCityRepository_List_Call {
+	_c.Call.Return(citys, err)
+	return _c
+}
+
+func (_c *MockCityRepository_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.City, error)) *MockCityRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetActive provides a mock function for the type MockCityRepository
+func (_mock *MockCityRepository) SetActive(ctx context.Context, id uuid.UUID, active bool) error {
+	ret := _mock.Called(ctx, id, active)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetActive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = returnFunc(ctx, id, active)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCityRepository_SetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetActive'
+type MockCityRepository_SetActive_Call struct {
+	*mock.Call
+}
+
+// SetActive is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - active
+func (_e *MockCityRepository_Expecter) SetActive(ctx interface{}, id interface{}, active interface{}) *MockCityRepository_SetActive_Call {
+	return &MockCityRepository_SetActive_Call{Call: _e.mock.On("SetActive", ctx, id, active)}
+}
+
+func (_c *MockCityRepository_SetActive_Call) Run(run func(ctx context.Context, id uuid.UUID, active bool)) *MockCityRepository_SetActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockCityRepository_SetActive_Call) Return(err error) *MockCityRepository_SetActive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCityRepository_SetActive_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, active bool) error) *MockCityRepository_SetActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoginAttemptRepository creates a new instance of MockLoginAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockUserRepository(t interface {
+func NewMockLoginAttemptRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockUserRepository {
-	mock := &MockUserRepository{}
+}) *MockLoginAttemptRepository {
+	mock := &MockLoginAttemptRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -610,118 +856,2940 @@ func NewMockUserRepository(t interface {
 	return mock
 }
 
-// MockUserRepository is an autogenerated mock type for the UserRepository type
-type MockUserRepository struct {
+// MockLoginAttemptRepository is an autogenerated mock type for the LoginAttemptRepository type
+type MockLoginAttemptRepository struct {
 	mock.Mock
 }
 
-type MockUserRepository_Expecter struct {
+type MockLoginAttemptRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
-	return &MockUserRepository_Expecter{mock: &_m.Mock}
+func (_m *MockLoginAttemptRepository) EXPECT() *MockLoginAttemptRepository_Expecter {
+	return &MockLoginAttemptRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) Create(ctx context.Context, user *domain.User) error {
-	ret := _mock.Called(ctx, user)
+// Delete provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) Delete(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockUserRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockUserRepository_Create_Call struct {
+// MockLoginAttemptRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockLoginAttemptRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Delete is a helper method to define mock.On call
 //   - ctx
-//   - user
-func (_e *MockUserRepository_Expecter) Create(ctx interface{}, user interface{}) *MockUserRepository_Create_Call {
-	return &MockUserRepository_Create_Call{Call: _e.mock.On("Create", ctx, user)}
+//   - key
+func (_e *MockLoginAttemptRepository_Expecter) Delete(ctx interface{}, key interface{}) *MockLoginAttemptRepository_Delete_Call {
+	return &MockLoginAttemptRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
 }
 
-func (_c *MockUserRepository_Create_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserRepository_Create_Call {
+func (_c *MockLoginAttemptRepository_Delete_Call) Run(run func(ctx context.Context, key string)) *MockLoginAttemptRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.User))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_Create_Call) Return(err error) *MockUserRepository_Create_Call {
+func (_c *MockLoginAttemptRepository_Delete_Call) Return(err error) *MockLoginAttemptRepository_Delete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) error) *MockUserRepository_Create_Call {
+func (_c *MockLoginAttemptRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockLoginAttemptRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByEmail provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
-	ret := _mock.Called(ctx, email)
+// Get provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) Get(ctx context.Context, key string) (*domain.LoginAttempt, error) {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByEmail")
+		panic("no return value specified for Get")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.LoginAttempt
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.LoginAttempt, error)); ok {
+		return returnFunc(ctx, key)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.LoginAttempt); ok {
+		r0 = returnFunc(ctx, key)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.LoginAttempt)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+		r1 = returnFunc(ctx, key)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepository_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
-type MockUserRepository_GetByEmail_Call struct {
+// MockLoginAttemptRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockLoginAttemptRepository_Get_Call struct {
 	*mock.Call
 }
 
-// GetByEmail is a helper method to define mock.On call
+// Get is a helper method to define mock.On call
 //   - ctx
-//   - email
-func (_e *MockUserRepository_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockUserRepository_GetByEmail_Call {
-	return &MockUserRepository_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+//   - key
+func (_e *MockLoginAttemptRepository_Expecter) Get(ctx interface{}, key interface{}) *MockLoginAttemptRepository_Get_Call {
+	return &MockLoginAttemptRepository_Get_Call{Call: _e.mock.On("Get", ctx, key)}
 }
 
-func (_c *MockUserRepository_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserRepository_GetByEmail_Call {
+func (_c *MockLoginAttemptRepository_Get_Call) Run(run func(ctx context.Context, key string)) *MockLoginAttemptRepository_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_GetByEmail_Call) Return(user *domain.User, err error) *MockUserRepository_GetByEmail_Call {
-	_c.Call.Return(user, err)
+func (_c *MockLoginAttemptRepository_Get_Call) Return(loginAttempt *domain.LoginAttempt, err error) *MockLoginAttemptRepository_Get_Call {
+	_c.Call.Return(loginAttempt, err)
 	return _c
 }
 
-func (_c *MockUserRepository_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockUserRepository_GetByEmail_Call {
+func (_c *MockLoginAttemptRepository_Get_Call) RunAndReturn(run func(ctx context.Context, key string) (*domain.LoginAttempt, error)) *MockLoginAttemptRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lock provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	ret := _mock.Called(ctx, key, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, key, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginAttemptRepository_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type MockLoginAttemptRepository_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx
+//   - key
+//   - until
+func (_e *MockLoginAttemptRepository_Expecter) Lock(ctx interface{}, key interface{}, until interface{}) *MockLoginAttemptRepository_Lock_Call {
+	return &MockLoginAttemptRepository_Lock_Call{Call: _e.mock.On("Lock", ctx, key, until)}
+}
+
+func (_c *MockLoginAttemptRepository_Lock_Call) Run(run func(ctx context.Context, key string, until time.Time)) *MockLoginAttemptRepository_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_Lock_Call) Return(err error) *MockLoginAttemptRepository_Lock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_Lock_Call) RunAndReturn(run func(ctx context.Context, key string, until time.Time) error) *MockLoginAttemptRepository_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailure provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) RecordFailure(ctx context.Context, key string, windowStart time.Time, now time.Time) (*domain.LoginAttempt, error) {
+	ret := _mock.Called(ctx, key, windowStart, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 *domain.LoginAttempt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (*domain.LoginAttempt, error)); ok {
+		return returnFunc(ctx, key, windowStart, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) *domain.LoginAttempt); ok {
+		r0 = returnFunc(ctx, key, windowStart, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginAttempt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, key, windowStart, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginAttemptRepository_RecordFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailure'
+type MockLoginAttemptRepository_RecordFailure_Call struct {
+	*mock.Call
+}
+
+// RecordFailure is a helper method to define mock.On call
+//   - ctx
+//   - key
+//   - windowStart
+//   - now
+func (_e *MockLoginAttemptRepository_Expecter) RecordFailure(ctx interface{}, key interface{}, windowStart interface{}, now interface{}) *MockLoginAttemptRepository_RecordFailure_Call {
+	return &MockLoginAttemptRepository_RecordFailure_Call{Call: _e.mock.On("RecordFailure", ctx, key, windowStart, now)}
+}
+
+func (_c *MockLoginAttemptRepository_RecordFailure_Call) Run(run func(ctx context.Context, key string, windowStart time.Time, now time.Time)) *MockLoginAttemptRepository_RecordFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_RecordFailure_Call) Return(loginAttempt *domain.LoginAttempt, err error) *MockLoginAttemptRepository_RecordFailure_Call {
+	_c.Call.Return(loginAttempt, err)
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_RecordFailure_Call) RunAndReturn(run func(ctx context.Context, key string, windowStart time.Time, now time.Time) (*domain.LoginAttempt, error)) *MockLoginAttemptRepository_RecordFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPasswordResetRepository creates a new instance of MockPasswordResetRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPasswordResetRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPasswordResetRepository {
+	mock := &MockPasswordResetRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPasswordResetRepository is an autogenerated mock type for the PasswordResetRepository type
+type MockPasswordResetRepository struct {
+	mock.Mock
+}
+
+type MockPasswordResetRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPasswordResetRepository) EXPECT() *MockPasswordResetRepository_Expecter {
+	return &MockPasswordResetRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockPasswordResetRepository
+func (_mock *MockPasswordResetRepository) Create(ctx context.Context, token *domain.PasswordResetToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PasswordResetToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPasswordResetRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockPasswordResetRepository_Expecter) Create(ctx interface{}, token interface{}) *MockPasswordResetRepository_Create_Call {
+	return &MockPasswordResetRepository_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *MockPasswordResetRepository_Create_Call) Run(run func(ctx context.Context, token *domain.PasswordResetToken)) *MockPasswordResetRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PasswordResetToken))
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetRepository_Create_Call) Return(err error) *MockPasswordResetRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetRepository_Create_Call) RunAndReturn(run func(ctx context.Context, token *domain.PasswordResetToken) error) *MockPasswordResetRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByHash provides a mock function for the type MockPasswordResetRepository
+func (_mock *MockPasswordResetRepository) GetByHash(ctx context.Context, hash string) (*domain.PasswordResetToken, error) {
+	ret := _mock.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHash")
+	}
+
+	var r0 *domain.PasswordResetToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.PasswordResetToken, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.PasswordResetToken); ok {
+		r0 = returnFunc(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PasswordResetToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPasswordResetRepository_GetByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHash'
+type MockPasswordResetRepository_GetByHash_Call struct {
+	*mock.Call
+}
+
+// GetByHash is a helper method to define mock.On call
+//   - ctx
+//   - hash
+func (_e *MockPasswordResetRepository_Expecter) GetByHash(ctx interface{}, hash interface{}) *MockPasswordResetRepository_GetByHash_Call {
+	return &MockPasswordResetRepository_GetByHash_Call{Call: _e.mock.On("GetByHash", ctx, hash)}
+}
+
+func (_c *MockPasswordResetRepository_GetByHash_Call) Run(run func(ctx context.Context, hash string)) *MockPasswordResetRepository_GetByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetRepository_GetByHash_Call) Return(passwordResetToken *domain.PasswordResetToken, err error) *MockPasswordResetRepository_GetByHash_Call {
+	_c.Call.Return(passwordResetToken, err)
+	return _c
+}
+
+func (_c *MockPasswordResetRepository_GetByHash_Call) RunAndReturn(run func(ctx context.Context, hash string) (*domain.PasswordResetToken, error)) *MockPasswordResetRepository_GetByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Use provides a mock function for the type MockPasswordResetRepository
+func (_mock *MockPasswordResetRepository) Use(ctx context.Context, token *domain.PasswordResetToken, passwordHash string) error {
+	ret := _mock.Called(ctx, token, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Use")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PasswordResetToken, string) error); ok {
+		r0 = returnFunc(ctx, token, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetRepository_Use_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Use'
+type MockPasswordResetRepository_Use_Call struct {
+	*mock.Call
+}
+
+// Use is a helper method to define mock.On call
+//   - ctx
+//   - token
+//   - passwordHash
+func (_e *MockPasswordResetRepository_Expecter) Use(ctx interface{}, token interface{}, passwordHash interface{}) *MockPasswordResetRepository_Use_Call {
+	return &MockPasswordResetRepository_Use_Call{Call: _e.mock.On("Use", ctx, token, passwordHash)}
+}
+
+func (_c *MockPasswordResetRepository_Use_Call) Run(run func(ctx context.Context, token *domain.PasswordResetToken, passwordHash string)) *MockPasswordResetRepository_Use_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PasswordResetToken), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetRepository_Use_Call) Return(err error) *MockPasswordResetRepository_Use_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetRepository_Use_Call) RunAndReturn(run func(ctx context.Context, token *domain.PasswordResetToken, passwordHash string) error) *MockPasswordResetRepository_Use_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductRepository creates a new instance of MockProductRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductRepository {
+	mock := &MockProductRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductRepository is an autogenerated mock type for the ProductRepository type
+type MockProductRepository struct {
+	mock.Mock
+}
+
+type MockProductRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductRepository) EXPECT() *MockProductRepository_Expecter {
+	return &MockProductRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) Create(ctx context.Context, product *domain.Product) error {
+	ret := _mock.Called(ctx, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) error); ok {
+		r0 = returnFunc(ctx, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProductRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - product
+func (_e *MockProductRepository_Expecter) Create(ctx interface{}, product interface{}) *MockProductRepository_Create_Call {
+	return &MockProductRepository_Create_Call{Call: _e.mock.On("Create", ctx, product)}
+}
+
+func (_c *MockProductRepository_Create_Call) Run(run func(ctx context.Context, product *domain.Product)) *MockProductRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Product))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_Create_Call) Return(err error) *MockProductRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_Create_Call) RunAndReturn(run func(ctx context.Context, product *domain.Product) error) *MockProductRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatch provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) CreateBatch(ctx context.Context, receptionID uuid.UUID, products []*domain.Product) error {
+	ret := _mock.Called(ctx, receptionID, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []*domain.Product) error); ok {
+		r0 = returnFunc(ctx, receptionID, products)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type MockProductRepository_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx
+//   - receptionID
+//   - products
+func (_e *MockProductRepository_Expecter) CreateBatch(ctx interface{}, receptionID interface{}, products interface{}) *MockProductRepository_CreateBatch_Call {
+	return &MockProductRepository_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, receptionID, products)}
+}
+
+func (_c *MockProductRepository_CreateBatch_Call) Run(run func(ctx context.Context, receptionID uuid.UUID, products []*domain.Product)) *MockProductRepository_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]*domain.Product))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_CreateBatch_Call) Return(err error) *MockProductRepository_CreateBatch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_CreateBatch_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID, products []*domain.Product) error) *MockProductRepository_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByBarcodes provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) FindByBarcodes(ctx context.Context, barcodes []string) ([]domain.Product, error) {
+	ret := _mock.Called(ctx, barcodes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByBarcodes")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Product, error)); ok {
+		return returnFunc(ctx, barcodes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []domain.Product); ok {
+		r0 = returnFunc(ctx, barcodes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, barcodes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_FindByBarcodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByBarcodes'
+type MockProductRepository_FindByBarcodes_Call struct {
+	*mock.Call
+}
+
+// FindByBarcodes is a helper method to define mock.On call
+//   - ctx
+//   - barcodes
+func (_e *MockProductRepository_Expecter) FindByBarcodes(ctx interface{}, barcodes interface{}) *MockProductRepository_FindByBarcodes_Call {
+	return &MockProductRepository_FindByBarcodes_Call{Call: _e.mock.On("FindByBarcodes", ctx, barcodes)}
+}
+
+func (_c *MockProductRepository_FindByBarcodes_Call) Run(run func(ctx context.Context, barcodes []string)) *MockProductRepository_FindByBarcodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_FindByBarcodes_Call) Return(products []domain.Product, err error) *MockProductRepository_FindByBarcodes_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockProductRepository_FindByBarcodes_Call) RunAndReturn(run func(ctx context.Context, barcodes []string) ([]domain.Product, error)) *MockProductRepository_FindByBarcodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByReception provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) GetByReception(ctx context.Context, receptionID uuid.UUID) ([]domain.Product, error) {
+	ret := _mock.Called(ctx, receptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByReception")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.Product, error)); ok {
+		return returnFunc(ctx, receptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.Product); ok {
+		r0 = returnFunc(ctx, receptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_GetByReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByReception'
+type MockProductRepository_GetByReception_Call struct {
+	*mock.Call
+}
+
+// GetByReception is a helper method to define mock.On call
+//   - ctx
+//   - receptionID
+func (_e *MockProductRepository_Expecter) GetByReception(ctx interface{}, receptionID interface{}) *MockProductRepository_GetByReception_Call {
+	return &MockProductRepository_GetByReception_Call{Call: _e.mock.On("GetByReception", ctx, receptionID)}
+}
+
+func (_c *MockProductRepository_GetByReception_Call) Run(run func(ctx context.Context, receptionID uuid.UUID)) *MockProductRepository_GetByReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_GetByReception_Call) Return(products []domain.Product, err error) *MockProductRepository_GetByReception_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockProductRepository_GetByReception_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID) ([]domain.Product, error)) *MockProductRepository_GetByReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLast provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) GetLast(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error) {
+	ret := _mock.Called(ctx, receptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLast")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Product, error)); ok {
+		return returnFunc(ctx, receptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Product); ok {
+		r0 = returnFunc(ctx, receptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
+type MockProductRepository_GetLast_Call struct {
+	*mock.Call
+}
+
+// GetLast is a helper method to define mock.On call
+//   - ctx
+//   - receptionID
+func (_e *MockProductRepository_Expecter) GetLast(ctx interface{}, receptionID interface{}) *MockProductRepository_GetLast_Call {
+	return &MockProductRepository_GetLast_Call{Call: _e.mock.On("GetLast", ctx, receptionID)}
+}
+
+func (_c *MockProductRepository_GetLast_Call) Run(run func(ctx context.Context, receptionID uuid.UUID)) *MockProductRepository_GetLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_GetLast_Call) Return(product *domain.Product, err error) *MockProductRepository_GetLast_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductRepository_GetLast_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error)) *MockProductRepository_GetLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Void provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) Void(ctx context.Context, void *domain.ProductVoid) (*domain.Product, error) {
+	ret := _mock.Called(ctx, void)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Void")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductVoid) (*domain.Product, error)); ok {
+		return returnFunc(ctx, void)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductVoid) *domain.Product); ok {
+		r0 = returnFunc(ctx, void)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ProductVoid) error); ok {
+		r1 = returnFunc(ctx, void)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_Void_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Void'
+type MockProductRepository_Void_Call struct {
+	*mock.Call
+}
+
+// Void is a helper method to define mock.On call
+//   - ctx
+//   - void
+func (_e *MockProductRepository_Expecter) Void(ctx interface{}, void interface{}) *MockProductRepository_Void_Call {
+	return &MockProductRepository_Void_Call{Call: _e.mock.On("Void", ctx, void)}
+}
+
+func (_c *MockProductRepository_Void_Call) Run(run func(ctx context.Context, void *domain.ProductVoid)) *MockProductRepository_Void_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ProductVoid))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_Void_Call) Return(product *domain.Product, err error) *MockProductRepository_Void_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductRepository_Void_Call) RunAndReturn(run func(ctx context.Context, void *domain.ProductVoid) (*domain.Product, error)) *MockProductRepository_Void_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductTypeRepository creates a new instance of MockProductTypeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductTypeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductTypeRepository {
+	mock := &MockProductTypeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductTypeRepository is an autogenerated mock type for the ProductTypeRepository type
+type MockProductTypeRepository struct {
+	mock.Mock
+}
+
+type MockProductTypeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductTypeRepository) EXPECT() *MockProductTypeRepository_Expecter {
+	return &MockProductTypeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockProductTypeRepository
+func (_mock *MockProductTypeRepository) Create(ctx context.Context, productType *domain.ProductTypeInfo) error {
+	ret := _mock.Called(ctx, productType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductTypeInfo) error); ok {
+		r0 = returnFunc(ctx, productType)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductTypeRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProductTypeRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - productType
+func (_e *MockProductTypeRepository_Expecter) Create(ctx interface{}, productType interface{}) *MockProductTypeRepository_Create_Call {
+	return &MockProductTypeRepository_Create_Call{Call: _e.mock.On("Create", ctx, productType)}
+}
+
+func (_c *MockProductTypeRepository_Create_Call) Run(run func(ctx context.Context, productType *domain.ProductTypeInfo)) *MockProductTypeRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ProductTypeInfo))
+	})
+	return _c
+}
+
+func (_c *MockProductTypeRepository_Create_Call) Return(err error) *MockProductTypeRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductTypeRepository_Create_Call) RunAndReturn(run func(ctx context.Context, productType *domain.ProductTypeInfo) error) *MockProductTypeRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCode provides a mock function for the type MockProductTypeRepository
+func (_mock *MockProductTypeRepository) GetByCode(ctx context.Context, code domain.ProductType) (*domain.ProductTypeInfo, error) {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCode")
+	}
+
+	var r0 *domain.ProductTypeInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductType) (*domain.ProductTypeInfo, error)); ok {
+		return returnFunc(ctx, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductType) *domain.ProductTypeInfo); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductTypeInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductType) error); ok {
+		r1 = returnFunc(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductTypeRepository_GetByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCode'
+type MockProductTypeRepository_GetByCode_Call struct {
+	*mock.Call
+}
+
+// GetByCode is a helper method to define mock.On call
+//   - ctx
+//   - code
+func (_e *MockProductTypeRepository_Expecter) GetByCode(ctx interface{}, code interface{}) *MockProductTypeRepository_GetByCode_Call {
+	return &MockProductTypeRepository_GetByCode_Call{Call: _e.mock.On("GetByCode", ctx, code)}
+}
+
+func (_c *MockProductTypeRepository_GetByCode_Call) Run(run func(ctx context.Context, code domain.ProductType)) *MockProductTypeRepository_GetByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProductType))
+	})
+	return _c
+}
+
+func (_c *MockProductTypeRepository_GetByCode_Call) Return(productTypeInfo *domain.ProductTypeInfo, err error) *MockProductTypeRepository_GetByCode_Call {
+	_c.Call.Return(productTypeInfo, err)
+	return _c
+}
+
+func (_c *MockProductTypeRepository_GetByCode_Call) RunAndReturn(run func(ctx context.Context, code domain.ProductType) (*domain.ProductTypeInfo, error)) *MockProductTypeRepository_GetByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockProductTypeRepository
+func (_mock *MockProductTypeRepository) List(ctx context.Context) ([]domain.ProductTypeInfo, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.ProductTypeInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.ProductTypeInfo, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.ProductTypeInfo); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductTypeInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductTypeRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockProductTypeRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+func (_e *MockProductTypeRepository_Expecter) List(ctx interface{}) *MockProductTypeRepository_List_Call {
+	return &MockProductTypeRepository_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockProductTypeRepository_List_Call) Run(run func(ctx context.Context)) *MockProductTypeRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProductTypeRepository_List_Call) Return(productTypeInfos []domain.ProductTypeInfo, err error) *MockProductTypeRepository_List_Call {
+	_c.Call.Return(productTypeInfos, err)
+	return _c
+}
+
+func (_c *MockProductTypeRepository_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.ProductTypeInfo, error)) *MockProductTypeRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockProductTypeRepository
+func (_mock *MockProductTypeRepository) Update(ctx context.Context, productType *domain.ProductTypeInfo) error {
+	ret := _mock.Called(ctx, productType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductTypeInfo) error); ok {
+		r0 = returnFunc(ctx, productType)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductTypeRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProductTypeRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - productType
+func (_e *MockProductTypeRepository_Expecter) Update(ctx interface{}, productType interface{}) *MockProductTypeRepository_Update_Call {
+	return &MockProductTypeRepository_Update_Call{Call: _e.mock.On("Update", ctx, productType)}
+}
+
+func (_c *MockProductTypeRepository_Update_Call) Run(run func(ctx context.Context, productType *domain.ProductTypeInfo)) *MockProductTypeRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ProductTypeInfo))
+	})
+	return _c
+}
+
+func (_c *MockProductTypeRepository_Update_Call) Return(err error) *MockProductTypeRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductTypeRepository_Update_Call) RunAndReturn(run func(ctx context.Context, productType *domain.ProductTypeInfo) error) *MockProductTypeRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPVZRepository creates a new instance of MockPVZRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPVZRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPVZRepository {
+	mock := &MockPVZRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPVZRepository is an autogenerated mock type for the PVZRepository type
+type MockPVZRepository struct {
+	mock.Mock
+}
+
+type MockPVZRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPVZRepository) EXPECT() *MockPVZRepository_Expecter {
+	return &MockPVZRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) Create(ctx context.Context, pvz *domain.PVZ) error {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZ) error); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPVZRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPVZRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - pvz
+func (_e *MockPVZRepository_Expecter) Create(ctx interface{}, pvz interface{}) *MockPVZRepository_Create_Call {
+	return &MockPVZRepository_Create_Call{Call: _e.mock.On("Create", ctx, pvz)}
+}
+
+func (_c *MockPVZRepository_Create_Call) Run(run func(ctx context.Context, pvz *domain.PVZ)) *MockPVZRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PVZ))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_Create_Call) Return(err error) *MockPVZRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPVZRepository_Create_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.PVZ) error) *MockPVZRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindNearest provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) FindNearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNearest")
+	}
+
+	var r0 []domain.NearbyPVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NearbyQuery) ([]domain.NearbyPVZ, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NearbyQuery) []domain.NearbyPVZ); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NearbyPVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.NearbyQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZRepository_FindNearest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNearest'
+type MockPVZRepository_FindNearest_Call struct {
+	*mock.Call
+}
+
+// FindNearest is a helper method to define mock.On call
+//   - ctx
+//   - query
+func (_e *MockPVZRepository_Expecter) FindNearest(ctx interface{}, query interface{}) *MockPVZRepository_FindNearest_Call {
+	return &MockPVZRepository_FindNearest_Call{Call: _e.mock.On("FindNearest", ctx, query)}
+}
+
+func (_c *MockPVZRepository_FindNearest_Call) Run(run func(ctx context.Context, query domain.NearbyQuery)) *MockPVZRepository_FindNearest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NearbyQuery))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_FindNearest_Call) Return(nearbyPVZs []domain.NearbyPVZ, err error) *MockPVZRepository_FindNearest_Call {
+	_c.Call.Return(nearbyPVZs, err)
+	return _c
+}
+
+func (_c *MockPVZRepository_FindNearest_Call) RunAndReturn(run func(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error)) *MockPVZRepository_FindNearest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) GetAll(ctx context.Context, status *domain.PVZStatus) ([]domain.PVZ, error) {
+	ret := _mock.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZStatus) ([]domain.PVZ, error)); ok {
+		return returnFunc(ctx, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZStatus) []domain.PVZ); ok {
+		r0 = returnFunc(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PVZStatus) error); ok {
+		r1 = returnFunc(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockPVZRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx
+//   - status
+func (_e *MockPVZRepository_Expecter) GetAll(ctx interface{}, status interface{}) *MockPVZRepository_GetAll_Call {
+	return &MockPVZRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, status)}
+}
+
+func (_c *MockPVZRepository_GetAll_Call) Run(run func(ctx context.Context, status *domain.PVZStatus)) *MockPVZRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PVZStatus))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_GetAll_Call) Return(pVZs []domain.PVZ, err error) *MockPVZRepository_GetAll_Call {
+	_c.Call.Return(pVZs, err)
+	return _c
+}
+
+func (_c *MockPVZRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context, status *domain.PVZStatus) ([]domain.PVZ, error)) *MockPVZRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.PVZ, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.PVZ, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.PVZ); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockPVZRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockPVZRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockPVZRepository_GetByID_Call {
+	return &MockPVZRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockPVZRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockPVZRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_GetByID_Call) Return(pVZ *domain.PVZ, err error) *MockPVZRepository_GetByID_Call {
+	_c.Call.Return(pVZ, err)
+	return _c
+}
+
+func (_c *MockPVZRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.PVZ, error)) *MockPVZRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfileHistory provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) GetProfileHistory(ctx context.Context, pvzID uuid.UUID) ([]domain.PVZProfileChange, error) {
+	ret := _mock.Called(ctx, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfileHistory")
+	}
+
+	var r0 []domain.PVZProfileChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.PVZProfileChange, error)); ok {
+		return returnFunc(ctx, pvzID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.PVZProfileChange); ok {
+		r0 = returnFunc(ctx, pvzID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZProfileChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZRepository_GetProfileHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfileHistory'
+type MockPVZRepository_GetProfileHistory_Call struct {
+	*mock.Call
+}
+
+// GetProfileHistory is a helper method to define mock.On call
+//   - ctx
+//   - pvzID
+func (_e *MockPVZRepository_Expecter) GetProfileHistory(ctx interface{}, pvzID interface{}) *MockPVZRepository_GetProfileHistory_Call {
+	return &MockPVZRepository_GetProfileHistory_Call{Call: _e.mock.On("GetProfileHistory", ctx, pvzID)}
+}
+
+func (_c *MockPVZRepository_GetProfileHistory_Call) Run(run func(ctx context.Context, pvzID uuid.UUID)) *MockPVZRepository_GetProfileHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_GetProfileHistory_Call) Return(pVZProfileChanges []domain.PVZProfileChange, err error) *MockPVZRepository_GetProfileHistory_Call {
+	_c.Call.Return(pVZProfileChanges, err)
+	return _c
+}
+
+func (_c *MockPVZRepository_GetProfileHistory_Call) RunAndReturn(run func(ctx context.Context, pvzID uuid.UUID) ([]domain.PVZProfileChange, error)) *MockPVZRepository_GetProfileHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStaff provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) GetStaff(ctx context.Context, pvz uuid.UUID) ([]domain.User, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaff")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.User, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.User); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZRepository_GetStaff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaff'
+type MockPVZRepository_GetStaff_Call struct {
+	*mock.Call
+}
+
+// GetStaff is a helper method to define mock.On call
+//   - ctx
+//   - pvz
+func (_e *MockPVZRepository_Expecter) GetStaff(ctx interface{}, pvz interface{}) *MockPVZRepository_GetStaff_Call {
+	return &MockPVZRepository_GetStaff_Call{Call: _e.mock.On("GetStaff", ctx, pvz)}
+}
+
+func (_c *MockPVZRepository_GetStaff_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockPVZRepository_GetStaff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_GetStaff_Call) Return(users []domain.User, err error) *MockPVZRepository_GetStaff_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockPVZRepository_GetStaff_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) ([]domain.User, error)) *MockPVZRepository_GetStaff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatus provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) GetStatus(ctx context.Context, id uuid.UUID) (domain.PVZStatus, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 domain.PVZStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.PVZStatus, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.PVZStatus); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.PVZStatus)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZRepository_GetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatus'
+type MockPVZRepository_GetStatus_Call struct {
+	*mock.Call
+}
+
+// GetStatus is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockPVZRepository_Expecter) GetStatus(ctx interface{}, id interface{}) *MockPVZRepository_GetStatus_Call {
+	return &MockPVZRepository_GetStatus_Call{Call: _e.mock.On("GetStatus", ctx, id)}
+}
+
+func (_c *MockPVZRepository_GetStatus_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockPVZRepository_GetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_GetStatus_Call) Return(pVZStatus domain.PVZStatus, err error) *MockPVZRepository_GetStatus_Call {
+	_c.Call.Return(pVZStatus, err)
+	return _c
+}
+
+func (_c *MockPVZRepository_GetStatus_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (domain.PVZStatus, error)) *MockPVZRepository_GetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWithParam provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) GetWithParam(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithParam")
+	}
+
+	var r0 []domain.PVZAgregate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) ([]domain.PVZAgregate, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) []domain.PVZAgregate); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZAgregate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Params) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZRepository_GetWithParam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithParam'
+type MockPVZRepository_GetWithParam_Call struct {
+	*mock.Call
+}
+
+// GetWithParam is a helper method to define mock.On call
+//   - ctx
+//   - params
+func (_e *MockPVZRepository_Expecter) GetWithParam(ctx interface{}, params interface{}) *MockPVZRepository_GetWithParam_Call {
+	return &MockPVZRepository_GetWithParam_Call{Call: _e.mock.On("GetWithParam", ctx, params)}
+}
+
+func (_c *MockPVZRepository_GetWithParam_Call) Run(run func(ctx context.Context, params domain.Params)) *MockPVZRepository_GetWithParam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Params))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_GetWithParam_Call) Return(pVZAgregates []domain.PVZAgregate, err error) *MockPVZRepository_GetWithParam_Call {
+	_c.Call.Return(pVZAgregates, err)
+	return _c
+}
+
+func (_c *MockPVZRepository_GetWithParam_Call) RunAndReturn(run func(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error)) *MockPVZRepository_GetWithParam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStatus provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) SetStatus(ctx context.Context, change *domain.PVZStatusChange) error {
+	ret := _mock.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZStatusChange) error); ok {
+		r0 = returnFunc(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPVZRepository_SetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStatus'
+type MockPVZRepository_SetStatus_Call struct {
+	*mock.Call
+}
+
+// SetStatus is a helper method to define mock.On call
+//   - ctx
+//   - change
+func (_e *MockPVZRepository_Expecter) SetStatus(ctx interface{}, change interface{}) *MockPVZRepository_SetStatus_Call {
+	return &MockPVZRepository_SetStatus_Call{Call: _e.mock.On("SetStatus", ctx, change)}
+}
+
+func (_c *MockPVZRepository_SetStatus_Call) Run(run func(ctx context.Context, change *domain.PVZStatusChange)) *MockPVZRepository_SetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PVZStatusChange))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_SetStatus_Call) Return(err error) *MockPVZRepository_SetStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPVZRepository_SetStatus_Call) RunAndReturn(run func(ctx context.Context, change *domain.PVZStatusChange) error) *MockPVZRepository_SetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function for the type MockPVZRepository
+func (_mock *MockPVZRepository) UpdateProfile(ctx context.Context, change *domain.PVZProfileChange) error {
+	ret := _mock.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZProfileChange) error); ok {
+		r0 = returnFunc(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPVZRepository_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockPVZRepository_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx
+//   - change
+func (_e *MockPVZRepository_Expecter) UpdateProfile(ctx interface{}, change interface{}) *MockPVZRepository_UpdateProfile_Call {
+	return &MockPVZRepository_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, change)}
+}
+
+func (_c *MockPVZRepository_UpdateProfile_Call) Run(run func(ctx context.Context, change *domain.PVZProfileChange)) *MockPVZRepository_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PVZProfileChange))
+	})
+	return _c
+}
+
+func (_c *MockPVZRepository_UpdateProfile_Call) Return(err error) *MockPVZRepository_UpdateProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPVZRepository_UpdateProfile_Call) RunAndReturn(run func(ctx context.Context, change *domain.PVZProfileChange) error) *MockPVZRepository_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReceptionRepository creates a new instance of MockReceptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReceptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReceptionRepository {
+	mock := &MockReceptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReceptionRepository is an autogenerated mock type for the ReceptionRepository type
+type MockReceptionRepository struct {
+	mock.Mock
+}
+
+type MockReceptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReceptionRepository) EXPECT() *MockReceptionRepository_Expecter {
+	return &MockReceptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function for the type MockReceptionRepository
+func (_mock *MockReceptionRepository) Close(ctx context.Context, reception domain.Reception) error {
+	ret := _mock.Called(ctx, reception)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) error); ok {
+		r0 = returnFunc(ctx, reception)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionRepository_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockReceptionRepository_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx
+//   - reception
+func (_e *MockReceptionRepository_Expecter) Close(ctx interface{}, reception interface{}) *MockReceptionRepository_Close_Call {
+	return &MockReceptionRepository_Close_Call{Call: _e.mock.On("Close", ctx, reception)}
+}
+
+func (_c *MockReceptionRepository_Close_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockReceptionRepository_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reception))
+	})
+	return _c
+}
+
+func (_c *MockReceptionRepository_Close_Call) Return(err error) *MockReceptionRepository_Close_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionRepository_Close_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) error) *MockReceptionRepository_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseIdle provides a mock function for the type MockReceptionRepository
+func (_mock *MockReceptionRepository) CloseIdle(ctx context.Context, idleSince time.Time) ([]domain.Reception, error) {
+	ret := _mock.Called(ctx, idleSince)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseIdle")
+	}
+
+	var r0 []domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Reception, error)); ok {
+		return returnFunc(ctx, idleSince)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Reception); ok {
+		r0 = returnFunc(ctx, idleSince)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, idleSince)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionRepository_CloseIdle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseIdle'
+type MockReceptionRepository_CloseIdle_Call struct {
+	*mock.Call
+}
+
+// CloseIdle is a helper method to define mock.On call
+//   - ctx
+//   - idleSince
+func (_e *MockReceptionRepository_Expecter) CloseIdle(ctx interface{}, idleSince interface{}) *MockReceptionRepository_CloseIdle_Call {
+	return &MockReceptionRepository_CloseIdle_Call{Call: _e.mock.On("CloseIdle", ctx, idleSince)}
+}
+
+func (_c *MockReceptionRepository_CloseIdle_Call) Run(run func(ctx context.Context, idleSince time.Time)) *MockReceptionRepository_CloseIdle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReceptionRepository_CloseIdle_Call) Return(receptions []domain.Reception, err error) *MockReceptionRepository_CloseIdle_Call {
+	_c.Call.Return(receptions, err)
+	return _c
+}
+
+func (_c *MockReceptionRepository_CloseIdle_Call) RunAndReturn(run func(ctx context.Context, idleSince time.Time) ([]domain.Reception, error)) *MockReceptionRepository_CloseIdle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseReconciled provides a mock function for the type MockReceptionRepository
+func (_mock *MockReceptionRepository) CloseReconciled(ctx context.Context, reception domain.Reception, report *domain.ReceptionReconciliation) error {
+	ret := _mock.Called(ctx, reception, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseReconciled")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception, *domain.ReceptionReconciliation) error); ok {
+		r0 = returnFunc(ctx, reception, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionRepository_CloseReconciled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReconciled'
+type MockReceptionRepository_CloseReconciled_Call struct {
+	*mock.Call
+}
+
+// CloseReconciled is a helper method to define mock.On call
+//   - ctx
+//   - reception
+//   - report
+func (_e *MockReceptionRepository_Expecter) CloseReconciled(ctx interface{}, reception interface{}, report interface{}) *MockReceptionRepository_CloseReconciled_Call {
+	return &MockReceptionRepository_CloseReconciled_Call{Call: _e.mock.On("CloseReconciled", ctx, reception, report)}
+}
+
+func (_c *MockReceptionRepository_CloseReconciled_Call) Run(run func(ctx context.Context, reception domain.Reception, report *domain.ReceptionReconciliation)) *MockReceptionRepository_CloseReconciled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reception), args[2].(*domain.ReceptionReconciliation))
+	})
+	return _c
+}
+
+func (_c *MockReceptionRepository_CloseReconciled_Call) Return(err error) *MockReceptionRepository_CloseReconciled_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionRepository_CloseReconciled_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception, report *domain.ReceptionReconciliation) error) *MockReceptionRepository_CloseReconciled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockReceptionRepository
+func (_mock *MockReceptionRepository) Create(ctx context.Context, reception domain.Reception) error {
+	ret := _mock.Called(ctx, reception)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) error); ok {
+		r0 = returnFunc(ctx, reception)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReceptionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - reception
+func (_e *MockReceptionRepository_Expecter) Create(ctx interface{}, reception interface{}) *MockReceptionRepository_Create_Call {
+	return &MockReceptionRepository_Create_Call{Call: _e.mock.On("Create", ctx, reception)}
+}
+
+func (_c *MockReceptionRepository_Create_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockReceptionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reception))
+	})
+	return _c
+}
+
+func (_c *MockReceptionRepository_Create_Call) Return(err error) *MockReceptionRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) error) *MockReceptionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockReceptionRepository
+func (_mock *MockReceptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockReceptionRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockReceptionRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockReceptionRepository_GetByID_Call {
+	return &MockReceptionRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockReceptionRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockReceptionRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReceptionRepository_GetByID_Call) Return(reception *domain.Reception, err error) *MockReceptionRepository_GetByID_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockReceptionRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.Reception, error)) *MockReceptionRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLast provides a mock function for the type MockReceptionRepository
+func (_mock *MockReceptionRepository) GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLast")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionRepository_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
+type MockReceptionRepository_GetLast_Call struct {
+	*mock.Call
+}
+
+// GetLast is a helper method to define mock.On call
+//   - ctx
+//   - pvz
+func (_e *MockReceptionRepository_Expecter) GetLast(ctx interface{}, pvz interface{}) *MockReceptionRepository_GetLast_Call {
+	return &MockReceptionRepository_GetLast_Call{Call: _e.mock.On("GetLast", ctx, pvz)}
+}
+
+func (_c *MockReceptionRepository_GetLast_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockReceptionRepository_GetLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReceptionRepository_GetLast_Call) Return(reception *domain.Reception, err error) *MockReceptionRepository_GetLast_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockReceptionRepository_GetLast_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error)) *MockReceptionRepository_GetLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reopen provides a mock function for the type MockReceptionRepository
+func (_mock *MockReceptionRepository) Reopen(ctx context.Context, correction *domain.ReceptionCorrection) error {
+	ret := _mock.Called(ctx, correction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reopen")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionCorrection) error); ok {
+		r0 = returnFunc(ctx, correction)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionRepository_Reopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reopen'
+type MockReceptionRepository_Reopen_Call struct {
+	*mock.Call
+}
+
+// Reopen is a helper method to define mock.On call
+//   - ctx
+//   - correction
+func (_e *MockReceptionRepository_Expecter) Reopen(ctx interface{}, correction interface{}) *MockReceptionRepository_Reopen_Call {
+	return &MockReceptionRepository_Reopen_Call{Call: _e.mock.On("Reopen", ctx, correction)}
+}
+
+func (_c *MockReceptionRepository_Reopen_Call) Run(run func(ctx context.Context, correction *domain.ReceptionCorrection)) *MockReceptionRepository_Reopen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ReceptionCorrection))
+	})
+	return _c
+}
+
+func (_c *MockReceptionRepository_Reopen_Call) Return(err error) *MockReceptionRepository_Reopen_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionRepository_Reopen_Call) RunAndReturn(run func(ctx context.Context, correction *domain.ReceptionCorrection) error) *MockReceptionRepository_Reopen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionRepository creates a new instance of MockSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionRepository {
+	mock := &MockSessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionRepository is an autogenerated mock type for the SessionRepository type
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+type MockSessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionRepository) EXPECT() *MockSessionRepository_Expecter {
+	return &MockSessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSessionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockSessionRepository_Expecter) Create(ctx interface{}, token interface{}) *MockSessionRepository_Create_Call {
+	return &MockSessionRepository_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *MockSessionRepository_Create_Call) Run(run func(ctx context.Context, token *domain.RefreshToken)) *MockSessionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Create_Call) Return(err error) *MockSessionRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, token *domain.RefreshToken) error) *MockSessionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByHash provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) GetByHash(ctx context.Context, hash string) (*domain.RefreshToken, error) {
+	ret := _mock.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHash")
+	}
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.RefreshToken, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.RefreshToken); ok {
+		r0 = returnFunc(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepository_GetByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHash'
+type MockSessionRepository_GetByHash_Call struct {
+	*mock.Call
+}
+
+// GetByHash is a helper method to define mock.On call
+//   - ctx
+//   - hash
+func (_e *MockSessionRepository_Expecter) GetByHash(ctx interface{}, hash interface{}) *MockSessionRepository_GetByHash_Call {
+	return &MockSessionRepository_GetByHash_Call{Call: _e.mock.On("GetByHash", ctx, hash)}
+}
+
+func (_c *MockSessionRepository_GetByHash_Call) Run(run func(ctx context.Context, hash string)) *MockSessionRepository_GetByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_GetByHash_Call) Return(refreshToken *domain.RefreshToken, err error) *MockSessionRepository_GetByHash_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_GetByHash_Call) RunAndReturn(run func(ctx context.Context, hash string) (*domain.RefreshToken, error)) *MockSessionRepository_GetByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.RefreshToken, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.RefreshToken); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockSessionRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockSessionRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockSessionRepository_GetByID_Call {
+	return &MockSessionRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockSessionRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockSessionRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_GetByID_Call) Return(refreshToken *domain.RefreshToken, err error) *MockSessionRepository_GetByID_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error)) *MockSessionRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockSessionRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockSessionRepository_Expecter) Revoke(ctx interface{}, id interface{}) *MockSessionRepository_Revoke_Call {
+	return &MockSessionRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
+}
+
+func (_c *MockSessionRepository_Revoke_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockSessionRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Revoke_Call) Return(err error) *MockSessionRepository_Revoke_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_Revoke_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockSessionRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllByUser provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) RevokeAllByUser(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllByUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_RevokeAllByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllByUser'
+type MockSessionRepository_RevokeAllByUser_Call struct {
+	*mock.Call
+}
+
+// RevokeAllByUser is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockSessionRepository_Expecter) RevokeAllByUser(ctx interface{}, userID interface{}) *MockSessionRepository_RevokeAllByUser_Call {
+	return &MockSessionRepository_RevokeAllByUser_Call{Call: _e.mock.On("RevokeAllByUser", ctx, userID)}
+}
+
+func (_c *MockSessionRepository_RevokeAllByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockSessionRepository_RevokeAllByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeAllByUser_Call) Return(err error) *MockSessionRepository_RevokeAllByUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeAllByUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *MockSessionRepository_RevokeAllByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFamily provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	ret := _mock.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFamily")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_RevokeFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFamily'
+type MockSessionRepository_RevokeFamily_Call struct {
+	*mock.Call
+}
+
+// RevokeFamily is a helper method to define mock.On call
+//   - ctx
+//   - familyID
+func (_e *MockSessionRepository_Expecter) RevokeFamily(ctx interface{}, familyID interface{}) *MockSessionRepository_RevokeFamily_Call {
+	return &MockSessionRepository_RevokeFamily_Call{Call: _e.mock.On("RevokeFamily", ctx, familyID)}
+}
+
+func (_c *MockSessionRepository_RevokeFamily_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockSessionRepository_RevokeFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeFamily_Call) Return(err error) *MockSessionRepository_RevokeFamily_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeFamily_Call) RunAndReturn(run func(ctx context.Context, familyID uuid.UUID) error) *MockSessionRepository_RevokeFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserRepository {
+	mock := &MockUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserRepository is an autogenerated mock type for the UserRepository type
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
+	return &MockUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// AssignPVZ provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) AssignPVZ(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignPVZ")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, pvzID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_AssignPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignPVZ'
+type MockUserRepository_AssignPVZ_Call struct {
+	*mock.Call
+}
+
+// AssignPVZ is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - pvzID
+func (_e *MockUserRepository_Expecter) AssignPVZ(ctx interface{}, userID interface{}, pvzID interface{}) *MockUserRepository_AssignPVZ_Call {
+	return &MockUserRepository_AssignPVZ_Call{Call: _e.mock.On("AssignPVZ", ctx, userID, pvzID)}
+}
+
+func (_c *MockUserRepository_AssignPVZ_Call) Run(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID)) *MockUserRepository_AssignPVZ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_AssignPVZ_Call) Return(err error) *MockUserRepository_AssignPVZ_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_AssignPVZ_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error) *MockUserRepository_AssignPVZ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Create(ctx context.Context, user *domain.User) error {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - user
+func (_e *MockUserRepository_Expecter) Create(ctx interface{}, user interface{}) *MockUserRepository_Create_Call {
+	return &MockUserRepository_Create_Call{Call: _e.mock.On("Create", ctx, user)}
+}
+
+func (_c *MockUserRepository_Create_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.User))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Create_Call) Return(err error) *MockUserRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) error) *MockUserRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExternal provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) CreateExternal(ctx context.Context, user *domain.User, issuer string, subject string) error {
+	ret := _mock.Called(ctx, user, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExternal")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, string, string) error); ok {
+		r0 = returnFunc(ctx, user, issuer, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_CreateExternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExternal'
+type MockUserRepository_CreateExternal_Call struct {
+	*mock.Call
+}
+
+// CreateExternal is a helper method to define mock.On call
+//   - ctx
+//   - user
+//   - issuer
+//   - subject
+func (_e *MockUserRepository_Expecter) CreateExternal(ctx interface{}, user interface{}, issuer interface{}, subject interface{}) *MockUserRepository_CreateExternal_Call {
+	return &MockUserRepository_CreateExternal_Call{Call: _e.mock.On("CreateExternal", ctx, user, issuer, subject)}
+}
+
+func (_c *MockUserRepository_CreateExternal_Call) Run(run func(ctx context.Context, user *domain.User, issuer string, subject string)) *MockUserRepository_CreateExternal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.User), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_CreateExternal_Call) Return(err error) *MockUserRepository_CreateExternal_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_CreateExternal_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, issuer string, subject string) error) *MockUserRepository_CreateExternal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByEmail provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type MockUserRepository_GetByEmail_Call struct {
+	*mock.Call
+}
+
+// GetByEmail is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockUserRepository_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockUserRepository_GetByEmail_Call {
+	return &MockUserRepository_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+}
+
+func (_c *MockUserRepository_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserRepository_GetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByEmail_Call) Return(user *domain.User, err error) *MockUserRepository_GetByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockUserRepository_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByExternalID provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByExternalID(ctx context.Context, issuer string, subject string) (*domain.User, error) {
+	ret := _mock.Called(ctx, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByExternalID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, issuer, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.User); ok {
+		r0 = returnFunc(ctx, issuer, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, issuer, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_GetByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByExternalID'
+type MockUserRepository_GetByExternalID_Call struct {
+	*mock.Call
+}
+
+// GetByExternalID is a helper method to define mock.On call
+//   - ctx
+//   - issuer
+//   - subject
+func (_e *MockUserRepository_Expecter) GetByExternalID(ctx interface{}, issuer interface{}, subject interface{}) *MockUserRepository_GetByExternalID_Call {
+	return &MockUserRepository_GetByExternalID_Call{Call: _e.mock.On("GetByExternalID", ctx, issuer, subject)}
+}
+
+func (_c *MockUserRepository_GetByExternalID_Call) Run(run func(ctx context.Context, issuer string, subject string)) *MockUserRepository_GetByExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByExternalID_Call) Return(user *domain.User, err error) *MockUserRepository_GetByExternalID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByExternalID_Call) RunAndReturn(run func(ctx context.Context, issuer string, subject string) (*domain.User, error)) *MockUserRepository_GetByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUserRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockUserRepository_GetByID_Call {
+	return &MockUserRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockUserRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockUserRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByID_Call) Return(user *domain.User, err error) *MockUserRepository_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.User, error)) *MockUserRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAssigned provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) IsAssigned(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) (bool, error) {
+	ret := _mock.Called(ctx, userID, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAssigned")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return returnFunc(ctx, userID, pvzID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = returnFunc(ctx, userID, pvzID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, pvzID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_IsAssigned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAssigned'
+type MockUserRepository_IsAssigned_Call struct {
+	*mock.Call
+}
+
+// IsAssigned is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - pvzID
+func (_e *MockUserRepository_Expecter) IsAssigned(ctx interface{}, userID interface{}, pvzID interface{}) *MockUserRepository_IsAssigned_Call {
+	return &MockUserRepository_IsAssigned_Call{Call: _e.mock.On("IsAssigned", ctx, userID, pvzID)}
+}
+
+func (_c *MockUserRepository_IsAssigned_Call) Run(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID)) *MockUserRepository_IsAssigned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_IsAssigned_Call) Return(b bool, err error) *MockUserRepository_IsAssigned_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserRepository_IsAssigned_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) (bool, error)) *MockUserRepository_IsAssigned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkExternal provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) LinkExternal(ctx context.Context, userID uuid.UUID, issuer string, subject string) error {
+	ret := _mock.Called(ctx, userID, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkExternal")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, issuer, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_LinkExternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkExternal'
+type MockUserRepository_LinkExternal_Call struct {
+	*mock.Call
+}
+
+// LinkExternal is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - issuer
+//   - subject
+func (_e *MockUserRepository_Expecter) LinkExternal(ctx interface{}, userID interface{}, issuer interface{}, subject interface{}) *MockUserRepository_LinkExternal_Call {
+	return &MockUserRepository_LinkExternal_Call{Call: _e.mock.On("LinkExternal", ctx, userID, issuer, subject)}
+}
+
+func (_c *MockUserRepository_LinkExternal_Call) Run(run func(ctx context.Context, userID uuid.UUID, issuer string, subject string)) *MockUserRepository_LinkExternal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_LinkExternal_Call) Return(err error) *MockUserRepository_LinkExternal_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_LinkExternal_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, issuer string, subject string) error) *MockUserRepository_LinkExternal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserFilter) ([]domain.User, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserFilter) []domain.User); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUserRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - filter
+func (_e *MockUserRepository_Expecter) List(ctx interface{}, filter interface{}) *MockUserRepository_List_Call {
+	return &MockUserRepository_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockUserRepository_List_Call) Run(run func(ctx context.Context, filter domain.UserFilter)) *MockUserRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserFilter))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_List_Call) Return(users []domain.User, err error) *MockUserRepository_List_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockUserRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)) *MockUserRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetActive provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) SetActive(ctx context.Context, id uuid.UUID, active bool) error {
+	ret := _mock.Called(ctx, id, active)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetActive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = returnFunc(ctx, id, active)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_SetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetActive'
+type MockUserRepository_SetActive_Call struct {
+	*mock.Call
+}
+
+// SetActive is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - active
+func (_e *MockUserRepository_Expecter) SetActive(ctx interface{}, id interface{}, active interface{}) *MockUserRepository_SetActive_Call {
+	return &MockUserRepository_SetActive_Call{Call: _e.mock.On("SetActive", ctx, id, active)}
+}
+
+func (_c *MockUserRepository_SetActive_Call) Run(run func(ctx context.Context, id uuid.UUID, active bool)) *MockUserRepository_SetActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SetActive_Call) Return(err error) *MockUserRepository_SetActive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_SetActive_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, active bool) error) *MockUserRepository_SetActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignPVZ provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UnassignPVZ(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignPVZ")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, pvzID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UnassignPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignPVZ'
+type MockUserRepository_UnassignPVZ_Call struct {
+	*mock.Call
+}
+
+// UnassignPVZ is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - pvzID
+func (_e *MockUserRepository_Expecter) UnassignPVZ(ctx interface{}, userID interface{}, pvzID interface{}) *MockUserRepository_UnassignPVZ_Call {
+	return &MockUserRepository_UnassignPVZ_Call{Call: _e.mock.On("UnassignPVZ", ctx, userID, pvzID)}
+}
+
+func (_c *MockUserRepository_UnassignPVZ_Call) Run(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID)) *MockUserRepository_UnassignPVZ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UnassignPVZ_Call) Return(err error) *MockUserRepository_UnassignPVZ_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UnassignPVZ_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error) *MockUserRepository_UnassignPVZ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	ret := _mock.Called(ctx, id, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockUserRepository_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - passwordHash
+func (_e *MockUserRepository_Expecter) UpdatePassword(ctx interface{}, id interface{}, passwordHash interface{}) *MockUserRepository_UpdatePassword_Call {
+	return &MockUserRepository_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, passwordHash)}
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) Run(run func(ctx context.Context, id uuid.UUID, passwordHash string)) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) Return(err error) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, passwordHash string) error) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error {
+	ret := _mock.Called(ctx, id, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Role) error); ok {
+		r0 = returnFunc(ctx, id, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockUserRepository_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - role
+func (_e *MockUserRepository_Expecter) UpdateRole(ctx interface{}, id interface{}, role interface{}) *MockUserRepository_UpdateRole_Call {
+	return &MockUserRepository_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, id, role)}
+}
+
+func (_c *MockUserRepository_UpdateRole_Call) Run(run func(ctx context.Context, id uuid.UUID, role domain.Role)) *MockUserRepository_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateRole_Call) Return(err error) *MockUserRepository_UpdateRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateRole_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, role domain.Role) error) *MockUserRepository_UpdateRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
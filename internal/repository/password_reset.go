@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+)
+
+type PasswordResetRepository interface {
+	Create(ctx context.Context, token *domain.PasswordResetToken) error
+	GetByHash(ctx context.Context, hash string) (*domain.PasswordResetToken, error)
+	Use(ctx context.Context, token *domain.PasswordResetToken, passwordHash string) error
+}
+
+type PasswordReset struct {
+	PasswordResetRepository
+}
+
+func NewPasswordReset(p PasswordResetRepository) *PasswordReset {
+	return &PasswordReset{
+		PasswordResetRepository: p,
+	}
+}
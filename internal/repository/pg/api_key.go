@@ -0,0 +1,217 @@
+package pgrepo
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	postgres "avito_pvz/internal/storage/pg"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var apiKeyColumns = []string{
+	"id",
+	"name",
+	"key_hash",
+	"role",
+	"scopes",
+	"pvz_id",
+	"created_by",
+	"created_at",
+	"expires_at",
+	"last_used_at",
+	"revoked_at",
+}
+
+type pgAPIKey struct {
+	storage *postgres.Storage
+}
+
+func NewPgAPIKey(db *postgres.Storage) *pgAPIKey {
+	return &pgAPIKey{
+		storage: db,
+	}
+}
+
+func (p *pgAPIKey) Create(ctx context.Context, key *domain.APIKey) error {
+	scopes := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	query, args, err := p.storage.Builder.
+		Insert("api_keys").
+		Columns(
+			"id",
+			"name",
+			"key_hash",
+			"role",
+			"scopes",
+			"pvz_id",
+			"created_by",
+			"created_at",
+			"expires_at",
+		).
+		Values(
+			key.ID,
+			key.Name,
+			key.KeyHash,
+			key.Role,
+			scopes,
+			key.PVZID,
+			key.CreatedBy,
+			key.CreatedAt,
+			key.ExpiresAt,
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domain.ErrNotFound
+		}
+
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgAPIKey) GetByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	query, args, err := p.storage.Builder.
+		Select(apiKeyColumns...).
+		From("api_keys").
+		Where(squirrel.Eq{"key_hash": hash}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	key, err := scanAPIKey(p.storage.DB.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return key, nil
+}
+
+func (p *pgAPIKey) List(ctx context.Context) ([]domain.APIKey, error) {
+	query, args, err := p.storage.Builder.
+		Select(apiKeyColumns...).
+		From("api_keys").
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	rows, err := p.storage.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0)
+
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+		}
+
+		keys = append(keys, *key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return keys, nil
+}
+
+// Revoke отзывает ключ. Для неизвестного или уже отозванного ключа
+// возвращается domain.ErrNotFound.
+func (p *pgAPIKey) Revoke(ctx context.Context, id uuid.UUID) error {
+	query, args, err := p.storage.Builder.
+		Update("api_keys").
+		Set("revoked_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": id}).
+		Where(squirrel.Eq{"revoked_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	tag, err := p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *pgAPIKey) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query, args, err := p.storage.Builder.
+		Update("api_keys").
+		Set("last_used_at", at).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func scanAPIKey(row pgx.Row) (*domain.APIKey, error) {
+	var (
+		key    domain.APIKey
+		scopes []string
+	)
+
+	if err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.KeyHash,
+		&key.Role,
+		&scopes,
+		&key.PVZID,
+		&key.CreatedBy,
+		&key.CreatedAt,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	key.Scopes = make([]domain.Scope, 0, len(scopes))
+	for _, scope := range scopes {
+		key.Scopes = append(key.Scopes, domain.Scope(scope))
+	}
+
+	return &key, nil
+}
@@ -0,0 +1,138 @@
+package pgrepo
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"fmt"
+
+	postgres "avito_pvz/internal/storage/pg"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var asnColumns = []string{
+	"id",
+	"pvz_id",
+	"reference",
+	"lines",
+	"created_by",
+	"created_at",
+	"reception_id",
+}
+
+type pgASN struct {
+	storage *postgres.Storage
+}
+
+func NewPgASN(db *postgres.Storage) *pgASN {
+	return &pgASN{
+		storage: db,
+	}
+}
+
+func (p *pgASN) Create(ctx context.Context, asn *domain.ASN) error {
+	query, args, err := p.storage.Builder.
+		Insert("asn_manifests").
+		Columns(asnColumns...).
+		Values(
+			asn.ID,
+			asn.PVZID,
+			asn.Reference,
+			asn.Lines,
+			asn.CreatedBy,
+			asn.CreatedAt,
+			asn.ReceptionID,
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+// GetForReception возвращает манифест, с которым сверяется приемка: уже
+// привязанный к ней (повторное закрытие после исправления), а если такого
+// нет, самый ранний ожидающий манифест ПВЗ.
+func (p *pgASN) GetForReception(ctx context.Context, reception domain.Reception) (*domain.ASN, error) {
+	query, args, err := p.storage.Builder.
+		Select(asnColumns...).
+		From("asn_manifests").
+		Where(squirrel.Eq{"pvz_id": reception.PvzID}).
+		Where(squirrel.Or{
+			squirrel.Eq{"reception_id": reception.ID},
+			squirrel.Eq{"reception_id": nil},
+		}).
+		OrderBy("reception_id IS NULL", "created_at").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	var asn domain.ASN
+
+	err = p.storage.DB.QueryRow(ctx, query, args...).Scan(
+		&asn.ID,
+		&asn.PVZID,
+		&asn.Reference,
+		&asn.Lines,
+		&asn.CreatedBy,
+		&asn.CreatedAt,
+		&asn.ReceptionID,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return &asn, nil
+}
+
+// GetReconciliation возвращает последнюю сверку приемки.
+func (p *pgASN) GetReconciliation(
+	ctx context.Context,
+	receptionID uuid.UUID,
+) (*domain.ReceptionReconciliation, error) {
+	query, args, err := p.storage.Builder.
+		Select("reception_id", "asn_id", "shortages", "surpluses", "unexpected", "created_at").
+		From("reception_reconciliations").
+		Where(squirrel.Eq{"reception_id": receptionID}).
+		OrderBy("created_at DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	var report domain.ReceptionReconciliation
+
+	err = p.storage.DB.QueryRow(ctx, query, args...).Scan(
+		&report.ReceptionID,
+		&report.ASNID,
+		&report.Shortages,
+		&report.Surpluses,
+		&report.Unexpected,
+		&report.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return &report, nil
+}
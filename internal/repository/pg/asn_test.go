@@ -0,0 +1,65 @@
+package pgrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	pgrepo "avito_pvz/internal/repository/pg"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgReception_CloseReconciled(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestStorage(t)
+	receptions := pgrepo.NewPgReception(storage)
+	manifests := pgrepo.NewPgASN(storage)
+	ctx := context.Background()
+
+	pvzID := insertPVZ(t, storage)
+
+	asn, err := domain.NewASN(ctx, domain.ASNRequest{
+		PVZID: domain.PVZID(pvzID),
+		Lines: []domain.ASNLine{{Type: "electronics", Quantity: 2}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, manifests.Create(ctx, asn))
+
+	require.NoError(t, receptions.Create(ctx, *domain.NewReception(pvzID)))
+
+	reception, err := receptions.GetLast(ctx, pvzID)
+	require.NoError(t, err)
+
+	insertProduct(t, storage, reception.ID, time.Now())
+
+	pending, err := manifests.GetForReception(ctx, *reception)
+	require.NoError(t, err)
+	require.Equal(t, asn.ID, pending.ID)
+	require.Nil(t, pending.ReceptionID)
+
+	report := pending.Reconcile(reception.ID, []domain.Product{{Type: "electronics"}})
+
+	reception.Close()
+	require.NoError(t, receptions.CloseReconciled(ctx, *reception, report))
+
+	closed, err := receptions.GetByID(ctx, reception.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.ReceptionStatusClosed, closed.Status)
+
+	saved, err := manifests.GetReconciliation(ctx, reception.ID)
+	require.NoError(t, err)
+	require.Equal(t, asn.ID, saved.ASNID)
+	require.Equal(t, report.Shortages, saved.Shortages)
+	require.Empty(t, saved.Surpluses)
+	require.Empty(t, saved.Unexpected)
+
+	attached, err := manifests.GetForReception(ctx, *reception)
+	require.NoError(t, err)
+	require.Equal(t, &reception.ID, attached.ReceptionID)
+
+	// Приемка уже закрыта: второй раз сверка не сохраняется.
+	require.ErrorIs(t, receptions.CloseReconciled(ctx, *reception, report), domain.ErrStatusChanged)
+}
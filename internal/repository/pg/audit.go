@@ -0,0 +1,175 @@
+package pgrepo
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"fmt"
+
+	postgres "avito_pvz/internal/storage/pg"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type pgAudit struct {
+	storage *postgres.Storage
+}
+
+func NewPgAudit(db *postgres.Storage) *pgAudit {
+	return &pgAudit{
+		storage: db,
+	}
+}
+
+func (p *pgAudit) Create(ctx context.Context, entry *domain.AuditEntry) error {
+	return insertAuditEntry(ctx, p.storage.DB, p.storage.Builder, entry)
+}
+
+// execer — общее у пула и транзакции: запись журнала, сделанная в транзакции
+// изменения, фиксируется вместе с ним.
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func insertAuditEntry(
+	ctx context.Context,
+	db execer,
+	builder *squirrel.StatementBuilderType,
+	entry *domain.AuditEntry,
+) error {
+	query, args, err := builder.
+		Insert("audit_log").
+		Columns(
+			"id",
+			"actor_id",
+			"actor_role",
+			"actor_type",
+			"action",
+			"entity_type",
+			"entity_id",
+			"before",
+			"after",
+			"request_id",
+			"created_at",
+		).
+		Values(
+			entry.ID,
+			entry.ActorID,
+			entry.ActorRole,
+			entry.ActorType,
+			entry.Action,
+			entry.Entity,
+			entry.EntityID,
+			nullableJSON(entry.Before),
+			nullableJSON(entry.After),
+			entry.RequestID,
+			entry.CreatedAt,
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgAudit) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	qb := p.storage.Builder.
+		Select(
+			"id",
+			"actor_id",
+			"actor_role",
+			"actor_type",
+			"action",
+			"entity_type",
+			"entity_id",
+			"before",
+			"after",
+			"request_id",
+			"created_at",
+		).
+		From("audit_log").
+		OrderBy("created_at DESC", "id")
+
+	if filter.Entity != nil {
+		qb = qb.Where(squirrel.Eq{"entity_type": *filter.Entity})
+	}
+
+	if filter.EntityID != nil {
+		qb = qb.Where(squirrel.Eq{"entity_id": *filter.EntityID})
+	}
+
+	if filter.ActorID != nil {
+		qb = qb.Where(squirrel.Eq{"actor_id": *filter.ActorID})
+	}
+
+	if filter.From != nil {
+		qb = qb.Where(squirrel.GtOrEq{"created_at": *filter.From})
+	}
+
+	if filter.To != nil {
+		qb = qb.Where(squirrel.Lt{"created_at": *filter.To})
+	}
+
+	if filter.Limit > 0 {
+		qb = qb.Limit(uint64(filter.Limit))
+	}
+
+	if filter.Page > 1 && filter.Limit > 0 {
+		qb = qb.Offset(uint64((filter.Page - 1) * filter.Limit))
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	rows, err := p.storage.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	entries := make([]domain.AuditEntry, 0)
+
+	for rows.Next() {
+		var entry domain.AuditEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.ActorID,
+			&entry.ActorRole,
+			&entry.ActorType,
+			&entry.Action,
+			&entry.Entity,
+			&entry.EntityID,
+			&entry.Before,
+			&entry.After,
+			&entry.RequestID,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return entries, nil
+}
+
+// nullableJSON сохраняет отсутствующий снимок как NULL, а не пустую строку.
+func nullableJSON(data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,131 @@
+package pgrepo
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"fmt"
+
+	postgres "avito_pvz/internal/storage/pg"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type pgCity struct {
+	storage *postgres.Storage
+}
+
+func NewPgCity(db *postgres.Storage) *pgCity {
+	return &pgCity{
+		storage: db,
+	}
+}
+
+func (p *pgCity) Create(ctx context.Context, city *domain.City) error {
+	query, args, err := p.storage.Builder.
+		Insert("cities").
+		Columns("id", "name", "active", "created_at").
+		Values(city.ID, city.Name, city.Active, city.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrAlreadyExists
+		}
+
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgCity) GetByID(ctx context.Context, id uuid.UUID) (*domain.City, error) {
+	query, args, err := p.storage.Builder.
+		Select("id", "name", "active", "created_at").
+		From("cities").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	var city domain.City
+	if err := p.storage.DB.QueryRow(ctx, query, args...).Scan(
+		&city.ID,
+		&city.Name,
+		&city.Active,
+		&city.CreatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return &city, nil
+}
+
+func (p *pgCity) List(ctx context.Context) ([]domain.City, error) {
+	query, args, err := p.storage.Builder.
+		Select("id", "name", "active", "created_at").
+		From("cities").
+		OrderBy("name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	rows, err := p.storage.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	var cities []domain.City
+
+	for rows.Next() {
+		var city domain.City
+		if err := rows.Scan(&city.ID, &city.Name, &city.Active, &city.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+		}
+
+		cities = append(cities, city)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return cities, nil
+}
+
+func (p *pgCity) SetActive(ctx context.Context, id uuid.UUID, active bool) error {
+	query, args, err := p.storage.Builder.
+		Update("cities").
+		Set("active", active).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	tag, err := p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
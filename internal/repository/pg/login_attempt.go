@@ -0,0 +1,123 @@
+package pgrepo
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	postgres "avito_pvz/internal/storage/pg"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type pgLoginAttempt struct {
+	storage *postgres.Storage
+}
+
+func NewPgLoginAttempt(db *postgres.Storage) *pgLoginAttempt {
+	return &pgLoginAttempt{
+		storage: db,
+	}
+}
+
+func (p *pgLoginAttempt) Get(ctx context.Context, key string) (*domain.LoginAttempt, error) {
+	query, args, err := p.storage.Builder.
+		Select("key", "failures", "locked_until", "last_failure").
+		From("login_attempts").
+		Where(squirrel.Eq{"key": key}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	row := p.storage.DB.QueryRow(ctx, query, args...)
+
+	var attempt domain.LoginAttempt
+	if err := row.Scan(
+		&attempt.Key,
+		&attempt.Failures,
+		&attempt.LockedUntil,
+		&attempt.LastFailure,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return &attempt, nil
+}
+
+// RecordFailure увеличивает счетчик ошибок ключа одним запросом и
+// возвращает его новое состояние. Если последняя ошибка была раньше
+// windowStart, счетчик начинается с единицы.
+func (p *pgLoginAttempt) RecordFailure(
+	ctx context.Context,
+	key string,
+	windowStart time.Time,
+	now time.Time,
+) (*domain.LoginAttempt, error) {
+	const query = `
+INSERT INTO login_attempts (key, failures, last_failure)
+VALUES ($1, 1, $2)
+ON CONFLICT (key) DO UPDATE SET
+    failures = CASE
+        WHEN login_attempts.last_failure < $3 THEN 1
+        ELSE login_attempts.failures + 1
+    END,
+    last_failure = EXCLUDED.last_failure
+RETURNING key, failures, locked_until, last_failure`
+
+	var attempt domain.LoginAttempt
+	if err := p.storage.DB.QueryRow(ctx, query, key, now, windowStart).Scan(
+		&attempt.Key,
+		&attempt.Failures,
+		&attempt.LockedUntil,
+		&attempt.LastFailure,
+	); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return &attempt, nil
+}
+
+// Lock блокирует ключ до until. Более долгая блокировка, которую успел
+// поставить параллельный запрос, не сокращается.
+func (p *pgLoginAttempt) Lock(ctx context.Context, key string, until time.Time) error {
+	query, args, err := p.storage.Builder.
+		Update("login_attempts").
+		Set("locked_until", squirrel.Expr("GREATEST(locked_until, ?)", until)).
+		Where(squirrel.Eq{"key": key}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgLoginAttempt) Delete(ctx context.Context, key string) error {
+	query, args, err := p.storage.Builder.
+		Delete("login_attempts").
+		Where(squirrel.Eq{"key": key}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
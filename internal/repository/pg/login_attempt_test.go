@@ -0,0 +1,56 @@
+package pgrepo_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	pgrepo "avito_pvz/internal/repository/pg"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgLoginAttempt_RecordFailureConcurrent(t *testing.T) {
+	t.Parallel()
+
+	repo := pgrepo.NewPgLoginAttempt(newTestStorage(t))
+	ctx := context.Background()
+	key := domain.AccountAttemptKey("user@example.com")
+	now := time.Now()
+
+	const attempts = 20
+
+	var wg sync.WaitGroup
+	for range attempts {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := repo.RecordFailure(ctx, key, now.Add(-time.Hour), now)
+			require.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	got, err := repo.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, attempts, got.Failures)
+
+	// Последняя ошибка вне окна: счетчик начинается заново.
+	later := now.Add(2 * time.Hour)
+	got, err = repo.RecordFailure(ctx, key, later.Add(-time.Hour), later)
+	require.NoError(t, err)
+	require.Equal(t, 1, got.Failures)
+
+	until := later.Add(time.Minute)
+	require.NoError(t, repo.Lock(ctx, key, until))
+	require.NoError(t, repo.Lock(ctx, key, later))
+
+	got, err = repo.Get(ctx, key)
+	require.NoError(t, err)
+	require.WithinDuration(t, until, *got.LockedUntil, time.Millisecond)
+}
@@ -0,0 +1,143 @@
+package pgrepo
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	postgres "avito_pvz/internal/storage/pg"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type pgPasswordReset struct {
+	storage *postgres.Storage
+}
+
+func NewPgPasswordReset(db *postgres.Storage) *pgPasswordReset {
+	return &pgPasswordReset{
+		storage: db,
+	}
+}
+
+func (p *pgPasswordReset) Create(ctx context.Context, token *domain.PasswordResetToken) error {
+	query, args, err := p.storage.Builder.
+		Insert("password_reset_tokens").
+		Columns("id", "user_id", "token_hash", "expires_at", "created_at").
+		Values(token.ID, token.UserID, token.TokenHash, token.ExpiresAt, token.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgPasswordReset) GetByHash(
+	ctx context.Context,
+	hash string,
+) (*domain.PasswordResetToken, error) {
+	query, args, err := p.storage.Builder.
+		Select("id", "user_id", "token_hash", "expires_at", "used_at", "created_at").
+		From("password_reset_tokens").
+		Where(squirrel.Eq{"token_hash": hash}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	row := p.storage.DB.QueryRow(ctx, query, args...)
+
+	var token domain.PasswordResetToken
+	if err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.CreatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return &token, nil
+}
+
+// Use помечает токен использованным и меняет пароль пользователя одной
+// транзакцией, заодно гася остальные его токены восстановления. Если токен
+// уже был использован параллельным запросом, возвращается domain.ErrNotFound
+// и пароль не меняется.
+func (p *pgPasswordReset) Use(
+	ctx context.Context,
+	token *domain.PasswordResetToken,
+	passwordHash string,
+) error {
+	tx, err := p.storage.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	//nolint:errcheck // после Commit откат ничего не делает
+	defer tx.Rollback(ctx)
+
+	query, args, err := p.storage.Builder.
+		Update("password_reset_tokens").
+		Set("used_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"user_id": token.UserID}).
+		Where(squirrel.Eq{"used_at": nil}).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	used, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if !slices.Contains(used, token.ID) {
+		return domain.ErrNotFound
+	}
+
+	query, args, err = p.storage.Builder.
+		Update("users").
+		Set("password_hash", passwordHash).
+		Where(squirrel.Eq{"id": token.UserID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
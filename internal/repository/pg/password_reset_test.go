@@ -0,0 +1,58 @@
+package pgrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	pgrepo "avito_pvz/internal/repository/pg"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgPasswordReset_Use(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestStorage(t)
+	repo := pgrepo.NewPgPasswordReset(storage)
+	ctx := context.Background()
+
+	user, err := domain.NewUser("user@example.com", "hash", "employee")
+	require.NoError(t, err)
+
+	_, err = storage.DB.Exec(ctx,
+		"INSERT INTO users (id, email, password_hash, role) VALUES ($1, $2, $3, $4)",
+		user.ID, user.Email, "old", user.Role,
+	)
+	require.NoError(t, err)
+
+	first, _, err := domain.NewPasswordResetToken(user.ID, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, first))
+
+	second, _, err := domain.NewPasswordResetToken(user.ID, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, second))
+
+	require.NoError(t, repo.Use(ctx, first, "new"))
+
+	var hash string
+
+	err = storage.DB.QueryRow(ctx, "SELECT password_hash FROM users WHERE id = $1", user.ID).
+		Scan(&hash)
+	require.NoError(t, err)
+	require.Equal(t, "new", hash)
+
+	// Остальные токены пользователя гаснут вместе с использованным.
+	got, err := repo.GetByHash(ctx, second.TokenHash)
+	require.NoError(t, err)
+	require.NotNil(t, got.UsedAt)
+
+	require.ErrorIs(t, repo.Use(ctx, second, "other"), domain.ErrNotFound)
+
+	err = storage.DB.QueryRow(ctx, "SELECT password_hash FROM users WHERE id = $1", user.ID).
+		Scan(&hash)
+	require.NoError(t, err)
+	require.Equal(t, "new", hash)
+}
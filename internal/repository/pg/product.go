@@ -11,8 +11,26 @@ import (
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// productColumns читаются из products p с присоединенной product_types t.
+// Аннулированные товары в приемке не учитываются, поэтому запросы, кроме Void,
+// отбирают только строки с voided_at IS NULL.
+var productColumns = []string{
+	"p.id",
+	"p.reception_id",
+	"p.product_type",
+	"t.name_ru",
+	"p.metadata",
+	"COALESCE(p.barcode, '')",
+	"COALESCE(p.order_id, '')",
+	"COALESCE(p.sku, '')",
+	"p.created_at",
+	"p.voided_at",
+	"COALESCE(p.void_reason, '')",
+}
+
 type pgProduct struct {
 	db *postgres.Storage
 }
@@ -24,10 +42,17 @@ func NewPgProduct(db *postgres.Storage) *pgProduct {
 }
 
 func (p *pgProduct) Create(ctx context.Context, product *domain.Product) error {
-	query, args, err := squirrel.
+	query, args, err := p.db.Builder.
 		Insert("products").
-		Columns("reception_id", "product_type").
-		Values(product.ReceptionID, product.Type).
+		Columns("reception_id", "product_type", "metadata", "barcode", "order_id", "sku").
+		Values(
+			product.ReceptionID,
+			product.Type,
+			metadataArg(product.Metadata),
+			nullable(product.Barcode),
+			nullable(product.OrderID),
+			nullable(product.SKU),
+		).
 		Suffix("RETURNING id, created_at").
 		ToSql()
 	if err != nil {
@@ -36,18 +61,194 @@ func (p *pgProduct) Create(ctx context.Context, product *domain.Product) error {
 
 	row := p.db.DB.QueryRow(ctx, query, args...)
 	if err := row.Scan(&product.ID, &product.CreatedAt); err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrAlreadyExists
+		}
+
+		return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+// CreateBatch добавляет товары в приемку одной транзакцией. Строка приемки
+// блокируется до конца транзакции, чтобы ее не закрыли посередине пакета.
+// created_at берется из clock_timestamp(), а не now(): внутри транзакции now()
+// одинаков, и товары пакета потеряли бы порядок для удаления последнего.
+func (p *pgProduct) CreateBatch(
+	ctx context.Context,
+	receptionID uuid.UUID,
+	products []*domain.Product,
+) error {
+	tx, err := p.db.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	//nolint:errcheck // после Commit откат ничего не делает
+	defer tx.Rollback(ctx)
+
+	var status domain.ReceptionStatus
+
+	err = tx.QueryRow(ctx,
+		"SELECT status FROM receptions WHERE id = $1 FOR UPDATE",
+		receptionID,
+	).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	if status != domain.ReceptionStatusInProgress {
+		return domain.ErrReceptionNotActive
+	}
+
+	batch := &pgx.Batch{}
+
+	for _, product := range products {
+		query, args, err := p.db.Builder.
+			Insert("products").
+			Columns(
+				"reception_id",
+				"product_type",
+				"metadata",
+				"barcode",
+				"order_id",
+				"sku",
+				"created_at",
+			).
+			Values(
+				receptionID,
+				product.Type,
+				metadataArg(product.Metadata),
+				nullable(product.Barcode),
+				nullable(product.OrderID),
+				nullable(product.SKU),
+				squirrel.Expr("clock_timestamp()"),
+			).
+			Suffix("RETURNING id, created_at").
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+
+		batch.Queue(query, args...)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+
+	for _, product := range products {
+		err := results.QueryRow().Scan(&product.ID, &product.CreatedAt)
+		if err != nil {
+			results.Close()
+
+			if isUniqueViolation(err) {
+				return domain.ErrAlreadyExists
+			}
+
+			return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+	}
+
+	if err := results.Close(); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("%w: %w", domain.ErrInternal, err)
 	}
 
 	return nil
 }
 
+// FindByBarcodes возвращает товары, которые уже приняты с этими штрихкодами.
+func (p *pgProduct) FindByBarcodes(ctx context.Context, barcodes []string) ([]domain.Product, error) {
+	query, args, err := p.db.Builder.
+		Select(productColumns...).
+		From("products p").
+		Join("product_types t ON t.code = p.product_type").
+		Where(squirrel.Eq{"p.barcode": barcodes, "p.voided_at": nil}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	rows, err := p.db.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	products := make([]domain.Product, 0)
+
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+
+		products = append(products, *product)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return products, nil
+}
+
+// Void помечает товар открытой приемки аннулированным и возвращает его.
+// Если товара нет, он уже аннулирован или приемка закрыта, строк не будет.
+func (p *pgProduct) Void(ctx context.Context, void *domain.ProductVoid) (*domain.Product, error) {
+	update := p.db.Builder.
+		Update("products").
+		Set("voided_at", void.VoidedAt).
+		Set("void_reason", void.Reason).
+		Set("voided_by", void.VoidedBy).
+		Where(squirrel.Eq{"reception_id": void.ReceptionID, "voided_at": nil}).
+		Where(
+			"EXISTS (SELECT 1 FROM receptions r WHERE r.id = products.reception_id AND r.status = ?)",
+			domain.ReceptionStatusInProgress,
+		).
+		Suffix("RETURNING *")
+
+	if void.ProductID != nil {
+		update = update.Where(squirrel.Eq{"id": *void.ProductID})
+	} else {
+		update = update.Where(squirrel.Eq{"barcode": void.Barcode})
+	}
+
+	query, args, err := p.db.Builder.
+		Select(productColumns...).
+		PrefixExpr(update.Prefix("WITH p AS (").Suffix(")")).
+		From("p").
+		Join("product_types t ON t.code = p.product_type").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	product, err := scanProduct(p.db.DB.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return product, nil
+}
+
 func (p *pgProduct) GetLast(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error) {
-	query, args, err := squirrel.
-		Select("id", "reception_id", "product_type", "created_at").
-		From("products").
-		Where(squirrel.Eq{"reception_id": receptionID}).
-		OrderBy("created_at DESC").
+	query, args, err := p.db.Builder.
+		Select(productColumns...).
+		From("products p").
+		Join("product_types t ON t.code = p.product_type").
+		Where(squirrel.Eq{"p.reception_id": receptionID, "p.voided_at": nil}).
+		OrderBy("p.created_at DESC").
 		Limit(1).
 		ToSql()
 	if err != nil {
@@ -56,8 +257,8 @@ func (p *pgProduct) GetLast(ctx context.Context, receptionID uuid.UUID) (*domain
 
 	row := p.db.DB.QueryRow(ctx, query, args...)
 
-	var product domain.Product
-	if err := row.Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt); err != nil {
+	product, err := scanProduct(row)
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrNotFound
 		}
@@ -65,22 +266,90 @@ func (p *pgProduct) GetLast(ctx context.Context, receptionID uuid.UUID) (*domain
 		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
 	}
 
-	return &product, nil
+	return product, nil
 }
 
-func (p *pgProduct) Delete(ctx context.Context, product *domain.Product) error {
-	query, args, err := squirrel.
-		Delete("products").
-		Where(squirrel.Eq{"id": product.ID}).
+// GetByReception возвращает товары приемки в порядке добавления.
+func (p *pgProduct) GetByReception(ctx context.Context, receptionID uuid.UUID) ([]domain.Product, error) {
+	query, args, err := p.db.Builder.
+		Select(productColumns...).
+		From("products p").
+		Join("product_types t ON t.code = p.product_type").
+		Where(squirrel.Eq{"p.reception_id": receptionID, "p.voided_at": nil}).
+		OrderBy("p.created_at").
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
 	}
 
-	_, err = p.db.DB.Exec(ctx, query, args...)
+	rows, err := p.db.DB.Query(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
 	}
+	defer rows.Close()
 
-	return nil
+	products := make([]domain.Product, 0)
+
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+
+		products = append(products, *product)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return products, nil
+}
+
+// metadataArg не дает записать NULL в metadata, если у товара нет метаданных.
+func metadataArg(metadata domain.ProductMetadata) domain.ProductMetadata {
+	if metadata == nil {
+		return domain.ProductMetadata{}
+	}
+
+	return metadata
+}
+
+func scanProduct(row pgx.Row) (*domain.Product, error) {
+	var product domain.Product
+
+	err := row.Scan(
+		&product.ID,
+		&product.ReceptionID,
+		&product.Type,
+		&product.TypeName,
+		&product.Metadata,
+		&product.Barcode,
+		&product.OrderID,
+		&product.SKU,
+		&product.CreatedAt,
+		&product.VoidedAt,
+		&product.VoidReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// nullable пишет пустую строку как NULL, чтобы товары без штрихкода
+// не попадали под уникальный индекс.
+func nullable(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
 }
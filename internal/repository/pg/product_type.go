@@ -0,0 +1,172 @@
+package pgrepo
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"fmt"
+
+	postgres "avito_pvz/internal/storage/pg"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var productTypeColumns = []string{
+	"id",
+	"code",
+	"name_ru",
+	"name_en",
+	"requires_serial",
+	"requires_age_check",
+	"active",
+	"created_at",
+}
+
+type pgProductType struct {
+	storage *postgres.Storage
+}
+
+func NewPgProductType(db *postgres.Storage) *pgProductType {
+	return &pgProductType{
+		storage: db,
+	}
+}
+
+func (p *pgProductType) Create(ctx context.Context, productType *domain.ProductTypeInfo) error {
+	query, args, err := p.storage.Builder.
+		Insert("product_types").
+		Columns(productTypeColumns...).
+		Values(
+			productType.ID,
+			productType.Code,
+			productType.NameRu,
+			productType.NameEn,
+			productType.Attributes.RequiresSerial,
+			productType.Attributes.RequiresAgeCheck,
+			productType.Active,
+			productType.CreatedAt,
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrAlreadyExists
+		}
+
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgProductType) GetByCode(
+	ctx context.Context,
+	code domain.ProductType,
+) (*domain.ProductTypeInfo, error) {
+	query, args, err := p.storage.Builder.
+		Select(productTypeColumns...).
+		From("product_types").
+		Where(squirrel.Eq{"code": code}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	productType, err := scanProductType(p.storage.DB.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return productType, nil
+}
+
+func (p *pgProductType) List(ctx context.Context) ([]domain.ProductTypeInfo, error) {
+	query, args, err := p.storage.Builder.
+		Select(productTypeColumns...).
+		From("product_types").
+		OrderBy("code").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	rows, err := p.storage.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	var productTypes []domain.ProductTypeInfo
+
+	for rows.Next() {
+		productType, err := scanProductType(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+		}
+
+		productTypes = append(productTypes, *productType)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return productTypes, nil
+}
+
+func (p *pgProductType) Update(ctx context.Context, productType *domain.ProductTypeInfo) error {
+	query, args, err := p.storage.Builder.
+		Update("product_types").
+		Set("name_ru", productType.NameRu).
+		Set("name_en", productType.NameEn).
+		Set("requires_serial", productType.Attributes.RequiresSerial).
+		Set("requires_age_check", productType.Attributes.RequiresAgeCheck).
+		Set("active", productType.Active).
+		Where(squirrel.Eq{"code": productType.Code}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	tag, err := p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanProductType(row pgx.Row) (*domain.ProductTypeInfo, error) {
+	var productType domain.ProductTypeInfo
+
+	err := row.Scan(
+		&productType.ID,
+		&productType.Code,
+		&productType.NameRu,
+		&productType.NameEn,
+		&productType.Attributes.RequiresSerial,
+		&productType.Attributes.RequiresAgeCheck,
+		&productType.Active,
+		&productType.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &productType, nil
+}
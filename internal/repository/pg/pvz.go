@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	postgres "avito_pvz/internal/storage/pg"
 
@@ -13,6 +14,21 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+var pvzColumns = []string{
+	"id",
+	"city",
+	"created_at",
+	"address",
+	"latitude",
+	"longitude",
+	"timezone",
+	"working_hours",
+	"phone",
+	"status",
+	"status_reason",
+	"status_changed_at",
+}
+
 type pgPvz struct {
 	storage *postgres.Storage
 }
@@ -24,10 +40,25 @@ func NewPgPvz(db *postgres.Storage) *pgPvz {
 }
 
 func (p *pgPvz) Create(ctx context.Context, pvz *domain.PVZ) error {
+	lat, lon := locationArgs(pvz.Profile.Location)
+
 	query, args, err := p.storage.Builder.
 		Insert("pvzs").
-		Columns("id", "city", "created_at").
-		Values(pvz.ID, pvz.City, pvz.RegistrationDate).
+		Columns(pvzColumns...).
+		Values(
+			pvz.ID,
+			pvz.City,
+			pvz.RegistrationDate,
+			pvz.Profile.Address,
+			lat,
+			lon,
+			pvz.Profile.Timezone,
+			pvz.Profile.Hours,
+			pvz.Profile.Phone,
+			pvz.Status,
+			pvz.StatusReason,
+			pvz.StatusChangedAt,
+		).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
@@ -41,11 +72,16 @@ func (p *pgPvz) Create(ctx context.Context, pvz *domain.PVZ) error {
 	return nil
 }
 
-func (p *pgPvz) GetAll(ctx context.Context) ([]domain.PVZ, error) {
-	query, args, err := p.storage.Builder.
-		Select("id", "city", "created_at").
-		From("pvzs").
-		ToSql()
+func (p *pgPvz) GetAll(ctx context.Context, status *domain.PVZStatus) ([]domain.PVZ, error) {
+	qb := p.storage.Builder.
+		Select(pvzColumns...).
+		From("pvzs")
+
+	if status != nil {
+		qb = qb.Where(squirrel.Eq{"status": *status})
+	}
+
+	query, args, err := qb.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
 	}
@@ -62,13 +98,13 @@ func (p *pgPvz) GetAll(ctx context.Context) ([]domain.PVZ, error) {
 	list := make([]domain.PVZ, 0, 1)
 
 	for rows.Next() {
-		var pvz domain.PVZ
-		if err := rows.Scan(&pvz.ID, &pvz.City, &pvz.RegistrationDate); err != nil {
+		pvz, err := scanPVZ(rows)
+		if err != nil {
 			// TODO: add log
 			continue
 		}
 
-		list = append(list, pvz)
+		list = append(list, *pvz)
 	}
 
 	return list, nil
@@ -121,38 +157,278 @@ func (p *pgPvz) GetAll(ctx context.Context) ([]domain.PVZ, error) {
 // 	return list, nil
 // }
 
-func (p *pgPvz) Exist(ctx context.Context, pvz uuid.UUID) error {
-	query, args, err := p.storage.Builder.Select("id", "city", "created_at").
+func (p *pgPvz) GetStatus(ctx context.Context, id uuid.UUID) (domain.PVZStatus, error) {
+	query, args, err := p.storage.Builder.
+		Select("status").
 		From("pvzs").
-		Where(squirrel.Eq{"id": pvz}).
+		Where(squirrel.Eq{"id": id}).
 		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	var status domain.PVZStatus
+
+	err = p.storage.DB.QueryRow(ctx, query, args...).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return status, nil
+}
+
+// SetStatus применяет переход, только если ПВЗ все еще в change.From, а при
+// закрытии еще и нет открытой приемки: проверка и запись идут одним запросом,
+// поэтому приемка не может открыться между ними.
+func (p *pgPvz) SetStatus(ctx context.Context, change *domain.PVZStatusChange) error {
+	const query = `
+UPDATE pvzs
+SET status = $2, status_reason = $3, status_changed_at = $4
+WHERE id = $1 AND status = $5
+  AND ($2 <> 'closed' OR NOT EXISTS (
+      SELECT 1 FROM receptions r WHERE r.pvz_id = pvzs.id AND r.status = $6
+  ))`
+
+	tag, err := p.storage.DB.Exec(
+		ctx,
+		query,
+		change.PVZID,
+		change.To,
+		change.Reason,
+		change.ChangedAt,
+		change.From,
+		domain.ReceptionStatusInProgress,
+	)
 	if err != nil {
 		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
 	}
 
-	row := p.storage.DB.QueryRow(ctx, query, args...)
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
 
-	var pvztst domain.PVZ
+	status, err := p.GetStatus(ctx, change.PVZID)
+	if err != nil {
+		return err
+	}
+
+	if status != change.From {
+		return domain.ErrStatusChanged
+	}
+
+	return domain.ErrHasOpenReception
+}
 
-	err = row.Scan(&pvztst.ID, &pvztst.City, &pvztst.RegistrationDate)
+func (p *pgPvz) GetByID(ctx context.Context, id uuid.UUID) (*domain.PVZ, error) {
+	query, args, err := p.storage.Builder.
+		Select(pvzColumns...).
+		From("pvzs").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.ErrNotFound
-		}
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
 
+	pvz, err := scanPVZ(p.storage.DB.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return pvz, nil
+}
+
+// UpdateProfile сохраняет профиль и запись истории одним запросом,
+// чтобы изменение не осталось без записи в истории.
+func (p *pgPvz) UpdateProfile(ctx context.Context, change *domain.PVZProfileChange) error {
+	const query = `
+WITH upd AS (
+    UPDATE pvzs
+    SET address = $2, latitude = $3, longitude = $4,
+        timezone = $5, working_hours = $6, phone = $7
+    WHERE id = $1
+    RETURNING id
+)
+INSERT INTO pvz_profile_history (id, pvz_id, changed_by, before, after, created_at)
+SELECT $8, id, $9, $10, $11, $12 FROM upd`
+
+	profile := change.After
+	lat, lon := locationArgs(profile.Location)
+
+	tag, err := p.storage.DB.Exec(
+		ctx,
+		query,
+		change.PVZID,
+		profile.Address,
+		lat,
+		lon,
+		profile.Timezone,
+		profile.Hours,
+		profile.Phone,
+		change.ID,
+		change.ChangedBy,
+		change.Before,
+		change.After,
+		change.CreatedAt,
+	)
+	if err != nil {
 		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
 	}
 
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
 	return nil
 }
 
+func (p *pgPvz) GetProfileHistory(
+	ctx context.Context,
+	pvzID uuid.UUID,
+) ([]domain.PVZProfileChange, error) {
+	query, args, err := p.storage.Builder.
+		Select("id", "pvz_id", "changed_by", "before", "after", "created_at").
+		From("pvz_profile_history").
+		Where(squirrel.Eq{"pvz_id": pvzID}).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	rows, err := p.storage.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	history := make([]domain.PVZProfileChange, 0)
+
+	for rows.Next() {
+		var change domain.PVZProfileChange
+		if err := rows.Scan(
+			&change.ID,
+			&change.PVZID,
+			&change.ChangedBy,
+			&change.Before,
+			&change.After,
+			&change.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+		}
+
+		history = append(history, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return history, nil
+}
+
+// FindNearest читает ПВЗ в радиусе от точки в порядке удаления по индексу
+// pvzs_location_idx (KNN). Часы работы проверяет функция pvz_open_at в том
+// же запросе, поэтому индекс читается, пока не наберется query.Limit
+// открытых ПВЗ.
+func (p *pgPvz) FindNearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error) {
+	// earth_box отбирает кандидатов по индексу, earth_distance отсекает углы
+	// куба, а сортировка по <-> идет тем же индексом.
+	sql := fmt.Sprintf(`
+SELECT %s, earth_distance(ll_to_earth(latitude, longitude), ll_to_earth($1, $2)) / 1000
+FROM pvzs
+WHERE status = $4
+    AND latitude IS NOT NULL
+    AND earth_box(ll_to_earth($1, $2), $3) @> ll_to_earth(latitude, longitude)
+    AND earth_distance(ll_to_earth(latitude, longitude), ll_to_earth($1, $2)) <= $3
+    AND ($6::timestamptz IS NULL OR pvz_open_at(working_hours, timezone, $6))
+ORDER BY ll_to_earth(latitude, longitude) <-> ll_to_earth($1, $2)
+LIMIT $5`, strings.Join(pvzColumns, ", "))
+
+	rows, err := p.storage.DB.Query(ctx, sql,
+		query.Point.Lat,
+		query.Point.Lon,
+		query.RadiusKm*1000,
+		domain.PVZStatusActive,
+		query.Limit,
+		query.OpenAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.NearbyPVZ, 0, query.Limit)
+
+	for rows.Next() {
+		var found domain.NearbyPVZ
+
+		pvz, err := scanPVZ(rows, &found.DistanceKm)
+		if err != nil {
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+		}
+
+		found.PVZ = *pvz
+		result = append(result, found)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return result, nil
+}
+
+func (p *pgPvz) GetStaff(ctx context.Context, pvz uuid.UUID) ([]domain.User, error) {
+	query, args, err := p.storage.Builder.
+		Select("u.id", "u.email", "u.role", "u.created_at").
+		From("pvz_staff s").
+		Join("users u ON u.id = s.user_id").
+		Where(squirrel.Eq{"s.pvz_id": pvz}).
+		OrderBy("s.created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	rows, err := p.storage.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	staff := make([]domain.User, 0)
+
+	for rows.Next() {
+		var user domain.User
+		if err := rows.Scan(&user.ID, &user.Email, &user.Role, &user.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+		}
+
+		staff = append(staff, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return staff, nil
+}
+
 func (p *pgPvz) GetWithParam(
 	ctx context.Context,
 	params domain.Params,
 ) ([]domain.PVZAgregate, error) {
 	// Строим запрос для получения данных о ПВЗ
 	qb := p.storage.Builder.
-		Select("pvzs.id", "pvzs.city", "pvzs.created_at").
+		Select(pvzColumns...).
 		From("pvzs")
 
 	if params.StartDate != nil {
@@ -163,6 +439,10 @@ func (p *pgPvz) GetWithParam(
 		qb = qb.Where(squirrel.LtOrEq{"pvzs.created_at": *params.EndDate})
 	}
 
+	if params.Status != nil {
+		qb = qb.Where(squirrel.Eq{"pvzs.status": *params.Status})
+	}
+
 	if params.Limit != nil {
 		qb = qb.Limit(uint64(*params.Limit))
 	}
@@ -191,12 +471,12 @@ func (p *pgPvz) GetWithParam(
 	var pvzs []domain.PVZ
 
 	for rows.Next() {
-		var pvz domain.PVZ
-		if err := rows.Scan(&pvz.ID, &pvz.City, &pvz.RegistrationDate); err != nil {
+		pvz, err := scanPVZ(rows)
+		if err != nil {
 			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
 		}
 
-		pvzs = append(pvzs, pvz)
+		pvzs = append(pvzs, *pvz)
 	}
 
 	// Теперь получаем данные о приемках и продуктах для каждого ПВЗ
@@ -245,8 +525,8 @@ func (p *pgPvz) getReceptionsByPVZID(
 	pvzID string,
 ) ([]domain.Reception, error) {
 	qb := p.storage.Builder.
-		Select("id", "pvz_id", "status", "created_at").
-		From("recepcions").
+		Select(receptionColumns...).
+		From("receptions").
 		Where(squirrel.Eq{"pvz_id": pvzID})
 
 	query, args, err := qb.ToSql()
@@ -263,12 +543,12 @@ func (p *pgPvz) getReceptionsByPVZID(
 	var receptions []domain.Reception
 
 	for rows.Next() {
-		var reception domain.Reception
-		if err := rows.Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.CreatedAt); err != nil {
+		reception, err := scanReception(rows)
+		if err != nil {
 			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
 		}
 
-		receptions = append(receptions, reception)
+		receptions = append(receptions, *reception)
 	}
 
 	return receptions, nil
@@ -280,9 +560,10 @@ func (p *pgPvz) getProductsByReceptionID(
 	receptionID string,
 ) ([]domain.Product, error) {
 	qb := p.storage.Builder.
-		Select("id", "reception_id", "product_type", "created_at").
-		From("products").
-		Where(squirrel.Eq{"reception_id": receptionID})
+		Select(productColumns...).
+		From("products p").
+		Join("product_types t ON t.code = p.product_type").
+		Where(squirrel.Eq{"p.reception_id": receptionID, "p.voided_at": nil})
 
 	query, args, err := qb.ToSql()
 	if err != nil {
@@ -298,13 +579,55 @@ func (p *pgPvz) getProductsByReceptionID(
 	var products []domain.Product
 
 	for rows.Next() {
-		var product domain.Product
-		if err := rows.Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt); err != nil {
+		product, err := scanProduct(rows)
+		if err != nil {
 			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
 		}
 
-		products = append(products, product)
+		products = append(products, *product)
 	}
 
 	return products, nil
 }
+
+// scanPVZ читает колонки pvzColumns, extra дочитывает колонки после них.
+func scanPVZ(row pgx.Row, extra ...any) (*domain.PVZ, error) {
+	var (
+		pvz      domain.PVZ
+		lat, lon *float64
+	)
+
+	dest := []any{
+		&pvz.ID,
+		&pvz.City,
+		&pvz.RegistrationDate,
+		&pvz.Profile.Address,
+		&lat,
+		&lon,
+		&pvz.Profile.Timezone,
+		&pvz.Profile.Hours,
+		&pvz.Profile.Phone,
+		&pvz.Status,
+		&pvz.StatusReason,
+		&pvz.StatusChangedAt,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if lat != nil && lon != nil {
+		pvz.Profile.Location = &domain.GeoPoint{Lat: *lat, Lon: *lon}
+	}
+
+	return &pvz, nil
+}
+
+func locationArgs(location *domain.GeoPoint) (*float64, *float64) {
+	if location == nil {
+		return nil, nil
+	}
+
+	return &location.Lat, &location.Lon
+}
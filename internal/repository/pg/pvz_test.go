@@ -0,0 +1,125 @@
+package pgrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	pgrepo "avito_pvz/internal/repository/pg"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgPvz_FindNearest(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestStorage(t)
+	repo := pgrepo.NewPgPvz(storage)
+	ctx := context.Background()
+
+	point := domain.GeoPoint{Lat: 55.75, Lon: 37.61}
+	hours := domain.WorkingHours{Weekly: []domain.DayHours{
+		{Day: "mon", Open: "00:00", Close: "24:00"},
+		{Day: "tue", Open: "00:00", Close: "24:00"},
+		{Day: "wed", Open: "00:00", Close: "24:00"},
+		{Day: "thu", Open: "00:00", Close: "24:00"},
+		{Day: "fri", Open: "00:00", Close: "24:00"},
+		{Day: "sat", Open: "00:00", Close: "24:00"},
+		{Day: "sun", Open: "00:00", Close: "24:00"},
+	}}
+
+	create := func(location domain.GeoPoint, hours domain.WorkingHours) *domain.PVZ {
+		pvz := domain.NewPVZ("Москва", domain.PVZProfile{
+			Location: &location,
+			Timezone: "Europe/Moscow",
+			Hours:    hours,
+		})
+		require.NoError(t, repo.Create(ctx, pvz))
+
+		return pvz
+	}
+
+	// Ближний ПВЗ без часов работы, дальний открыт круглосуточно.
+	near := create(point, domain.WorkingHours{})
+	open := create(domain.GeoPoint{Lat: 55.76, Lon: 37.61}, hours)
+	create(domain.GeoPoint{Lat: 56.75, Lon: 37.61}, hours)
+
+	suspended := create(domain.GeoPoint{Lat: 55.751, Lon: 37.61}, hours)
+	_, err := storage.DB.Exec(ctx, "UPDATE pvzs SET status = $1 WHERE id = $2",
+		domain.PVZStatusSuspended, suspended.ID)
+	require.NoError(t, err)
+
+	query := domain.NewNearbyQuery(point)
+
+	found, err := repo.FindNearest(ctx, query)
+	require.NoError(t, err)
+	require.Len(t, found, 2, "suspended and out-of-radius PVZs are skipped")
+	require.Equal(t, *near.ID, *found[0].PVZ.ID)
+	require.InDelta(t, 0, found[0].DistanceKm, 0.01)
+	require.Equal(t, *open.ID, *found[1].PVZ.ID)
+	require.InDelta(t, 1.11, found[1].DistanceKm, 0.01)
+
+	query.Limit = 1
+	found, err = repo.FindNearest(ctx, query)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.Equal(t, *near.ID, *found[0].PVZ.ID)
+
+	now := time.Now()
+	query.OpenAt = &now
+	query.Limit = 10
+	found, err = repo.FindNearest(ctx, query)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.Equal(t, *open.ID, *found[0].PVZ.ID)
+}
+
+func TestPgPvz_OpenAt(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestStorage(t)
+	ctx := context.Background()
+
+	hours := domain.WorkingHours{
+		Weekly: []domain.DayHours{
+			{Day: "mon", Open: "09:00", Close: "13:00"},
+			{Day: "mon", Open: "14:00", Close: "24:00"},
+		},
+		Exceptions: []domain.HoursException{
+			{Date: "2025-06-16", Closed: true},
+			{Date: "2025-06-23", Open: "10:00", Close: "12:00"},
+		},
+	}
+
+	openAt := func(hours domain.WorkingHours, timezone string, at time.Time) bool {
+		var open bool
+
+		err := storage.DB.QueryRow(ctx, "SELECT pvz_open_at($1, $2, $3)", hours, timezone, at).
+			Scan(&open)
+		require.NoError(t, err)
+
+		return open
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{name: "monday_morning", at: time.Date(2025, 6, 9, 4, 30, 0, 0, time.UTC), want: true},
+		{name: "monday_lunch", at: time.Date(2025, 6, 9, 8, 30, 0, 0, time.UTC), want: false},
+		{name: "monday_late", at: time.Date(2025, 6, 9, 18, 59, 0, 0, time.UTC), want: true},
+		{name: "tuesday_local", at: time.Date(2025, 6, 9, 19, 0, 0, 0, time.UTC), want: false},
+		{name: "holiday", at: time.Date(2025, 6, 16, 5, 0, 0, 0, time.UTC), want: false},
+		{name: "short_day_open", at: time.Date(2025, 6, 23, 5, 30, 0, 0, time.UTC), want: true},
+		{name: "short_day_closed", at: time.Date(2025, 6, 23, 8, 0, 0, 0, time.UTC), want: false},
+	}
+
+	for _, tt := range tests {
+		// Asia/Yekaterinburg — UTC+5.
+		require.Equal(t, tt.want, openAt(hours, "Asia/Yekaterinburg", tt.at), tt.name)
+	}
+
+	require.False(t, openAt(domain.WorkingHours{}, "", time.Now()), "без часов работы ПВЗ закрыт")
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	postgres "avito_pvz/internal/storage/pg"
 
@@ -13,6 +15,21 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+var receptionColumns = []string{
+	"id",
+	"pvz_id",
+	"status",
+	"created_at",
+	"closed_at",
+	"close_reason",
+	"reopened_at",
+	"first_closed_at",
+}
+
+// receptionSweepLock имя advisory-блокировки обработчика простоя: ее держит
+// только один экземпляр сервиса, остальные пропускают проход.
+const receptionSweepLock = "avito_pvz.reception_sweep"
+
 type pgReception struct {
 	storage *postgres.Storage
 }
@@ -23,11 +40,17 @@ func NewPgReception(db *postgres.Storage) *pgReception {
 	}
 }
 
+// Close закрывает приемку, если она еще открыта. Если ее уже закрыли
+// параллельно (вручную или обработчиком простоя), возвращается
+// domain.ErrStatusChanged.
 func (p *pgReception) Close(ctx context.Context, reception domain.Reception) error {
 	query, args, err := p.storage.Builder.
 		Update("receptions").
 		Set("status", reception.Status).
-		Where(squirrel.Eq{"id": reception.ID}).
+		Set("closed_at", reception.ClosedAt).
+		Set("close_reason", reception.CloseReason).
+		Set("first_closed_at", squirrel.Expr("COALESCE(first_closed_at, ?)", reception.ClosedAt)).
+		Where(squirrel.Eq{"id": reception.ID, "status": domain.ReceptionStatusInProgress}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
@@ -39,7 +62,59 @@ func (p *pgReception) Close(ctx context.Context, reception domain.Reception) err
 	}
 
 	if ct.RowsAffected() == 0 {
-		return domain.ErrNotFound
+		return domain.ErrStatusChanged
+	}
+
+	return nil
+}
+
+// CloseReconciled закрывает приемку, привязывает к ней манифест и сохраняет
+// сверку одним запросом, чтобы приемка не закрылась без результата сверки.
+// Если приемку уже закрыли или манифест забрала другая приемка, строк не
+// будет и возвращается domain.ErrStatusChanged.
+func (p *pgReception) CloseReconciled(
+	ctx context.Context,
+	reception domain.Reception,
+	report *domain.ReceptionReconciliation,
+) error {
+	const query = `
+WITH closed AS (
+    UPDATE receptions
+    SET status = $2, closed_at = $3, close_reason = $4,
+        first_closed_at = COALESCE(first_closed_at, $3)
+    WHERE id = $1 AND status = $5
+    RETURNING id
+), asn AS (
+    UPDATE asn_manifests a
+    SET reception_id = closed.id
+    FROM closed
+    WHERE a.id = $6 AND (a.reception_id IS NULL OR a.reception_id = closed.id)
+    RETURNING a.id
+)
+INSERT INTO reception_reconciliations
+    (reception_id, asn_id, shortages, surpluses, unexpected, created_at)
+SELECT $1, id, $7, $8, $9, $10 FROM asn`
+
+	tag, err := p.storage.DB.Exec(
+		ctx,
+		query,
+		reception.ID,
+		reception.Status,
+		reception.ClosedAt,
+		reception.CloseReason,
+		domain.ReceptionStatusInProgress,
+		report.ASNID,
+		report.Shortages,
+		report.Surpluses,
+		report.Unexpected,
+		report.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrStatusChanged
 	}
 
 	return nil
@@ -47,7 +122,7 @@ func (p *pgReception) Close(ctx context.Context, reception domain.Reception) err
 
 func (p *pgReception) GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error) {
 	query, args, err := p.storage.Builder.
-		Select("id", "pvz_id", "status", "created_at").
+		Select(receptionColumns...).
 		From("receptions").
 		Where(squirrel.Eq{"pvz_id": pvz}).
 		OrderBy("created_at DESC").
@@ -57,18 +132,175 @@ func (p *pgReception) GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Recep
 		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
 	}
 
-	row := p.storage.DB.QueryRow(ctx, query, args...)
+	reception, err := scanReception(p.storage.DB.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
 
-	var reception domain.Reception
-	if err := row.Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.CreatedAt); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domain.ErrNotFound
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return reception, nil
+}
+
+func (p *pgReception) GetByID(ctx context.Context, id uuid.UUID) (*domain.Reception, error) {
+	query, args, err := p.storage.Builder.
+		Select(receptionColumns...).
+		From("receptions").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	reception, err := scanReception(p.storage.DB.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return reception, nil
+}
+
+// Reopen открывает закрытую приемку и сохраняет исправление одним запросом.
+// Условия сервиса проверяются повторно, чтобы между проверкой и записью
+// никто не успел создать новую приемку или открыть эту же.
+func (p *pgReception) Reopen(ctx context.Context, correction *domain.ReceptionCorrection) error {
+	const query = `
+WITH upd AS (
+    UPDATE receptions r
+    SET status = $2, reopened_at = $3, closed_at = NULL, close_reason = ''
+    WHERE r.id = $1
+      AND r.status IN ($4, $5)
+      AND r.first_closed_at >= $6
+      AND NOT EXISTS (
+          SELECT 1 FROM receptions n
+          WHERE n.pvz_id = r.pvz_id AND n.created_at > r.created_at
+      )
+    RETURNING r.id
+)
+INSERT INTO reception_corrections (id, reception_id, reopened_by, justification, created_at)
+SELECT $7, id, $8, $9, $3 FROM upd`
+
+	tag, err := p.storage.DB.Exec(
+		ctx,
+		query,
+		correction.ReceptionID,
+		domain.ReceptionStatusInProgress,
+		correction.CreatedAt,
+		domain.ReceptionStatusClosed,
+		domain.ReceptionStatusCorrected,
+		correction.ClosedAfter,
+		correction.ID,
+		correction.ReopenedBy,
+		correction.Justification,
+	)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrStatusChanged
+	}
+
+	return nil
+}
+
+// CloseIdle закрывает приемки, в которых с idleSince не было ни товаров,
+// ни открытия для исправления, и возвращает их уже закрытыми. Проход идет
+// под advisory-блокировкой транзакции: если ее держит другой экземпляр,
+// возвращается domain.ErrLocked. Запись в журнал аудита о каждом закрытии
+// делается в той же транзакции от имени актора из ctx.
+func (p *pgReception) CloseIdle(ctx context.Context, idleSince time.Time) ([]domain.Reception, error) {
+	tx, err := p.storage.DB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	//nolint:errcheck // после Commit откат ничего не делает
+	defer tx.Rollback(ctx)
+
+	var locked bool
+
+	err = tx.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock(hashtext($1))", receptionSweepLock).
+		Scan(&locked)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if !locked {
+		return nil, domain.ErrLocked
+	}
+
+	query := `
+UPDATE receptions r
+SET status = CASE WHEN r.reopened_at IS NULL THEN $2 ELSE $3 END,
+    closed_at = now(),
+    close_reason = $4,
+    first_closed_at = COALESCE(r.first_closed_at, now())
+WHERE r.status = $1
+  AND GREATEST(
+      r.created_at,
+      r.reopened_at,
+      (SELECT max(p.created_at) FROM products p WHERE p.reception_id = r.id)
+  ) < $5
+RETURNING ` + strings.Join(receptionColumns, ", ")
+
+	rows, err := tx.Query(
+		ctx,
+		query,
+		domain.ReceptionStatusInProgress,
+		domain.ReceptionStatusClosed,
+		domain.ReceptionStatusCorrected,
+		domain.ReceptionClosedBySystem,
+		idleSince,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	receptions := make([]domain.Reception, 0)
+
+	for rows.Next() {
+		reception, err := scanReception(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
 		}
 
+		receptions = append(receptions, *reception)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
 	}
 
-	return &reception, nil
+	for _, reception := range receptions {
+		entry, err := domain.NewAuditEntry(ctx, domain.AuditEvent{
+			Action:   domain.AuditReceptionClose,
+			Entity:   domain.AuditEntityReception,
+			EntityID: reception.ID,
+			After:    reception.ToDTO(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+		}
+
+		if err := insertAuditEntry(ctx, tx, p.storage.Builder, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return receptions, nil
 }
 
 func (p *pgReception) Create(ctx context.Context, reception domain.Reception) error {
@@ -89,3 +321,23 @@ func (p *pgReception) Create(ctx context.Context, reception domain.Reception) er
 
 	return nil
 }
+
+func scanReception(row pgx.Row) (*domain.Reception, error) {
+	var reception domain.Reception
+
+	err := row.Scan(
+		&reception.ID,
+		&reception.PvzID,
+		&reception.Status,
+		&reception.CreatedAt,
+		&reception.ClosedAt,
+		&reception.CloseReason,
+		&reception.ReopenedAt,
+		&reception.FirstClosedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reception, nil
+}
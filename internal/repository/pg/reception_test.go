@@ -0,0 +1,128 @@
+package pgrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	pgrepo "avito_pvz/internal/repository/pg"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgReception_Reopen(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestStorage(t)
+	repo := pgrepo.NewPgReception(storage)
+	ctx := context.Background()
+
+	pvzID := insertPVZ(t, storage)
+
+	require.NoError(t, repo.Create(ctx, *domain.NewReception(pvzID)))
+
+	reception, err := repo.GetLast(ctx, pvzID)
+	require.NoError(t, err)
+	require.Equal(t, domain.ReceptionStatusInProgress, reception.Status)
+
+	reception.Close()
+	require.NoError(t, repo.Close(ctx, *reception))
+
+	// Уже закрытую приемку второй раз не закрыть.
+	require.ErrorIs(t, repo.Close(ctx, *reception), domain.ErrStatusChanged)
+
+	correction, err := domain.NewReceptionCorrection(ctx, reception, "пересчет", time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, repo.Reopen(ctx, correction))
+
+	reopened, err := repo.GetByID(ctx, reception.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.ReceptionStatusInProgress, reopened.Status)
+	require.NotNil(t, reopened.ReopenedAt)
+	require.Nil(t, reopened.ClosedAt)
+
+	// Повторное открытие уже открытой приемки не проходит.
+	correction.ID = uuid.New()
+	require.ErrorIs(t, repo.Reopen(ctx, correction), domain.ErrStatusChanged)
+
+	// Закрытие после исправления не сдвигает время первого закрытия.
+	require.NotNil(t, reopened.FirstClosedAt)
+	firstClosedAt := *reopened.FirstClosedAt
+
+	reopened.Close()
+	require.NoError(t, repo.Close(ctx, *reopened))
+
+	corrected, err := repo.GetByID(ctx, reception.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.ReceptionStatusCorrected, corrected.Status)
+	require.Equal(t, firstClosedAt, *corrected.FirstClosedAt)
+}
+
+func TestPgReception_ReopenNewerExists(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestStorage(t)
+	repo := pgrepo.NewPgReception(storage)
+	ctx := context.Background()
+
+	pvzID := insertPVZ(t, storage)
+
+	require.NoError(t, repo.Create(ctx, *domain.NewReception(pvzID)))
+
+	first, err := repo.GetLast(ctx, pvzID)
+	require.NoError(t, err)
+
+	first.Close()
+	require.NoError(t, repo.Close(ctx, *first))
+	require.NoError(t, repo.Create(ctx, *domain.NewReception(pvzID)))
+
+	correction, err := domain.NewReceptionCorrection(ctx, first, "пересчет", time.Hour)
+	require.NoError(t, err)
+	require.ErrorIs(t, repo.Reopen(ctx, correction), domain.ErrStatusChanged)
+}
+
+func TestPgReception_CloseIdle(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestStorage(t)
+	repo := pgrepo.NewPgReception(storage)
+	ctx := context.Background()
+
+	idlePVZ := insertPVZ(t, storage)
+	busyPVZ := insertPVZ(t, storage)
+
+	require.NoError(t, repo.Create(ctx, *domain.NewReception(idlePVZ)))
+	require.NoError(t, repo.Create(ctx, *domain.NewReception(busyPVZ)))
+
+	busy, err := repo.GetLast(ctx, busyPVZ)
+	require.NoError(t, err)
+
+	// Приемки созданы только что; граница простоя в будущем делает
+	// простаивающими обе, пока в одну из них не добавлен более поздний товар.
+	idleSince := time.Now().Add(time.Hour)
+	insertProduct(t, storage, busy.ID, idleSince.Add(time.Minute))
+
+	closed, err := repo.CloseIdle(domain.WithSystemActor(ctx), idleSince)
+	require.NoError(t, err)
+	require.Len(t, closed, 1)
+	require.Equal(t, idlePVZ, closed[0].PvzID)
+	require.Equal(t, domain.ReceptionStatusClosed, closed[0].Status)
+	require.Equal(t, domain.ReceptionClosedBySystem, closed[0].CloseReason)
+	require.NotNil(t, closed[0].ClosedAt)
+
+	entries, err := pgrepo.NewPgAudit(storage).List(ctx, domain.AuditFilter{EntityID: &closed[0].ID})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, domain.AuditReceptionClose, entries[0].Action)
+	require.Equal(t, domain.ActorSystem, entries[0].ActorType)
+
+	busy, err = repo.GetByID(ctx, busy.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.ReceptionStatusInProgress, busy.Status)
+
+	closed, err = repo.CloseIdle(ctx, idleSince)
+	require.NoError(t, err)
+	require.Empty(t, closed)
+}
@@ -0,0 +1,153 @@
+package pgrepo
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"fmt"
+
+	postgres "avito_pvz/internal/storage/pg"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type pgSession struct {
+	storage *postgres.Storage
+}
+
+func NewPgSession(db *postgres.Storage) *pgSession {
+	return &pgSession{
+		storage: db,
+	}
+}
+
+func (p *pgSession) Create(ctx context.Context, token *domain.RefreshToken) error {
+	query, args, err := p.storage.Builder.
+		Insert("refresh_tokens").
+		Columns("id", "user_id", "family_id", "token_hash", "expires_at", "created_at").
+		Values(
+			token.ID,
+			token.UserID,
+			token.FamilyID,
+			token.TokenHash,
+			token.ExpiresAt,
+			token.CreatedAt,
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgSession) GetByID(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error) {
+	return p.getOne(ctx, squirrel.Eq{"id": id})
+}
+
+func (p *pgSession) GetByHash(ctx context.Context, hash string) (*domain.RefreshToken, error) {
+	return p.getOne(ctx, squirrel.Eq{"token_hash": hash})
+}
+
+// Revoke отзывает сессию. Если она уже отозвана, в том числе параллельным
+// обменом того же refresh-токена, возвращается domain.ErrNotFound.
+func (p *pgSession) Revoke(ctx context.Context, id uuid.UUID) error {
+	query, args, err := p.storage.Builder.
+		Update("refresh_tokens").
+		Set("revoked_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": id}).
+		Where(squirrel.Eq{"revoked_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	tag, err := p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// RevokeFamily отзывает все сессии, полученные обменом из одного входа.
+func (p *pgSession) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	query, args, err := p.storage.Builder.
+		Update("refresh_tokens").
+		Set("revoked_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"family_id": familyID}).
+		Where(squirrel.Eq{"revoked_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgSession) RevokeAllByUser(ctx context.Context, userID uuid.UUID) error {
+	query, args, err := p.storage.Builder.
+		Update("refresh_tokens").
+		Set("revoked_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"user_id": userID}).
+		Where(squirrel.Eq{"revoked_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgSession) getOne(ctx context.Context, where squirrel.Eq) (*domain.RefreshToken, error) {
+	query, args, err := p.storage.Builder.
+		Select("id", "user_id", "family_id", "token_hash", "expires_at", "revoked_at", "created_at").
+		From("refresh_tokens").
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	row := p.storage.DB.QueryRow(ctx, query, args...)
+
+	var token domain.RefreshToken
+	if err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.RevokedAt,
+		&token.CreatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return &token, nil
+}
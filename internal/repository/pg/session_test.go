@@ -0,0 +1,49 @@
+package pgrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	pgrepo "avito_pvz/internal/repository/pg"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgSession_RevokeOnce(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestStorage(t)
+	repo := pgrepo.NewPgSession(storage)
+	ctx := context.Background()
+
+	user, err := domain.NewUser("user@example.com", "hash", "employee")
+	require.NoError(t, err)
+
+	_, err = storage.DB.Exec(ctx,
+		"INSERT INTO users (id, email, password_hash, role) VALUES ($1, $2, $3, $4)",
+		user.ID, user.Email, "hash", user.Role,
+	)
+	require.NoError(t, err)
+
+	first, _, err := domain.NewRefreshToken(user.ID, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, first))
+
+	second, _, err := domain.NewRefreshToken(user.ID, time.Hour)
+	require.NoError(t, err)
+
+	second.FamilyID = first.FamilyID
+	require.NoError(t, repo.Create(ctx, second))
+
+	require.NoError(t, repo.Revoke(ctx, first.ID))
+	require.ErrorIs(t, repo.Revoke(ctx, first.ID), domain.ErrNotFound)
+
+	require.NoError(t, repo.RevokeFamily(ctx, first.FamilyID))
+
+	got, err := repo.GetByID(ctx, second.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.RevokedAt)
+	require.Equal(t, first.FamilyID, got.FamilyID)
+}
@@ -0,0 +1,95 @@
+package pgrepo_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models/domain"
+	postgres "avito_pvz/internal/storage/pg"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStorage накатывает миграции в отдельную схему базы из PG_TEST_DSN
+// и удаляет схему после теста. Без PG_TEST_DSN тест пропускается.
+func newTestStorage(t *testing.T) *postgres.Storage {
+	t.Helper()
+
+	dsn := os.Getenv("PG_TEST_DSN")
+	if dsn == "" {
+		t.Skip("PG_TEST_DSN is not set")
+	}
+
+	ctx := context.Background()
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	admin, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(admin.Close)
+
+	_, err = admin.Exec(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+	})
+
+	config, err := pgxpool.ParseConfig(dsn)
+	require.NoError(t, err)
+
+	config.ConnConfig.RuntimeParams["search_path"] = schema + ",public"
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	migrations, err := filepath.Glob(filepath.Join("..", "..", "..", "migrations", "pg", "*.sql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	sort.Strings(migrations)
+
+	for _, migration := range migrations {
+		sql, err := os.ReadFile(migration)
+		require.NoError(t, err)
+
+		_, err = pool.Exec(ctx, string(sql))
+		require.NoError(t, err, migration)
+	}
+
+	builder := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	return &postgres.Storage{DB: pool, Builder: &builder}
+}
+
+// insertPVZ создает активный ПВЗ в городе из начального справочника.
+func insertPVZ(t *testing.T, storage *postgres.Storage) uuid.UUID {
+	t.Helper()
+
+	id := uuid.New()
+
+	_, err := storage.DB.Exec(context.Background(),
+		"INSERT INTO pvzs (id, city, status) VALUES ($1, $2, $3)",
+		id, "Москва", domain.PVZStatusActive,
+	)
+	require.NoError(t, err)
+
+	return id
+}
+
+// insertProduct добавляет товар в приемку с заданным временем приема.
+func insertProduct(t *testing.T, storage *postgres.Storage, receptionID uuid.UUID, createdAt time.Time) {
+	t.Helper()
+
+	_, err := storage.DB.Exec(context.Background(),
+		"INSERT INTO products (reception_id, product_type, created_at) VALUES ($1, $2, $3)",
+		receptionID, "electronics", createdAt,
+	)
+	require.NoError(t, err)
+}
@@ -5,10 +5,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	postgres "avito_pvz/internal/storage/pg"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
@@ -25,7 +27,7 @@ func NewPgUser(db *postgres.Storage) *pgUser {
 
 func (p *pgUser) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	query, args, err := p.storage.Builder.
-		Select("id", "email", "password_hash", "role", "created_at").
+		Select("id", "email", "password_hash", "role", "is_active", "created_at").
 		From("users").
 		Where(squirrel.Eq{"email": email}).
 		ToSql()
@@ -36,7 +38,45 @@ func (p *pgUser) GetByEmail(ctx context.Context, email string) (*domain.User, er
 	row := p.storage.DB.QueryRow(ctx, query, args...)
 
 	var user domain.User
-	if err := row.Scan(&user.ID, &user.Email, &user.PasswordHash, &user.Role, &user.CreatedAt); err != nil {
+	if err := row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.PasswordHash,
+		&user.Role,
+		&user.Active,
+		&user.CreatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return &user, nil
+}
+
+func (p *pgUser) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	query, args, err := p.storage.Builder.
+		Select("id", "email", "password_hash", "role", "is_active", "created_at").
+		From("users").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	row := p.storage.DB.QueryRow(ctx, query, args...)
+
+	var user domain.User
+	if err := row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.PasswordHash,
+		&user.Role,
+		&user.Active,
+		&user.CreatedAt,
+	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrNotFound
 		}
@@ -50,8 +90,8 @@ func (p *pgUser) GetByEmail(ctx context.Context, email string) (*domain.User, er
 func (p *pgUser) Create(ctx context.Context, user *domain.User) error {
 	query, args, err := p.storage.Builder.
 		Insert("users").
-		Columns("email", "password_hash", "role").
-		Values(user.Email, user.PasswordHash, user.Role).
+		Columns("email", "password_hash", "role", "is_active").
+		Values(user.Email, user.PasswordHash, user.Role, user.Active).
 		Suffix("RETURNING id, created_at").
 		ToSql()
 	if err != nil {
@@ -70,3 +110,279 @@ func (p *pgUser) Create(ctx context.Context, user *domain.User) error {
 
 	return nil
 }
+
+func (p *pgUser) AssignPVZ(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error {
+	query, args, err := p.storage.Builder.
+		Insert("pvz_staff").
+		Columns("user_id", "pvz_id").
+		Values(userID, pvzID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return domain.ErrAlreadyExists
+			case "23503":
+				return domain.ErrNotFound
+			}
+		}
+
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgUser) UnassignPVZ(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error {
+	query, args, err := p.storage.Builder.
+		Delete("pvz_staff").
+		Where(squirrel.Eq{"user_id": userID, "pvz_id": pvzID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	tag, err := p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *pgUser) IsAssigned(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) (bool, error) {
+	query, args, err := p.storage.Builder.
+		Select("1").
+		Prefix("SELECT EXISTS (").
+		From("pvz_staff").
+		Where(squirrel.Eq{"user_id": userID, "pvz_id": pvzID}).
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	var assigned bool
+
+	err = p.storage.DB.QueryRow(ctx, query, args...).Scan(&assigned)
+	if err != nil {
+		return false, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return assigned, nil
+}
+
+func (p *pgUser) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	qb := p.storage.Builder.
+		Select("id", "email", "password_hash", "role", "is_active", "created_at").
+		From("users").
+		OrderBy("email")
+
+	if filter.Email != nil && *filter.Email != "" {
+		qb = qb.Where(squirrel.ILike{"email": "%" + escapeLike(*filter.Email) + "%"})
+	}
+
+	if filter.Role != nil {
+		qb = qb.Where(squirrel.Eq{"role": *filter.Role})
+	}
+
+	if filter.Limit != nil {
+		qb = qb.Limit(uint64(*filter.Limit))
+	}
+
+	if filter.Page != nil && filter.Limit != nil {
+		offset := (*filter.Page - 1) * (*filter.Limit)
+		qb = qb.Offset(uint64(offset))
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	rows, err := p.storage.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+
+	for rows.Next() {
+		var user domain.User
+		if err := rows.Scan(
+			&user.ID,
+			&user.Email,
+			&user.PasswordHash,
+			&user.Role,
+			&user.Active,
+			&user.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+		}
+
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return users, nil
+}
+
+func (p *pgUser) UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error {
+	return p.update(ctx, id, "role", role)
+}
+
+func (p *pgUser) SetActive(ctx context.Context, id uuid.UUID, active bool) error {
+	return p.update(ctx, id, "is_active", active)
+}
+
+func (p *pgUser) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	return p.update(ctx, id, "password_hash", passwordHash)
+}
+
+func (p *pgUser) update(ctx context.Context, id uuid.UUID, column string, value any) error {
+	query, args, err := p.storage.Builder.
+		Update("users").
+		Set(column, value).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	tag, err := p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *pgUser) GetByExternalID(
+	ctx context.Context,
+	issuer, subject string,
+) (*domain.User, error) {
+	query, args, err := p.storage.Builder.
+		Select("u.id", "u.email", "u.password_hash", "u.role", "u.is_active", "u.created_at").
+		From("users u").
+		Join("user_identities i ON i.user_id = u.id").
+		Where(squirrel.Eq{"i.issuer": issuer, "i.subject": subject}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	row := p.storage.DB.QueryRow(ctx, query, args...)
+
+	var user domain.User
+	if err := row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.PasswordHash,
+		&user.Role,
+		&user.Active,
+		&user.CreatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return &user, nil
+}
+
+// CreateExternal создает пользователя вместе со связью с внешней учетной
+// записью одним запросом, чтобы не остался пользователь без связи.
+func (p *pgUser) CreateExternal(
+	ctx context.Context,
+	user *domain.User,
+	issuer, subject string,
+) error {
+	const query = `
+WITH u AS (
+    INSERT INTO users (email, password_hash, role, is_active)
+    VALUES ($1, $2, $3, $4)
+    RETURNING id, created_at
+), i AS (
+    INSERT INTO user_identities (issuer, subject, user_id)
+    SELECT $5, $6, id FROM u
+)
+SELECT id, created_at FROM u`
+
+	err := p.storage.DB.QueryRow(
+		ctx,
+		query,
+		user.Email,
+		user.PasswordHash,
+		user.Role,
+		user.Active,
+		issuer,
+		subject,
+	).Scan(&user.ID, &user.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrAlreadyExists
+		}
+
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (p *pgUser) LinkExternal(
+	ctx context.Context,
+	userID uuid.UUID,
+	issuer, subject string,
+) error {
+	query, args, err := p.storage.Builder.
+		Insert("user_identities").
+		Columns("issuer", "subject", "user_id").
+		Values(issuer, subject, userID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	_, err = p.storage.DB.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return domain.ErrAlreadyExists
+			case "23503":
+				return domain.ErrNotFound
+			}
+		}
+
+		return fmt.Errorf("%w (%w)", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+// escapeLike экранирует спецсимволы шаблона LIKE.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -9,8 +9,11 @@ import (
 
 type ProductRepository interface {
 	Create(ctx context.Context, product *domain.Product) error
+	CreateBatch(ctx context.Context, receptionID uuid.UUID, products []*domain.Product) error
 	GetLast(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error)
-	Delete(ctx context.Context, product *domain.Product) error
+	FindByBarcodes(ctx context.Context, barcodes []string) ([]domain.Product, error)
+	GetByReception(ctx context.Context, receptionID uuid.UUID) ([]domain.Product, error)
+	Void(ctx context.Context, void *domain.ProductVoid) (*domain.Product, error)
 }
 
 type Product struct {
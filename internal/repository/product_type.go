@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+)
+
+type ProductTypeRepository interface {
+	Create(ctx context.Context, productType *domain.ProductTypeInfo) error
+	GetByCode(ctx context.Context, code domain.ProductType) (*domain.ProductTypeInfo, error)
+	List(ctx context.Context) ([]domain.ProductTypeInfo, error)
+	Update(ctx context.Context, productType *domain.ProductTypeInfo) error
+}
+
+type ProductType struct {
+	ProductTypeRepository
+}
+
+func NewProductType(p ProductTypeRepository) *ProductType {
+	return &ProductType{
+		ProductTypeRepository: p,
+	}
+}
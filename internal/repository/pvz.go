@@ -9,9 +9,15 @@ import (
 
 type PVZRepository interface {
 	Create(ctx context.Context, pvz *domain.PVZ) error
-	GetAll(ctx context.Context) ([]domain.PVZ, error)
+	GetAll(ctx context.Context, status *domain.PVZStatus) ([]domain.PVZ, error)
 	GetWithParam(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error)
-	Exist(ctx context.Context, pvz uuid.UUID) error
+	GetStatus(ctx context.Context, id uuid.UUID) (domain.PVZStatus, error)
+	SetStatus(ctx context.Context, change *domain.PVZStatusChange) error
+	GetStaff(ctx context.Context, pvz uuid.UUID) ([]domain.User, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.PVZ, error)
+	UpdateProfile(ctx context.Context, change *domain.PVZProfileChange) error
+	GetProfileHistory(ctx context.Context, pvzID uuid.UUID) ([]domain.PVZProfileChange, error)
+	FindNearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error)
 }
 
 type PVZ struct {
@@ -3,14 +3,23 @@ package repository
 import (
 	"avito_pvz/internal/models/domain"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type ReceptionRepository interface {
 	Close(ctx context.Context, reception domain.Reception) error
+	CloseReconciled(
+		ctx context.Context,
+		reception domain.Reception,
+		report *domain.ReceptionReconciliation,
+	) error
 	GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error)
 	Create(ctx context.Context, reception domain.Reception) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Reception, error)
+	Reopen(ctx context.Context, correction *domain.ReceptionCorrection) error
+	CloseIdle(ctx context.Context, idleSince time.Time) ([]domain.Reception, error)
 }
 
 type Reception struct {
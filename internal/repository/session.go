@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"avito_pvz/internal/models/domain"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, token *domain.RefreshToken) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error)
+	GetByHash(ctx context.Context, hash string) (*domain.RefreshToken, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeAllByUser(ctx context.Context, userID uuid.UUID) error
+}
+
+type Session struct {
+	SessionRepository
+}
+
+func NewSession(s SessionRepository) *Session {
+	return &Session{
+		SessionRepository: s,
+	}
+}
@@ -3,11 +3,24 @@ package repository
 import (
 	"avito_pvz/internal/models/domain"
 	"context"
+
+	"github.com/google/uuid"
 )
 
 type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
 	Create(ctx context.Context, user *domain.User) error
+	List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)
+	UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error
+	SetActive(ctx context.Context, id uuid.UUID, active bool) error
+	UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error
+	AssignPVZ(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error
+	UnassignPVZ(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error
+	IsAssigned(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) (bool, error)
+	GetByExternalID(ctx context.Context, issuer, subject string) (*domain.User, error)
+	CreateExternal(ctx context.Context, user *domain.User, issuer, subject string) error
+	LinkExternal(ctx context.Context, userID uuid.UUID, issuer, subject string) error
 }
 
 type User struct {
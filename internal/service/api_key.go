@@ -0,0 +1,142 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// apiKeyTouchInterval ограничивает частоту обновления last_used_at,
+// чтобы каждый запрос по ключу не превращался в запись в базу.
+const apiKeyTouchInterval = time.Minute
+
+type APIKeyProvider interface {
+	Create(ctx context.Context, key *domain.APIKey) error
+	GetByHash(ctx context.Context, hash string) (*domain.APIKey, error)
+	List(ctx context.Context) ([]domain.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	Touch(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// APIKey управляет ключами интеграций и аутентифицирует запросы по ним.
+type APIKey struct {
+	keys  APIKeyProvider
+	audit AuditRecorder
+}
+
+// Create выпускает ключ от имени модератора из контекста
+// и возвращает его исходное значение.
+func (a *APIKey) Create(
+	ctx context.Context,
+	params domain.APIKeyToCreate,
+) (*domain.APIKey, string, error) {
+	identity, ok := domain.IdentityFromCtx(ctx)
+	if !ok {
+		return nil, "", models.ErrInternal
+	}
+
+	key, raw, err := domain.NewAPIKey(params, identity.UserID)
+	if err != nil {
+		return nil, "", mapAPIKeyErr(err)
+	}
+
+	err = a.keys.Create(ctx, key)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, "", models.ErrPVZNotFound
+	}
+
+	if err != nil {
+		return nil, "", models.ErrInternal
+	}
+
+	a.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditAPIKeyCreate,
+		Entity:   domain.AuditEntityAPIKey,
+		EntityID: key.ID,
+		After:    key.ToDTO(),
+	})
+
+	return key, raw, nil
+}
+
+func (a *APIKey) List(ctx context.Context) ([]domain.APIKey, error) {
+	keys, err := a.keys.List(ctx)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return keys, nil
+}
+
+func (a *APIKey) Revoke(ctx context.Context, id uuid.UUID) error {
+	err := a.keys.Revoke(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.ErrAPIKeyNotFound
+	}
+
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	a.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditAPIKeyRevoke,
+		Entity:   domain.AuditEntityAPIKey,
+		EntityID: id,
+	})
+
+	return nil
+}
+
+// Authenticate проверяет ключ и возвращает личность,
+// от имени которой выполняется запрос.
+func (a *APIKey) Authenticate(ctx context.Context, raw string) (*domain.Identity, error) {
+	key, err := a.keys.GetByHash(ctx, domain.HashToken(raw))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrInvalidAPIKey
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	now := time.Now()
+
+	if !key.IsActive(now) {
+		return nil, models.ErrInvalidAPIKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		// Учет использования не должен ломать запрос.
+		_ = a.keys.Touch(ctx, key.ID, now)
+	}
+
+	identity := key.Identity()
+
+	return &identity, nil
+}
+
+func mapAPIKeyErr(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidRole):
+		return models.ErrInvalidRole
+	case errors.Is(err, domain.ErrInvalidScope):
+		return models.ErrInvalidScope
+	case errors.Is(err, domain.ErrInvalidAPIKeyName):
+		return models.ErrInvalidAPIKeyName
+	case errors.Is(err, domain.ErrInvalidExpiry):
+		return models.ErrInvalidExpiry
+	default:
+		return models.ErrInternal
+	}
+}
+
+func NewAPIKeyService(keys APIKeyProvider, audit AuditRecorder) *APIKey {
+	return &APIKey{
+		keys:  keys,
+		audit: audit,
+	}
+}
@@ -0,0 +1,360 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func moderatorCtx(id uuid.UUID) context.Context {
+	return domain.WithIdentity(context.Background(), domain.Identity{
+		UserID: id,
+		Role:   domain.RoleModerator,
+	})
+}
+
+func TestAPIKey_Create(t *testing.T) {
+	t.Parallel()
+
+	moderatorID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+
+	valid := domain.APIKeyToCreate{
+		Name:   "warehouse scanner",
+		Role:   domain.RoleEmploye,
+		Scopes: []domain.Scope{domain.ScopeProductsWrite, domain.ScopeReceptionsWrite},
+	}
+
+	tests := []struct {
+		name       string
+		params     func() domain.APIKeyToCreate
+		setupMocks func(keys *service.MockAPIKeyProvider)
+		wantErr    error
+	}{
+		{
+			name:   "created",
+			params: func() domain.APIKeyToCreate { return valid },
+			setupMocks: func(keys *service.MockAPIKeyProvider) {
+				keys.On("Create", mock.Anything, mock.MatchedBy(func(k *domain.APIKey) bool {
+					return k.CreatedBy == moderatorID && k.KeyHash != ""
+				})).Return(nil)
+			},
+		},
+		{
+			name: "empty_name",
+			params: func() domain.APIKeyToCreate {
+				p := valid
+				p.Name = "  "
+
+				return p
+			},
+			wantErr: models.ErrInvalidAPIKeyName,
+		},
+		{
+			name: "unknown_scope",
+			params: func() domain.APIKeyToCreate {
+				p := valid
+				p.Scopes = []domain.Scope{"users:write"}
+
+				return p
+			},
+			wantErr: models.ErrInvalidScope,
+		},
+		{
+			name: "no_scopes",
+			params: func() domain.APIKeyToCreate {
+				p := valid
+				p.Scopes = nil
+
+				return p
+			},
+			wantErr: models.ErrInvalidScope,
+		},
+		{
+			name: "invalid_role",
+			params: func() domain.APIKeyToCreate {
+				p := valid
+				p.Role = "admin"
+
+				return p
+			},
+			wantErr: models.ErrInvalidRole,
+		},
+		{
+			name: "expiry_in_past",
+			params: func() domain.APIKeyToCreate {
+				p := valid
+				p.ExpiresAt = &past
+
+				return p
+			},
+			wantErr: models.ErrInvalidExpiry,
+		},
+		{
+			name: "unknown_pvz",
+			params: func() domain.APIKeyToCreate {
+				p := valid
+				pvzID := uuid.New()
+				p.PVZID = &pvzID
+
+				return p
+			},
+			setupMocks: func(keys *service.MockAPIKeyProvider) {
+				keys.On("Create", mock.Anything, mock.Anything).Return(domain.ErrNotFound)
+			},
+			wantErr: models.ErrPVZNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys := service.NewMockAPIKeyProvider(t)
+			if tt.setupMocks != nil {
+				tt.setupMocks(keys)
+			}
+
+			svc := service.NewAPIKeyService(keys, noAudit(t))
+
+			key, raw, err := svc.Create(moderatorCtx(moderatorID), tt.params())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, domain.IsAPIKey(raw))
+			require.Equal(t, domain.HashToken(raw), key.KeyHash)
+			require.Equal(t,
+				[]domain.Scope{domain.ScopeProductsWrite, domain.ScopeReceptionsWrite},
+				key.Scopes,
+			)
+		})
+	}
+}
+
+func TestAPIKey_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	recent := time.Now().Add(-time.Second)
+	stale := time.Now().Add(-time.Hour)
+
+	active := func() *domain.APIKey {
+		return &domain.APIKey{
+			ID:     uuid.New(),
+			Role:   domain.RoleEmploye,
+			Scopes: []domain.Scope{domain.ScopeReceptionsWrite},
+			PVZID:  &pvzID,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		setupMocks func(keys *service.MockAPIKeyProvider)
+		wantErr    error
+	}{
+		{
+			name: "first_use_is_recorded",
+			setupMocks: func(keys *service.MockAPIKeyProvider) {
+				key := active()
+				keys.On("GetByHash", mock.Anything, domain.HashToken("pvzk_raw")).Return(key, nil)
+				keys.On("Touch", mock.Anything, key.ID, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "recent_use_is_not_rewritten",
+			setupMocks: func(keys *service.MockAPIKeyProvider) {
+				key := active()
+				key.LastUsedAt = &recent
+				keys.On("GetByHash", mock.Anything, mock.Anything).Return(key, nil)
+			},
+		},
+		{
+			name: "touch_failure_is_ignored",
+			setupMocks: func(keys *service.MockAPIKeyProvider) {
+				key := active()
+				key.LastUsedAt = &stale
+				keys.On("GetByHash", mock.Anything, mock.Anything).Return(key, nil)
+				keys.On("Touch", mock.Anything, key.ID, mock.Anything).Return(domain.ErrInternal)
+			},
+		},
+		{
+			name: "unknown_key",
+			setupMocks: func(keys *service.MockAPIKeyProvider) {
+				keys.On("GetByHash", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrInvalidAPIKey,
+		},
+		{
+			name: "revoked_key",
+			setupMocks: func(keys *service.MockAPIKeyProvider) {
+				key := active()
+				key.RevokedAt = &recent
+				keys.On("GetByHash", mock.Anything, mock.Anything).Return(key, nil)
+			},
+			wantErr: models.ErrInvalidAPIKey,
+		},
+		{
+			name: "expired_key",
+			setupMocks: func(keys *service.MockAPIKeyProvider) {
+				key := active()
+				key.ExpiresAt = &stale
+				keys.On("GetByHash", mock.Anything, mock.Anything).Return(key, nil)
+			},
+			wantErr: models.ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys := service.NewMockAPIKeyProvider(t)
+			tt.setupMocks(keys)
+
+			identity, err := service.NewAPIKeyService(keys, noAudit(t)).
+				Authenticate(context.Background(), "pvzk_raw")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, identity.IsAPIKey())
+			require.Equal(t, uuid.Nil, identity.UserID)
+			require.Equal(t, identity.APIKeyID, identity.ActorID())
+			require.Equal(t, domain.RoleEmploye, identity.Role)
+			require.Equal(t, &pvzID, identity.PVZID)
+		})
+	}
+}
+
+func TestAPIKey_Revoke(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+
+	keys := service.NewMockAPIKeyProvider(t)
+	keys.On("Revoke", mock.Anything, id).Return(domain.ErrNotFound)
+
+	err := service.NewAPIKeyService(keys, noAudit(t)).Revoke(context.Background(), id)
+	require.ErrorIs(t, err, models.ErrAPIKeyNotFound)
+}
+
+func TestAuthenticator_DispatchesByTokenKind(t *testing.T) {
+	t.Parallel()
+
+	sessions := service.NewMockTokenAuthenticator(t)
+	apiKeys := service.NewMockTokenAuthenticator(t)
+
+	userIdentity := &domain.Identity{UserID: uuid.New(), Role: domain.RoleModerator}
+	keyIdentity := &domain.Identity{Actor: domain.ActorAPIKey, APIKeyID: uuid.New()}
+
+	sessions.On("Authenticate", mock.Anything, "eyJhbGciOi.jwt").Return(userIdentity, nil)
+	apiKeys.On("Authenticate", mock.Anything, "pvzk_secret").Return(keyIdentity, nil)
+
+	auth := service.NewAuthenticator(sessions, apiKeys, false)
+
+	got, err := auth.Authenticate(context.Background(), "eyJhbGciOi.jwt")
+	require.NoError(t, err)
+	require.Equal(t, userIdentity, got)
+
+	got, err = auth.Authenticate(context.Background(), "pvzk_secret")
+	require.NoError(t, err)
+	require.Equal(t, keyIdentity, got)
+}
+
+func TestAuthenticator_DummyTokens(t *testing.T) {
+	t.Parallel()
+
+	dummy := &domain.Identity{UserID: uuid.New(), Role: domain.RoleModerator, Actor: domain.ActorDummy}
+
+	tests := []struct {
+		name       string
+		allowDummy bool
+		wantErr    error
+	}{
+		{
+			name:       "accepted_when_enabled",
+			allowDummy: true,
+		},
+		{
+			name:    "rejected_when_disabled",
+			wantErr: models.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sessions := service.NewMockTokenAuthenticator(t)
+			sessions.On("Authenticate", mock.Anything, "dummy.jwt").Return(dummy, nil)
+
+			auth := service.NewAuthenticator(
+				sessions,
+				service.NewMockTokenAuthenticator(t),
+				tt.allowDummy,
+			)
+
+			got, err := auth.Authenticate(context.Background(), "dummy.jwt")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, got)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, dummy, got)
+		})
+	}
+}
+
+func TestAuthenticator_DispatchesByIssuer(t *testing.T) {
+	t.Parallel()
+
+	external := service.NewMockExternalAuthenticator(t)
+	external.On("Issuer").Return("https://sso.example.com")
+
+	sessions := service.NewMockTokenAuthenticator(t)
+
+	ssoToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Issuer: "https://sso.example.com/",
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	ssoIdentity := &domain.Identity{UserID: uuid.New(), Role: domain.RoleEmploye}
+	userIdentity := &domain.Identity{UserID: uuid.New(), Role: domain.RoleModerator}
+
+	external.On("Authenticate", mock.Anything, ssoToken).Return(ssoIdentity, nil)
+	sessions.On("Authenticate", mock.Anything, "own.jwt").Return(userIdentity, nil)
+
+	auth := service.NewAuthenticator(
+		sessions,
+		service.NewMockTokenAuthenticator(t),
+		false,
+		external,
+	)
+
+	got, err := auth.Authenticate(context.Background(), ssoToken)
+	require.NoError(t, err)
+	require.Equal(t, ssoIdentity, got)
+
+	got, err = auth.Authenticate(context.Background(), "own.jwt")
+	require.NoError(t, err)
+	require.Equal(t, userIdentity, got)
+}
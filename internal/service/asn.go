@@ -0,0 +1,136 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type ASNProvider interface {
+	Create(ctx context.Context, asn *domain.ASN) error
+	GetReconciliation(ctx context.Context, receptionID uuid.UUID) (*domain.ReceptionReconciliation, error)
+}
+
+type ReceptionFinder interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Reception, error)
+}
+
+// ASN ведет манифесты ожидаемых поставок. Сверка с манифестом выполняется
+// при закрытии приемки, см. Reception.CloseLastReception.
+type ASN struct {
+	repo       ASNProvider
+	pvz        PVZChecker
+	receptions ReceptionFinder
+	staff      AssignmentChecker
+	types      ProductTypeResolver
+	audit      AuditRecorder
+}
+
+// Create сохраняет манифест поставки в работающий ПВЗ. Типы в строках
+// принимаются как код или название из справочника и сохраняются кодом.
+func (a *ASN) Create(ctx context.Context, req domain.ASNRequest) (*domain.ASN, error) {
+	for i, line := range req.Lines {
+		if line.Type == "" {
+			continue
+		}
+
+		productType, err := a.types.Resolve(ctx, string(line.Type))
+		if err != nil {
+			return nil, err
+		}
+
+		req.Lines[i].Type = productType.Code
+	}
+
+	asn, err := domain.NewASN(ctx, req)
+	if err != nil {
+		return nil, asnError(err)
+	}
+
+	err = checkPVZStatus(ctx, a.pvz, asn.PVZID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.repo.Create(ctx, asn)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	a.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditASNCreate,
+		Entity:   domain.AuditEntityASN,
+		EntityID: asn.ID,
+		After:    asn.ToDTO(),
+	})
+
+	return asn, nil
+}
+
+// Reconciliation возвращает последнюю сверку приемки с манифестом.
+// Сотрудник видит сверки только приемок своих ПВЗ.
+func (a *ASN) Reconciliation(
+	ctx context.Context,
+	receptionID uuid.UUID,
+) (*domain.ReceptionReconciliation, error) {
+	reception, err := a.receptions.GetByID(ctx, receptionID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrReceptionNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	err = checkPVZAccess(ctx, a.staff, reception.PvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := a.repo.GetReconciliation(ctx, receptionID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrReconciliationNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return report, nil
+}
+
+func asnError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrEmptyASN):
+		return models.ErrEmptyASN
+	case errors.Is(err, domain.ErrASNTooLarge):
+		return models.ErrASNTooLarge
+	case errors.Is(err, domain.ErrInvalidASNLine):
+		return models.ErrInvalidASNLine
+	case errors.Is(err, domain.ErrInvalidASNReference):
+		return models.ErrInvalidASNReference
+	default:
+		return models.ErrInternal
+	}
+}
+
+func NewASNService(
+	repo ASNProvider,
+	pvz PVZChecker,
+	receptions ReceptionFinder,
+	staff AssignmentChecker,
+	types ProductTypeResolver,
+	audit AuditRecorder,
+) *ASN {
+	return &ASN{
+		repo:       repo,
+		pvz:        pvz,
+		receptions: receptions,
+		staff:      staff,
+		types:      types,
+		audit:      audit,
+	}
+}
@@ -0,0 +1,220 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestASN_Create(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name       string
+		lines      []domain.ASNLine
+		setupMocks func(*service.MockASNProvider, *service.MockPVZChecker)
+		wantErr    error
+	}{
+		{
+			name: "type names resolved to codes",
+			lines: []domain.ASNLine{
+				{Barcode: "A1"},
+				{Type: "обувь", Quantity: 4},
+			},
+			setupMocks: func(repo *service.MockASNProvider, pvz *service.MockPVZChecker) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				repo.On("Create", mock.Anything, mock.MatchedBy(func(asn *domain.ASN) bool {
+					return asn.PVZID == pvzID &&
+						asn.Lines[0] == domain.ASNLine{Barcode: "A1", Quantity: 1} &&
+						asn.Lines[1] == domain.ASNLine{Type: "shoes", Quantity: 4}
+				})).Return(nil)
+			},
+		},
+		{
+			name: "same type under code and name",
+			lines: []domain.ASNLine{
+				{Type: "shoes", Quantity: 1},
+				{Type: "обувь", Quantity: 2},
+			},
+			wantErr: models.ErrInvalidASNLine,
+		},
+		{
+			name:    "inactive type",
+			lines:   []domain.ASNLine{{Type: "clothing", Quantity: 1}},
+			wantErr: models.ErrInvalidProductType,
+		},
+		{
+			name:    "empty manifest",
+			wantErr: models.ErrEmptyASN,
+		},
+		{
+			name:  "pvz closed",
+			lines: []domain.ASNLine{{Barcode: "A1"}},
+			setupMocks: func(repo *service.MockASNProvider, pvz *service.MockPVZChecker) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusClosed, nil)
+			},
+			wantErr: models.ErrPVZClosed,
+		},
+		{
+			name:  "pvz not found",
+			lines: []domain.ASNLine{{Barcode: "A1"}},
+			setupMocks: func(repo *service.MockASNProvider, pvz *service.MockPVZChecker) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatus(""), domain.ErrNotFound)
+			},
+			wantErr: models.ErrPVZNotFound,
+		},
+		{
+			name:  "create fails",
+			lines: []domain.ASNLine{{Barcode: "A1"}},
+			setupMocks: func(repo *service.MockASNProvider, pvz *service.MockPVZChecker) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				repo.On("Create", mock.Anything, mock.Anything).Return(domain.ErrInternal)
+			},
+			wantErr: models.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := service.NewMockASNProvider(t)
+			pvz := service.NewMockPVZChecker(t)
+
+			if tt.setupMocks != nil {
+				tt.setupMocks(repo, pvz)
+			}
+
+			svc := service.NewASNService(
+				repo,
+				pvz,
+				service.NewMockReceptionFinder(t),
+				service.NewMockAssignmentChecker(t),
+				knownProductTypes(t),
+				noAudit(t),
+			)
+
+			asn, err := svc.Create(moderatorCtx(uuid.New()), domain.ASNRequest{
+				PVZID: domain.PVZID(pvzID),
+				Lines: tt.lines,
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, asn)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, asn.CreatedBy)
+			assert.Nil(t, asn.ReceptionID)
+		})
+	}
+}
+
+func TestASN_Reconciliation(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	reception := &domain.Reception{ID: uuid.New(), PvzID: pvzID}
+	report := &domain.ReceptionReconciliation{ReceptionID: reception.ID}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		setupMocks func(*service.MockReceptionFinder, *service.MockAssignmentChecker, *service.MockASNProvider)
+		want       *domain.ReceptionReconciliation
+		wantErr    error
+	}{
+		{
+			name: "found",
+			ctx:  employeeCtx(),
+			setupMocks: func(rp *service.MockReceptionFinder, staff *service.MockAssignmentChecker, repo *service.MockASNProvider) {
+				rp.On("GetByID", mock.Anything, reception.ID).Return(reception, nil)
+				staff.On("IsAssigned", mock.Anything, mock.Anything, pvzID).Return(true, nil)
+				repo.On("GetReconciliation", mock.Anything, reception.ID).Return(report, nil)
+			},
+			want: report,
+		},
+		{
+			name: "moderator is not assigned to pvz",
+			ctx:  moderatorCtx(uuid.New()),
+			setupMocks: func(rp *service.MockReceptionFinder, _ *service.MockAssignmentChecker, repo *service.MockASNProvider) {
+				rp.On("GetByID", mock.Anything, reception.ID).Return(reception, nil)
+				repo.On("GetReconciliation", mock.Anything, reception.ID).Return(report, nil)
+			},
+			want: report,
+		},
+		{
+			name: "employee of another pvz",
+			ctx:  employeeCtx(),
+			setupMocks: func(rp *service.MockReceptionFinder, staff *service.MockAssignmentChecker, _ *service.MockASNProvider) {
+				rp.On("GetByID", mock.Anything, reception.ID).Return(reception, nil)
+				staff.On("IsAssigned", mock.Anything, mock.Anything, pvzID).Return(false, nil)
+			},
+			wantErr: models.ErrPVZAccessDenied,
+		},
+		{
+			name: "reception not found",
+			ctx:  employeeCtx(),
+			setupMocks: func(rp *service.MockReceptionFinder, _ *service.MockAssignmentChecker, _ *service.MockASNProvider) {
+				rp.On("GetByID", mock.Anything, reception.ID).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrReceptionNotFound,
+		},
+		{
+			name: "not reconciled",
+			ctx:  employeeCtx(),
+			setupMocks: func(rp *service.MockReceptionFinder, staff *service.MockAssignmentChecker, repo *service.MockASNProvider) {
+				rp.On("GetByID", mock.Anything, reception.ID).Return(reception, nil)
+				staff.On("IsAssigned", mock.Anything, mock.Anything, pvzID).Return(true, nil)
+				repo.On("GetReconciliation", mock.Anything, reception.ID).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrReconciliationNotFound,
+		},
+		{
+			name: "repository fails",
+			ctx:  employeeCtx(),
+			setupMocks: func(rp *service.MockReceptionFinder, staff *service.MockAssignmentChecker, repo *service.MockASNProvider) {
+				rp.On("GetByID", mock.Anything, reception.ID).Return(reception, nil)
+				staff.On("IsAssigned", mock.Anything, mock.Anything, pvzID).Return(true, nil)
+				repo.On("GetReconciliation", mock.Anything, reception.ID).Return(nil, domain.ErrInternal)
+			},
+			wantErr: models.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			receptions := service.NewMockReceptionFinder(t)
+			staff := service.NewMockAssignmentChecker(t)
+			repo := service.NewMockASNProvider(t)
+			tt.setupMocks(receptions, staff, repo)
+
+			svc := service.NewASNService(
+				repo,
+				service.NewMockPVZChecker(t),
+				receptions,
+				staff,
+				service.NewMockProductTypeResolver(t),
+				noAudit(t),
+			)
+
+			got, err := svc.Reconciliation(tt.ctx, reception.ID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"log/slog"
+)
+
+type AuditRecorder interface {
+	Record(ctx context.Context, event domain.AuditEvent)
+}
+
+type AuditProvider interface {
+	Create(ctx context.Context, entry *domain.AuditEntry) error
+	List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+}
+
+// Audit ведет журнал изменений с указанием исполнителя.
+type Audit struct {
+	entries AuditProvider
+	log     *slog.Logger
+}
+
+// Record добавляет запись в журнал. Изменение к этому моменту уже
+// сохранено, поэтому ошибка записи не возвращается: клиент получил бы
+// ошибку за выполненное действие, а повтор упал бы или повторил его.
+// Вместо этого запись целиком пишется в лог, чтобы ее можно было восстановить.
+func (a *Audit) Record(ctx context.Context, event domain.AuditEvent) {
+	entry, err := domain.NewAuditEntry(ctx, event)
+	if err == nil {
+		err = a.entries.Create(ctx, entry)
+	}
+
+	if err != nil {
+		a.log.ErrorContext(ctx, "audit record lost",
+			slog.String("action", string(event.Action)),
+			slog.String("entity", string(event.Entity)),
+			slog.String("entity_id", event.EntityID.String()),
+			slog.String("request_id", domain.RequestIDFromCtx(ctx)),
+			slog.Any("entry", entry),
+			slog.Any("error", err),
+		)
+	}
+}
+
+func (a *Audit) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, models.ErrInvalidTimeRange
+	}
+
+	entries, err := a.entries.List(ctx, filter)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return entries, nil
+}
+
+func NewAuditService(entries AuditProvider, log *slog.Logger) *Audit {
+	return &Audit{
+		entries: entries,
+		log:     log,
+	}
+}
@@ -0,0 +1,331 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// noAudit принимает любые события журнала аудита.
+func noAudit(t *testing.T) *service.MockAuditRecorder {
+	t.Helper()
+
+	audit := service.NewMockAuditRecorder(t)
+	audit.On("Record", mock.Anything, mock.Anything).Maybe()
+
+	return audit
+}
+
+func TestAudit_Record(t *testing.T) {
+	t.Parallel()
+
+	entityID := uuid.New()
+	keyID := uuid.New()
+
+	tests := []struct {
+		name      string
+		ctx       func() context.Context
+		wantActor *uuid.UUID
+		wantType  domain.ActorType
+		wantRole  domain.Role
+	}{
+		{
+			name:     "anonymous",
+			ctx:      context.Background,
+			wantType: domain.ActorAnonymous,
+		},
+		{
+			name: "dummy",
+			ctx: func() context.Context {
+				return domain.WithIdentity(context.Background(), domain.Identity{
+					UserID: keyID,
+					Role:   domain.RoleModerator,
+					Actor:  domain.ActorDummy,
+				})
+			},
+			wantActor: &keyID,
+			wantType:  domain.ActorDummy,
+			wantRole:  domain.RoleModerator,
+		},
+		{
+			name: "api_key",
+			ctx: func() context.Context {
+				return domain.WithIdentity(context.Background(), domain.Identity{
+					Role:     domain.RoleEmploye,
+					Actor:    domain.ActorAPIKey,
+					APIKeyID: keyID,
+				})
+			},
+			wantActor: &keyID,
+			wantType:  domain.ActorAPIKey,
+			wantRole:  domain.RoleEmploye,
+		},
+		{
+			name: "system",
+			ctx: func() context.Context {
+				return domain.WithSystemActor(context.Background())
+			},
+			wantType: domain.ActorSystem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *domain.AuditEntry
+
+			entries := service.NewMockAuditProvider(t)
+			entries.On("Create", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) { got = args.Get(1).(*domain.AuditEntry) }).
+				Return(nil)
+
+			audit := service.NewAuditService(entries, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			ctx := domain.WithRequestID(tt.ctx(), "req-1")
+			audit.Record(ctx, domain.AuditEvent{
+				Action:   domain.AuditReceptionClose,
+				Entity:   domain.AuditEntityReception,
+				EntityID: entityID,
+				Before:   map[string]string{"status": "in_progress"},
+				After:    map[string]string{"status": "close"},
+			})
+
+			require.NotNil(t, got)
+			require.Equal(t, tt.wantActor, got.ActorID)
+			require.Equal(t, tt.wantType, got.ActorType)
+			require.Equal(t, tt.wantRole, got.ActorRole)
+			require.Equal(t, entityID, got.EntityID)
+			require.Equal(t, "req-1", got.RequestID)
+			require.JSONEq(t, `{"status":"in_progress"}`, string(got.Before))
+			require.JSONEq(t, `{"status":"close"}`, string(got.After))
+		})
+	}
+}
+
+func TestAudit_RecordFailureIsLogged(t *testing.T) {
+	t.Parallel()
+
+	entries := service.NewMockAuditProvider(t)
+	entries.On("Create", mock.Anything, mock.Anything).Return(domain.ErrInternal)
+
+	var logs bytes.Buffer
+
+	audit := service.NewAuditService(entries, slog.New(slog.NewTextHandler(&logs, nil)))
+
+	entityID := uuid.New()
+	audit.Record(context.Background(), domain.AuditEvent{
+		Action:   domain.AuditPVZCreate,
+		Entity:   domain.AuditEntityPVZ,
+		EntityID: entityID,
+	})
+
+	require.Contains(t, logs.String(), "audit record lost")
+	require.Contains(t, logs.String(), entityID.String())
+}
+
+func TestAudit_List(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	entries := service.NewMockAuditProvider(t)
+	entries.On("List", mock.Anything, mock.Anything).Return([]domain.AuditEntry{}, nil).Once()
+
+	audit := service.NewAuditService(entries, slog.Default())
+
+	_, err := audit.List(context.Background(), domain.AuditFilter{From: &earlier, To: &now})
+	require.NoError(t, err)
+
+	_, err = audit.List(context.Background(), domain.AuditFilter{From: &now, To: &earlier})
+	require.ErrorIs(t, err, models.ErrInvalidTimeRange)
+}
+
+func TestProduct_DeleteLastIsAudited(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	reception := &domain.Reception{ID: uuid.New(), PvzID: pvzID, Status: domain.ReceptionStatusInProgress}
+	product := &domain.Product{ID: uuid.New(), ReceptionID: reception.ID, Type: "shoes"}
+
+	pvz := service.NewMockPVZChecker(t)
+	pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+
+	receptions := service.NewMockReceptionGetter(t)
+	receptions.On("GetLast", mock.Anything, pvzID).Return(reception, nil)
+
+	products := service.NewMockProductProvider(t)
+	products.On("GetLast", mock.Anything, reception.ID).Return(product, nil)
+	products.On("Void", mock.Anything, mock.Anything).Return(product, nil)
+
+	audit := service.NewMockAuditRecorder(t)
+	audit.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuditEvent) bool {
+		before, _ := json.Marshal(e.Before)
+		after, _ := json.Marshal(e.After)
+
+		return e.Action == domain.AuditProductDelete &&
+			e.EntityID == product.ID &&
+			string(before) != "null" &&
+			string(after) != "null"
+	})).Once()
+
+	svc := service.NewProduct(
+		products,
+		receptions,
+		pvz,
+		knownProductTypes(t),
+		assignedStaff(t),
+		audit,
+	)
+
+	err := svc.DeleteLast(employeeCtx(), domain.PVZID(pvzID))
+	require.NoError(t, err)
+}
+
+// Товар уже аннулирован, поэтому потерянная запись журнала не превращает
+// выполненное действие в ошибку.
+func TestProduct_DeleteLastSucceedsWhenAuditLost(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	reception := &domain.Reception{ID: uuid.New(), PvzID: pvzID, Status: domain.ReceptionStatusInProgress}
+	product := &domain.Product{ID: uuid.New(), ReceptionID: reception.ID, Type: "shoes"}
+
+	pvz := service.NewMockPVZChecker(t)
+	pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+
+	receptions := service.NewMockReceptionGetter(t)
+	receptions.On("GetLast", mock.Anything, pvzID).Return(reception, nil)
+
+	products := service.NewMockProductProvider(t)
+	products.On("GetLast", mock.Anything, reception.ID).Return(product, nil)
+	products.On("Void", mock.Anything, mock.Anything).Return(product, nil)
+
+	entries := service.NewMockAuditProvider(t)
+	entries.On("Create", mock.Anything, mock.Anything).Return(domain.ErrInternal).Once()
+
+	audit := service.NewAuditService(entries, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	svc := service.NewProduct(
+		products,
+		receptions,
+		pvz,
+		knownProductTypes(t),
+		assignedStaff(t),
+		audit,
+	)
+
+	err := svc.DeleteLast(employeeCtx(), domain.PVZID(pvzID))
+	require.NoError(t, err)
+}
+
+func TestUser_ChangeRoleIsAudited(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	repo := service.NewMockUserProvider(t)
+	repo.On("GetByID", mock.Anything, userID).
+		Return(&domain.User{ID: userID, Email: "user@example.com", Role: domain.RoleEmploye}, nil).Once()
+	repo.On("UpdateRole", mock.Anything, userID, domain.RoleModerator).Return(nil)
+	repo.On("GetByID", mock.Anything, userID).
+		Return(&domain.User{ID: userID, Email: "user@example.com", Role: domain.RoleModerator}, nil).Once()
+
+	sessions := service.NewMockSessionManager(t)
+	sessions.On("RevokeAll", mock.Anything, userID).Return(nil)
+
+	audit := service.NewMockAuditRecorder(t)
+	audit.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuditEvent) bool {
+		before, errBefore := json.Marshal(e.Before)
+		after, errAfter := json.Marshal(e.After)
+
+		return e.Action == domain.AuditUserRoleChange &&
+			e.EntityID == userID &&
+			errBefore == nil && errAfter == nil &&
+			string(before) != string(after)
+	})).Once()
+
+	svc := service.NewUserService(
+		repo,
+		sessions,
+		openLockout(t),
+		testHasher(),
+		testPolicy,
+		audit,
+	)
+
+	_, err := svc.ChangeRole(moderatorCtx(uuid.New()), userID, domain.RoleModerator)
+	require.NoError(t, err)
+}
+
+func TestStaff_AssignmentIsAudited(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	employee := &domain.User{ID: uuid.New(), Role: domain.RoleEmploye}
+
+	pvz := service.NewMockStaffLister(t)
+	pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+
+	users := service.NewMockStaffProvider(t)
+	users.On("GetByID", mock.Anything, employee.ID).Return(employee, nil)
+	users.On("AssignPVZ", mock.Anything, employee.ID, pvzID).Return(nil)
+	users.On("UnassignPVZ", mock.Anything, employee.ID, pvzID).Return(nil)
+
+	audit := service.NewMockAuditRecorder(t)
+	for _, action := range []domain.AuditAction{domain.AuditStaffAssign, domain.AuditStaffUnassign} {
+		audit.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuditEvent) bool {
+			return e.Action == action && e.EntityID == employee.ID
+		})).Once()
+	}
+
+	svc := service.NewStaffService(users, pvz, audit)
+
+	require.NoError(t, svc.Assign(moderatorCtx(uuid.New()), domain.PVZID(pvzID), employee.ID))
+	require.NoError(t, svc.Unassign(moderatorCtx(uuid.New()), domain.PVZID(pvzID), employee.ID))
+}
+
+func TestAPIKey_LifecycleIsAudited(t *testing.T) {
+	t.Parallel()
+
+	keys := service.NewMockAPIKeyProvider(t)
+	keys.On("Create", mock.Anything, mock.Anything).Return(nil)
+	keys.On("Revoke", mock.Anything, mock.Anything).Return(nil)
+
+	var created uuid.UUID
+
+	audit := service.NewMockAuditRecorder(t)
+	audit.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuditEvent) bool {
+		return e.Action == domain.AuditAPIKeyCreate && e.Entity == domain.AuditEntityAPIKey
+	})).Run(func(args mock.Arguments) {
+		created = args.Get(1).(domain.AuditEvent).EntityID
+	}).Once()
+	audit.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuditEvent) bool {
+		return e.Action == domain.AuditAPIKeyRevoke && e.EntityID == created
+	})).Once()
+
+	svc := service.NewAPIKeyService(keys, audit)
+
+	key, _, err := svc.Create(moderatorCtx(uuid.New()), domain.APIKeyToCreate{
+		Name:   "erp",
+		Role:   domain.RoleEmploye,
+		Scopes: []domain.Scope{domain.ScopePVZRead},
+	})
+	require.NoError(t, err)
+	require.Equal(t, key.ID, created)
+
+	require.NoError(t, svc.Revoke(moderatorCtx(uuid.New()), key.ID))
+}
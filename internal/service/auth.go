@@ -0,0 +1,86 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type TokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (*domain.Identity, error)
+}
+
+// ExternalAuthenticator проверяет токены внешнего издателя.
+type ExternalAuthenticator interface {
+	TokenAuthenticator
+	Issuer() string
+}
+
+// Authenticator выбирает способ проверки по виду токена: API-ключи
+// начинаются с domain.APIKeyPrefix, JWT внешних издателей узнаются
+// по claim iss, остальные токены проверяются как собственные JWT.
+// Тестовые токены /dummyLogin принимаются, только пока он включен.
+type Authenticator struct {
+	sessions   TokenAuthenticator
+	apiKeys    TokenAuthenticator
+	external   map[string]TokenAuthenticator
+	allowDummy bool
+}
+
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*domain.Identity, error) {
+	if domain.IsAPIKey(token) {
+		return a.apiKeys.Authenticate(ctx, token)
+	}
+
+	if len(a.external) > 0 {
+		if external, ok := a.external[tokenIssuer(token)]; ok {
+			return external.Authenticate(ctx, token)
+		}
+	}
+
+	identity, err := a.sessions.Authenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if identity.IsDummy() && !a.allowDummy {
+		return nil, models.ErrInvalidToken
+	}
+
+	return identity, nil
+}
+
+func NewAuthenticator(
+	sessions TokenAuthenticator,
+	apiKeys TokenAuthenticator,
+	allowDummy bool,
+	external ...ExternalAuthenticator,
+) *Authenticator {
+	issuers := make(map[string]TokenAuthenticator, len(external))
+	for _, e := range external {
+		issuers[e.Issuer()] = e
+	}
+
+	return &Authenticator{
+		sessions:   sessions,
+		apiKeys:    apiKeys,
+		external:   issuers,
+		allowDummy: allowDummy,
+	}
+}
+
+// tokenIssuer читает iss без проверки подписи: он нужен только для выбора
+// способа проверки. Собственные токены сервиса iss не содержат.
+func tokenIssuer(token string) string {
+	claims := jwt.RegisteredClaims{}
+
+	_, _, err := jwt.NewParser().ParseUnverified(token, &claims)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(claims.Issuer, "/")
+}
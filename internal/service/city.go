@@ -0,0 +1,191 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+)
+
+type CityProvider interface {
+	Create(ctx context.Context, city *domain.City) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.City, error)
+	List(ctx context.Context) ([]domain.City, error)
+	SetActive(ctx context.Context, id uuid.UUID, active bool) error
+}
+
+// City справочник городов. Активные города кешируются на ttl: справочник
+// меняется редко, а проверяется при каждом открытии ПВЗ. Изменения через
+// этот экземпляр сбрасывают кеш сразу, изменения на других экземплярах
+// становятся видны не позже чем через ttl. Справочник загружается без
+// блокировки, а параллельные загрузки объединяются, поэтому проверки по
+// свежему кешу не ждут базу.
+type City struct {
+	repo  CityProvider
+	audit AuditRecorder
+	ttl   time.Duration
+
+	loads singleflight.Group
+	cache atomic.Pointer[activeCities]
+}
+
+// activeCities снимок активных городов. Снимок не меняется после загрузки,
+// кеш заменяется целиком.
+type activeCities struct {
+	names    map[domain.PvzCity]struct{}
+	loadedAt time.Time
+}
+
+func (c *City) Create(ctx context.Context, name string) (*domain.City, error) {
+	city, err := domain.NewCity(name)
+	if err != nil {
+		return nil, models.ErrInvalidCityName
+	}
+
+	err = c.repo.Create(ctx, city)
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		return nil, models.ErrCityAlreadyExists
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	c.invalidate()
+
+	c.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditCityCreate,
+		Entity:   domain.AuditEntityCity,
+		EntityID: city.ID,
+		After:    city.ToDTO(),
+	})
+
+	return city, nil
+}
+
+func (c *City) List(ctx context.Context) ([]domain.City, error) {
+	cities, err := c.repo.List(ctx)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return cities, nil
+}
+
+// SetActive включает или отключает город.
+func (c *City) SetActive(ctx context.Context, id uuid.UUID, active bool) (*domain.City, error) {
+	before, err := c.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.repo.SetActive(ctx, id, active)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrCityNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	c.invalidate()
+
+	after := *before
+	after.Active = active
+
+	action := domain.AuditCityActivate
+	if !active {
+		action = domain.AuditCityDeactivate
+	}
+
+	c.audit.Record(ctx, domain.AuditEvent{
+		Action:   action,
+		Entity:   domain.AuditEntityCity,
+		EntityID: id,
+		Before:   before.ToDTO(),
+		After:    after.ToDTO(),
+	})
+
+	return &after, nil
+}
+
+// IsActive сообщает, можно ли открыть ПВЗ в городе.
+func (c *City) IsActive(ctx context.Context, name domain.PvzCity) (bool, error) {
+	cache := c.cache.Load()
+	if cache.stale(c.ttl) {
+		// Загрузку разделяют все ждущие ее запросы, поэтому отмена одного
+		// из них не должна ее прерывать.
+		loaded, err, _ := c.loads.Do("cities", func() (any, error) {
+			return c.load(context.WithoutCancel(ctx), cache)
+		})
+		if err != nil {
+			return false, models.ErrInternal
+		}
+
+		cache = loaded.(*activeCities)
+	}
+
+	_, ok := cache.names[name]
+
+	return ok, nil
+}
+
+// load читает справочник и заменяет им снимок prev. Если кеш сбросили, пока
+// шла загрузка, снимок не сохраняется: он мог быть прочитан до изменения.
+func (c *City) load(ctx context.Context, prev *activeCities) (*activeCities, error) {
+	cities, err := c.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &activeCities{
+		names:    make(map[domain.PvzCity]struct{}, len(cities)),
+		loadedAt: time.Now(),
+	}
+
+	for _, city := range cities {
+		if city.Active {
+			cache.names[city.Name] = struct{}{}
+		}
+	}
+
+	c.cache.CompareAndSwap(prev, cache)
+
+	return cache, nil
+}
+
+// invalidate сбрасывает кеш. Пустой снимок, а не nil, нужен, чтобы загрузка,
+// начатая до сброса, не перезаписала его.
+func (c *City) invalidate() {
+	c.cache.Store(&activeCities{})
+}
+
+func (a *activeCities) stale(ttl time.Duration) bool {
+	return a == nil || time.Since(a.loadedAt) >= ttl
+}
+
+func (c *City) get(ctx context.Context, id uuid.UUID) (*domain.City, error) {
+	city, err := c.repo.GetByID(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrCityNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return city, nil
+}
+
+func NewCityService(repo CityProvider, audit AuditRecorder, ttl time.Duration) *City {
+	return &City{
+		repo:  repo,
+		audit: audit,
+		ttl:   ttl,
+	}
+}
@@ -0,0 +1,193 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCity_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		city       string
+		setupMocks func(repo *service.MockCityProvider)
+		wantErr    error
+	}{
+		{
+			name: "created",
+			city: "  Новосибирск ",
+			setupMocks: func(repo *service.MockCityProvider) {
+				repo.On("Create", mock.Anything, mock.MatchedBy(func(c *domain.City) bool {
+					return c.Name == "Новосибирск" && c.Active
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "empty_name",
+			city:    " ",
+			wantErr: models.ErrInvalidCityName,
+		},
+		{
+			name:    "too_long_name",
+			city:    strings.Repeat("я", 101),
+			wantErr: models.ErrInvalidCityName,
+		},
+		{
+			name: "duplicate",
+			city: "Москва",
+			setupMocks: func(repo *service.MockCityProvider) {
+				repo.On("Create", mock.Anything, mock.Anything).Return(domain.ErrAlreadyExists)
+			},
+			wantErr: models.ErrCityAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := service.NewMockCityProvider(t)
+			if tt.setupMocks != nil {
+				tt.setupMocks(repo)
+			}
+
+			svc := service.NewCityService(repo, noAudit(t), time.Minute)
+
+			city, err := svc.Create(moderatorCtx(uuid.New()), tt.city)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.PvzCity(strings.TrimSpace(tt.city)), city.Name)
+		})
+	}
+}
+
+func TestCity_SetActive(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	city := &domain.City{ID: id, Name: "Казань", Active: true}
+
+	repo := service.NewMockCityProvider(t)
+	repo.On("GetByID", mock.Anything, id).Return(city, nil).Once()
+	repo.On("SetActive", mock.Anything, id, false).Return(nil).Once()
+	repo.On("GetByID", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound).Once()
+
+	svc := service.NewCityService(repo, noAudit(t), time.Minute)
+
+	got, err := svc.SetActive(moderatorCtx(uuid.New()), id, false)
+	require.NoError(t, err)
+	assert.False(t, got.Active)
+
+	_, err = svc.SetActive(moderatorCtx(uuid.New()), uuid.New(), true)
+	require.ErrorIs(t, err, models.ErrCityNotFound)
+}
+
+func TestCity_IsActiveIsCached(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	cities := []domain.City{
+		{ID: id, Name: "Москва", Active: true},
+		{ID: uuid.New(), Name: "Казань", Active: false},
+	}
+
+	repo := service.NewMockCityProvider(t)
+	repo.On("List", mock.Anything).Return(cities, nil).Once()
+
+	svc := service.NewCityService(repo, noAudit(t), time.Hour)
+	ctx := context.Background()
+
+	for range 3 {
+		active, err := svc.IsActive(ctx, "Москва")
+		require.NoError(t, err)
+		assert.True(t, active)
+	}
+
+	active, err := svc.IsActive(ctx, "Казань")
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	active, err = svc.IsActive(ctx, "Тверь")
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	// Отключение города через сервис сбрасывает кеш.
+	repo.On("GetByID", mock.Anything, id).Return(&cities[0], nil).Once()
+	repo.On("SetActive", mock.Anything, id, false).Return(nil).Once()
+	repo.On("List", mock.Anything).Return([]domain.City{
+		{ID: id, Name: "Москва", Active: false},
+	}, nil).Once()
+
+	_, err = svc.SetActive(moderatorCtx(uuid.New()), id, false)
+	require.NoError(t, err)
+
+	active, err = svc.IsActive(ctx, "Москва")
+	require.NoError(t, err)
+	assert.False(t, active)
+}
+
+func TestCity_SetActiveDuringReload(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	moscow := domain.City{ID: id, Name: "Москва", Active: true}
+
+	loading := make(chan struct{})
+	release := make(chan struct{})
+
+	repo := service.NewMockCityProvider(t)
+	repo.On("List", mock.Anything).
+		Run(func(mock.Arguments) {
+			close(loading)
+			<-release
+		}).
+		Return([]domain.City{moscow}, nil).
+		Once()
+	repo.On("GetByID", mock.Anything, id).Return(&moscow, nil).Once()
+	repo.On("SetActive", mock.Anything, id, false).Return(nil).Once()
+
+	svc := service.NewCityService(repo, noAudit(t), time.Hour)
+	ctx := context.Background()
+
+	done := make(chan bool)
+
+	go func() {
+		active, err := svc.IsActive(ctx, "Москва")
+		assert.NoError(t, err)
+		done <- active
+	}()
+
+	// Изменение не ждет загрузку справочника.
+	<-loading
+	_, err := svc.SetActive(moderatorCtx(uuid.New()), id, false)
+	require.NoError(t, err)
+
+	close(release)
+	assert.True(t, <-done)
+
+	// Загрузка, начатая до изменения, не попадает в кеш.
+	repo.On("List", mock.Anything).Return([]domain.City{
+		{ID: id, Name: "Москва", Active: false},
+	}, nil).Once()
+
+	active, err := svc.IsActive(ctx, "Москва")
+	require.NoError(t, err)
+	assert.False(t, active)
+}
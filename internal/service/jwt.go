@@ -2,11 +2,13 @@
 package service
 
 import (
+	"avito_pvz/internal/models/domain"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 var (
@@ -17,36 +19,80 @@ var (
 )
 
 type UserClaims struct {
-	UUID string `json:"uuid"`
-	Role string `json:"role"`
+	UUID      string `json:"uuid"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid,omitempty"`
+	// Dummy отмечает тестовые токены, выданные /dummyLogin.
+	Dummy bool `json:"dummy,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// JWTManager signs access tokens with the current key of the ring and
+// verifies them with the key referenced by the kid header.
 type JWTManager struct {
-	secretKey []byte
-	expiry    time.Duration
+	keys   *KeyRing
+	expiry time.Duration
 }
 
-func NewJWTManager(secretKey string, expiry time.Duration) *JWTManager {
+func NewJWTManager(keys *KeyRing, expiry time.Duration) *JWTManager {
 	return &JWTManager{
-		secretKey: []byte(secretKey),
-		expiry:    expiry,
+		keys:   keys,
+		expiry: expiry,
 	}
 }
 
 func (m *JWTManager) GenerateToken(userUUID, role string) (string, error) {
-	claims := UserClaims{
-		UUID: userUUID,
-		Role: role,
+	token, _, err := m.GenerateSessionToken(userUUID, role, "")
+
+	return token, err
+}
+
+// GenerateDummyToken issues a test token for a random subject.
+// The token is marked with the dummy claim so it can be told apart
+// from tokens of real users.
+func (m *JWTManager) GenerateDummyToken(role string) (string, error) {
+	claims := m.claims(uuid.NewString(), role, "")
+	claims.Dummy = true
+
+	return m.sign(claims)
+}
+
+// GenerateSessionToken issues an access token bound to a session,
+// so the token can be revoked together with the session.
+func (m *JWTManager) GenerateSessionToken(
+	userUUID, role, sessionID string,
+) (string, time.Time, error) {
+	claims := m.claims(userUUID, role, sessionID)
+
+	token, err := m.sign(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, claims.ExpiresAt.Time, nil
+}
+
+func (m *JWTManager) claims(userUUID, role, sessionID string) *UserClaims {
+	now := time.Now()
+
+	return &UserClaims{
+		UUID:      userUUID,
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.expiry)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ID:        uuid.NewString(),
+			Subject:   userUUID,
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.expiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+func (m *JWTManager) sign(claims *UserClaims) (string, error) {
+	token := jwt.NewWithClaims(m.keys.method, claims)
+	token.Header["kid"] = m.keys.signingKID
 
-	signed, err := token.SignedString(m.secretKey)
+	signed, err := token.SignedString(m.keys.signer)
 	if err != nil {
 		return "", ErrInternalCodeGen
 	}
@@ -55,26 +101,48 @@ func (m *JWTManager) GenerateToken(userUUID, role string) (string, error) {
 }
 
 func (m *JWTManager) ValidateToken(tokenString string) (string, string, error) {
+	claims, err := m.ParseToken(tokenString)
+	if err != nil {
+		return "", "", err
+	}
+
+	return claims.UUID, claims.Role, nil
+}
+
+// JWKS returns the public keys that verify tokens issued by the manager.
+func (m *JWTManager) JWKS() domain.JWKSet {
+	return m.keys.JWKS()
+}
+
+func (m *JWTManager) ParseToken(tokenString string) (*UserClaims, error) {
 	token, err := jwt.ParseWithClaims(
 		tokenString,
 		&UserClaims{},
 		func(token *jwt.Token) (any, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			kid, _ := token.Header["kid"].(string)
+
+			key, ok := m.keys.PublicKey(kid)
+			if !ok {
+				return nil, fmt.Errorf("%w (kid %q)", ErrInvalidKey, kid)
+			}
+
+			method, err := signingMethod(key)
+			if err != nil || method.Alg() != token.Method.Alg() {
 				return nil, fmt.Errorf("%w (%v)", ErrUnexpectedSignMethod, token.Header["alg"])
 			}
 
-			return m.secretKey, nil
+			return key, nil
 		},
 	)
 	if err != nil {
-		return "", "", fmt.Errorf("%w (%w)", ErrInvalidToken, err)
+		return nil, fmt.Errorf("%w (%w)", ErrInvalidToken, err)
 	}
 
 	claims, ok := token.Claims.(*UserClaims)
 
 	if !ok || !token.Valid {
-		return "", "", ErrInvalidTokenClaims
+		return nil, ErrInvalidTokenClaims
 	}
 
-	return claims.UUID, claims.Role, nil
+	return claims, nil
 }
@@ -10,9 +10,11 @@ import (
 func TestJWTManager_ValidateToken(t *testing.T) {
 	t.Parallel()
 
+	edKeys := newKeyRing(t, "ed", ed25519Key(t))
+
 	tests := []struct {
 		name        string
-		secretKey   string
+		keys        *service.KeyRing
 		expiry      time.Duration
 		userUUID    string
 		role        string
@@ -21,11 +23,11 @@ func TestJWTManager_ValidateToken(t *testing.T) {
 		wantErr     bool
 	}{
 		{
-			name:      "valid-token",
-			secretKey: "test-secret-key",
-			expiry:    time.Hour,
-			userUUID:  "test-uuid",
-			role:      "admin",
+			name:     "valid-token",
+			keys:     edKeys,
+			expiry:   time.Hour,
+			userUUID: "test-uuid",
+			role:     "admin",
 			want: &service.UserClaims{
 				UUID: "test-uuid",
 				Role: "admin",
@@ -34,7 +36,7 @@ func TestJWTManager_ValidateToken(t *testing.T) {
 		},
 		{
 			name:        "invalid-token",
-			secretKey:   "test-secret-key",
+			keys:        edKeys,
 			expiry:      time.Hour,
 			tokenString: "invalid-token",
 			want:        nil,
@@ -42,20 +44,20 @@ func TestJWTManager_ValidateToken(t *testing.T) {
 		},
 		{
 			name:        "empty-token",
-			secretKey:   "test-secret-key",
+			keys:        edKeys,
 			expiry:      time.Hour,
 			tokenString: "",
 			want:        nil,
 			wantErr:     true,
 		},
 		{
-			name:      "expired-token",
-			secretKey: "test-secret-key",
-			expiry:    -time.Hour,
-			userUUID:  "test-uuid",
-			role:      "admin",
-			want:      nil,
-			wantErr:   true,
+			name:     "expired-token",
+			keys:     edKeys,
+			expiry:   -time.Hour,
+			userUUID: "test-uuid",
+			role:     "admin",
+			want:     nil,
+			wantErr:  true,
 		},
 	}
 
@@ -64,7 +66,7 @@ func TestJWTManager_ValidateToken(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			m := service.NewJWTManager(tt.secretKey, tt.expiry)
+			m := service.NewJWTManager(tt.keys, tt.expiry)
 
 			// Генерируем токен только для тестов с валидным и просроченным токеном
 			if tt.name == "valid-token" || tt.name == "expired-token" {
@@ -98,45 +100,48 @@ func TestJWTManager_ValidateToken(t *testing.T) {
 func TestJWTManager_GenerateToken(t *testing.T) {
 	t.Parallel()
 
+	edKeys := newKeyRing(t, "ed", ed25519Key(t))
+	rsaKeys := newKeyRing(t, "rsa", rsaKey(t))
+
 	tests := []struct {
-		name      string
-		secretKey string
-		expiry    time.Duration
-		userUUID  string
-		role      string
-		wantErr   bool
+		name     string
+		keys     *service.KeyRing
+		expiry   time.Duration
+		userUUID string
+		role     string
+		wantErr  bool
 	}{
 		{
-			name:      "successful-token-generation",
-			secretKey: "test-secret-key",
-			expiry:    time.Hour,
-			userUUID:  "test-uuid",
-			role:      "admin",
-			wantErr:   false,
+			name:     "successful-token-generation",
+			keys:     edKeys,
+			expiry:   time.Hour,
+			userUUID: "test-uuid",
+			role:     "admin",
+			wantErr:  false,
 		},
 		{
-			name:      "empty-uuid",
-			secretKey: "test-secret-key",
-			expiry:    time.Hour,
-			userUUID:  "",
-			role:      "admin",
-			wantErr:   false,
+			name:     "empty-uuid",
+			keys:     edKeys,
+			expiry:   time.Hour,
+			userUUID: "",
+			role:     "admin",
+			wantErr:  false,
 		},
 		{
-			name:      "empty-role",
-			secretKey: "test-secret-key",
-			expiry:    time.Hour,
-			userUUID:  "test-uuid",
-			role:      "",
-			wantErr:   false,
+			name:     "empty-role",
+			keys:     edKeys,
+			expiry:   time.Hour,
+			userUUID: "test-uuid",
+			role:     "",
+			wantErr:  false,
 		},
 		{
-			name:      "empty-secret-key",
-			secretKey: "",
-			expiry:    time.Hour,
-			userUUID:  "test-uuid",
-			role:      "admin",
-			wantErr:   false,
+			name:     "rsa-key",
+			keys:     rsaKeys,
+			expiry:   time.Hour,
+			userUUID: "test-uuid",
+			role:     "admin",
+			wantErr:  false,
 		},
 	}
 
@@ -145,7 +150,7 @@ func TestJWTManager_GenerateToken(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			m := service.NewJWTManager(tt.secretKey, tt.expiry)
+			m := service.NewJWTManager(tt.keys, tt.expiry)
 			got, err := m.GenerateToken(tt.userUUID, tt.role)
 
 			if (err != nil) != tt.wantErr {
@@ -179,3 +184,37 @@ func TestJWTManager_GenerateToken(t *testing.T) {
 		})
 	}
 }
+
+func TestJWTManager_GenerateDummyToken(t *testing.T) {
+	t.Parallel()
+
+	m := service.NewJWTManager(newKeyRing(t, "ed", ed25519Key(t)), time.Hour)
+
+	dummy, err := m.GenerateDummyToken("moderator")
+	if err != nil {
+		t.Fatalf("GenerateDummyToken() error = %v", err)
+	}
+
+	claims, err := m.ParseToken(dummy)
+	if err != nil {
+		t.Fatalf("ParseToken() error = %v", err)
+	}
+
+	if !claims.Dummy || claims.Role != "moderator" || claims.UUID == "" {
+		t.Errorf("GenerateDummyToken() claims = %+v", claims)
+	}
+
+	regular, err := m.GenerateToken("test-uuid", "moderator")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	claims, err = m.ParseToken(regular)
+	if err != nil {
+		t.Fatalf("ParseToken() error = %v", err)
+	}
+
+	if claims.Dummy {
+		t.Error("GenerateToken() выдал токен с claim dummy")
+	}
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"avito_pvz/internal/models/domain"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrInvalidKey        = errors.New("ErrInvalidKey")
+	ErrUnsupportedKey    = errors.New("ErrUnsupportedKey")
+	ErrSigningKeyMissing = errors.New("ErrSigningKeyMissing")
+)
+
+// KeyRing holds the key used to sign new tokens and every public key
+// tokens may still be signed with. Rotating means adding a new key,
+// switching the signing kid to it and keeping the previous key until
+// the tokens it signed have expired.
+type KeyRing struct {
+	signingKID string
+	signer     crypto.Signer
+	method     jwt.SigningMethod
+	public     map[string]crypto.PublicKey
+}
+
+// NewKeyRing builds a key ring from private (crypto.Signer) or public keys
+// indexed by kid. The signing key must be a private key.
+func NewKeyRing(signingKID string, keys map[string]any) (*KeyRing, error) {
+	ring := &KeyRing{
+		signingKID: signingKID,
+		public:     make(map[string]crypto.PublicKey, len(keys)),
+	}
+
+	for kid, key := range keys {
+		pub := key
+
+		if signer, ok := key.(crypto.Signer); ok {
+			pub = signer.Public()
+
+			if kid == signingKID {
+				ring.signer = signer
+			}
+		}
+
+		if _, err := signingMethod(pub); err != nil {
+			return nil, fmt.Errorf("%w (kid %s)", err, kid)
+		}
+
+		ring.public[kid] = pub
+	}
+
+	if ring.signer == nil {
+		return nil, fmt.Errorf("%w (kid %s)", ErrSigningKeyMissing, signingKID)
+	}
+
+	method, err := signingMethod(ring.signer.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	ring.method = method
+
+	return ring, nil
+}
+
+// LoadKeyRing reads PEM encoded keys from the given files indexed by kid.
+func LoadKeyRing(signingKID string, paths map[string]string) (*KeyRing, error) {
+	keys := make(map[string]any, len(paths))
+
+	for kid, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read key %s: %w", kid, err)
+		}
+
+		key, err := ParsePEMKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse key %s: %w", kid, err)
+		}
+
+		keys[kid] = key
+	}
+
+	return NewKeyRing(signingKID, keys)
+}
+
+// GenerateKeyRing builds a key ring with a fresh in-memory Ed25519 key.
+// Tokens it signs become invalid after a restart, so it is meant only for
+// development environments without a provisioned key.
+func GenerateKeyRing(kid string) (*KeyRing, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", ErrInvalidKey, err)
+	}
+
+	return NewKeyRing(kid, map[string]any{kid: key})
+}
+
+// ParsePEMKey parses a PKCS#8 or PKCS#1 private key, or a PKIX public key.
+func ParsePEMKey(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+
+	var (
+		key any
+		err error
+	)
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w (%s)", ErrUnsupportedKey, block.Type)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w (%w)", ErrInvalidKey, err)
+	}
+
+	return key, nil
+}
+
+// PublicKey returns the verification key for kid.
+func (r *KeyRing) PublicKey(kid string) (crypto.PublicKey, bool) {
+	key, ok := r.public[kid]
+
+	return key, ok
+}
+
+// JWKS returns all public keys sorted by kid.
+func (r *KeyRing) JWKS() domain.JWKSet {
+	kids := make([]string, 0, len(r.public))
+	for kid := range r.public {
+		kids = append(kids, kid)
+	}
+
+	sort.Strings(kids)
+
+	set := make(domain.JWKSet, 0, len(kids))
+
+	for _, kid := range kids {
+		switch pub := r.public[kid].(type) {
+		case *rsa.PublicKey:
+			set = append(set, domain.JWK{
+				Kty: "RSA",
+				Kid: kid,
+				Alg: jwt.SigningMethodRS256.Alg(),
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E: base64.RawURLEncoding.EncodeToString(
+					big.NewInt(int64(pub.E)).Bytes(),
+				),
+			})
+		case ed25519.PublicKey:
+			set = append(set, domain.JWK{
+				Kty: "OKP",
+				Kid: kid,
+				Alg: jwt.SigningMethodEdDSA.Alg(),
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+
+	return set
+}
+
+func signingMethod(pub crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("%w (%T)", ErrUnsupportedKey, pub)
+	}
+}
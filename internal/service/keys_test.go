@@ -0,0 +1,182 @@
+package service_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/service"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func ed25519Key(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return key
+}
+
+func rsaKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return key
+}
+
+func newKeyRing(t *testing.T, kid string, key any) *service.KeyRing {
+	t.Helper()
+
+	ring, err := service.NewKeyRing(kid, map[string]any{kid: key})
+	require.NoError(t, err)
+
+	return ring
+}
+
+func TestKeyRing_Rotation(t *testing.T) {
+	t.Parallel()
+
+	oldKey := ed25519Key(t)
+	newKey := rsaKey(t)
+
+	before := service.NewJWTManager(newKeyRing(t, "old", oldKey), time.Hour)
+
+	token, err := before.GenerateToken("test-uuid", "employee")
+	require.NoError(t, err)
+
+	// После ротации старый ключ остается только для проверки подписи.
+	rotated, err := service.NewKeyRing("new", map[string]any{
+		"old": oldKey.Public(),
+		"new": newKey,
+	})
+	require.NoError(t, err)
+
+	after := service.NewJWTManager(rotated, time.Hour)
+
+	uuid, role, err := after.ValidateToken(token)
+	require.NoError(t, err)
+	require.Equal(t, "test-uuid", uuid)
+	require.Equal(t, "employee", role)
+
+	fresh, err := after.GenerateToken("test-uuid", "moderator")
+	require.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(fresh, &service.UserClaims{})
+	require.NoError(t, err)
+	require.Equal(t, "new", parsed.Header["kid"])
+	require.Equal(t, "RS256", parsed.Header["alg"])
+
+	_, _, err = before.ValidateToken(fresh)
+	require.ErrorIs(t, err, service.ErrInvalidToken)
+
+	jwks := after.JWKS()
+	require.Len(t, jwks, 2)
+	require.Equal(t, "new", jwks[0].Kid)
+	require.Equal(t, "RSA", jwks[0].Kty)
+	require.NotEmpty(t, jwks[0].N)
+	require.Equal(t, "AQAB", jwks[0].E)
+	require.Equal(t, "old", jwks[1].Kid)
+	require.Equal(t, "OKP", jwks[1].Kty)
+	require.Equal(t, "Ed25519", jwks[1].Crv)
+	require.NotEmpty(t, jwks[1].X)
+}
+
+func TestKeyRing_RejectsForeignTokens(t *testing.T) {
+	t.Parallel()
+
+	m := service.NewJWTManager(newKeyRing(t, "ed", ed25519Key(t)), time.Hour)
+
+	claims := service.UserClaims{UUID: "test-uuid", Role: "moderator"}
+
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	hmac.Header["kid"] = "ed"
+	hmacToken, err := hmac.SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	_, _, err = m.ValidateToken(hmacToken)
+	require.ErrorIs(t, err, service.ErrInvalidToken)
+
+	foreign := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	foreign.Header["kid"] = "ed"
+	foreignToken, err := foreign.SignedString(ed25519Key(t))
+	require.NoError(t, err)
+
+	_, _, err = m.ValidateToken(foreignToken)
+	require.ErrorIs(t, err, service.ErrInvalidToken)
+}
+
+func TestNewKeyRing_SigningKeyMustBePrivate(t *testing.T) {
+	t.Parallel()
+
+	_, err := service.NewKeyRing("ed", map[string]any{"ed": ed25519Key(t).Public()})
+	require.ErrorIs(t, err, service.ErrSigningKeyMissing)
+
+	_, err = service.NewKeyRing("missing", map[string]any{"ed": ed25519Key(t)})
+	require.ErrorIs(t, err, service.ErrSigningKeyMissing)
+}
+
+func TestLoadKeyRing(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	privDER, err := x509.MarshalPKCS8PrivateKey(ed25519Key(t))
+	require.NoError(t, err)
+
+	pubDER, err := x509.MarshalPKIXPublicKey(&rsaKey(t).PublicKey)
+	require.NoError(t, err)
+
+	privPath := filepath.Join(dir, "current.pem")
+	pubPath := filepath.Join(dir, "previous.pem")
+
+	require.NoError(t, os.WriteFile(
+		privPath,
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}),
+		0o600,
+	))
+	require.NoError(t, os.WriteFile(
+		pubPath,
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}),
+		0o600,
+	))
+
+	ring, err := service.LoadKeyRing("current", map[string]string{
+		"current":  privPath,
+		"previous": pubPath,
+	})
+	require.NoError(t, err)
+	require.Len(t, ring.JWKS(), 2)
+
+	_, err = service.LoadKeyRing("current", map[string]string{
+		"current": filepath.Join(dir, "missing.pem"),
+	})
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestGenerateKeyRing(t *testing.T) {
+	t.Parallel()
+
+	ring, err := service.GenerateKeyRing("dev")
+	require.NoError(t, err)
+
+	jwks := ring.JWKS()
+	require.Len(t, jwks, 1)
+	require.Equal(t, "dev", jwks[0].Kid)
+	require.Equal(t, "OKP", jwks[0].Kty)
+
+	other, err := service.GenerateKeyRing("dev")
+	require.NoError(t, err)
+	require.NotEqual(t, jwks[0].X, other.JWKS()[0].X)
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+type LoginAttemptProvider interface {
+	Get(ctx context.Context, key string) (*domain.LoginAttempt, error)
+	RecordFailure(
+		ctx context.Context,
+		key string,
+		windowStart time.Time,
+		now time.Time,
+	) (*domain.LoginAttempt, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Delete(ctx context.Context, key string) error
+}
+
+// Lockout ограничивает число неудачных попыток входа отдельно
+// для учетной записи и для IP-адреса клиента.
+type Lockout struct {
+	attempts LoginAttemptProvider
+	account  domain.LockoutPolicy
+	ip       domain.LockoutPolicy
+	log      *slog.Logger
+}
+
+// Check возвращает models.RetryError с models.ErrLoginLocked,
+// если учетная запись или IP-адрес заблокированы.
+func (l *Lockout) Check(ctx context.Context, email string, ip string) error {
+	now := time.Now()
+
+	var retryAfter time.Duration
+
+	for _, key := range attemptKeys(email, ip) {
+		attempt, err := l.attempts.Get(ctx, key)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return models.ErrInternal
+		}
+
+		retryAfter = max(retryAfter, attempt.RetryAfter(now))
+	}
+
+	if retryAfter > 0 {
+		return &models.RetryError{Err: models.ErrLoginLocked, RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// Fail учитывает неудачную попытку. Счетчик увеличивается в базе одним
+// запросом, чтобы параллельные попытки не затирали друг друга, а блокировка
+// считается по значению, которое вернула база.
+func (l *Lockout) Fail(ctx context.Context, email string, ip string) error {
+	now := time.Now()
+
+	for _, key := range attemptKeys(email, ip) {
+		policy := l.account
+		if strings.HasPrefix(key, domain.IPAttemptKey("")) {
+			policy = l.ip
+		}
+
+		attempt, err := l.attempts.RecordFailure(ctx, key, policy.WindowStart(now), now)
+		if err != nil {
+			return models.ErrInternal
+		}
+
+		if !attempt.Lock(policy, now) {
+			continue
+		}
+
+		err = l.attempts.Lock(ctx, key, *attempt.LockedUntil)
+		if err != nil {
+			return models.ErrInternal
+		}
+
+		l.log.WarnContext(ctx, "login locked out",
+			slog.String("key", key),
+			slog.String("email", email),
+			slog.String("ip", ip),
+			slog.Int("failures", attempt.Failures),
+			slog.Time("locked_until", *attempt.LockedUntil),
+		)
+	}
+
+	return nil
+}
+
+// Succeed сбрасывает счетчик учетной записи после успешного входа.
+// Счетчик IP-адреса сбрасывается только по истечении окна.
+func (l *Lockout) Succeed(ctx context.Context, email string) error {
+	err := l.attempts.Delete(ctx, domain.AccountAttemptKey(normalizeEmail(email)))
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	return nil
+}
+
+func attemptKeys(email string, ip string) []string {
+	keys := []string{domain.AccountAttemptKey(normalizeEmail(email))}
+	if ip != "" {
+		keys = append(keys, domain.IPAttemptKey(ip))
+	}
+
+	return keys
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func NewLockout(
+	attempts LoginAttemptProvider,
+	account domain.LockoutPolicy,
+	ip domain.LockoutPolicy,
+	log *slog.Logger,
+) *Lockout {
+	return &Lockout{
+		attempts: attempts,
+		account:  account,
+		ip:       ip,
+		log:      log,
+	}
+}
@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockout(t *testing.T) {
+	t.Parallel()
+
+	policy := domain.LockoutPolicy{
+		Threshold: 2,
+		BaseDelay: time.Minute,
+		MaxDelay:  time.Hour,
+		Window:    time.Hour,
+	}
+
+	accountKey := domain.AccountAttemptKey("user@example.com")
+	ipKey := domain.IPAttemptKey("10.0.0.1")
+
+	// Хранилище в памяти поверх мока, чтобы проверить последовательность попыток.
+	store := map[string]domain.LoginAttempt{}
+
+	attempts := service.NewMockLoginAttemptProvider(t)
+	attempts.On("Get", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, key string) (*domain.LoginAttempt, error) {
+			a, ok := store[key]
+			if !ok {
+				return nil, domain.ErrNotFound
+			}
+
+			return &a, nil
+		})
+	attempts.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(
+			_ context.Context,
+			key string,
+			windowStart time.Time,
+			now time.Time,
+		) (*domain.LoginAttempt, error) {
+			a := store[key]
+			if a.LastFailure.Before(windowStart) {
+				a.Failures = 0
+			}
+
+			a.Key = key
+			a.Failures++
+			a.LastFailure = now
+			store[key] = a
+
+			return &a, nil
+		})
+	attempts.On("Lock", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			a := store[args.String(1)]
+			until := args.Get(2).(time.Time)
+			a.LockedUntil = &until
+			store[a.Key] = a
+		}).
+		Return(nil)
+	attempts.On("Delete", mock.Anything, accountKey).
+		Run(func(mock.Arguments) { delete(store, accountKey) }).
+		Return(nil)
+
+	ctx := context.Background()
+	lockout := service.NewLockout(
+		attempts,
+		policy,
+		domain.LockoutPolicy{Threshold: 10, BaseDelay: time.Minute, MaxDelay: time.Hour},
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+
+	require.NoError(t, lockout.Check(ctx, "User@Example.com", "10.0.0.1"))
+	require.NoError(t, lockout.Fail(ctx, "User@Example.com", "10.0.0.1"))
+	require.NoError(t, lockout.Check(ctx, "user@example.com", "10.0.0.1"))
+	require.NoError(t, lockout.Fail(ctx, "user@example.com", "10.0.0.1"))
+
+	err := lockout.Check(ctx, "user@example.com", "10.0.0.2")
+	require.ErrorIs(t, err, models.ErrLoginLocked)
+
+	var retry *models.RetryError
+	require.ErrorAs(t, err, &retry)
+	require.InDelta(t, time.Minute, retry.RetryAfter, float64(time.Second))
+
+	require.Equal(t, 2, store[ipKey].Failures)
+	require.Nil(t, store[ipKey].LockedUntil)
+
+	// Успешный вход сбрасывает только счетчик учетной записи.
+	require.NoError(t, lockout.Succeed(ctx, "user@example.com"))
+	require.NoError(t, lockout.Check(ctx, "user@example.com", "10.0.0.1"))
+	require.Contains(t, store, ipKey)
+}
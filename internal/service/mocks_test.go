@@ -7,18 +7,19 @@ package service
 import (
 	"avito_pvz/internal/models/domain"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockProductProvider creates a new instance of MockProductProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAPIKeyProvider creates a new instance of MockAPIKeyProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProductProvider(t interface {
+func NewMockAPIKeyProvider(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProductProvider {
-	mock := &MockProductProvider{}
+}) *MockAPIKeyProvider {
+	mock := &MockAPIKeyProvider{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -26,332 +27,278 @@ func NewMockProductProvider(t interface {
 	return mock
 }
 
-// MockProductProvider is an autogenerated mock type for the ProductProvider type
-type MockProductProvider struct {
+// MockAPIKeyProvider is an autogenerated mock type for the APIKeyProvider type
+type MockAPIKeyProvider struct {
 	mock.Mock
 }
 
-type MockProductProvider_Expecter struct {
+type MockAPIKeyProvider_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProductProvider) EXPECT() *MockProductProvider_Expecter {
-	return &MockProductProvider_Expecter{mock: &_m.Mock}
+func (_m *MockAPIKeyProvider) EXPECT() *MockAPIKeyProvider_Expecter {
+	return &MockAPIKeyProvider_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockProductProvider
-func (_mock *MockProductProvider) Create(ctx context.Context, product *domain.Product) error {
-	ret := _mock.Called(ctx, product)
+// Create provides a mock function for the type MockAPIKeyProvider
+func (_mock *MockAPIKeyProvider) Create(ctx context.Context, key *domain.APIKey) error {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) error); ok {
-		r0 = returnFunc(ctx, product)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.APIKey) error); ok {
+		r0 = returnFunc(ctx, key)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockProductProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockProductProvider_Create_Call struct {
+// MockAPIKeyProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAPIKeyProvider_Create_Call struct {
 	*mock.Call
 }
 
 // Create is a helper method to define mock.On call
 //   - ctx
-//   - product
-func (_e *MockProductProvider_Expecter) Create(ctx interface{}, product interface{}) *MockProductProvider_Create_Call {
-	return &MockProductProvider_Create_Call{Call: _e.mock.On("Create", ctx, product)}
+//   - key
+func (_e *MockAPIKeyProvider_Expecter) Create(ctx interface{}, key interface{}) *MockAPIKeyProvider_Create_Call {
+	return &MockAPIKeyProvider_Create_Call{Call: _e.mock.On("Create", ctx, key)}
 }
 
-func (_c *MockProductProvider_Create_Call) Run(run func(ctx context.Context, product *domain.Product)) *MockProductProvider_Create_Call {
+func (_c *MockAPIKeyProvider_Create_Call) Run(run func(ctx context.Context, key *domain.APIKey)) *MockAPIKeyProvider_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.Product))
+		run(args[0].(context.Context), args[1].(*domain.APIKey))
 	})
 	return _c
 }
 
-func (_c *MockProductProvider_Create_Call) Return(err error) *MockProductProvider_Create_Call {
+func (_c *MockAPIKeyProvider_Create_Call) Return(err error) *MockAPIKeyProvider_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProductProvider_Create_Call) RunAndReturn(run func(ctx context.Context, product *domain.Product) error) *MockProductProvider_Create_Call {
+func (_c *MockAPIKeyProvider_Create_Call) RunAndReturn(run func(ctx context.Context, key *domain.APIKey) error) *MockAPIKeyProvider_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockProductProvider
-func (_mock *MockProductProvider) Delete(ctx context.Context, product *domain.Product) error {
-	ret := _mock.Called(ctx, product)
+// GetByHash provides a mock function for the type MockAPIKeyProvider
+func (_mock *MockAPIKeyProvider) GetByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	ret := _mock.Called(ctx, hash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for GetByHash")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) error); ok {
-		r0 = returnFunc(ctx, product)
+	var r0 *domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.APIKey, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.APIKey); ok {
+		r0 = returnFunc(ctx, hash)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockProductProvider_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockProductProvider_Delete_Call struct {
+// MockAPIKeyProvider_GetByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHash'
+type MockAPIKeyProvider_GetByHash_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// GetByHash is a helper method to define mock.On call
 //   - ctx
-//   - product
-func (_e *MockProductProvider_Expecter) Delete(ctx interface{}, product interface{}) *MockProductProvider_Delete_Call {
-	return &MockProductProvider_Delete_Call{Call: _e.mock.On("Delete", ctx, product)}
+//   - hash
+func (_e *MockAPIKeyProvider_Expecter) GetByHash(ctx interface{}, hash interface{}) *MockAPIKeyProvider_GetByHash_Call {
+	return &MockAPIKeyProvider_GetByHash_Call{Call: _e.mock.On("GetByHash", ctx, hash)}
 }
 
-func (_c *MockProductProvider_Delete_Call) Run(run func(ctx context.Context, product *domain.Product)) *MockProductProvider_Delete_Call {
+func (_c *MockAPIKeyProvider_GetByHash_Call) Run(run func(ctx context.Context, hash string)) *MockAPIKeyProvider_GetByHash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.Product))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockProductProvider_Delete_Call) Return(err error) *MockProductProvider_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockAPIKeyProvider_GetByHash_Call) Return(aPIKey *domain.APIKey, err error) *MockAPIKeyProvider_GetByHash_Call {
+	_c.Call.Return(aPIKey, err)
 	return _c
 }
 
-func (_c *MockProductProvider_Delete_Call) RunAndReturn(run func(ctx context.Context, product *domain.Product) error) *MockProductProvider_Delete_Call {
+func (_c *MockAPIKeyProvider_GetByHash_Call) RunAndReturn(run func(ctx context.Context, hash string) (*domain.APIKey, error)) *MockAPIKeyProvider_GetByHash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLast provides a mock function for the type MockProductProvider
-func (_mock *MockProductProvider) GetLast(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error) {
-	ret := _mock.Called(ctx, receptionID)
+// List provides a mock function for the type MockAPIKeyProvider
+func (_mock *MockAPIKeyProvider) List(ctx context.Context) ([]domain.APIKey, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLast")
+		panic("no return value specified for List")
 	}
 
-	var r0 *domain.Product
+	var r0 []domain.APIKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Product, error)); ok {
-		return returnFunc(ctx, receptionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.APIKey, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Product); ok {
-		r0 = returnFunc(ctx, receptionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.APIKey); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Product)
+			r0 = ret.Get(0).([]domain.APIKey)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, receptionID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProductProvider_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
-type MockProductProvider_GetLast_Call struct {
+// MockAPIKeyProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAPIKeyProvider_List_Call struct {
 	*mock.Call
 }
 
-// GetLast is a helper method to define mock.On call
+// List is a helper method to define mock.On call
 //   - ctx
-//   - receptionID
-func (_e *MockProductProvider_Expecter) GetLast(ctx interface{}, receptionID interface{}) *MockProductProvider_GetLast_Call {
-	return &MockProductProvider_GetLast_Call{Call: _e.mock.On("GetLast", ctx, receptionID)}
+func (_e *MockAPIKeyProvider_Expecter) List(ctx interface{}) *MockAPIKeyProvider_List_Call {
+	return &MockAPIKeyProvider_List_Call{Call: _e.mock.On("List", ctx)}
 }
 
-func (_c *MockProductProvider_GetLast_Call) Run(run func(ctx context.Context, receptionID uuid.UUID)) *MockProductProvider_GetLast_Call {
+func (_c *MockAPIKeyProvider_List_Call) Run(run func(ctx context.Context)) *MockAPIKeyProvider_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockProductProvider_GetLast_Call) Return(product *domain.Product, err error) *MockProductProvider_GetLast_Call {
-	_c.Call.Return(product, err)
+func (_c *MockAPIKeyProvider_List_Call) Return(aPIKeys []domain.APIKey, err error) *MockAPIKeyProvider_List_Call {
+	_c.Call.Return(aPIKeys, err)
 	return _c
 }
 
-func (_c *MockProductProvider_GetLast_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error)) *MockProductProvider_GetLast_Call {
+func (_c *MockAPIKeyProvider_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.APIKey, error)) *MockAPIKeyProvider_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockReceptionGetter creates a new instance of MockReceptionGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockReceptionGetter(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockReceptionGetter {
-	mock := &MockReceptionGetter{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockReceptionGetter is an autogenerated mock type for the ReceptionGetter type
-type MockReceptionGetter struct {
-	mock.Mock
-}
-
-type MockReceptionGetter_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockReceptionGetter) EXPECT() *MockReceptionGetter_Expecter {
-	return &MockReceptionGetter_Expecter{mock: &_m.Mock}
-}
-
-// GetLast provides a mock function for the type MockReceptionGetter
-func (_mock *MockReceptionGetter) GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error) {
-	ret := _mock.Called(ctx, pvz)
+// Revoke provides a mock function for the type MockAPIKeyProvider
+func (_mock *MockAPIKeyProvider) Revoke(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLast")
+		panic("no return value specified for Revoke")
 	}
 
-	var r0 *domain.Reception
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Reception, error)); ok {
-		return returnFunc(ctx, pvz)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Reception); ok {
-		r0 = returnFunc(ctx, pvz)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Reception)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, pvz)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockReceptionGetter_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
-type MockReceptionGetter_GetLast_Call struct {
+// MockAPIKeyProvider_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockAPIKeyProvider_Revoke_Call struct {
 	*mock.Call
 }
 
-// GetLast is a helper method to define mock.On call
+// Revoke is a helper method to define mock.On call
 //   - ctx
-//   - pvz
-func (_e *MockReceptionGetter_Expecter) GetLast(ctx interface{}, pvz interface{}) *MockReceptionGetter_GetLast_Call {
-	return &MockReceptionGetter_GetLast_Call{Call: _e.mock.On("GetLast", ctx, pvz)}
+//   - id
+func (_e *MockAPIKeyProvider_Expecter) Revoke(ctx interface{}, id interface{}) *MockAPIKeyProvider_Revoke_Call {
+	return &MockAPIKeyProvider_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
 }
 
-func (_c *MockReceptionGetter_GetLast_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockReceptionGetter_GetLast_Call {
+func (_c *MockAPIKeyProvider_Revoke_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyProvider_Revoke_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockReceptionGetter_GetLast_Call) Return(reception *domain.Reception, err error) *MockReceptionGetter_GetLast_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockAPIKeyProvider_Revoke_Call) Return(err error) *MockAPIKeyProvider_Revoke_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockReceptionGetter_GetLast_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error)) *MockReceptionGetter_GetLast_Call {
+func (_c *MockAPIKeyProvider_Revoke_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockAPIKeyProvider_Revoke_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPVZChecker creates a new instance of MockPVZChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPVZChecker(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPVZChecker {
-	mock := &MockPVZChecker{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockPVZChecker is an autogenerated mock type for the PVZChecker type
-type MockPVZChecker struct {
-	mock.Mock
-}
-
-type MockPVZChecker_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockPVZChecker) EXPECT() *MockPVZChecker_Expecter {
-	return &MockPVZChecker_Expecter{mock: &_m.Mock}
-}
-
-// Exist provides a mock function for the type MockPVZChecker
-func (_mock *MockPVZChecker) Exist(ctx context.Context, pvz uuid.UUID) error {
-	ret := _mock.Called(ctx, pvz)
+// Touch provides a mock function for the type MockAPIKeyProvider
+func (_mock *MockAPIKeyProvider) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	ret := _mock.Called(ctx, id, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Exist")
+		panic("no return value specified for Touch")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, at)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPVZChecker_Exist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exist'
-type MockPVZChecker_Exist_Call struct {
+// MockAPIKeyProvider_Touch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Touch'
+type MockAPIKeyProvider_Touch_Call struct {
 	*mock.Call
 }
 
-// Exist is a helper method to define mock.On call
+// Touch is a helper method to define mock.On call
 //   - ctx
-//   - pvz
-func (_e *MockPVZChecker_Expecter) Exist(ctx interface{}, pvz interface{}) *MockPVZChecker_Exist_Call {
-	return &MockPVZChecker_Exist_Call{Call: _e.mock.On("Exist", ctx, pvz)}
+//   - id
+//   - at
+func (_e *MockAPIKeyProvider_Expecter) Touch(ctx interface{}, id interface{}, at interface{}) *MockAPIKeyProvider_Touch_Call {
+	return &MockAPIKeyProvider_Touch_Call{Call: _e.mock.On("Touch", ctx, id, at)}
 }
 
-func (_c *MockPVZChecker_Exist_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockPVZChecker_Exist_Call {
+func (_c *MockAPIKeyProvider_Touch_Call) Run(run func(ctx context.Context, id uuid.UUID, at time.Time)) *MockAPIKeyProvider_Touch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockPVZChecker_Exist_Call) Return(err error) *MockPVZChecker_Exist_Call {
+func (_c *MockAPIKeyProvider_Touch_Call) Return(err error) *MockAPIKeyProvider_Touch_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPVZChecker_Exist_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) error) *MockPVZChecker_Exist_Call {
+func (_c *MockAPIKeyProvider_Touch_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, at time.Time) error) *MockAPIKeyProvider_Touch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPVZProvider creates a new instance of MockPVZProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockASNProvider creates a new instance of MockASNProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockPVZProvider(t interface {
+func NewMockASNProvider(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockPVZProvider {
-	mock := &MockPVZProvider{}
+}) *MockASNProvider {
+	mock := &MockASNProvider{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -359,185 +306,213 @@ func NewMockPVZProvider(t interface {
 	return mock
 }
 
-// MockPVZProvider is an autogenerated mock type for the PVZProvider type
-type MockPVZProvider struct {
+// MockASNProvider is an autogenerated mock type for the ASNProvider type
+type MockASNProvider struct {
 	mock.Mock
 }
 
-type MockPVZProvider_Expecter struct {
+type MockASNProvider_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockPVZProvider) EXPECT() *MockPVZProvider_Expecter {
-	return &MockPVZProvider_Expecter{mock: &_m.Mock}
+func (_m *MockASNProvider) EXPECT() *MockASNProvider_Expecter {
+	return &MockASNProvider_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockPVZProvider
-func (_mock *MockPVZProvider) Create(ctx context.Context, pvz *domain.PVZ) error {
-	ret := _mock.Called(ctx, pvz)
+// Create provides a mock function for the type MockASNProvider
+func (_mock *MockASNProvider) Create(ctx context.Context, asn *domain.ASN) error {
+	ret := _mock.Called(ctx, asn)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZ) error); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ASN) error); ok {
+		r0 = returnFunc(ctx, asn)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPVZProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockPVZProvider_Create_Call struct {
+// MockASNProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockASNProvider_Create_Call struct {
 	*mock.Call
 }
 
 // Create is a helper method to define mock.On call
 //   - ctx
-//   - pvz
-func (_e *MockPVZProvider_Expecter) Create(ctx interface{}, pvz interface{}) *MockPVZProvider_Create_Call {
-	return &MockPVZProvider_Create_Call{Call: _e.mock.On("Create", ctx, pvz)}
+//   - asn
+func (_e *MockASNProvider_Expecter) Create(ctx interface{}, asn interface{}) *MockASNProvider_Create_Call {
+	return &MockASNProvider_Create_Call{Call: _e.mock.On("Create", ctx, asn)}
 }
 
-func (_c *MockPVZProvider_Create_Call) Run(run func(ctx context.Context, pvz *domain.PVZ)) *MockPVZProvider_Create_Call {
+func (_c *MockASNProvider_Create_Call) Run(run func(ctx context.Context, asn *domain.ASN)) *MockASNProvider_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.PVZ))
+		run(args[0].(context.Context), args[1].(*domain.ASN))
 	})
 	return _c
 }
 
-func (_c *MockPVZProvider_Create_Call) Return(err error) *MockPVZProvider_Create_Call {
+func (_c *MockASNProvider_Create_Call) Return(err error) *MockASNProvider_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPVZProvider_Create_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.PVZ) error) *MockPVZProvider_Create_Call {
+func (_c *MockASNProvider_Create_Call) RunAndReturn(run func(ctx context.Context, asn *domain.ASN) error) *MockASNProvider_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function for the type MockPVZProvider
-func (_mock *MockPVZProvider) GetAll(ctx context.Context) ([]domain.PVZ, error) {
-	ret := _mock.Called(ctx)
+// GetReconciliation provides a mock function for the type MockASNProvider
+func (_mock *MockASNProvider) GetReconciliation(ctx context.Context, receptionID uuid.UUID) (*domain.ReceptionReconciliation, error) {
+	ret := _mock.Called(ctx, receptionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAll")
+		panic("no return value specified for GetReconciliation")
 	}
 
-	var r0 []domain.PVZ
+	var r0 *domain.ReceptionReconciliation
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.PVZ, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ReceptionReconciliation, error)); ok {
+		return returnFunc(ctx, receptionID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.PVZ); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ReceptionReconciliation); ok {
+		r0 = returnFunc(ctx, receptionID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.PVZ)
+			r0 = ret.Get(0).(*domain.ReceptionReconciliation)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPVZProvider_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
-type MockPVZProvider_GetAll_Call struct {
+// MockASNProvider_GetReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliation'
+type MockASNProvider_GetReconciliation_Call struct {
 	*mock.Call
 }
 
-// GetAll is a helper method to define mock.On call
+// GetReconciliation is a helper method to define mock.On call
 //   - ctx
-func (_e *MockPVZProvider_Expecter) GetAll(ctx interface{}) *MockPVZProvider_GetAll_Call {
-	return &MockPVZProvider_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+//   - receptionID
+func (_e *MockASNProvider_Expecter) GetReconciliation(ctx interface{}, receptionID interface{}) *MockASNProvider_GetReconciliation_Call {
+	return &MockASNProvider_GetReconciliation_Call{Call: _e.mock.On("GetReconciliation", ctx, receptionID)}
 }
 
-func (_c *MockPVZProvider_GetAll_Call) Run(run func(ctx context.Context)) *MockPVZProvider_GetAll_Call {
+func (_c *MockASNProvider_GetReconciliation_Call) Run(run func(ctx context.Context, receptionID uuid.UUID)) *MockASNProvider_GetReconciliation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockPVZProvider_GetAll_Call) Return(pVZs []domain.PVZ, err error) *MockPVZProvider_GetAll_Call {
-	_c.Call.Return(pVZs, err)
+func (_c *MockASNProvider_GetReconciliation_Call) Return(receptionReconciliation *domain.ReceptionReconciliation, err error) *MockASNProvider_GetReconciliation_Call {
+	_c.Call.Return(receptionReconciliation, err)
 	return _c
 }
 
-func (_c *MockPVZProvider_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.PVZ, error)) *MockPVZProvider_GetAll_Call {
+func (_c *MockASNProvider_GetReconciliation_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID) (*domain.ReceptionReconciliation, error)) *MockASNProvider_GetReconciliation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWithParam provides a mock function for the type MockPVZProvider
-func (_mock *MockPVZProvider) GetWithParam(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error) {
-	ret := _mock.Called(ctx, params)
+// NewMockReceptionFinder creates a new instance of MockReceptionFinder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReceptionFinder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReceptionFinder {
+	mock := &MockReceptionFinder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReceptionFinder is an autogenerated mock type for the ReceptionFinder type
+type MockReceptionFinder struct {
+	mock.Mock
+}
+
+type MockReceptionFinder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReceptionFinder) EXPECT() *MockReceptionFinder_Expecter {
+	return &MockReceptionFinder_Expecter{mock: &_m.Mock}
+}
+
+// GetByID provides a mock function for the type MockReceptionFinder
+func (_mock *MockReceptionFinder) GetByID(ctx context.Context, id uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWithParam")
+		panic("no return value specified for GetByID")
 	}
 
-	var r0 []domain.PVZAgregate
+	var r0 *domain.Reception
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) ([]domain.PVZAgregate, error)); ok {
-		return returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) []domain.PVZAgregate); ok {
-		r0 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.PVZAgregate)
+			r0 = ret.Get(0).(*domain.Reception)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Params) error); ok {
-		r1 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPVZProvider_GetWithParam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithParam'
-type MockPVZProvider_GetWithParam_Call struct {
+// MockReceptionFinder_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockReceptionFinder_GetByID_Call struct {
 	*mock.Call
 }
 
-// GetWithParam is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
 //   - ctx
-//   - params
-func (_e *MockPVZProvider_Expecter) GetWithParam(ctx interface{}, params interface{}) *MockPVZProvider_GetWithParam_Call {
-	return &MockPVZProvider_GetWithParam_Call{Call: _e.mock.On("GetWithParam", ctx, params)}
+//   - id
+func (_e *MockReceptionFinder_Expecter) GetByID(ctx interface{}, id interface{}) *MockReceptionFinder_GetByID_Call {
+	return &MockReceptionFinder_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *MockPVZProvider_GetWithParam_Call) Run(run func(ctx context.Context, params domain.Params)) *MockPVZProvider_GetWithParam_Call {
+func (_c *MockReceptionFinder_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockReceptionFinder_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Params))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockPVZProvider_GetWithParam_Call) Return(pVZAgregates []domain.PVZAgregate, err error) *MockPVZProvider_GetWithParam_Call {
-	_c.Call.Return(pVZAgregates, err)
+func (_c *MockReceptionFinder_GetByID_Call) Return(reception *domain.Reception, err error) *MockReceptionFinder_GetByID_Call {
+	_c.Call.Return(reception, err)
 	return _c
 }
 
-func (_c *MockPVZProvider_GetWithParam_Call) RunAndReturn(run func(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error)) *MockPVZProvider_GetWithParam_Call {
+func (_c *MockReceptionFinder_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.Reception, error)) *MockReceptionFinder_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockReceptionProvider creates a new instance of MockReceptionProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAuditRecorder creates a new instance of MockAuditRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockReceptionProvider(t interface {
+func NewMockAuditRecorder(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockReceptionProvider {
-	mock := &MockReceptionProvider{}
+}) *MockAuditRecorder {
+	mock := &MockAuditRecorder{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -545,175 +520,275 @@ func NewMockReceptionProvider(t interface {
 	return mock
 }
 
-// MockReceptionProvider is an autogenerated mock type for the ReceptionProvider type
-type MockReceptionProvider struct {
+// MockAuditRecorder is an autogenerated mock type for the AuditRecorder type
+type MockAuditRecorder struct {
 	mock.Mock
 }
 
-type MockReceptionProvider_Expecter struct {
+type MockAuditRecorder_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockReceptionProvider) EXPECT() *MockReceptionProvider_Expecter {
-	return &MockReceptionProvider_Expecter{mock: &_m.Mock}
+func (_m *MockAuditRecorder) EXPECT() *MockAuditRecorder_Expecter {
+	return &MockAuditRecorder_Expecter{mock: &_m.Mock}
 }
 
-// Close provides a mock function for the type MockReceptionProvider
-func (_mock *MockReceptionProvider) Close(ctx context.Context, reception domain.Reception) error {
-	ret := _mock.Called(ctx, reception)
+// Record provides a mock function for the type MockAuditRecorder
+func (_mock *MockAuditRecorder) Record(ctx context.Context, event domain.AuditEvent) {
+	_mock.Called(ctx, event)
+	return
+}
+
+// MockAuditRecorder_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockAuditRecorder_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx
+//   - event
+func (_e *MockAuditRecorder_Expecter) Record(ctx interface{}, event interface{}) *MockAuditRecorder_Record_Call {
+	return &MockAuditRecorder_Record_Call{Call: _e.mock.On("Record", ctx, event)}
+}
+
+func (_c *MockAuditRecorder_Record_Call) Run(run func(ctx context.Context, event domain.AuditEvent)) *MockAuditRecorder_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *MockAuditRecorder_Record_Call) Return() *MockAuditRecorder_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockAuditRecorder_Record_Call) RunAndReturn(run func(ctx context.Context, event domain.AuditEvent)) *MockAuditRecorder_Record_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockAuditProvider creates a new instance of MockAuditProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditProvider {
+	mock := &MockAuditProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuditProvider is an autogenerated mock type for the AuditProvider type
+type MockAuditProvider struct {
+	mock.Mock
+}
+
+type MockAuditProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditProvider) EXPECT() *MockAuditProvider_Expecter {
+	return &MockAuditProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockAuditProvider
+func (_mock *MockAuditProvider) Create(ctx context.Context, entry *domain.AuditEntry) error {
+	ret := _mock.Called(ctx, entry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Close")
+		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) error); ok {
-		r0 = returnFunc(ctx, reception)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AuditEntry) error); ok {
+		r0 = returnFunc(ctx, entry)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockReceptionProvider_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockReceptionProvider_Close_Call struct {
+// MockAuditProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAuditProvider_Create_Call struct {
 	*mock.Call
 }
 
-// Close is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx
-//   - reception
-func (_e *MockReceptionProvider_Expecter) Close(ctx interface{}, reception interface{}) *MockReceptionProvider_Close_Call {
-	return &MockReceptionProvider_Close_Call{Call: _e.mock.On("Close", ctx, reception)}
+//   - entry
+func (_e *MockAuditProvider_Expecter) Create(ctx interface{}, entry interface{}) *MockAuditProvider_Create_Call {
+	return &MockAuditProvider_Create_Call{Call: _e.mock.On("Create", ctx, entry)}
 }
 
-func (_c *MockReceptionProvider_Close_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockReceptionProvider_Close_Call {
+func (_c *MockAuditProvider_Create_Call) Run(run func(ctx context.Context, entry *domain.AuditEntry)) *MockAuditProvider_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Reception))
+		run(args[0].(context.Context), args[1].(*domain.AuditEntry))
 	})
 	return _c
 }
 
-func (_c *MockReceptionProvider_Close_Call) Return(err error) *MockReceptionProvider_Close_Call {
+func (_c *MockAuditProvider_Create_Call) Return(err error) *MockAuditProvider_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockReceptionProvider_Close_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) error) *MockReceptionProvider_Close_Call {
+func (_c *MockAuditProvider_Create_Call) RunAndReturn(run func(ctx context.Context, entry *domain.AuditEntry) error) *MockAuditProvider_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockReceptionProvider
-func (_mock *MockReceptionProvider) Create(ctx context.Context, reception domain.Reception) error {
-	ret := _mock.Called(ctx, reception)
+// List provides a mock function for the type MockAuditProvider
+func (_mock *MockAuditProvider) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for List")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) error); ok {
-		r0 = returnFunc(ctx, reception)
+	var r0 []domain.AuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuditFilter) ([]domain.AuditEntry, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuditFilter) []domain.AuditEntry); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEntry)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuditFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockReceptionProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockReceptionProvider_Create_Call struct {
+// MockAuditProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAuditProvider_List_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// List is a helper method to define mock.On call
 //   - ctx
-//   - reception
-func (_e *MockReceptionProvider_Expecter) Create(ctx interface{}, reception interface{}) *MockReceptionProvider_Create_Call {
-	return &MockReceptionProvider_Create_Call{Call: _e.mock.On("Create", ctx, reception)}
+//   - filter
+func (_e *MockAuditProvider_Expecter) List(ctx interface{}, filter interface{}) *MockAuditProvider_List_Call {
+	return &MockAuditProvider_List_Call{Call: _e.mock.On("List", ctx, filter)}
 }
 
-func (_c *MockReceptionProvider_Create_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockReceptionProvider_Create_Call {
+func (_c *MockAuditProvider_List_Call) Run(run func(ctx context.Context, filter domain.AuditFilter)) *MockAuditProvider_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Reception))
+		run(args[0].(context.Context), args[1].(domain.AuditFilter))
 	})
 	return _c
 }
 
-func (_c *MockReceptionProvider_Create_Call) Return(err error) *MockReceptionProvider_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockAuditProvider_List_Call) Return(auditEntrys []domain.AuditEntry, err error) *MockAuditProvider_List_Call {
+	_c.Call.Return(auditEntrys, err)
 	return _c
 }
 
-func (_c *MockReceptionProvider_Create_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) error) *MockReceptionProvider_Create_Call {
+func (_c *MockAuditProvider_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)) *MockAuditProvider_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLast provides a mock function for the type MockReceptionProvider
-func (_mock *MockReceptionProvider) GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error) {
-	ret := _mock.Called(ctx, pvz)
+// NewMockTokenAuthenticator creates a new instance of MockTokenAuthenticator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenAuthenticator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenAuthenticator {
+	mock := &MockTokenAuthenticator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTokenAuthenticator is an autogenerated mock type for the TokenAuthenticator type
+type MockTokenAuthenticator struct {
+	mock.Mock
+}
+
+type MockTokenAuthenticator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenAuthenticator) EXPECT() *MockTokenAuthenticator_Expecter {
+	return &MockTokenAuthenticator_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function for the type MockTokenAuthenticator
+func (_mock *MockTokenAuthenticator) Authenticate(ctx context.Context, token string) (*domain.Identity, error) {
+	ret := _mock.Called(ctx, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLast")
+		panic("no return value specified for Authenticate")
 	}
 
-	var r0 *domain.Reception
+	var r0 *domain.Identity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Reception, error)); ok {
-		return returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Identity, error)); ok {
+		return returnFunc(ctx, token)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Reception); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Identity); ok {
+		r0 = returnFunc(ctx, token)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Reception)
+			r0 = ret.Get(0).(*domain.Identity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockReceptionProvider_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
-type MockReceptionProvider_GetLast_Call struct {
+// MockTokenAuthenticator_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockTokenAuthenticator_Authenticate_Call struct {
 	*mock.Call
 }
 
-// GetLast is a helper method to define mock.On call
+// Authenticate is a helper method to define mock.On call
 //   - ctx
-//   - pvz
-func (_e *MockReceptionProvider_Expecter) GetLast(ctx interface{}, pvz interface{}) *MockReceptionProvider_GetLast_Call {
-	return &MockReceptionProvider_GetLast_Call{Call: _e.mock.On("GetLast", ctx, pvz)}
+//   - token
+func (_e *MockTokenAuthenticator_Expecter) Authenticate(ctx interface{}, token interface{}) *MockTokenAuthenticator_Authenticate_Call {
+	return &MockTokenAuthenticator_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, token)}
 }
 
-func (_c *MockReceptionProvider_GetLast_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockReceptionProvider_GetLast_Call {
+func (_c *MockTokenAuthenticator_Authenticate_Call) Run(run func(ctx context.Context, token string)) *MockTokenAuthenticator_Authenticate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockReceptionProvider_GetLast_Call) Return(reception *domain.Reception, err error) *MockReceptionProvider_GetLast_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockTokenAuthenticator_Authenticate_Call) Return(identity *domain.Identity, err error) *MockTokenAuthenticator_Authenticate_Call {
+	_c.Call.Return(identity, err)
 	return _c
 }
 
-func (_c *MockReceptionProvider_GetLast_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error)) *MockReceptionProvider_GetLast_Call {
+func (_c *MockTokenAuthenticator_Authenticate_Call) RunAndReturn(run func(ctx context.Context, token string) (*domain.Identity, error)) *MockTokenAuthenticator_Authenticate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJWTGenerator creates a new instance of MockJWTGenerator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockExternalAuthenticator creates a new instance of MockExternalAuthenticator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJWTGenerator(t interface {
+func NewMockExternalAuthenticator(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJWTGenerator {
-	mock := &MockJWTGenerator{}
+}) *MockExternalAuthenticator {
+	mock := &MockExternalAuthenticator{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -721,81 +796,127 @@ func NewMockJWTGenerator(t interface {
 	return mock
 }
 
-// MockJWTGenerator is an autogenerated mock type for the JWTGenerator type
-type MockJWTGenerator struct {
+// MockExternalAuthenticator is an autogenerated mock type for the ExternalAuthenticator type
+type MockExternalAuthenticator struct {
 	mock.Mock
 }
 
-type MockJWTGenerator_Expecter struct {
+type MockExternalAuthenticator_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJWTGenerator) EXPECT() *MockJWTGenerator_Expecter {
-	return &MockJWTGenerator_Expecter{mock: &_m.Mock}
+func (_m *MockExternalAuthenticator) EXPECT() *MockExternalAuthenticator_Expecter {
+	return &MockExternalAuthenticator_Expecter{mock: &_m.Mock}
 }
 
-// GenerateToken provides a mock function for the type MockJWTGenerator
-func (_mock *MockJWTGenerator) GenerateToken(email string, role string) (string, error) {
-	ret := _mock.Called(email, role)
+// Authenticate provides a mock function for the type MockExternalAuthenticator
+func (_mock *MockExternalAuthenticator) Authenticate(ctx context.Context, token string) (*domain.Identity, error) {
+	ret := _mock.Called(ctx, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateToken")
+		panic("no return value specified for Authenticate")
 	}
 
-	var r0 string
+	var r0 *domain.Identity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) (string, error)); ok {
-		return returnFunc(email, role)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Identity, error)); ok {
+		return returnFunc(ctx, token)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = returnFunc(email, role)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Identity); ok {
+		r0 = returnFunc(ctx, token)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Identity)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(email, role)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockJWTGenerator_GenerateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateToken'
-type MockJWTGenerator_GenerateToken_Call struct {
+// MockExternalAuthenticator_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockExternalAuthenticator_Authenticate_Call struct {
 	*mock.Call
 }
 
-// GenerateToken is a helper method to define mock.On call
-//   - email
-//   - role
-func (_e *MockJWTGenerator_Expecter) GenerateToken(email interface{}, role interface{}) *MockJWTGenerator_GenerateToken_Call {
-	return &MockJWTGenerator_GenerateToken_Call{Call: _e.mock.On("GenerateToken", email, role)}
+// Authenticate is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockExternalAuthenticator_Expecter) Authenticate(ctx interface{}, token interface{}) *MockExternalAuthenticator_Authenticate_Call {
+	return &MockExternalAuthenticator_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, token)}
 }
 
-func (_c *MockJWTGenerator_GenerateToken_Call) Run(run func(email string, role string)) *MockJWTGenerator_GenerateToken_Call {
+func (_c *MockExternalAuthenticator_Authenticate_Call) Run(run func(ctx context.Context, token string)) *MockExternalAuthenticator_Authenticate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockJWTGenerator_GenerateToken_Call) Return(s string, err error) *MockJWTGenerator_GenerateToken_Call {
-	_c.Call.Return(s, err)
+func (_c *MockExternalAuthenticator_Authenticate_Call) Return(identity *domain.Identity, err error) *MockExternalAuthenticator_Authenticate_Call {
+	_c.Call.Return(identity, err)
 	return _c
 }
 
-func (_c *MockJWTGenerator_GenerateToken_Call) RunAndReturn(run func(email string, role string) (string, error)) *MockJWTGenerator_GenerateToken_Call {
+func (_c *MockExternalAuthenticator_Authenticate_Call) RunAndReturn(run func(ctx context.Context, token string) (*domain.Identity, error)) *MockExternalAuthenticator_Authenticate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserProvider creates a new instance of MockUserProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// Issuer provides a mock function for the type MockExternalAuthenticator
+func (_mock *MockExternalAuthenticator) Issuer() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Issuer")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockExternalAuthenticator_Issuer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issuer'
+type MockExternalAuthenticator_Issuer_Call struct {
+	*mock.Call
+}
+
+// Issuer is a helper method to define mock.On call
+func (_e *MockExternalAuthenticator_Expecter) Issuer() *MockExternalAuthenticator_Issuer_Call {
+	return &MockExternalAuthenticator_Issuer_Call{Call: _e.mock.On("Issuer")}
+}
+
+func (_c *MockExternalAuthenticator_Issuer_Call) Run(run func()) *MockExternalAuthenticator_Issuer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockExternalAuthenticator_Issuer_Call) Return(s string) *MockExternalAuthenticator_Issuer_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockExternalAuthenticator_Issuer_Call) RunAndReturn(run func() string) *MockExternalAuthenticator_Issuer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCityProvider creates a new instance of MockCityProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockUserProvider(t interface {
+func NewMockCityProvider(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockUserProvider {
-	mock := &MockUserProvider{}
+}) *MockCityProvider {
+	mock := &MockCityProvider{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -803,118 +924,4968 @@ func NewMockUserProvider(t interface {
 	return mock
 }
 
-// MockUserProvider is an autogenerated mock type for the UserProvider type
-type MockUserProvider struct {
+// MockCityProvider is an autogenerated mock type for the CityProvider type
+type MockCityProvider struct {
 	mock.Mock
 }
 
-type MockUserProvider_Expecter struct {
+type MockCityProvider_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockUserProvider) EXPECT() *MockUserProvider_Expecter {
-	return &MockUserProvider_Expecter{mock: &_m.Mock}
+func (_m *MockCityProvider) EXPECT() *MockCityProvider_Expecter {
+	return &MockCityProvider_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockUserProvider
-func (_mock *MockUserProvider) Create(ctx context.Context, user *domain.User) error {
-	ret := _mock.Called(ctx, user)
+// Create provides a mock function for the type MockCityProvider
+func (_mock *MockCityProvider) Create(ctx context.Context, city *domain.City) error {
+	ret := _mock.Called(ctx, city)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.City) error); ok {
+		r0 = returnFunc(ctx, city)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockUserProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockUserProvider_Create_Call struct {
+// MockCityProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCityProvider_Create_Call struct {
 	*mock.Call
 }
 
 // Create is a helper method to define mock.On call
 //   - ctx
-//   - user
-func (_e *MockUserProvider_Expecter) Create(ctx interface{}, user interface{}) *MockUserProvider_Create_Call {
-	return &MockUserProvider_Create_Call{Call: _e.mock.On("Create", ctx, user)}
+//   - city
+func (_e *MockCityProvider_Expecter) Create(ctx interface{}, city interface{}) *MockCityProvider_Create_Call {
+	return &MockCityProvider_Create_Call{Call: _e.mock.On("Create", ctx, city)}
 }
 
-func (_c *MockUserProvider_Create_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserProvider_Create_Call {
+func (_c *MockCityProvider_Create_Call) Run(run func(ctx context.Context, city *domain.City)) *MockCityProvider_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.User))
+		run(args[0].(context.Context), args[1].(*domain.City))
 	})
 	return _c
 }
 
-func (_c *MockUserProvider_Create_Call) Return(err error) *MockUserProvider_Create_Call {
+func (_c *MockCityProvider_Create_Call) Return(err error) *MockCityProvider_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserProvider_Create_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) error) *MockUserProvider_Create_Call {
+func (_c *MockCityProvider_Create_Call) RunAndReturn(run func(ctx context.Context, city *domain.City) error) *MockCityProvider_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByEmail provides a mock function for the type MockUserProvider
-func (_mock *MockUserProvider) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
-	ret := _mock.Called(ctx, email)
+// GetByID provides a mock function for the type MockCityProvider
+func (_mock *MockCityProvider) GetByID(ctx context.Context, id uuid.UUID) (*domain.City, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByEmail")
+		panic("no return value specified for GetByID")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.City
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.City, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.City); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.City)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserProvider_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
-type MockUserProvider_GetByEmail_Call struct {
+// MockCityProvider_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCityProvider_GetByID_Call struct {
 	*mock.Call
 }
 
-// GetByEmail is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
 //   - ctx
-//   - email
-func (_e *MockUserProvider_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockUserProvider_GetByEmail_Call {
-	return &MockUserProvider_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+//   - id
+func (_e *MockCityProvider_Expecter) GetByID(ctx interface{}, id interface{}) *MockCityProvider_GetByID_Call {
+	return &MockCityProvider_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *MockUserProvider_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserProvider_GetByEmail_Call {
+func (_c *MockCityProvider_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockCityProvider_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockUserProvider_GetByEmail_Call) Return(user *domain.User, err error) *MockUserProvider_GetByEmail_Call {
-	_c.Call.Return(user, err)
+func (_c *MockCityProvider_GetByID_Call) Return(city *domain.City, err error) *MockCityProvider_GetByID_Call {
+	_c.Call.Return(city, err)
 	return _c
 }
 
-func (_c *MockUserProvider_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockUserProvider_GetByEmail_Call {
+func (_c *MockCityProvider_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.City, error)) *MockCityProvider_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockCityProvider
+func (_mock *MockCityProvider) List(ctx context.Context) ([]domain.City, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.City
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.City, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.City); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.City)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCityProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockCityProvider_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+func (_e *MockCityProvider_Expecter) List(ctx interface{}) *MockCityProvider_List_Call {
+	return &MockCityProvider_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockCityProvider_List_Call) Run(run func(ctx context.Context)) *MockCityProvider_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCityProvider_List_Call) Return(citys []domain.City, err error) *MockCityProvider_List_Call {
+	_c.Call.Return(citys, err)
+	return _c
+}
+
+func (_c *MockCityProvider_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.City, error)) *MockCityProvider_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetActive provides a mock function for the type MockCityProvider
+func (_mock *MockCityProvider) SetActive(ctx context.Context, id uuid.UUID, active bool) error {
+	ret := _mock.Called(ctx, id, active)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetActive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = returnFunc(ctx, id, active)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCityProvider_SetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetActive'
+type MockCityProvider_SetActive_Call struct {
+	*mock.Call
+}
+
+// SetActive is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - active
+func (_e *MockCityProvider_Expecter) SetActive(ctx interface{}, id interface{}, active interface{}) *MockCityProvider_SetActive_Call {
+	return &MockCityProvider_SetActive_Call{Call: _e.mock.On("SetActive", ctx, id, active)}
+}
+
+func (_c *MockCityProvider_SetActive_Call) Run(run func(ctx context.Context, id uuid.UUID, active bool)) *MockCityProvider_SetActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockCityProvider_SetActive_Call) Return(err error) *MockCityProvider_SetActive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCityProvider_SetActive_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, active bool) error) *MockCityProvider_SetActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoginAttemptProvider creates a new instance of MockLoginAttemptProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoginAttemptProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoginAttemptProvider {
+	mock := &MockLoginAttemptProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLoginAttemptProvider is an autogenerated mock type for the LoginAttemptProvider type
+type MockLoginAttemptProvider struct {
+	mock.Mock
+}
+
+type MockLoginAttemptProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoginAttemptProvider) EXPECT() *MockLoginAttemptProvider_Expecter {
+	return &MockLoginAttemptProvider_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockLoginAttemptProvider
+func (_mock *MockLoginAttemptProvider) Delete(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginAttemptProvider_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockLoginAttemptProvider_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - key
+func (_e *MockLoginAttemptProvider_Expecter) Delete(ctx interface{}, key interface{}) *MockLoginAttemptProvider_Delete_Call {
+	return &MockLoginAttemptProvider_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockLoginAttemptProvider_Delete_Call) Run(run func(ctx context.Context, key string)) *MockLoginAttemptProvider_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptProvider_Delete_Call) Return(err error) *MockLoginAttemptProvider_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginAttemptProvider_Delete_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockLoginAttemptProvider_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockLoginAttemptProvider
+func (_mock *MockLoginAttemptProvider) Get(ctx context.Context, key string) (*domain.LoginAttempt, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.LoginAttempt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.LoginAttempt, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.LoginAttempt); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginAttempt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginAttemptProvider_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockLoginAttemptProvider_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx
+//   - key
+func (_e *MockLoginAttemptProvider_Expecter) Get(ctx interface{}, key interface{}) *MockLoginAttemptProvider_Get_Call {
+	return &MockLoginAttemptProvider_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockLoginAttemptProvider_Get_Call) Run(run func(ctx context.Context, key string)) *MockLoginAttemptProvider_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptProvider_Get_Call) Return(loginAttempt *domain.LoginAttempt, err error) *MockLoginAttemptProvider_Get_Call {
+	_c.Call.Return(loginAttempt, err)
+	return _c
+}
+
+func (_c *MockLoginAttemptProvider_Get_Call) RunAndReturn(run func(ctx context.Context, key string) (*domain.LoginAttempt, error)) *MockLoginAttemptProvider_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lock provides a mock function for the type MockLoginAttemptProvider
+func (_mock *MockLoginAttemptProvider) Lock(ctx context.Context, key string, until time.Time) error {
+	ret := _mock.Called(ctx, key, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, key, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginAttemptProvider_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type MockLoginAttemptProvider_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx
+//   - key
+//   - until
+func (_e *MockLoginAttemptProvider_Expecter) Lock(ctx interface{}, key interface{}, until interface{}) *MockLoginAttemptProvider_Lock_Call {
+	return &MockLoginAttemptProvider_Lock_Call{Call: _e.mock.On("Lock", ctx, key, until)}
+}
+
+func (_c *MockLoginAttemptProvider_Lock_Call) Run(run func(ctx context.Context, key string, until time.Time)) *MockLoginAttemptProvider_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptProvider_Lock_Call) Return(err error) *MockLoginAttemptProvider_Lock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginAttemptProvider_Lock_Call) RunAndReturn(run func(ctx context.Context, key string, until time.Time) error) *MockLoginAttemptProvider_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailure provides a mock function for the type MockLoginAttemptProvider
+func (_mock *MockLoginAttemptProvider) RecordFailure(ctx context.Context, key string, windowStart time.Time, now time.Time) (*domain.LoginAttempt, error) {
+	ret := _mock.Called(ctx, key, windowStart, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 *domain.LoginAttempt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (*domain.LoginAttempt, error)); ok {
+		return returnFunc(ctx, key, windowStart, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) *domain.LoginAttempt); ok {
+		r0 = returnFunc(ctx, key, windowStart, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginAttempt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, key, windowStart, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginAttemptProvider_RecordFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailure'
+type MockLoginAttemptProvider_RecordFailure_Call struct {
+	*mock.Call
+}
+
+// RecordFailure is a helper method to define mock.On call
+//   - ctx
+//   - key
+//   - windowStart
+//   - now
+func (_e *MockLoginAttemptProvider_Expecter) RecordFailure(ctx interface{}, key interface{}, windowStart interface{}, now interface{}) *MockLoginAttemptProvider_RecordFailure_Call {
+	return &MockLoginAttemptProvider_RecordFailure_Call{Call: _e.mock.On("RecordFailure", ctx, key, windowStart, now)}
+}
+
+func (_c *MockLoginAttemptProvider_RecordFailure_Call) Run(run func(ctx context.Context, key string, windowStart time.Time, now time.Time)) *MockLoginAttemptProvider_RecordFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptProvider_RecordFailure_Call) Return(loginAttempt *domain.LoginAttempt, err error) *MockLoginAttemptProvider_RecordFailure_Call {
+	_c.Call.Return(loginAttempt, err)
+	return _c
+}
+
+func (_c *MockLoginAttemptProvider_RecordFailure_Call) RunAndReturn(run func(ctx context.Context, key string, windowStart time.Time, now time.Time) (*domain.LoginAttempt, error)) *MockLoginAttemptProvider_RecordFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExternalTokenVerifier creates a new instance of MockExternalTokenVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExternalTokenVerifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExternalTokenVerifier {
+	mock := &MockExternalTokenVerifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExternalTokenVerifier is an autogenerated mock type for the ExternalTokenVerifier type
+type MockExternalTokenVerifier struct {
+	mock.Mock
+}
+
+type MockExternalTokenVerifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExternalTokenVerifier) EXPECT() *MockExternalTokenVerifier_Expecter {
+	return &MockExternalTokenVerifier_Expecter{mock: &_m.Mock}
+}
+
+// Issuer provides a mock function for the type MockExternalTokenVerifier
+func (_mock *MockExternalTokenVerifier) Issuer() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Issuer")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockExternalTokenVerifier_Issuer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issuer'
+type MockExternalTokenVerifier_Issuer_Call struct {
+	*mock.Call
+}
+
+// Issuer is a helper method to define mock.On call
+func (_e *MockExternalTokenVerifier_Expecter) Issuer() *MockExternalTokenVerifier_Issuer_Call {
+	return &MockExternalTokenVerifier_Issuer_Call{Call: _e.mock.On("Issuer")}
+}
+
+func (_c *MockExternalTokenVerifier_Issuer_Call) Run(run func()) *MockExternalTokenVerifier_Issuer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockExternalTokenVerifier_Issuer_Call) Return(s string) *MockExternalTokenVerifier_Issuer_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockExternalTokenVerifier_Issuer_Call) RunAndReturn(run func() string) *MockExternalTokenVerifier_Issuer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function for the type MockExternalTokenVerifier
+func (_mock *MockExternalTokenVerifier) Verify(ctx context.Context, token string) (*domain.ExternalIdentity, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 *domain.ExternalIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.ExternalIdentity, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.ExternalIdentity); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExternalIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExternalTokenVerifier_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockExternalTokenVerifier_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockExternalTokenVerifier_Expecter) Verify(ctx interface{}, token interface{}) *MockExternalTokenVerifier_Verify_Call {
+	return &MockExternalTokenVerifier_Verify_Call{Call: _e.mock.On("Verify", ctx, token)}
+}
+
+func (_c *MockExternalTokenVerifier_Verify_Call) Run(run func(ctx context.Context, token string)) *MockExternalTokenVerifier_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExternalTokenVerifier_Verify_Call) Return(externalIdentity *domain.ExternalIdentity, err error) *MockExternalTokenVerifier_Verify_Call {
+	_c.Call.Return(externalIdentity, err)
+	return _c
+}
+
+func (_c *MockExternalTokenVerifier_Verify_Call) RunAndReturn(run func(ctx context.Context, token string) (*domain.ExternalIdentity, error)) *MockExternalTokenVerifier_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExternalUserProvider creates a new instance of MockExternalUserProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExternalUserProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExternalUserProvider {
+	mock := &MockExternalUserProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExternalUserProvider is an autogenerated mock type for the ExternalUserProvider type
+type MockExternalUserProvider struct {
+	mock.Mock
+}
+
+type MockExternalUserProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExternalUserProvider) EXPECT() *MockExternalUserProvider_Expecter {
+	return &MockExternalUserProvider_Expecter{mock: &_m.Mock}
+}
+
+// CreateExternal provides a mock function for the type MockExternalUserProvider
+func (_mock *MockExternalUserProvider) CreateExternal(ctx context.Context, user *domain.User, issuer string, subject string) error {
+	ret := _mock.Called(ctx, user, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExternal")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, string, string) error); ok {
+		r0 = returnFunc(ctx, user, issuer, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExternalUserProvider_CreateExternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExternal'
+type MockExternalUserProvider_CreateExternal_Call struct {
+	*mock.Call
+}
+
+// CreateExternal is a helper method to define mock.On call
+//   - ctx
+//   - user
+//   - issuer
+//   - subject
+func (_e *MockExternalUserProvider_Expecter) CreateExternal(ctx interface{}, user interface{}, issuer interface{}, subject interface{}) *MockExternalUserProvider_CreateExternal_Call {
+	return &MockExternalUserProvider_CreateExternal_Call{Call: _e.mock.On("CreateExternal", ctx, user, issuer, subject)}
+}
+
+func (_c *MockExternalUserProvider_CreateExternal_Call) Run(run func(ctx context.Context, user *domain.User, issuer string, subject string)) *MockExternalUserProvider_CreateExternal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.User), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockExternalUserProvider_CreateExternal_Call) Return(err error) *MockExternalUserProvider_CreateExternal_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExternalUserProvider_CreateExternal_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, issuer string, subject string) error) *MockExternalUserProvider_CreateExternal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByEmail provides a mock function for the type MockExternalUserProvider
+func (_mock *MockExternalUserProvider) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExternalUserProvider_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type MockExternalUserProvider_GetByEmail_Call struct {
+	*mock.Call
+}
+
+// GetByEmail is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockExternalUserProvider_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockExternalUserProvider_GetByEmail_Call {
+	return &MockExternalUserProvider_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+}
+
+func (_c *MockExternalUserProvider_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockExternalUserProvider_GetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExternalUserProvider_GetByEmail_Call) Return(user *domain.User, err error) *MockExternalUserProvider_GetByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockExternalUserProvider_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockExternalUserProvider_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByExternalID provides a mock function for the type MockExternalUserProvider
+func (_mock *MockExternalUserProvider) GetByExternalID(ctx context.Context, issuer string, subject string) (*domain.User, error) {
+	ret := _mock.Called(ctx, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByExternalID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, issuer, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.User); ok {
+		r0 = returnFunc(ctx, issuer, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, issuer, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExternalUserProvider_GetByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByExternalID'
+type MockExternalUserProvider_GetByExternalID_Call struct {
+	*mock.Call
+}
+
+// GetByExternalID is a helper method to define mock.On call
+//   - ctx
+//   - issuer
+//   - subject
+func (_e *MockExternalUserProvider_Expecter) GetByExternalID(ctx interface{}, issuer interface{}, subject interface{}) *MockExternalUserProvider_GetByExternalID_Call {
+	return &MockExternalUserProvider_GetByExternalID_Call{Call: _e.mock.On("GetByExternalID", ctx, issuer, subject)}
+}
+
+func (_c *MockExternalUserProvider_GetByExternalID_Call) Run(run func(ctx context.Context, issuer string, subject string)) *MockExternalUserProvider_GetByExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockExternalUserProvider_GetByExternalID_Call) Return(user *domain.User, err error) *MockExternalUserProvider_GetByExternalID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockExternalUserProvider_GetByExternalID_Call) RunAndReturn(run func(ctx context.Context, issuer string, subject string) (*domain.User, error)) *MockExternalUserProvider_GetByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkExternal provides a mock function for the type MockExternalUserProvider
+func (_mock *MockExternalUserProvider) LinkExternal(ctx context.Context, userID uuid.UUID, issuer string, subject string) error {
+	ret := _mock.Called(ctx, userID, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkExternal")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, issuer, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExternalUserProvider_LinkExternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkExternal'
+type MockExternalUserProvider_LinkExternal_Call struct {
+	*mock.Call
+}
+
+// LinkExternal is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - issuer
+//   - subject
+func (_e *MockExternalUserProvider_Expecter) LinkExternal(ctx interface{}, userID interface{}, issuer interface{}, subject interface{}) *MockExternalUserProvider_LinkExternal_Call {
+	return &MockExternalUserProvider_LinkExternal_Call{Call: _e.mock.On("LinkExternal", ctx, userID, issuer, subject)}
+}
+
+func (_c *MockExternalUserProvider_LinkExternal_Call) Run(run func(ctx context.Context, userID uuid.UUID, issuer string, subject string)) *MockExternalUserProvider_LinkExternal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockExternalUserProvider_LinkExternal_Call) Return(err error) *MockExternalUserProvider_LinkExternal_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExternalUserProvider_LinkExternal_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, issuer string, subject string) error) *MockExternalUserProvider_LinkExternal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function for the type MockExternalUserProvider
+func (_mock *MockExternalUserProvider) UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error {
+	ret := _mock.Called(ctx, id, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Role) error); ok {
+		r0 = returnFunc(ctx, id, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExternalUserProvider_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockExternalUserProvider_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - role
+func (_e *MockExternalUserProvider_Expecter) UpdateRole(ctx interface{}, id interface{}, role interface{}) *MockExternalUserProvider_UpdateRole_Call {
+	return &MockExternalUserProvider_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, id, role)}
+}
+
+func (_c *MockExternalUserProvider_UpdateRole_Call) Run(run func(ctx context.Context, id uuid.UUID, role domain.Role)) *MockExternalUserProvider_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockExternalUserProvider_UpdateRole_Call) Return(err error) *MockExternalUserProvider_UpdateRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExternalUserProvider_UpdateRole_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, role domain.Role) error) *MockExternalUserProvider_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPasswordHasher creates a new instance of MockPasswordHasher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPasswordHasher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPasswordHasher {
+	mock := &MockPasswordHasher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPasswordHasher is an autogenerated mock type for the PasswordHasher type
+type MockPasswordHasher struct {
+	mock.Mock
+}
+
+type MockPasswordHasher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPasswordHasher) EXPECT() *MockPasswordHasher_Expecter {
+	return &MockPasswordHasher_Expecter{mock: &_m.Mock}
+}
+
+// Hash provides a mock function for the type MockPasswordHasher
+func (_mock *MockPasswordHasher) Hash(password string) (string, error) {
+	ret := _mock.Called(password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hash")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(password)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(password)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(password)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPasswordHasher_Hash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hash'
+type MockPasswordHasher_Hash_Call struct {
+	*mock.Call
+}
+
+// Hash is a helper method to define mock.On call
+//   - password
+func (_e *MockPasswordHasher_Expecter) Hash(password interface{}) *MockPasswordHasher_Hash_Call {
+	return &MockPasswordHasher_Hash_Call{Call: _e.mock.On("Hash", password)}
+}
+
+func (_c *MockPasswordHasher_Hash_Call) Run(run func(password string)) *MockPasswordHasher_Hash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordHasher_Hash_Call) Return(s string, err error) *MockPasswordHasher_Hash_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockPasswordHasher_Hash_Call) RunAndReturn(run func(password string) (string, error)) *MockPasswordHasher_Hash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NeedsRehash provides a mock function for the type MockPasswordHasher
+func (_mock *MockPasswordHasher) NeedsRehash(hash string) bool {
+	ret := _mock.Called(hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NeedsRehash")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = returnFunc(hash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockPasswordHasher_NeedsRehash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NeedsRehash'
+type MockPasswordHasher_NeedsRehash_Call struct {
+	*mock.Call
+}
+
+// NeedsRehash is a helper method to define mock.On call
+//   - hash
+func (_e *MockPasswordHasher_Expecter) NeedsRehash(hash interface{}) *MockPasswordHasher_NeedsRehash_Call {
+	return &MockPasswordHasher_NeedsRehash_Call{Call: _e.mock.On("NeedsRehash", hash)}
+}
+
+func (_c *MockPasswordHasher_NeedsRehash_Call) Run(run func(hash string)) *MockPasswordHasher_NeedsRehash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordHasher_NeedsRehash_Call) Return(b bool) *MockPasswordHasher_NeedsRehash_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockPasswordHasher_NeedsRehash_Call) RunAndReturn(run func(hash string) bool) *MockPasswordHasher_NeedsRehash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function for the type MockPasswordHasher
+func (_mock *MockPasswordHasher) Verify(hash string, password string) bool {
+	ret := _mock.Called(hash, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = returnFunc(hash, password)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockPasswordHasher_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockPasswordHasher_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - hash
+//   - password
+func (_e *MockPasswordHasher_Expecter) Verify(hash interface{}, password interface{}) *MockPasswordHasher_Verify_Call {
+	return &MockPasswordHasher_Verify_Call{Call: _e.mock.On("Verify", hash, password)}
+}
+
+func (_c *MockPasswordHasher_Verify_Call) Run(run func(hash string, password string)) *MockPasswordHasher_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordHasher_Verify_Call) Return(b bool) *MockPasswordHasher_Verify_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockPasswordHasher_Verify_Call) RunAndReturn(run func(hash string, password string) bool) *MockPasswordHasher_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotifier creates a new instance of MockNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotifier {
+	mock := &MockNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotifier is an autogenerated mock type for the Notifier type
+type MockNotifier struct {
+	mock.Mock
+}
+
+type MockNotifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotifier) EXPECT() *MockNotifier_Expecter {
+	return &MockNotifier_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function for the type MockNotifier
+func (_mock *MockNotifier) Send(ctx context.Context, msg domain.Message) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Message) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotifier_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockNotifier_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx
+//   - msg
+func (_e *MockNotifier_Expecter) Send(ctx interface{}, msg interface{}) *MockNotifier_Send_Call {
+	return &MockNotifier_Send_Call{Call: _e.mock.On("Send", ctx, msg)}
+}
+
+func (_c *MockNotifier_Send_Call) Run(run func(ctx context.Context, msg domain.Message)) *MockNotifier_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Message))
+	})
+	return _c
+}
+
+func (_c *MockNotifier_Send_Call) Return(err error) *MockNotifier_Send_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotifier_Send_Call) RunAndReturn(run func(ctx context.Context, msg domain.Message) error) *MockNotifier_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPasswordResetProvider creates a new instance of MockPasswordResetProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPasswordResetProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPasswordResetProvider {
+	mock := &MockPasswordResetProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPasswordResetProvider is an autogenerated mock type for the PasswordResetProvider type
+type MockPasswordResetProvider struct {
+	mock.Mock
+}
+
+type MockPasswordResetProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPasswordResetProvider) EXPECT() *MockPasswordResetProvider_Expecter {
+	return &MockPasswordResetProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockPasswordResetProvider
+func (_mock *MockPasswordResetProvider) Create(ctx context.Context, token *domain.PasswordResetToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PasswordResetToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPasswordResetProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockPasswordResetProvider_Expecter) Create(ctx interface{}, token interface{}) *MockPasswordResetProvider_Create_Call {
+	return &MockPasswordResetProvider_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *MockPasswordResetProvider_Create_Call) Run(run func(ctx context.Context, token *domain.PasswordResetToken)) *MockPasswordResetProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PasswordResetToken))
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_Create_Call) Return(err error) *MockPasswordResetProvider_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_Create_Call) RunAndReturn(run func(ctx context.Context, token *domain.PasswordResetToken) error) *MockPasswordResetProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByHash provides a mock function for the type MockPasswordResetProvider
+func (_mock *MockPasswordResetProvider) GetByHash(ctx context.Context, hash string) (*domain.PasswordResetToken, error) {
+	ret := _mock.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHash")
+	}
+
+	var r0 *domain.PasswordResetToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.PasswordResetToken, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.PasswordResetToken); ok {
+		r0 = returnFunc(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PasswordResetToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPasswordResetProvider_GetByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHash'
+type MockPasswordResetProvider_GetByHash_Call struct {
+	*mock.Call
+}
+
+// GetByHash is a helper method to define mock.On call
+//   - ctx
+//   - hash
+func (_e *MockPasswordResetProvider_Expecter) GetByHash(ctx interface{}, hash interface{}) *MockPasswordResetProvider_GetByHash_Call {
+	return &MockPasswordResetProvider_GetByHash_Call{Call: _e.mock.On("GetByHash", ctx, hash)}
+}
+
+func (_c *MockPasswordResetProvider_GetByHash_Call) Run(run func(ctx context.Context, hash string)) *MockPasswordResetProvider_GetByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_GetByHash_Call) Return(passwordResetToken *domain.PasswordResetToken, err error) *MockPasswordResetProvider_GetByHash_Call {
+	_c.Call.Return(passwordResetToken, err)
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_GetByHash_Call) RunAndReturn(run func(ctx context.Context, hash string) (*domain.PasswordResetToken, error)) *MockPasswordResetProvider_GetByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Use provides a mock function for the type MockPasswordResetProvider
+func (_mock *MockPasswordResetProvider) Use(ctx context.Context, token *domain.PasswordResetToken, passwordHash string) error {
+	ret := _mock.Called(ctx, token, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Use")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PasswordResetToken, string) error); ok {
+		r0 = returnFunc(ctx, token, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetProvider_Use_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Use'
+type MockPasswordResetProvider_Use_Call struct {
+	*mock.Call
+}
+
+// Use is a helper method to define mock.On call
+//   - ctx
+//   - token
+//   - passwordHash
+func (_e *MockPasswordResetProvider_Expecter) Use(ctx interface{}, token interface{}, passwordHash interface{}) *MockPasswordResetProvider_Use_Call {
+	return &MockPasswordResetProvider_Use_Call{Call: _e.mock.On("Use", ctx, token, passwordHash)}
+}
+
+func (_c *MockPasswordResetProvider_Use_Call) Run(run func(ctx context.Context, token *domain.PasswordResetToken, passwordHash string)) *MockPasswordResetProvider_Use_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PasswordResetToken), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_Use_Call) Return(err error) *MockPasswordResetProvider_Use_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetProvider_Use_Call) RunAndReturn(run func(ctx context.Context, token *domain.PasswordResetToken, passwordHash string) error) *MockPasswordResetProvider_Use_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPasswordUserProvider creates a new instance of MockPasswordUserProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPasswordUserProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPasswordUserProvider {
+	mock := &MockPasswordUserProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPasswordUserProvider is an autogenerated mock type for the PasswordUserProvider type
+type MockPasswordUserProvider struct {
+	mock.Mock
+}
+
+type MockPasswordUserProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPasswordUserProvider) EXPECT() *MockPasswordUserProvider_Expecter {
+	return &MockPasswordUserProvider_Expecter{mock: &_m.Mock}
+}
+
+// GetByEmail provides a mock function for the type MockPasswordUserProvider
+func (_mock *MockPasswordUserProvider) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPasswordUserProvider_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type MockPasswordUserProvider_GetByEmail_Call struct {
+	*mock.Call
+}
+
+// GetByEmail is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockPasswordUserProvider_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockPasswordUserProvider_GetByEmail_Call {
+	return &MockPasswordUserProvider_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+}
+
+func (_c *MockPasswordUserProvider_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockPasswordUserProvider_GetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordUserProvider_GetByEmail_Call) Return(user *domain.User, err error) *MockPasswordUserProvider_GetByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockPasswordUserProvider_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockPasswordUserProvider_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionRevoker creates a new instance of MockSessionRevoker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionRevoker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionRevoker {
+	mock := &MockSessionRevoker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionRevoker is an autogenerated mock type for the SessionRevoker type
+type MockSessionRevoker struct {
+	mock.Mock
+}
+
+type MockSessionRevoker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionRevoker) EXPECT() *MockSessionRevoker_Expecter {
+	return &MockSessionRevoker_Expecter{mock: &_m.Mock}
+}
+
+// RevokeAll provides a mock function for the type MockSessionRevoker
+func (_mock *MockSessionRevoker) RevokeAll(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRevoker_RevokeAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAll'
+type MockSessionRevoker_RevokeAll_Call struct {
+	*mock.Call
+}
+
+// RevokeAll is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockSessionRevoker_Expecter) RevokeAll(ctx interface{}, userID interface{}) *MockSessionRevoker_RevokeAll_Call {
+	return &MockSessionRevoker_RevokeAll_Call{Call: _e.mock.On("RevokeAll", ctx, userID)}
+}
+
+func (_c *MockSessionRevoker_RevokeAll_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockSessionRevoker_RevokeAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionRevoker_RevokeAll_Call) Return(err error) *MockSessionRevoker_RevokeAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRevoker_RevokeAll_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *MockSessionRevoker_RevokeAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductProvider creates a new instance of MockProductProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductProvider {
+	mock := &MockProductProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductProvider is an autogenerated mock type for the ProductProvider type
+type MockProductProvider struct {
+	mock.Mock
+}
+
+type MockProductProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductProvider) EXPECT() *MockProductProvider_Expecter {
+	return &MockProductProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockProductProvider
+func (_mock *MockProductProvider) Create(ctx context.Context, product *domain.Product) error {
+	ret := _mock.Called(ctx, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) error); ok {
+		r0 = returnFunc(ctx, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProductProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - product
+func (_e *MockProductProvider_Expecter) Create(ctx interface{}, product interface{}) *MockProductProvider_Create_Call {
+	return &MockProductProvider_Create_Call{Call: _e.mock.On("Create", ctx, product)}
+}
+
+func (_c *MockProductProvider_Create_Call) Run(run func(ctx context.Context, product *domain.Product)) *MockProductProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Product))
+	})
+	return _c
+}
+
+func (_c *MockProductProvider_Create_Call) Return(err error) *MockProductProvider_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductProvider_Create_Call) RunAndReturn(run func(ctx context.Context, product *domain.Product) error) *MockProductProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatch provides a mock function for the type MockProductProvider
+func (_mock *MockProductProvider) CreateBatch(ctx context.Context, receptionID uuid.UUID, products []*domain.Product) error {
+	ret := _mock.Called(ctx, receptionID, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []*domain.Product) error); ok {
+		r0 = returnFunc(ctx, receptionID, products)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductProvider_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type MockProductProvider_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx
+//   - receptionID
+//   - products
+func (_e *MockProductProvider_Expecter) CreateBatch(ctx interface{}, receptionID interface{}, products interface{}) *MockProductProvider_CreateBatch_Call {
+	return &MockProductProvider_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, receptionID, products)}
+}
+
+func (_c *MockProductProvider_CreateBatch_Call) Run(run func(ctx context.Context, receptionID uuid.UUID, products []*domain.Product)) *MockProductProvider_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]*domain.Product))
+	})
+	return _c
+}
+
+func (_c *MockProductProvider_CreateBatch_Call) Return(err error) *MockProductProvider_CreateBatch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductProvider_CreateBatch_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID, products []*domain.Product) error) *MockProductProvider_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByBarcodes provides a mock function for the type MockProductProvider
+func (_mock *MockProductProvider) FindByBarcodes(ctx context.Context, barcodes []string) ([]domain.Product, error) {
+	ret := _mock.Called(ctx, barcodes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByBarcodes")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Product, error)); ok {
+		return returnFunc(ctx, barcodes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []domain.Product); ok {
+		r0 = returnFunc(ctx, barcodes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, barcodes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductProvider_FindByBarcodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByBarcodes'
+type MockProductProvider_FindByBarcodes_Call struct {
+	*mock.Call
+}
+
+// FindByBarcodes is a helper method to define mock.On call
+//   - ctx
+//   - barcodes
+func (_e *MockProductProvider_Expecter) FindByBarcodes(ctx interface{}, barcodes interface{}) *MockProductProvider_FindByBarcodes_Call {
+	return &MockProductProvider_FindByBarcodes_Call{Call: _e.mock.On("FindByBarcodes", ctx, barcodes)}
+}
+
+func (_c *MockProductProvider_FindByBarcodes_Call) Run(run func(ctx context.Context, barcodes []string)) *MockProductProvider_FindByBarcodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockProductProvider_FindByBarcodes_Call) Return(products []domain.Product, err error) *MockProductProvider_FindByBarcodes_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockProductProvider_FindByBarcodes_Call) RunAndReturn(run func(ctx context.Context, barcodes []string) ([]domain.Product, error)) *MockProductProvider_FindByBarcodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLast provides a mock function for the type MockProductProvider
+func (_mock *MockProductProvider) GetLast(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error) {
+	ret := _mock.Called(ctx, receptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLast")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Product, error)); ok {
+		return returnFunc(ctx, receptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Product); ok {
+		r0 = returnFunc(ctx, receptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductProvider_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
+type MockProductProvider_GetLast_Call struct {
+	*mock.Call
+}
+
+// GetLast is a helper method to define mock.On call
+//   - ctx
+//   - receptionID
+func (_e *MockProductProvider_Expecter) GetLast(ctx interface{}, receptionID interface{}) *MockProductProvider_GetLast_Call {
+	return &MockProductProvider_GetLast_Call{Call: _e.mock.On("GetLast", ctx, receptionID)}
+}
+
+func (_c *MockProductProvider_GetLast_Call) Run(run func(ctx context.Context, receptionID uuid.UUID)) *MockProductProvider_GetLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProductProvider_GetLast_Call) Return(product *domain.Product, err error) *MockProductProvider_GetLast_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductProvider_GetLast_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error)) *MockProductProvider_GetLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Void provides a mock function for the type MockProductProvider
+func (_mock *MockProductProvider) Void(ctx context.Context, void *domain.ProductVoid) (*domain.Product, error) {
+	ret := _mock.Called(ctx, void)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Void")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductVoid) (*domain.Product, error)); ok {
+		return returnFunc(ctx, void)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductVoid) *domain.Product); ok {
+		r0 = returnFunc(ctx, void)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ProductVoid) error); ok {
+		r1 = returnFunc(ctx, void)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductProvider_Void_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Void'
+type MockProductProvider_Void_Call struct {
+	*mock.Call
+}
+
+// Void is a helper method to define mock.On call
+//   - ctx
+//   - void
+func (_e *MockProductProvider_Expecter) Void(ctx interface{}, void interface{}) *MockProductProvider_Void_Call {
+	return &MockProductProvider_Void_Call{Call: _e.mock.On("Void", ctx, void)}
+}
+
+func (_c *MockProductProvider_Void_Call) Run(run func(ctx context.Context, void *domain.ProductVoid)) *MockProductProvider_Void_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ProductVoid))
+	})
+	return _c
+}
+
+func (_c *MockProductProvider_Void_Call) Return(product *domain.Product, err error) *MockProductProvider_Void_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductProvider_Void_Call) RunAndReturn(run func(ctx context.Context, void *domain.ProductVoid) (*domain.Product, error)) *MockProductProvider_Void_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReceptionGetter creates a new instance of MockReceptionGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReceptionGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReceptionGetter {
+	mock := &MockReceptionGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReceptionGetter is an autogenerated mock type for the ReceptionGetter type
+type MockReceptionGetter struct {
+	mock.Mock
+}
+
+type MockReceptionGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReceptionGetter) EXPECT() *MockReceptionGetter_Expecter {
+	return &MockReceptionGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetLast provides a mock function for the type MockReceptionGetter
+func (_mock *MockReceptionGetter) GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLast")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionGetter_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
+type MockReceptionGetter_GetLast_Call struct {
+	*mock.Call
+}
+
+// GetLast is a helper method to define mock.On call
+//   - ctx
+//   - pvz
+func (_e *MockReceptionGetter_Expecter) GetLast(ctx interface{}, pvz interface{}) *MockReceptionGetter_GetLast_Call {
+	return &MockReceptionGetter_GetLast_Call{Call: _e.mock.On("GetLast", ctx, pvz)}
+}
+
+func (_c *MockReceptionGetter_GetLast_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockReceptionGetter_GetLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReceptionGetter_GetLast_Call) Return(reception *domain.Reception, err error) *MockReceptionGetter_GetLast_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockReceptionGetter_GetLast_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error)) *MockReceptionGetter_GetLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPVZChecker creates a new instance of MockPVZChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPVZChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPVZChecker {
+	mock := &MockPVZChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPVZChecker is an autogenerated mock type for the PVZChecker type
+type MockPVZChecker struct {
+	mock.Mock
+}
+
+type MockPVZChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPVZChecker) EXPECT() *MockPVZChecker_Expecter {
+	return &MockPVZChecker_Expecter{mock: &_m.Mock}
+}
+
+// GetStatus provides a mock function for the type MockPVZChecker
+func (_mock *MockPVZChecker) GetStatus(ctx context.Context, pvz uuid.UUID) (domain.PVZStatus, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 domain.PVZStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.PVZStatus, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.PVZStatus); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		r0 = ret.Get(0).(domain.PVZStatus)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZChecker_GetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatus'
+type MockPVZChecker_GetStatus_Call struct {
+	*mock.Call
+}
+
+// GetStatus is a helper method to define mock.On call
+//   - ctx
+//   - pvz
+func (_e *MockPVZChecker_Expecter) GetStatus(ctx interface{}, pvz interface{}) *MockPVZChecker_GetStatus_Call {
+	return &MockPVZChecker_GetStatus_Call{Call: _e.mock.On("GetStatus", ctx, pvz)}
+}
+
+func (_c *MockPVZChecker_GetStatus_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockPVZChecker_GetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPVZChecker_GetStatus_Call) Return(pVZStatus domain.PVZStatus, err error) *MockPVZChecker_GetStatus_Call {
+	_c.Call.Return(pVZStatus, err)
+	return _c
+}
+
+func (_c *MockPVZChecker_GetStatus_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) (domain.PVZStatus, error)) *MockPVZChecker_GetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductTypeResolver creates a new instance of MockProductTypeResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductTypeResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductTypeResolver {
+	mock := &MockProductTypeResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductTypeResolver is an autogenerated mock type for the ProductTypeResolver type
+type MockProductTypeResolver struct {
+	mock.Mock
+}
+
+type MockProductTypeResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductTypeResolver) EXPECT() *MockProductTypeResolver_Expecter {
+	return &MockProductTypeResolver_Expecter{mock: &_m.Mock}
+}
+
+// Resolve provides a mock function for the type MockProductTypeResolver
+func (_mock *MockProductTypeResolver) Resolve(ctx context.Context, value string) (*domain.ProductTypeInfo, error) {
+	ret := _mock.Called(ctx, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 *domain.ProductTypeInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.ProductTypeInfo, error)); ok {
+		return returnFunc(ctx, value)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.ProductTypeInfo); ok {
+		r0 = returnFunc(ctx, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductTypeInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductTypeResolver_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type MockProductTypeResolver_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx
+//   - value
+func (_e *MockProductTypeResolver_Expecter) Resolve(ctx interface{}, value interface{}) *MockProductTypeResolver_Resolve_Call {
+	return &MockProductTypeResolver_Resolve_Call{Call: _e.mock.On("Resolve", ctx, value)}
+}
+
+func (_c *MockProductTypeResolver_Resolve_Call) Run(run func(ctx context.Context, value string)) *MockProductTypeResolver_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProductTypeResolver_Resolve_Call) Return(productTypeInfo *domain.ProductTypeInfo, err error) *MockProductTypeResolver_Resolve_Call {
+	_c.Call.Return(productTypeInfo, err)
+	return _c
+}
+
+func (_c *MockProductTypeResolver_Resolve_Call) RunAndReturn(run func(ctx context.Context, value string) (*domain.ProductTypeInfo, error)) *MockProductTypeResolver_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductTypeProvider creates a new instance of MockProductTypeProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductTypeProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductTypeProvider {
+	mock := &MockProductTypeProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductTypeProvider is an autogenerated mock type for the ProductTypeProvider type
+type MockProductTypeProvider struct {
+	mock.Mock
+}
+
+type MockProductTypeProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductTypeProvider) EXPECT() *MockProductTypeProvider_Expecter {
+	return &MockProductTypeProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockProductTypeProvider
+func (_mock *MockProductTypeProvider) Create(ctx context.Context, productType *domain.ProductTypeInfo) error {
+	ret := _mock.Called(ctx, productType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductTypeInfo) error); ok {
+		r0 = returnFunc(ctx, productType)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductTypeProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProductTypeProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - productType
+func (_e *MockProductTypeProvider_Expecter) Create(ctx interface{}, productType interface{}) *MockProductTypeProvider_Create_Call {
+	return &MockProductTypeProvider_Create_Call{Call: _e.mock.On("Create", ctx, productType)}
+}
+
+func (_c *MockProductTypeProvider_Create_Call) Run(run func(ctx context.Context, productType *domain.ProductTypeInfo)) *MockProductTypeProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ProductTypeInfo))
+	})
+	return _c
+}
+
+func (_c *MockProductTypeProvider_Create_Call) Return(err error) *MockProductTypeProvider_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductTypeProvider_Create_Call) RunAndReturn(run func(ctx context.Context, productType *domain.ProductTypeInfo) error) *MockProductTypeProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCode provides a mock function for the type MockProductTypeProvider
+func (_mock *MockProductTypeProvider) GetByCode(ctx context.Context, code domain.ProductType) (*domain.ProductTypeInfo, error) {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCode")
+	}
+
+	var r0 *domain.ProductTypeInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductType) (*domain.ProductTypeInfo, error)); ok {
+		return returnFunc(ctx, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductType) *domain.ProductTypeInfo); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductTypeInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductType) error); ok {
+		r1 = returnFunc(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductTypeProvider_GetByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCode'
+type MockProductTypeProvider_GetByCode_Call struct {
+	*mock.Call
+}
+
+// GetByCode is a helper method to define mock.On call
+//   - ctx
+//   - code
+func (_e *MockProductTypeProvider_Expecter) GetByCode(ctx interface{}, code interface{}) *MockProductTypeProvider_GetByCode_Call {
+	return &MockProductTypeProvider_GetByCode_Call{Call: _e.mock.On("GetByCode", ctx, code)}
+}
+
+func (_c *MockProductTypeProvider_GetByCode_Call) Run(run func(ctx context.Context, code domain.ProductType)) *MockProductTypeProvider_GetByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProductType))
+	})
+	return _c
+}
+
+func (_c *MockProductTypeProvider_GetByCode_Call) Return(productTypeInfo *domain.ProductTypeInfo, err error) *MockProductTypeProvider_GetByCode_Call {
+	_c.Call.Return(productTypeInfo, err)
+	return _c
+}
+
+func (_c *MockProductTypeProvider_GetByCode_Call) RunAndReturn(run func(ctx context.Context, code domain.ProductType) (*domain.ProductTypeInfo, error)) *MockProductTypeProvider_GetByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockProductTypeProvider
+func (_mock *MockProductTypeProvider) List(ctx context.Context) ([]domain.ProductTypeInfo, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.ProductTypeInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.ProductTypeInfo, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.ProductTypeInfo); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductTypeInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductTypeProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockProductTypeProvider_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+func (_e *MockProductTypeProvider_Expecter) List(ctx interface{}) *MockProductTypeProvider_List_Call {
+	return &MockProductTypeProvider_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockProductTypeProvider_List_Call) Run(run func(ctx context.Context)) *MockProductTypeProvider_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProductTypeProvider_List_Call) Return(productTypeInfos []domain.ProductTypeInfo, err error) *MockProductTypeProvider_List_Call {
+	_c.Call.Return(productTypeInfos, err)
+	return _c
+}
+
+func (_c *MockProductTypeProvider_List_Call) RunAndReturn(run func(ctx context.Context) ([]domain.ProductTypeInfo, error)) *MockProductTypeProvider_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockProductTypeProvider
+func (_mock *MockProductTypeProvider) Update(ctx context.Context, productType *domain.ProductTypeInfo) error {
+	ret := _mock.Called(ctx, productType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductTypeInfo) error); ok {
+		r0 = returnFunc(ctx, productType)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductTypeProvider_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProductTypeProvider_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - productType
+func (_e *MockProductTypeProvider_Expecter) Update(ctx interface{}, productType interface{}) *MockProductTypeProvider_Update_Call {
+	return &MockProductTypeProvider_Update_Call{Call: _e.mock.On("Update", ctx, productType)}
+}
+
+func (_c *MockProductTypeProvider_Update_Call) Run(run func(ctx context.Context, productType *domain.ProductTypeInfo)) *MockProductTypeProvider_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ProductTypeInfo))
+	})
+	return _c
+}
+
+func (_c *MockProductTypeProvider_Update_Call) Return(err error) *MockProductTypeProvider_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductTypeProvider_Update_Call) RunAndReturn(run func(ctx context.Context, productType *domain.ProductTypeInfo) error) *MockProductTypeProvider_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPVZProvider creates a new instance of MockPVZProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPVZProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPVZProvider {
+	mock := &MockPVZProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPVZProvider is an autogenerated mock type for the PVZProvider type
+type MockPVZProvider struct {
+	mock.Mock
+}
+
+type MockPVZProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPVZProvider) EXPECT() *MockPVZProvider_Expecter {
+	return &MockPVZProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) Create(ctx context.Context, pvz *domain.PVZ) error {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZ) error); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPVZProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPVZProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - pvz
+func (_e *MockPVZProvider_Expecter) Create(ctx interface{}, pvz interface{}) *MockPVZProvider_Create_Call {
+	return &MockPVZProvider_Create_Call{Call: _e.mock.On("Create", ctx, pvz)}
+}
+
+func (_c *MockPVZProvider_Create_Call) Run(run func(ctx context.Context, pvz *domain.PVZ)) *MockPVZProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PVZ))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_Create_Call) Return(err error) *MockPVZProvider_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPVZProvider_Create_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.PVZ) error) *MockPVZProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindNearest provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) FindNearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNearest")
+	}
+
+	var r0 []domain.NearbyPVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NearbyQuery) ([]domain.NearbyPVZ, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NearbyQuery) []domain.NearbyPVZ); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NearbyPVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.NearbyQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_FindNearest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNearest'
+type MockPVZProvider_FindNearest_Call struct {
+	*mock.Call
+}
+
+// FindNearest is a helper method to define mock.On call
+//   - ctx
+//   - query
+func (_e *MockPVZProvider_Expecter) FindNearest(ctx interface{}, query interface{}) *MockPVZProvider_FindNearest_Call {
+	return &MockPVZProvider_FindNearest_Call{Call: _e.mock.On("FindNearest", ctx, query)}
+}
+
+func (_c *MockPVZProvider_FindNearest_Call) Run(run func(ctx context.Context, query domain.NearbyQuery)) *MockPVZProvider_FindNearest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NearbyQuery))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_FindNearest_Call) Return(nearbyPVZs []domain.NearbyPVZ, err error) *MockPVZProvider_FindNearest_Call {
+	_c.Call.Return(nearbyPVZs, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_FindNearest_Call) RunAndReturn(run func(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error)) *MockPVZProvider_FindNearest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) GetAll(ctx context.Context, status *domain.PVZStatus) ([]domain.PVZ, error) {
+	ret := _mock.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZStatus) ([]domain.PVZ, error)); ok {
+		return returnFunc(ctx, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZStatus) []domain.PVZ); ok {
+		r0 = returnFunc(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PVZStatus) error); ok {
+		r1 = returnFunc(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockPVZProvider_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx
+//   - status
+func (_e *MockPVZProvider_Expecter) GetAll(ctx interface{}, status interface{}) *MockPVZProvider_GetAll_Call {
+	return &MockPVZProvider_GetAll_Call{Call: _e.mock.On("GetAll", ctx, status)}
+}
+
+func (_c *MockPVZProvider_GetAll_Call) Run(run func(ctx context.Context, status *domain.PVZStatus)) *MockPVZProvider_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PVZStatus))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_GetAll_Call) Return(pVZs []domain.PVZ, err error) *MockPVZProvider_GetAll_Call {
+	_c.Call.Return(pVZs, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_GetAll_Call) RunAndReturn(run func(ctx context.Context, status *domain.PVZStatus) ([]domain.PVZ, error)) *MockPVZProvider_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) GetByID(ctx context.Context, id uuid.UUID) (*domain.PVZ, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.PVZ, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.PVZ); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockPVZProvider_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockPVZProvider_Expecter) GetByID(ctx interface{}, id interface{}) *MockPVZProvider_GetByID_Call {
+	return &MockPVZProvider_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockPVZProvider_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockPVZProvider_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_GetByID_Call) Return(pVZ *domain.PVZ, err error) *MockPVZProvider_GetByID_Call {
+	_c.Call.Return(pVZ, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.PVZ, error)) *MockPVZProvider_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfileHistory provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) GetProfileHistory(ctx context.Context, pvzID uuid.UUID) ([]domain.PVZProfileChange, error) {
+	ret := _mock.Called(ctx, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfileHistory")
+	}
+
+	var r0 []domain.PVZProfileChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.PVZProfileChange, error)); ok {
+		return returnFunc(ctx, pvzID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.PVZProfileChange); ok {
+		r0 = returnFunc(ctx, pvzID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZProfileChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_GetProfileHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfileHistory'
+type MockPVZProvider_GetProfileHistory_Call struct {
+	*mock.Call
+}
+
+// GetProfileHistory is a helper method to define mock.On call
+//   - ctx
+//   - pvzID
+func (_e *MockPVZProvider_Expecter) GetProfileHistory(ctx interface{}, pvzID interface{}) *MockPVZProvider_GetProfileHistory_Call {
+	return &MockPVZProvider_GetProfileHistory_Call{Call: _e.mock.On("GetProfileHistory", ctx, pvzID)}
+}
+
+func (_c *MockPVZProvider_GetProfileHistory_Call) Run(run func(ctx context.Context, pvzID uuid.UUID)) *MockPVZProvider_GetProfileHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_GetProfileHistory_Call) Return(pVZProfileChanges []domain.PVZProfileChange, err error) *MockPVZProvider_GetProfileHistory_Call {
+	_c.Call.Return(pVZProfileChanges, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_GetProfileHistory_Call) RunAndReturn(run func(ctx context.Context, pvzID uuid.UUID) ([]domain.PVZProfileChange, error)) *MockPVZProvider_GetProfileHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWithParam provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) GetWithParam(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithParam")
+	}
+
+	var r0 []domain.PVZAgregate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) ([]domain.PVZAgregate, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Params) []domain.PVZAgregate); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZAgregate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Params) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZProvider_GetWithParam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithParam'
+type MockPVZProvider_GetWithParam_Call struct {
+	*mock.Call
+}
+
+// GetWithParam is a helper method to define mock.On call
+//   - ctx
+//   - params
+func (_e *MockPVZProvider_Expecter) GetWithParam(ctx interface{}, params interface{}) *MockPVZProvider_GetWithParam_Call {
+	return &MockPVZProvider_GetWithParam_Call{Call: _e.mock.On("GetWithParam", ctx, params)}
+}
+
+func (_c *MockPVZProvider_GetWithParam_Call) Run(run func(ctx context.Context, params domain.Params)) *MockPVZProvider_GetWithParam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Params))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_GetWithParam_Call) Return(pVZAgregates []domain.PVZAgregate, err error) *MockPVZProvider_GetWithParam_Call {
+	_c.Call.Return(pVZAgregates, err)
+	return _c
+}
+
+func (_c *MockPVZProvider_GetWithParam_Call) RunAndReturn(run func(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error)) *MockPVZProvider_GetWithParam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStatus provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) SetStatus(ctx context.Context, change *domain.PVZStatusChange) error {
+	ret := _mock.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZStatusChange) error); ok {
+		r0 = returnFunc(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPVZProvider_SetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStatus'
+type MockPVZProvider_SetStatus_Call struct {
+	*mock.Call
+}
+
+// SetStatus is a helper method to define mock.On call
+//   - ctx
+//   - change
+func (_e *MockPVZProvider_Expecter) SetStatus(ctx interface{}, change interface{}) *MockPVZProvider_SetStatus_Call {
+	return &MockPVZProvider_SetStatus_Call{Call: _e.mock.On("SetStatus", ctx, change)}
+}
+
+func (_c *MockPVZProvider_SetStatus_Call) Run(run func(ctx context.Context, change *domain.PVZStatusChange)) *MockPVZProvider_SetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PVZStatusChange))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_SetStatus_Call) Return(err error) *MockPVZProvider_SetStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPVZProvider_SetStatus_Call) RunAndReturn(run func(ctx context.Context, change *domain.PVZStatusChange) error) *MockPVZProvider_SetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function for the type MockPVZProvider
+func (_mock *MockPVZProvider) UpdateProfile(ctx context.Context, change *domain.PVZProfileChange) error {
+	ret := _mock.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PVZProfileChange) error); ok {
+		r0 = returnFunc(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPVZProvider_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockPVZProvider_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx
+//   - change
+func (_e *MockPVZProvider_Expecter) UpdateProfile(ctx interface{}, change interface{}) *MockPVZProvider_UpdateProfile_Call {
+	return &MockPVZProvider_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, change)}
+}
+
+func (_c *MockPVZProvider_UpdateProfile_Call) Run(run func(ctx context.Context, change *domain.PVZProfileChange)) *MockPVZProvider_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PVZProfileChange))
+	})
+	return _c
+}
+
+func (_c *MockPVZProvider_UpdateProfile_Call) Return(err error) *MockPVZProvider_UpdateProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPVZProvider_UpdateProfile_Call) RunAndReturn(run func(ctx context.Context, change *domain.PVZProfileChange) error) *MockPVZProvider_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCityChecker creates a new instance of MockCityChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCityChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCityChecker {
+	mock := &MockCityChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCityChecker is an autogenerated mock type for the CityChecker type
+type MockCityChecker struct {
+	mock.Mock
+}
+
+type MockCityChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCityChecker) EXPECT() *MockCityChecker_Expecter {
+	return &MockCityChecker_Expecter{mock: &_m.Mock}
+}
+
+// IsActive provides a mock function for the type MockCityChecker
+func (_mock *MockCityChecker) IsActive(ctx context.Context, name domain.PvzCity) (bool, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsActive")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PvzCity) (bool, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PvzCity) bool); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PvzCity) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCityChecker_IsActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsActive'
+type MockCityChecker_IsActive_Call struct {
+	*mock.Call
+}
+
+// IsActive is a helper method to define mock.On call
+//   - ctx
+//   - name
+func (_e *MockCityChecker_Expecter) IsActive(ctx interface{}, name interface{}) *MockCityChecker_IsActive_Call {
+	return &MockCityChecker_IsActive_Call{Call: _e.mock.On("IsActive", ctx, name)}
+}
+
+func (_c *MockCityChecker_IsActive_Call) Run(run func(ctx context.Context, name domain.PvzCity)) *MockCityChecker_IsActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PvzCity))
+	})
+	return _c
+}
+
+func (_c *MockCityChecker_IsActive_Call) Return(b bool, err error) *MockCityChecker_IsActive_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockCityChecker_IsActive_Call) RunAndReturn(run func(ctx context.Context, name domain.PvzCity) (bool, error)) *MockCityChecker_IsActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReceptionProvider creates a new instance of MockReceptionProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReceptionProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReceptionProvider {
+	mock := &MockReceptionProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReceptionProvider is an autogenerated mock type for the ReceptionProvider type
+type MockReceptionProvider struct {
+	mock.Mock
+}
+
+type MockReceptionProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReceptionProvider) EXPECT() *MockReceptionProvider_Expecter {
+	return &MockReceptionProvider_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function for the type MockReceptionProvider
+func (_mock *MockReceptionProvider) Close(ctx context.Context, reception domain.Reception) error {
+	ret := _mock.Called(ctx, reception)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) error); ok {
+		r0 = returnFunc(ctx, reception)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionProvider_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockReceptionProvider_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx
+//   - reception
+func (_e *MockReceptionProvider_Expecter) Close(ctx interface{}, reception interface{}) *MockReceptionProvider_Close_Call {
+	return &MockReceptionProvider_Close_Call{Call: _e.mock.On("Close", ctx, reception)}
+}
+
+func (_c *MockReceptionProvider_Close_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockReceptionProvider_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reception))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProvider_Close_Call) Return(err error) *MockReceptionProvider_Close_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionProvider_Close_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) error) *MockReceptionProvider_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseReconciled provides a mock function for the type MockReceptionProvider
+func (_mock *MockReceptionProvider) CloseReconciled(ctx context.Context, reception domain.Reception, report *domain.ReceptionReconciliation) error {
+	ret := _mock.Called(ctx, reception, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseReconciled")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception, *domain.ReceptionReconciliation) error); ok {
+		r0 = returnFunc(ctx, reception, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionProvider_CloseReconciled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReconciled'
+type MockReceptionProvider_CloseReconciled_Call struct {
+	*mock.Call
+}
+
+// CloseReconciled is a helper method to define mock.On call
+//   - ctx
+//   - reception
+//   - report
+func (_e *MockReceptionProvider_Expecter) CloseReconciled(ctx interface{}, reception interface{}, report interface{}) *MockReceptionProvider_CloseReconciled_Call {
+	return &MockReceptionProvider_CloseReconciled_Call{Call: _e.mock.On("CloseReconciled", ctx, reception, report)}
+}
+
+func (_c *MockReceptionProvider_CloseReconciled_Call) Run(run func(ctx context.Context, reception domain.Reception, report *domain.ReceptionReconciliation)) *MockReceptionProvider_CloseReconciled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reception), args[2].(*domain.ReceptionReconciliation))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProvider_CloseReconciled_Call) Return(err error) *MockReceptionProvider_CloseReconciled_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionProvider_CloseReconciled_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception, report *domain.ReceptionReconciliation) error) *MockReceptionProvider_CloseReconciled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockReceptionProvider
+func (_mock *MockReceptionProvider) Create(ctx context.Context, reception domain.Reception) error {
+	ret := _mock.Called(ctx, reception)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) error); ok {
+		r0 = returnFunc(ctx, reception)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReceptionProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - reception
+func (_e *MockReceptionProvider_Expecter) Create(ctx interface{}, reception interface{}) *MockReceptionProvider_Create_Call {
+	return &MockReceptionProvider_Create_Call{Call: _e.mock.On("Create", ctx, reception)}
+}
+
+func (_c *MockReceptionProvider_Create_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockReceptionProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reception))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProvider_Create_Call) Return(err error) *MockReceptionProvider_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionProvider_Create_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) error) *MockReceptionProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockReceptionProvider
+func (_mock *MockReceptionProvider) GetByID(ctx context.Context, id uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionProvider_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockReceptionProvider_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockReceptionProvider_Expecter) GetByID(ctx interface{}, id interface{}) *MockReceptionProvider_GetByID_Call {
+	return &MockReceptionProvider_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockReceptionProvider_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockReceptionProvider_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProvider_GetByID_Call) Return(reception *domain.Reception, err error) *MockReceptionProvider_GetByID_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockReceptionProvider_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.Reception, error)) *MockReceptionProvider_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLast provides a mock function for the type MockReceptionProvider
+func (_mock *MockReceptionProvider) GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLast")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionProvider_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
+type MockReceptionProvider_GetLast_Call struct {
+	*mock.Call
+}
+
+// GetLast is a helper method to define mock.On call
+//   - ctx
+//   - pvz
+func (_e *MockReceptionProvider_Expecter) GetLast(ctx interface{}, pvz interface{}) *MockReceptionProvider_GetLast_Call {
+	return &MockReceptionProvider_GetLast_Call{Call: _e.mock.On("GetLast", ctx, pvz)}
+}
+
+func (_c *MockReceptionProvider_GetLast_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockReceptionProvider_GetLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProvider_GetLast_Call) Return(reception *domain.Reception, err error) *MockReceptionProvider_GetLast_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockReceptionProvider_GetLast_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error)) *MockReceptionProvider_GetLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reopen provides a mock function for the type MockReceptionProvider
+func (_mock *MockReceptionProvider) Reopen(ctx context.Context, correction *domain.ReceptionCorrection) error {
+	ret := _mock.Called(ctx, correction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reopen")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionCorrection) error); ok {
+		r0 = returnFunc(ctx, correction)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionProvider_Reopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reopen'
+type MockReceptionProvider_Reopen_Call struct {
+	*mock.Call
+}
+
+// Reopen is a helper method to define mock.On call
+//   - ctx
+//   - correction
+func (_e *MockReceptionProvider_Expecter) Reopen(ctx interface{}, correction interface{}) *MockReceptionProvider_Reopen_Call {
+	return &MockReceptionProvider_Reopen_Call{Call: _e.mock.On("Reopen", ctx, correction)}
+}
+
+func (_c *MockReceptionProvider_Reopen_Call) Run(run func(ctx context.Context, correction *domain.ReceptionCorrection)) *MockReceptionProvider_Reopen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ReceptionCorrection))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProvider_Reopen_Call) Return(err error) *MockReceptionProvider_Reopen_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionProvider_Reopen_Call) RunAndReturn(run func(ctx context.Context, correction *domain.ReceptionCorrection) error) *MockReceptionProvider_Reopen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockASNGetter creates a new instance of MockASNGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockASNGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockASNGetter {
+	mock := &MockASNGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockASNGetter is an autogenerated mock type for the ASNGetter type
+type MockASNGetter struct {
+	mock.Mock
+}
+
+type MockASNGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockASNGetter) EXPECT() *MockASNGetter_Expecter {
+	return &MockASNGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetForReception provides a mock function for the type MockASNGetter
+func (_mock *MockASNGetter) GetForReception(ctx context.Context, reception domain.Reception) (*domain.ASN, error) {
+	ret := _mock.Called(ctx, reception)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForReception")
+	}
+
+	var r0 *domain.ASN
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) (*domain.ASN, error)); ok {
+		return returnFunc(ctx, reception)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Reception) *domain.ASN); ok {
+		r0 = returnFunc(ctx, reception)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ASN)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Reception) error); ok {
+		r1 = returnFunc(ctx, reception)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockASNGetter_GetForReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForReception'
+type MockASNGetter_GetForReception_Call struct {
+	*mock.Call
+}
+
+// GetForReception is a helper method to define mock.On call
+//   - ctx
+//   - reception
+func (_e *MockASNGetter_Expecter) GetForReception(ctx interface{}, reception interface{}) *MockASNGetter_GetForReception_Call {
+	return &MockASNGetter_GetForReception_Call{Call: _e.mock.On("GetForReception", ctx, reception)}
+}
+
+func (_c *MockASNGetter_GetForReception_Call) Run(run func(ctx context.Context, reception domain.Reception)) *MockASNGetter_GetForReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reception))
+	})
+	return _c
+}
+
+func (_c *MockASNGetter_GetForReception_Call) Return(aSN *domain.ASN, err error) *MockASNGetter_GetForReception_Call {
+	_c.Call.Return(aSN, err)
+	return _c
+}
+
+func (_c *MockASNGetter_GetForReception_Call) RunAndReturn(run func(ctx context.Context, reception domain.Reception) (*domain.ASN, error)) *MockASNGetter_GetForReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReceptionProducts creates a new instance of MockReceptionProducts. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReceptionProducts(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReceptionProducts {
+	mock := &MockReceptionProducts{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReceptionProducts is an autogenerated mock type for the ReceptionProducts type
+type MockReceptionProducts struct {
+	mock.Mock
+}
+
+type MockReceptionProducts_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReceptionProducts) EXPECT() *MockReceptionProducts_Expecter {
+	return &MockReceptionProducts_Expecter{mock: &_m.Mock}
+}
+
+// GetByReception provides a mock function for the type MockReceptionProducts
+func (_mock *MockReceptionProducts) GetByReception(ctx context.Context, receptionID uuid.UUID) ([]domain.Product, error) {
+	ret := _mock.Called(ctx, receptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByReception")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.Product, error)); ok {
+		return returnFunc(ctx, receptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.Product); ok {
+		r0 = returnFunc(ctx, receptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionProducts_GetByReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByReception'
+type MockReceptionProducts_GetByReception_Call struct {
+	*mock.Call
+}
+
+// GetByReception is a helper method to define mock.On call
+//   - ctx
+//   - receptionID
+func (_e *MockReceptionProducts_Expecter) GetByReception(ctx interface{}, receptionID interface{}) *MockReceptionProducts_GetByReception_Call {
+	return &MockReceptionProducts_GetByReception_Call{Call: _e.mock.On("GetByReception", ctx, receptionID)}
+}
+
+func (_c *MockReceptionProducts_GetByReception_Call) Run(run func(ctx context.Context, receptionID uuid.UUID)) *MockReceptionProducts_GetByReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReceptionProducts_GetByReception_Call) Return(products []domain.Product, err error) *MockReceptionProducts_GetByReception_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockReceptionProducts_GetByReception_Call) RunAndReturn(run func(ctx context.Context, receptionID uuid.UUID) ([]domain.Product, error)) *MockReceptionProducts_GetByReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdleReceptionCloser creates a new instance of MockIdleReceptionCloser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdleReceptionCloser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdleReceptionCloser {
+	mock := &MockIdleReceptionCloser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdleReceptionCloser is an autogenerated mock type for the IdleReceptionCloser type
+type MockIdleReceptionCloser struct {
+	mock.Mock
+}
+
+type MockIdleReceptionCloser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdleReceptionCloser) EXPECT() *MockIdleReceptionCloser_Expecter {
+	return &MockIdleReceptionCloser_Expecter{mock: &_m.Mock}
+}
+
+// CloseIdle provides a mock function for the type MockIdleReceptionCloser
+func (_mock *MockIdleReceptionCloser) CloseIdle(ctx context.Context, idleSince time.Time) ([]domain.Reception, error) {
+	ret := _mock.Called(ctx, idleSince)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseIdle")
+	}
+
+	var r0 []domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Reception, error)); ok {
+		return returnFunc(ctx, idleSince)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Reception); ok {
+		r0 = returnFunc(ctx, idleSince)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, idleSince)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdleReceptionCloser_CloseIdle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseIdle'
+type MockIdleReceptionCloser_CloseIdle_Call struct {
+	*mock.Call
+}
+
+// CloseIdle is a helper method to define mock.On call
+//   - ctx
+//   - idleSince
+func (_e *MockIdleReceptionCloser_Expecter) CloseIdle(ctx interface{}, idleSince interface{}) *MockIdleReceptionCloser_CloseIdle_Call {
+	return &MockIdleReceptionCloser_CloseIdle_Call{Call: _e.mock.On("CloseIdle", ctx, idleSince)}
+}
+
+func (_c *MockIdleReceptionCloser_CloseIdle_Call) Run(run func(ctx context.Context, idleSince time.Time)) *MockIdleReceptionCloser_CloseIdle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIdleReceptionCloser_CloseIdle_Call) Return(receptions []domain.Reception, err error) *MockIdleReceptionCloser_CloseIdle_Call {
+	_c.Call.Return(receptions, err)
+	return _c
+}
+
+func (_c *MockIdleReceptionCloser_CloseIdle_Call) RunAndReturn(run func(ctx context.Context, idleSince time.Time) ([]domain.Reception, error)) *MockIdleReceptionCloser_CloseIdle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionProvider creates a new instance of MockSessionProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionProvider {
+	mock := &MockSessionProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionProvider is an autogenerated mock type for the SessionProvider type
+type MockSessionProvider struct {
+	mock.Mock
+}
+
+type MockSessionProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionProvider) EXPECT() *MockSessionProvider_Expecter {
+	return &MockSessionProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) Create(ctx context.Context, token *domain.RefreshToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSessionProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockSessionProvider_Expecter) Create(ctx interface{}, token interface{}) *MockSessionProvider_Create_Call {
+	return &MockSessionProvider_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *MockSessionProvider_Create_Call) Run(run func(ctx context.Context, token *domain.RefreshToken)) *MockSessionProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_Create_Call) Return(err error) *MockSessionProvider_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionProvider_Create_Call) RunAndReturn(run func(ctx context.Context, token *domain.RefreshToken) error) *MockSessionProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByHash provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) GetByHash(ctx context.Context, hash string) (*domain.RefreshToken, error) {
+	ret := _mock.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHash")
+	}
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.RefreshToken, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.RefreshToken); ok {
+		r0 = returnFunc(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionProvider_GetByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHash'
+type MockSessionProvider_GetByHash_Call struct {
+	*mock.Call
+}
+
+// GetByHash is a helper method to define mock.On call
+//   - ctx
+//   - hash
+func (_e *MockSessionProvider_Expecter) GetByHash(ctx interface{}, hash interface{}) *MockSessionProvider_GetByHash_Call {
+	return &MockSessionProvider_GetByHash_Call{Call: _e.mock.On("GetByHash", ctx, hash)}
+}
+
+func (_c *MockSessionProvider_GetByHash_Call) Run(run func(ctx context.Context, hash string)) *MockSessionProvider_GetByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_GetByHash_Call) Return(refreshToken *domain.RefreshToken, err error) *MockSessionProvider_GetByHash_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockSessionProvider_GetByHash_Call) RunAndReturn(run func(ctx context.Context, hash string) (*domain.RefreshToken, error)) *MockSessionProvider_GetByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) GetByID(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.RefreshToken, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.RefreshToken); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionProvider_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockSessionProvider_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockSessionProvider_Expecter) GetByID(ctx interface{}, id interface{}) *MockSessionProvider_GetByID_Call {
+	return &MockSessionProvider_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockSessionProvider_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockSessionProvider_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_GetByID_Call) Return(refreshToken *domain.RefreshToken, err error) *MockSessionProvider_GetByID_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockSessionProvider_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error)) *MockSessionProvider_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) Revoke(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionProvider_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockSessionProvider_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockSessionProvider_Expecter) Revoke(ctx interface{}, id interface{}) *MockSessionProvider_Revoke_Call {
+	return &MockSessionProvider_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
+}
+
+func (_c *MockSessionProvider_Revoke_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockSessionProvider_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_Revoke_Call) Return(err error) *MockSessionProvider_Revoke_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionProvider_Revoke_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockSessionProvider_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllByUser provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) RevokeAllByUser(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllByUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionProvider_RevokeAllByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllByUser'
+type MockSessionProvider_RevokeAllByUser_Call struct {
+	*mock.Call
+}
+
+// RevokeAllByUser is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockSessionProvider_Expecter) RevokeAllByUser(ctx interface{}, userID interface{}) *MockSessionProvider_RevokeAllByUser_Call {
+	return &MockSessionProvider_RevokeAllByUser_Call{Call: _e.mock.On("RevokeAllByUser", ctx, userID)}
+}
+
+func (_c *MockSessionProvider_RevokeAllByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockSessionProvider_RevokeAllByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_RevokeAllByUser_Call) Return(err error) *MockSessionProvider_RevokeAllByUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionProvider_RevokeAllByUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *MockSessionProvider_RevokeAllByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFamily provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	ret := _mock.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFamily")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionProvider_RevokeFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFamily'
+type MockSessionProvider_RevokeFamily_Call struct {
+	*mock.Call
+}
+
+// RevokeFamily is a helper method to define mock.On call
+//   - ctx
+//   - familyID
+func (_e *MockSessionProvider_Expecter) RevokeFamily(ctx interface{}, familyID interface{}) *MockSessionProvider_RevokeFamily_Call {
+	return &MockSessionProvider_RevokeFamily_Call{Call: _e.mock.On("RevokeFamily", ctx, familyID)}
+}
+
+func (_c *MockSessionProvider_RevokeFamily_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockSessionProvider_RevokeFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_RevokeFamily_Call) Return(err error) *MockSessionProvider_RevokeFamily_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionProvider_RevokeFamily_Call) RunAndReturn(run func(ctx context.Context, familyID uuid.UUID) error) *MockSessionProvider_RevokeFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserGetter creates a new instance of MockUserGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserGetter {
+	mock := &MockUserGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserGetter is an autogenerated mock type for the UserGetter type
+type MockUserGetter struct {
+	mock.Mock
+}
+
+type MockUserGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserGetter) EXPECT() *MockUserGetter_Expecter {
+	return &MockUserGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetByID provides a mock function for the type MockUserGetter
+func (_mock *MockUserGetter) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserGetter_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUserGetter_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserGetter_Expecter) GetByID(ctx interface{}, id interface{}) *MockUserGetter_GetByID_Call {
+	return &MockUserGetter_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockUserGetter_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockUserGetter_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserGetter_GetByID_Call) Return(user *domain.User, err error) *MockUserGetter_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserGetter_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.User, error)) *MockUserGetter_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenIssuer creates a new instance of MockTokenIssuer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenIssuer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenIssuer {
+	mock := &MockTokenIssuer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTokenIssuer is an autogenerated mock type for the TokenIssuer type
+type MockTokenIssuer struct {
+	mock.Mock
+}
+
+type MockTokenIssuer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenIssuer) EXPECT() *MockTokenIssuer_Expecter {
+	return &MockTokenIssuer_Expecter{mock: &_m.Mock}
+}
+
+// GenerateSessionToken provides a mock function for the type MockTokenIssuer
+func (_mock *MockTokenIssuer) GenerateSessionToken(userUUID string, role string, sessionID string) (string, time.Time, error) {
+	ret := _mock.Called(userUUID, role, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateSessionToken")
+	}
+
+	var r0 string
+	var r1 time.Time
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) (string, time.Time, error)); ok {
+		return returnFunc(userUUID, role, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = returnFunc(userUUID, role, sessionID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) time.Time); ok {
+		r1 = returnFunc(userUUID, role, sessionID)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, string, string) error); ok {
+		r2 = returnFunc(userUUID, role, sessionID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockTokenIssuer_GenerateSessionToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateSessionToken'
+type MockTokenIssuer_GenerateSessionToken_Call struct {
+	*mock.Call
+}
+
+// GenerateSessionToken is a helper method to define mock.On call
+//   - userUUID
+//   - role
+//   - sessionID
+func (_e *MockTokenIssuer_Expecter) GenerateSessionToken(userUUID interface{}, role interface{}, sessionID interface{}) *MockTokenIssuer_GenerateSessionToken_Call {
+	return &MockTokenIssuer_GenerateSessionToken_Call{Call: _e.mock.On("GenerateSessionToken", userUUID, role, sessionID)}
+}
+
+func (_c *MockTokenIssuer_GenerateSessionToken_Call) Run(run func(userUUID string, role string, sessionID string)) *MockTokenIssuer_GenerateSessionToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenIssuer_GenerateSessionToken_Call) Return(s string, time1 time.Time, err error) *MockTokenIssuer_GenerateSessionToken_Call {
+	_c.Call.Return(s, time1, err)
+	return _c
+}
+
+func (_c *MockTokenIssuer_GenerateSessionToken_Call) RunAndReturn(run func(userUUID string, role string, sessionID string) (string, time.Time, error)) *MockTokenIssuer_GenerateSessionToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParseToken provides a mock function for the type MockTokenIssuer
+func (_mock *MockTokenIssuer) ParseToken(tokenString string) (*UserClaims, error) {
+	ret := _mock.Called(tokenString)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseToken")
+	}
+
+	var r0 *UserClaims
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*UserClaims, error)); ok {
+		return returnFunc(tokenString)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *UserClaims); ok {
+		r0 = returnFunc(tokenString)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserClaims)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(tokenString)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenIssuer_ParseToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseToken'
+type MockTokenIssuer_ParseToken_Call struct {
+	*mock.Call
+}
+
+// ParseToken is a helper method to define mock.On call
+//   - tokenString
+func (_e *MockTokenIssuer_Expecter) ParseToken(tokenString interface{}) *MockTokenIssuer_ParseToken_Call {
+	return &MockTokenIssuer_ParseToken_Call{Call: _e.mock.On("ParseToken", tokenString)}
+}
+
+func (_c *MockTokenIssuer_ParseToken_Call) Run(run func(tokenString string)) *MockTokenIssuer_ParseToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenIssuer_ParseToken_Call) Return(userClaims *UserClaims, err error) *MockTokenIssuer_ParseToken_Call {
+	_c.Call.Return(userClaims, err)
+	return _c
+}
+
+func (_c *MockTokenIssuer_ParseToken_Call) RunAndReturn(run func(tokenString string) (*UserClaims, error)) *MockTokenIssuer_ParseToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStaffProvider creates a new instance of MockStaffProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStaffProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStaffProvider {
+	mock := &MockStaffProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStaffProvider is an autogenerated mock type for the StaffProvider type
+type MockStaffProvider struct {
+	mock.Mock
+}
+
+type MockStaffProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStaffProvider) EXPECT() *MockStaffProvider_Expecter {
+	return &MockStaffProvider_Expecter{mock: &_m.Mock}
+}
+
+// AssignPVZ provides a mock function for the type MockStaffProvider
+func (_mock *MockStaffProvider) AssignPVZ(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignPVZ")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, pvzID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStaffProvider_AssignPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignPVZ'
+type MockStaffProvider_AssignPVZ_Call struct {
+	*mock.Call
+}
+
+// AssignPVZ is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - pvzID
+func (_e *MockStaffProvider_Expecter) AssignPVZ(ctx interface{}, userID interface{}, pvzID interface{}) *MockStaffProvider_AssignPVZ_Call {
+	return &MockStaffProvider_AssignPVZ_Call{Call: _e.mock.On("AssignPVZ", ctx, userID, pvzID)}
+}
+
+func (_c *MockStaffProvider_AssignPVZ_Call) Run(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID)) *MockStaffProvider_AssignPVZ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStaffProvider_AssignPVZ_Call) Return(err error) *MockStaffProvider_AssignPVZ_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStaffProvider_AssignPVZ_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error) *MockStaffProvider_AssignPVZ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockStaffProvider
+func (_mock *MockStaffProvider) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStaffProvider_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockStaffProvider_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockStaffProvider_Expecter) GetByID(ctx interface{}, id interface{}) *MockStaffProvider_GetByID_Call {
+	return &MockStaffProvider_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockStaffProvider_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStaffProvider_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStaffProvider_GetByID_Call) Return(user *domain.User, err error) *MockStaffProvider_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockStaffProvider_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.User, error)) *MockStaffProvider_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignPVZ provides a mock function for the type MockStaffProvider
+func (_mock *MockStaffProvider) UnassignPVZ(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignPVZ")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, pvzID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStaffProvider_UnassignPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignPVZ'
+type MockStaffProvider_UnassignPVZ_Call struct {
+	*mock.Call
+}
+
+// UnassignPVZ is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - pvzID
+func (_e *MockStaffProvider_Expecter) UnassignPVZ(ctx interface{}, userID interface{}, pvzID interface{}) *MockStaffProvider_UnassignPVZ_Call {
+	return &MockStaffProvider_UnassignPVZ_Call{Call: _e.mock.On("UnassignPVZ", ctx, userID, pvzID)}
+}
+
+func (_c *MockStaffProvider_UnassignPVZ_Call) Run(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID)) *MockStaffProvider_UnassignPVZ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStaffProvider_UnassignPVZ_Call) Return(err error) *MockStaffProvider_UnassignPVZ_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStaffProvider_UnassignPVZ_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) error) *MockStaffProvider_UnassignPVZ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStaffLister creates a new instance of MockStaffLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStaffLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStaffLister {
+	mock := &MockStaffLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStaffLister is an autogenerated mock type for the StaffLister type
+type MockStaffLister struct {
+	mock.Mock
+}
+
+type MockStaffLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStaffLister) EXPECT() *MockStaffLister_Expecter {
+	return &MockStaffLister_Expecter{mock: &_m.Mock}
+}
+
+// GetStaff provides a mock function for the type MockStaffLister
+func (_mock *MockStaffLister) GetStaff(ctx context.Context, pvz uuid.UUID) ([]domain.User, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaff")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.User, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.User); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStaffLister_GetStaff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaff'
+type MockStaffLister_GetStaff_Call struct {
+	*mock.Call
+}
+
+// GetStaff is a helper method to define mock.On call
+//   - ctx
+//   - pvz
+func (_e *MockStaffLister_Expecter) GetStaff(ctx interface{}, pvz interface{}) *MockStaffLister_GetStaff_Call {
+	return &MockStaffLister_GetStaff_Call{Call: _e.mock.On("GetStaff", ctx, pvz)}
+}
+
+func (_c *MockStaffLister_GetStaff_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockStaffLister_GetStaff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStaffLister_GetStaff_Call) Return(users []domain.User, err error) *MockStaffLister_GetStaff_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockStaffLister_GetStaff_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) ([]domain.User, error)) *MockStaffLister_GetStaff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatus provides a mock function for the type MockStaffLister
+func (_mock *MockStaffLister) GetStatus(ctx context.Context, pvz uuid.UUID) (domain.PVZStatus, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 domain.PVZStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.PVZStatus, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.PVZStatus); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		r0 = ret.Get(0).(domain.PVZStatus)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStaffLister_GetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatus'
+type MockStaffLister_GetStatus_Call struct {
+	*mock.Call
+}
+
+// GetStatus is a helper method to define mock.On call
+//   - ctx
+//   - pvz
+func (_e *MockStaffLister_Expecter) GetStatus(ctx interface{}, pvz interface{}) *MockStaffLister_GetStatus_Call {
+	return &MockStaffLister_GetStatus_Call{Call: _e.mock.On("GetStatus", ctx, pvz)}
+}
+
+func (_c *MockStaffLister_GetStatus_Call) Run(run func(ctx context.Context, pvz uuid.UUID)) *MockStaffLister_GetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStaffLister_GetStatus_Call) Return(pVZStatus domain.PVZStatus, err error) *MockStaffLister_GetStatus_Call {
+	_c.Call.Return(pVZStatus, err)
+	return _c
+}
+
+func (_c *MockStaffLister_GetStatus_Call) RunAndReturn(run func(ctx context.Context, pvz uuid.UUID) (domain.PVZStatus, error)) *MockStaffLister_GetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAssignmentChecker creates a new instance of MockAssignmentChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAssignmentChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAssignmentChecker {
+	mock := &MockAssignmentChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAssignmentChecker is an autogenerated mock type for the AssignmentChecker type
+type MockAssignmentChecker struct {
+	mock.Mock
+}
+
+type MockAssignmentChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAssignmentChecker) EXPECT() *MockAssignmentChecker_Expecter {
+	return &MockAssignmentChecker_Expecter{mock: &_m.Mock}
+}
+
+// IsAssigned provides a mock function for the type MockAssignmentChecker
+func (_mock *MockAssignmentChecker) IsAssigned(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) (bool, error) {
+	ret := _mock.Called(ctx, userID, pvzID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAssigned")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return returnFunc(ctx, userID, pvzID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = returnFunc(ctx, userID, pvzID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, pvzID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssignmentChecker_IsAssigned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAssigned'
+type MockAssignmentChecker_IsAssigned_Call struct {
+	*mock.Call
+}
+
+// IsAssigned is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - pvzID
+func (_e *MockAssignmentChecker_Expecter) IsAssigned(ctx interface{}, userID interface{}, pvzID interface{}) *MockAssignmentChecker_IsAssigned_Call {
+	return &MockAssignmentChecker_IsAssigned_Call{Call: _e.mock.On("IsAssigned", ctx, userID, pvzID)}
+}
+
+func (_c *MockAssignmentChecker_IsAssigned_Call) Run(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID)) *MockAssignmentChecker_IsAssigned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentChecker_IsAssigned_Call) Return(b bool, err error) *MockAssignmentChecker_IsAssigned_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAssignmentChecker_IsAssigned_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, pvzID uuid.UUID) (bool, error)) *MockAssignmentChecker_IsAssigned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionManager creates a new instance of MockSessionManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionManager {
+	mock := &MockSessionManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionManager is an autogenerated mock type for the SessionManager type
+type MockSessionManager struct {
+	mock.Mock
+}
+
+type MockSessionManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionManager) EXPECT() *MockSessionManager_Expecter {
+	return &MockSessionManager_Expecter{mock: &_m.Mock}
+}
+
+// RevokeAll provides a mock function for the type MockSessionManager
+func (_mock *MockSessionManager) RevokeAll(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionManager_RevokeAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAll'
+type MockSessionManager_RevokeAll_Call struct {
+	*mock.Call
+}
+
+// RevokeAll is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockSessionManager_Expecter) RevokeAll(ctx interface{}, userID interface{}) *MockSessionManager_RevokeAll_Call {
+	return &MockSessionManager_RevokeAll_Call{Call: _e.mock.On("RevokeAll", ctx, userID)}
+}
+
+func (_c *MockSessionManager_RevokeAll_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockSessionManager_RevokeAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_RevokeAll_Call) Return(err error) *MockSessionManager_RevokeAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionManager_RevokeAll_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *MockSessionManager_RevokeAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function for the type MockSessionManager
+func (_mock *MockSessionManager) Start(ctx context.Context, user *domain.User) (*domain.TokenPair, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 *domain.TokenPair
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) (*domain.TokenPair, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) *domain.TokenPair); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TokenPair)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionManager_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockSessionManager_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx
+//   - user
+func (_e *MockSessionManager_Expecter) Start(ctx interface{}, user interface{}) *MockSessionManager_Start_Call {
+	return &MockSessionManager_Start_Call{Call: _e.mock.On("Start", ctx, user)}
+}
+
+func (_c *MockSessionManager_Start_Call) Run(run func(ctx context.Context, user *domain.User)) *MockSessionManager_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.User))
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_Start_Call) Return(tokenPair *domain.TokenPair, err error) *MockSessionManager_Start_Call {
+	_c.Call.Return(tokenPair, err)
+	return _c
+}
+
+func (_c *MockSessionManager_Start_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) (*domain.TokenPair, error)) *MockSessionManager_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserProvider creates a new instance of MockUserProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserProvider {
+	mock := &MockUserProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserProvider is an autogenerated mock type for the UserProvider type
+type MockUserProvider struct {
+	mock.Mock
+}
+
+type MockUserProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserProvider) EXPECT() *MockUserProvider_Expecter {
+	return &MockUserProvider_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) Create(ctx context.Context, user *domain.User) error {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserProvider_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserProvider_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - user
+func (_e *MockUserProvider_Expecter) Create(ctx interface{}, user interface{}) *MockUserProvider_Create_Call {
+	return &MockUserProvider_Create_Call{Call: _e.mock.On("Create", ctx, user)}
+}
+
+func (_c *MockUserProvider_Create_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserProvider_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.User))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_Create_Call) Return(err error) *MockUserProvider_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserProvider_Create_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) error) *MockUserProvider_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByEmail provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserProvider_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type MockUserProvider_GetByEmail_Call struct {
+	*mock.Call
+}
+
+// GetByEmail is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockUserProvider_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockUserProvider_GetByEmail_Call {
+	return &MockUserProvider_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+}
+
+func (_c *MockUserProvider_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserProvider_GetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_GetByEmail_Call) Return(user *domain.User, err error) *MockUserProvider_GetByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserProvider_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockUserProvider_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserProvider_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUserProvider_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserProvider_Expecter) GetByID(ctx interface{}, id interface{}) *MockUserProvider_GetByID_Call {
+	return &MockUserProvider_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockUserProvider_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockUserProvider_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_GetByID_Call) Return(user *domain.User, err error) *MockUserProvider_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserProvider_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.User, error)) *MockUserProvider_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserFilter) ([]domain.User, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserFilter) []domain.User); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserProvider_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUserProvider_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - filter
+func (_e *MockUserProvider_Expecter) List(ctx interface{}, filter interface{}) *MockUserProvider_List_Call {
+	return &MockUserProvider_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockUserProvider_List_Call) Run(run func(ctx context.Context, filter domain.UserFilter)) *MockUserProvider_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserFilter))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_List_Call) Return(users []domain.User, err error) *MockUserProvider_List_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockUserProvider_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)) *MockUserProvider_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetActive provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) SetActive(ctx context.Context, id uuid.UUID, active bool) error {
+	ret := _mock.Called(ctx, id, active)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetActive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = returnFunc(ctx, id, active)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserProvider_SetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetActive'
+type MockUserProvider_SetActive_Call struct {
+	*mock.Call
+}
+
+// SetActive is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - active
+func (_e *MockUserProvider_Expecter) SetActive(ctx interface{}, id interface{}, active interface{}) *MockUserProvider_SetActive_Call {
+	return &MockUserProvider_SetActive_Call{Call: _e.mock.On("SetActive", ctx, id, active)}
+}
+
+func (_c *MockUserProvider_SetActive_Call) Run(run func(ctx context.Context, id uuid.UUID, active bool)) *MockUserProvider_SetActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_SetActive_Call) Return(err error) *MockUserProvider_SetActive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserProvider_SetActive_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, active bool) error) *MockUserProvider_SetActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	ret := _mock.Called(ctx, id, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserProvider_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockUserProvider_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - passwordHash
+func (_e *MockUserProvider_Expecter) UpdatePassword(ctx interface{}, id interface{}, passwordHash interface{}) *MockUserProvider_UpdatePassword_Call {
+	return &MockUserProvider_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, passwordHash)}
+}
+
+func (_c *MockUserProvider_UpdatePassword_Call) Run(run func(ctx context.Context, id uuid.UUID, passwordHash string)) *MockUserProvider_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_UpdatePassword_Call) Return(err error) *MockUserProvider_UpdatePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserProvider_UpdatePassword_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, passwordHash string) error) *MockUserProvider_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function for the type MockUserProvider
+func (_mock *MockUserProvider) UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error {
+	ret := _mock.Called(ctx, id, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Role) error); ok {
+		r0 = returnFunc(ctx, id, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserProvider_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockUserProvider_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - role
+func (_e *MockUserProvider_Expecter) UpdateRole(ctx interface{}, id interface{}, role interface{}) *MockUserProvider_UpdateRole_Call {
+	return &MockUserProvider_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, id, role)}
+}
+
+func (_c *MockUserProvider_UpdateRole_Call) Run(run func(ctx context.Context, id uuid.UUID, role domain.Role)) *MockUserProvider_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockUserProvider_UpdateRole_Call) Return(err error) *MockUserProvider_UpdateRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserProvider_UpdateRole_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, role domain.Role) error) *MockUserProvider_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoginLimiter creates a new instance of MockLoginLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoginLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoginLimiter {
+	mock := &MockLoginLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLoginLimiter is an autogenerated mock type for the LoginLimiter type
+type MockLoginLimiter struct {
+	mock.Mock
+}
+
+type MockLoginLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoginLimiter) EXPECT() *MockLoginLimiter_Expecter {
+	return &MockLoginLimiter_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function for the type MockLoginLimiter
+func (_mock *MockLoginLimiter) Check(ctx context.Context, email string, ip string) error {
+	ret := _mock.Called(ctx, email, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, email, ip)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginLimiter_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockLoginLimiter_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx
+//   - email
+//   - ip
+func (_e *MockLoginLimiter_Expecter) Check(ctx interface{}, email interface{}, ip interface{}) *MockLoginLimiter_Check_Call {
+	return &MockLoginLimiter_Check_Call{Call: _e.mock.On("Check", ctx, email, ip)}
+}
+
+func (_c *MockLoginLimiter_Check_Call) Run(run func(ctx context.Context, email string, ip string)) *MockLoginLimiter_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoginLimiter_Check_Call) Return(err error) *MockLoginLimiter_Check_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginLimiter_Check_Call) RunAndReturn(run func(ctx context.Context, email string, ip string) error) *MockLoginLimiter_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fail provides a mock function for the type MockLoginLimiter
+func (_mock *MockLoginLimiter) Fail(ctx context.Context, email string, ip string) error {
+	ret := _mock.Called(ctx, email, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, email, ip)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginLimiter_Fail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fail'
+type MockLoginLimiter_Fail_Call struct {
+	*mock.Call
+}
+
+// Fail is a helper method to define mock.On call
+//   - ctx
+//   - email
+//   - ip
+func (_e *MockLoginLimiter_Expecter) Fail(ctx interface{}, email interface{}, ip interface{}) *MockLoginLimiter_Fail_Call {
+	return &MockLoginLimiter_Fail_Call{Call: _e.mock.On("Fail", ctx, email, ip)}
+}
+
+func (_c *MockLoginLimiter_Fail_Call) Run(run func(ctx context.Context, email string, ip string)) *MockLoginLimiter_Fail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoginLimiter_Fail_Call) Return(err error) *MockLoginLimiter_Fail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginLimiter_Fail_Call) RunAndReturn(run func(ctx context.Context, email string, ip string) error) *MockLoginLimiter_Fail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Succeed provides a mock function for the type MockLoginLimiter
+func (_mock *MockLoginLimiter) Succeed(ctx context.Context, email string) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Succeed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginLimiter_Succeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Succeed'
+type MockLoginLimiter_Succeed_Call struct {
+	*mock.Call
+}
+
+// Succeed is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockLoginLimiter_Expecter) Succeed(ctx interface{}, email interface{}) *MockLoginLimiter_Succeed_Call {
+	return &MockLoginLimiter_Succeed_Call{Call: _e.mock.On("Succeed", ctx, email)}
+}
+
+func (_c *MockLoginLimiter_Succeed_Call) Run(run func(ctx context.Context, email string)) *MockLoginLimiter_Succeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoginLimiter_Succeed_Call) Return(err error) *MockLoginLimiter_Succeed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginLimiter_Succeed_Call) RunAndReturn(run func(ctx context.Context, email string) error) *MockLoginLimiter_Succeed_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type ExternalTokenVerifier interface {
+	Issuer() string
+	Verify(ctx context.Context, token string) (*domain.ExternalIdentity, error)
+}
+
+type ExternalUserProvider interface {
+	GetByExternalID(ctx context.Context, issuer, subject string) (*domain.User, error)
+	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	CreateExternal(ctx context.Context, user *domain.User, issuer, subject string) error
+	LinkExternal(ctx context.Context, userID uuid.UUID, issuer, subject string) error
+	UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error
+}
+
+// OIDC принимает токены внешнего провайдера. Роль берется из claim токена
+// при каждом входе, а пользователь создается при первом входе без пароля.
+type OIDC struct {
+	verifier ExternalTokenVerifier
+	users    ExternalUserProvider
+	roles    domain.RoleMapping
+	audit    AuditRecorder
+}
+
+func (o *OIDC) Issuer() string {
+	return o.verifier.Issuer()
+}
+
+func (o *OIDC) Authenticate(ctx context.Context, token string) (*domain.Identity, error) {
+	external, err := o.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, models.ErrInvalidToken
+	}
+
+	role, ok := o.roles.Resolve(external.Claims)
+	if !ok {
+		return nil, models.ErrInvalidTokenClaims
+	}
+
+	user, err := o.users.GetByExternalID(ctx, external.Issuer, external.Subject)
+	if errors.Is(err, domain.ErrNotFound) {
+		user, err = o.provision(ctx, external, role)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.Active {
+		return nil, models.ErrUserDeactivated
+	}
+
+	if user.Role != role {
+		err = o.syncRole(ctx, user, role)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.Identity{
+		Actor:  domain.ActorUser,
+		UserID: user.ID,
+		Role:   role,
+	}, nil
+}
+
+// provision создает пользователя при первом входе. Существующая учетная
+// запись с тем же email связывается, только если провайдер подтвердил email,
+// иначе через провайдера можно было бы войти в чужую учетную запись.
+func (o *OIDC) provision(
+	ctx context.Context,
+	external *domain.ExternalIdentity,
+	role domain.Role,
+) (*domain.User, error) {
+	if external.Email == "" {
+		return nil, models.ErrInvalidTokenClaims
+	}
+
+	existing, err := o.users.GetByEmail(ctx, external.Email)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrInternal
+	}
+
+	if existing != nil {
+		if !external.EmailVerified {
+			return nil, models.ErrInvalidTokenClaims
+		}
+
+		err = o.users.LinkExternal(ctx, existing.ID, external.Issuer, external.Subject)
+		if err != nil && !errors.Is(err, domain.ErrAlreadyExists) {
+			return nil, models.ErrInternal
+		}
+
+		return existing, nil
+	}
+
+	user, err := domain.NewUser(external.Email, "", string(role))
+	if err != nil {
+		return nil, models.ErrInvalidTokenClaims
+	}
+
+	err = o.users.CreateExternal(ctx, user, external.Issuer, external.Subject)
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		// Пользователя успел создать параллельный запрос с тем же токеном.
+		user, err = o.users.GetByExternalID(ctx, external.Issuer, external.Subject)
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	o.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditUserCreate,
+		Entity:   domain.AuditEntityUser,
+		EntityID: user.ID,
+		After:    user.ToDto(),
+	})
+
+	return user, nil
+}
+
+func (o *OIDC) syncRole(ctx context.Context, user *domain.User, role domain.Role) error {
+	before := *user
+
+	err := o.users.UpdateRole(ctx, user.ID, role)
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	user.Role = role
+
+	o.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditUserRoleChange,
+		Entity:   domain.AuditEntityUser,
+		EntityID: user.ID,
+		Before:   before.ToDto(),
+		After:    user.ToDto(),
+	})
+
+	return nil
+}
+
+func NewOIDCService(
+	verifier ExternalTokenVerifier,
+	users ExternalUserProvider,
+	roles domain.RoleMapping,
+	audit AuditRecorder,
+) *OIDC {
+	return &OIDC{
+		verifier: verifier,
+		users:    users,
+		roles:    roles,
+		audit:    audit,
+	}
+}
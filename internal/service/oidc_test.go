@@ -0,0 +1,169 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuer = "https://sso.example.com"
+
+var testRoleMapping = domain.RoleMapping{
+	Claim: "realm_access.roles",
+	Roles: map[string]domain.Role{
+		"pvz-employee":  domain.RoleEmploye,
+		"pvz-moderator": domain.RoleModerator,
+	},
+}
+
+func externalIdentity(verified bool, roles ...any) *domain.ExternalIdentity {
+	return &domain.ExternalIdentity{
+		Issuer:        testIssuer,
+		Subject:       "user-42",
+		Email:         "staff@example.com",
+		EmailVerified: verified,
+		Claims: map[string]any{
+			"realm_access": map[string]any{"roles": roles},
+		},
+	}
+}
+
+func TestOIDC_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name       string
+		external   *domain.ExternalIdentity
+		setupMocks func(users *service.MockExternalUserProvider)
+		want       *domain.Identity
+		wantErr    error
+	}{
+		{
+			name:     "known_user",
+			external: externalIdentity(true, "pvz-employee"),
+			setupMocks: func(users *service.MockExternalUserProvider) {
+				users.On("GetByExternalID", mock.Anything, testIssuer, "user-42").
+					Return(&domain.User{ID: userID, Role: domain.RoleEmploye, Active: true}, nil)
+			},
+			want: &domain.Identity{Actor: domain.ActorUser, UserID: userID, Role: domain.RoleEmploye},
+		},
+		{
+			name:     "first_login_provisions_user",
+			external: externalIdentity(false, "offline_access", "pvz-moderator"),
+			setupMocks: func(users *service.MockExternalUserProvider) {
+				users.On("GetByExternalID", mock.Anything, testIssuer, "user-42").
+					Return(nil, domain.ErrNotFound)
+				users.On("GetByEmail", mock.Anything, "staff@example.com").
+					Return(nil, domain.ErrNotFound)
+				users.On("CreateExternal", mock.Anything, mock.MatchedBy(func(u *domain.User) bool {
+					return u.Email == "staff@example.com" &&
+						u.Role == domain.RoleModerator &&
+						!u.HasPassword()
+				}), testIssuer, "user-42").
+					Run(func(args mock.Arguments) { args.Get(1).(*domain.User).ID = userID }).
+					Return(nil)
+			},
+			want: &domain.Identity{Actor: domain.ActorUser, UserID: userID, Role: domain.RoleModerator},
+		},
+		{
+			name:     "links_existing_user_with_verified_email",
+			external: externalIdentity(true, "pvz-employee"),
+			setupMocks: func(users *service.MockExternalUserProvider) {
+				users.On("GetByExternalID", mock.Anything, testIssuer, "user-42").
+					Return(nil, domain.ErrNotFound)
+				users.On("GetByEmail", mock.Anything, "staff@example.com").
+					Return(&domain.User{ID: userID, Role: domain.RoleEmploye, Active: true}, nil)
+				users.On("LinkExternal", mock.Anything, userID, testIssuer, "user-42").Return(nil)
+			},
+			want: &domain.Identity{Actor: domain.ActorUser, UserID: userID, Role: domain.RoleEmploye},
+		},
+		{
+			name:     "unverified_email_of_existing_user",
+			external: externalIdentity(false, "pvz-employee"),
+			setupMocks: func(users *service.MockExternalUserProvider) {
+				users.On("GetByExternalID", mock.Anything, testIssuer, "user-42").
+					Return(nil, domain.ErrNotFound)
+				users.On("GetByEmail", mock.Anything, "staff@example.com").
+					Return(&domain.User{ID: userID, Role: domain.RoleEmploye, Active: true}, nil)
+			},
+			wantErr: models.ErrInvalidTokenClaims,
+		},
+		{
+			name:     "role_changed_at_provider",
+			external: externalIdentity(true, "pvz-moderator"),
+			setupMocks: func(users *service.MockExternalUserProvider) {
+				users.On("GetByExternalID", mock.Anything, testIssuer, "user-42").
+					Return(&domain.User{ID: userID, Role: domain.RoleEmploye, Active: true}, nil)
+				users.On("UpdateRole", mock.Anything, userID, domain.RoleModerator).Return(nil)
+			},
+			want: &domain.Identity{Actor: domain.ActorUser, UserID: userID, Role: domain.RoleModerator},
+		},
+		{
+			name:       "no_mapped_role",
+			external:   externalIdentity(true, "offline_access"),
+			setupMocks: func(users *service.MockExternalUserProvider) {},
+			wantErr:    models.ErrInvalidTokenClaims,
+		},
+		{
+			name:     "deactivated_user",
+			external: externalIdentity(true, "pvz-employee"),
+			setupMocks: func(users *service.MockExternalUserProvider) {
+				users.On("GetByExternalID", mock.Anything, testIssuer, "user-42").
+					Return(&domain.User{ID: userID, Role: domain.RoleEmploye}, nil)
+			},
+			wantErr: models.ErrUserDeactivated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			verifier := service.NewMockExternalTokenVerifier(t)
+			verifier.On("Verify", mock.Anything, "token").Return(tt.external, nil)
+
+			users := service.NewMockExternalUserProvider(t)
+			tt.setupMocks(users)
+
+			svc := service.NewOIDCService(verifier, users, testRoleMapping, noAudit(t))
+
+			got, err := svc.Authenticate(context.Background(), "token")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, got)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOIDC_InvalidToken(t *testing.T) {
+	t.Parallel()
+
+	verifier := service.NewMockExternalTokenVerifier(t)
+	verifier.On("Verify", mock.Anything, "token").Return(nil, errors.New("bad signature"))
+
+	svc := service.NewOIDCService(
+		verifier,
+		service.NewMockExternalUserProvider(t),
+		testRoleMapping,
+		noAudit(t),
+	)
+
+	_, err := svc.Authenticate(context.Background(), "token")
+	require.ErrorIs(t, err, models.ErrInvalidToken)
+}
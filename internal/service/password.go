@@ -0,0 +1,68 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(hash, password string) bool
+	// NeedsRehash сообщает, что хеш нужно пересчитать текущим алгоритмом.
+	NeedsRehash(hash string) bool
+}
+
+// LoadPasswordDenylist читает файл с распространенными паролями, по одному
+// в строке. Пустые строки и строки, начинающиеся с #, пропускаются.
+// Пустой путь означает пустой список.
+func LoadPasswordDenylist(path string) (map[string]struct{}, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open password denylist: %w", err)
+	}
+	defer f.Close()
+
+	denylist := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		denylist[strings.ToLower(line)] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read password denylist: %w", err)
+	}
+
+	return denylist, nil
+}
+
+// checkPassword переводит нарушения политики в models.ErrWeakPassword,
+// сохраняя в тексте ошибки перечень требований.
+func checkPassword(policy domain.PasswordPolicy, password string) error {
+	err := policy.Validate(password)
+
+	var policyErr *domain.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		return fmt.Errorf("%w: %s", models.ErrWeakPassword, strings.Join(policyErr.Violations, "; "))
+	}
+
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Notifier interface {
+	Send(ctx context.Context, msg domain.Message) error
+}
+
+type PasswordResetProvider interface {
+	Create(ctx context.Context, token *domain.PasswordResetToken) error
+	GetByHash(ctx context.Context, hash string) (*domain.PasswordResetToken, error)
+	Use(ctx context.Context, token *domain.PasswordResetToken, passwordHash string) error
+}
+
+type PasswordUserProvider interface {
+	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+}
+
+type SessionRevoker interface {
+	RevokeAll(ctx context.Context, userID uuid.UUID) error
+}
+
+// PasswordReset восстанавливает доступ по одноразовому токену,
+// отправленному пользователю через Notifier.
+type PasswordReset struct {
+	tokens   PasswordResetProvider
+	users    PasswordUserProvider
+	sessions SessionRevoker
+	notifier Notifier
+	audit    AuditRecorder
+	hasher   PasswordHasher
+	policy   domain.PasswordPolicy
+	ttl      time.Duration
+	resetURL string
+	log      *slog.Logger
+
+	// sending отправки, которые еще идут в фоне.
+	sending sync.WaitGroup
+}
+
+// sendTimeout ограничивает фоновую отправку токена, которую уже не
+// ограничивает контекст запроса.
+const sendTimeout = time.Minute
+
+// Forgot отправляет токен восстановления. Для неизвестных и отключенных
+// учетных записей ничего не отправляется, но и ошибка не возвращается,
+// чтобы по ответу нельзя было проверить существование email. По той же
+// причине токен создается и отправляется в фоне: иначе ответ для
+// существующего email заметно дольше.
+func (p *PasswordReset) Forgot(ctx context.Context, email string) error {
+	user, err := p.users.GetByEmail(ctx, email)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	if !user.Active || !user.HasPassword() {
+		return nil
+	}
+
+	p.sending.Add(1)
+
+	go func() {
+		defer p.sending.Done()
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sendTimeout)
+		defer cancel()
+
+		err := p.send(ctx, user)
+		if err != nil {
+			p.log.ErrorContext(ctx, "password reset token not sent",
+				slog.String("user_id", user.ID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}()
+
+	return nil
+}
+
+// Wait дожидается фоновых отправок токенов.
+func (p *PasswordReset) Wait() {
+	p.sending.Wait()
+}
+
+func (p *PasswordReset) send(ctx context.Context, user *domain.User) error {
+	token, raw, err := domain.NewPasswordResetToken(user.ID, p.ttl)
+	if err != nil {
+		return err
+	}
+
+	err = p.tokens.Create(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return p.notifier.Send(ctx, domain.Message{
+		To:      user.Email,
+		Subject: "Восстановление пароля",
+		Body:    p.resetBody(raw),
+	})
+}
+
+// Reset устанавливает новый пароль и завершает все сессии пользователя.
+func (p *PasswordReset) Reset(ctx context.Context, rawToken string, password string) error {
+	err := checkPassword(p.policy, password)
+	if err != nil {
+		return err
+	}
+
+	token, err := p.tokens.GetByHash(ctx, domain.HashToken(rawToken))
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.ErrInvalidResetToken
+	}
+
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	if !token.IsUsable(time.Now()) {
+		return models.ErrInvalidResetToken
+	}
+
+	hash, err := p.hasher.Hash(password)
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	err = p.tokens.Use(ctx, token, hash)
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.ErrInvalidResetToken
+	}
+
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	err = p.sessions.RevokeAll(ctx, token.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Снимки не пишутся: в журнал не должны попадать ни пароль, ни хеш.
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditUserPasswordReset,
+		Entity:   domain.AuditEntityUser,
+		EntityID: token.UserID,
+	})
+
+	return nil
+}
+
+func (p *PasswordReset) resetBody(token string) string {
+	validity := "Ссылка действительна " + p.ttl.String() + "."
+
+	if p.resetURL == "" {
+		return "Токен для восстановления пароля: " + token + "\n" + validity
+	}
+
+	return "Для восстановления пароля перейдите по ссылке: " +
+		p.resetURL + "?token=" + url.QueryEscape(token) + "\n" + validity
+}
+
+func NewPasswordResetService(
+	tokens PasswordResetProvider,
+	users PasswordUserProvider,
+	sessions SessionRevoker,
+	notifier Notifier,
+	audit AuditRecorder,
+	hasher PasswordHasher,
+	policy domain.PasswordPolicy,
+	ttl time.Duration,
+	resetURL string,
+	log *slog.Logger,
+) *PasswordReset {
+	return &PasswordReset{
+		tokens:   tokens,
+		users:    users,
+		sessions: sessions,
+		notifier: notifier,
+		audit:    audit,
+		hasher:   hasher,
+		policy:   policy,
+		ttl:      ttl,
+		resetURL: resetURL,
+		log:      log,
+	}
+}
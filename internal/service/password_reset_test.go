@@ -0,0 +1,261 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordReset_Forgot(t *testing.T) {
+	t.Parallel()
+
+	user, _ := domain.NewUser("user@example.com", "hashedPassword123", "employee")
+
+	tests := []struct {
+		name       string
+		email      string
+		setupMocks func(
+			tokens *service.MockPasswordResetProvider,
+			users *service.MockPasswordUserProvider,
+			n *service.MockNotifier,
+		)
+	}{
+		{
+			name:  "token_sent",
+			email: "user@example.com",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				n *service.MockNotifier,
+			) {
+				users.On("GetByEmail", mock.Anything, "user@example.com").Return(user, nil)
+				tokens.On("Create", mock.Anything, mock.MatchedBy(func(tk *domain.PasswordResetToken) bool {
+					return tk.UserID == user.ID && tk.TokenHash != ""
+				})).Return(nil)
+				n.On("Send", mock.Anything, mock.MatchedBy(func(m domain.Message) bool {
+					return m.To == "user@example.com" &&
+						len(m.Body) > 0
+				})).Return(nil)
+			},
+		},
+		{
+			name:  "unknown_email_is_silent",
+			email: "ghost@example.com",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				n *service.MockNotifier,
+			) {
+				users.On("GetByEmail", mock.Anything, "ghost@example.com").
+					Return(nil, domain.ErrNotFound)
+			},
+		},
+		{
+			name:  "user_without_password_is_silent",
+			email: "sso@example.com",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				n *service.MockNotifier,
+			) {
+				users.On("GetByEmail", mock.Anything, "sso@example.com").
+					Return(&domain.User{ID: uuid.New(), Email: "sso@example.com", Active: true}, nil)
+			},
+		},
+		{
+			name:  "delivery_fails",
+			email: "user@example.com",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				n *service.MockNotifier,
+			) {
+				users.On("GetByEmail", mock.Anything, "user@example.com").Return(user, nil)
+				tokens.On("Create", mock.Anything, mock.Anything).Return(nil)
+				// Ошибка доставки только пишется в лог: ответ не должен
+				// отличаться от ответа для неизвестного email.
+				n.On("Send", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens := service.NewMockPasswordResetProvider(t)
+			users := service.NewMockPasswordUserProvider(t)
+			n := service.NewMockNotifier(t)
+			tt.setupMocks(tokens, users, n)
+
+			svc := service.NewPasswordResetService(
+				tokens,
+				users,
+				service.NewMockSessionRevoker(t),
+				n,
+				noAudit(t),
+				testHasher(),
+				testPolicy,
+				time.Hour,
+				"https://pvz.local/reset",
+				slog.New(slog.NewTextHandler(io.Discard, nil)),
+			)
+
+			require.NoError(t, svc.Forgot(context.Background(), tt.email))
+			svc.Wait()
+		})
+	}
+}
+
+func TestPasswordReset_Reset(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	usedAt := time.Now().Add(-time.Minute)
+
+	valid := &domain.PasswordResetToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name       string
+		password   string
+		setupMocks func(
+			tokens *service.MockPasswordResetProvider,
+			users *service.MockPasswordUserProvider,
+			sessions *service.MockSessionRevoker,
+		)
+		wantErr error
+	}{
+		{
+			name: "reset_success",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				sessions *service.MockSessionRevoker,
+			) {
+				tokens.On("GetByHash", mock.Anything, domain.HashToken("token")).Return(valid, nil)
+				tokens.On("Use", mock.Anything, valid, mock.Anything).Return(nil)
+				sessions.On("RevokeAll", mock.Anything, userID).Return(nil)
+			},
+		},
+		{
+			name: "unknown_token",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				sessions *service.MockSessionRevoker,
+			) {
+				tokens.On("GetByHash", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrInvalidResetToken,
+		},
+		{
+			name: "used_token",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				sessions *service.MockSessionRevoker,
+			) {
+				used := *valid
+				used.UsedAt = &usedAt
+
+				tokens.On("GetByHash", mock.Anything, mock.Anything).Return(&used, nil)
+			},
+			wantErr: models.ErrInvalidResetToken,
+		},
+		{
+			name: "expired_token",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				sessions *service.MockSessionRevoker,
+			) {
+				expired := *valid
+				expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+				tokens.On("GetByHash", mock.Anything, mock.Anything).Return(&expired, nil)
+			},
+			wantErr: models.ErrInvalidResetToken,
+		},
+		{
+			name:     "weak_password",
+			password: "password123",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				sessions *service.MockSessionRevoker,
+			) {
+			},
+			wantErr: models.ErrWeakPassword,
+		},
+		{
+			name: "token_used_concurrently",
+			setupMocks: func(
+				tokens *service.MockPasswordResetProvider,
+				users *service.MockPasswordUserProvider,
+				sessions *service.MockSessionRevoker,
+			) {
+				tokens.On("GetByHash", mock.Anything, mock.Anything).Return(valid, nil)
+				tokens.On("Use", mock.Anything, valid, mock.Anything).Return(domain.ErrNotFound)
+			},
+			wantErr: models.ErrInvalidResetToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens := service.NewMockPasswordResetProvider(t)
+			users := service.NewMockPasswordUserProvider(t)
+			sessions := service.NewMockSessionRevoker(t)
+			tt.setupMocks(tokens, users, sessions)
+
+			audit := service.NewMockAuditRecorder(t)
+			if tt.wantErr == nil {
+				audit.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuditEvent) bool {
+					return e.Action == domain.AuditUserPasswordReset && e.EntityID == userID
+				})).Once()
+			}
+
+			svc := service.NewPasswordResetService(
+				tokens,
+				users,
+				sessions,
+				service.NewMockNotifier(t),
+				audit,
+				testHasher(),
+				testPolicy,
+				time.Hour,
+				"",
+				slog.New(slog.NewTextHandler(io.Discard, nil)),
+			)
+
+			password := tt.password
+			if password == "" {
+				password = "newPassword123"
+			}
+
+			err := svc.Reset(context.Background(), "token", password)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package service_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"avito_pvz/internal/service"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPasswordDenylist(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	err := os.WriteFile(path, []byte("# comment\nQwerty123\n\n  password1  \n"), 0o600)
+	require.NoError(t, err)
+
+	denylist, err := service.LoadPasswordDenylist(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{
+		"qwerty123": {},
+		"password1": {},
+	}, denylist)
+
+	denylist, err = service.LoadPasswordDenylist("")
+	require.NoError(t, err)
+	require.Empty(t, denylist)
+
+	_, err = service.LoadPasswordDenylist(filepath.Join(t.TempDir(), "missing.txt"))
+	require.Error(t, err)
+}
+
+func TestLoadPasswordDenylist_Bundled(t *testing.T) {
+	t.Parallel()
+
+	denylist, err := service.LoadPasswordDenylist("../../config/common-passwords.txt")
+	require.NoError(t, err)
+	require.Contains(t, denylist, "password123")
+	require.Contains(t, denylist, "пароль123")
+}
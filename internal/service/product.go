@@ -11,8 +11,10 @@ import (
 
 type ProductProvider interface {
 	Create(ctx context.Context, product *domain.Product) error
+	CreateBatch(ctx context.Context, receptionID uuid.UUID, products []*domain.Product) error
 	GetLast(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error)
-	Delete(ctx context.Context, product *domain.Product) error
+	FindByBarcodes(ctx context.Context, barcodes []string) ([]domain.Product, error)
+	Void(ctx context.Context, void *domain.ProductVoid) (*domain.Product, error)
 }
 
 type ReceptionGetter interface {
@@ -20,50 +22,252 @@ type ReceptionGetter interface {
 }
 
 type PVZChecker interface {
-	Exist(ctx context.Context, pvz uuid.UUID) error
+	GetStatus(ctx context.Context, pvz uuid.UUID) (domain.PVZStatus, error)
+}
+
+type ProductTypeResolver interface {
+	Resolve(ctx context.Context, value string) (*domain.ProductTypeInfo, error)
 }
 
 type Product struct {
 	product   ProductProvider
 	reception ReceptionGetter
 	pvz       PVZChecker
+	types     ProductTypeResolver
+	staff     AssignmentChecker
+	audit     AuditRecorder
 }
 
 func (p *Product) Create(
 	ctx context.Context,
 	product domain.ProductToAdd,
 ) (*domain.Product, error) {
-	pType := domain.ProductType(product.Type)
-	if !pType.IsValid() {
-		return nil, models.ErrInvalidProductType
+	pType, err := p.types.Resolve(ctx, string(product.Type))
+	if err != nil {
+		return nil, err
 	}
 
-	reception, err := p.getActiveReceprion(ctx, uuid.UUID(product.UUID))
+	err = product.ProductIdentity.Validate()
+	if err != nil {
+		return nil, identityError(err)
+	}
+
+	reception, err := p.getActiveReceprion(ctx, uuid.UUID(product.UUID), true)
 	if err != nil {
 		return nil, err
 	}
 
+	if product.Barcode != "" {
+		held, err := p.heldBarcodes(ctx, []string{product.Barcode})
+		if err != nil {
+			return nil, err
+		}
+
+		if receptionID, ok := held[product.Barcode]; ok {
+			return nil, &models.DuplicateBarcodeError{Barcode: product.Barcode, ReceptionID: receptionID}
+		}
+	}
+
 	prod := domain.NewProduct(reception.ID, pType)
+	prod.ProductIdentity = product.ProductIdentity
 
 	err = p.product.Create(ctx, prod)
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		// Тот же штрихкод успели принять между проверкой и записью.
+		return nil, p.duplicateBarcode(ctx, prod.Barcode)
+	}
+
 	if err != nil {
 		return nil, models.ErrInternal
 	}
 
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditProductCreate,
+		Entity:   domain.AuditEntityProduct,
+		EntityID: prod.ID,
+		After:    prod.ToDto(),
+	})
+
 	return prod, nil
 }
 
+// CreateBatch добавляет товары в текущую приемку ПВЗ целиком или не добавляет
+// ни одного. Все товары проверяются до записи, ошибки отдельных товаров
+// возвращаются вместе в *models.BatchError.
+func (p *Product) CreateBatch(
+	ctx context.Context,
+	batch domain.ProductBatch,
+) ([]*domain.Product, error) {
+	err := batch.Validate()
+	if errors.Is(err, domain.ErrEmptyBatch) {
+		return nil, models.ErrEmptyBatch
+	}
+
+	if err != nil {
+		return nil, models.ErrBatchTooLarge
+	}
+
+	reception, err := p.getActiveReceprion(ctx, uuid.UUID(batch.PVZID), true)
+	if err != nil {
+		return nil, err
+	}
+
+	barcodes := make([]string, 0, len(batch.Items))
+	for _, item := range batch.Items {
+		if item.Barcode != "" {
+			barcodes = append(barcodes, item.Barcode)
+		}
+	}
+
+	held, err := p.heldBarcodes(ctx, barcodes)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*domain.Product, 0, len(batch.Items))
+	seen := make(map[string]struct{}, len(barcodes))
+
+	var itemErrs []models.ItemError
+
+	for i, item := range batch.Items {
+		pType, err := p.types.Resolve(ctx, string(item.Type))
+		if errors.Is(err, models.ErrInternal) {
+			return nil, err
+		}
+
+		if err != nil {
+			itemErrs = append(itemErrs, models.ItemError{Index: i, Err: err})
+
+			continue
+		}
+
+		if err := item.Metadata.Validate(); err != nil {
+			itemErrs = append(itemErrs, models.ItemError{Index: i, Err: models.ErrInvalidMetadata})
+
+			continue
+		}
+
+		if err := item.ProductIdentity.Validate(); err != nil {
+			itemErrs = append(itemErrs, models.ItemError{Index: i, Err: identityError(err)})
+
+			continue
+		}
+
+		if err := barcodeConflict(item.Barcode, held, seen); err != nil {
+			itemErrs = append(itemErrs, models.ItemError{Index: i, Err: err})
+
+			continue
+		}
+
+		prod := domain.NewProduct(reception.ID, pType)
+		prod.Metadata = item.Metadata
+		prod.ProductIdentity = item.ProductIdentity
+		products = append(products, prod)
+	}
+
+	if len(itemErrs) > 0 {
+		return nil, &models.BatchError{Items: itemErrs}
+	}
+
+	err = p.product.CreateBatch(ctx, reception.ID, products)
+	if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrReceptionNotActive) {
+		return nil, models.ErrReceptionAlreadyClosed
+	}
+
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		return nil, models.ErrBarcodeAlreadyReceived
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	// Пакет пишется в журнал одной записью по приемке, а не записью на товар.
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditProductBatch,
+		Entity:   domain.AuditEntityReception,
+		EntityID: reception.ID,
+		After:    domain.ProductsToDTO(products),
+	})
+
+	return products, nil
+}
+
+// heldBarcodes возвращает приемки, в которых уже приняты товары с этими
+// штрихкодами.
+func (p *Product) heldBarcodes(ctx context.Context, barcodes []string) (map[string]uuid.UUID, error) {
+	held := make(map[string]uuid.UUID)
+	if len(barcodes) == 0 {
+		return held, nil
+	}
+
+	products, err := p.product.FindByBarcodes(ctx, barcodes)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	for _, product := range products {
+		held[product.Barcode] = product.ReceptionID
+	}
+
+	return held, nil
+}
+
+func (p *Product) duplicateBarcode(ctx context.Context, barcode string) error {
+	held, err := p.heldBarcodes(ctx, []string{barcode})
+	if err != nil {
+		return err
+	}
+
+	return &models.DuplicateBarcodeError{Barcode: barcode, ReceptionID: held[barcode]}
+}
+
+// barcodeConflict проверяет штрихкод товара пакета против уже принятых
+// и предыдущих товаров того же пакета.
+func barcodeConflict(barcode string, held map[string]uuid.UUID, seen map[string]struct{}) error {
+	if barcode == "" {
+		return nil
+	}
+
+	if receptionID, ok := held[barcode]; ok {
+		return &models.DuplicateBarcodeError{Barcode: barcode, ReceptionID: receptionID}
+	}
+
+	if _, ok := seen[barcode]; ok {
+		return models.ErrDuplicateBarcode
+	}
+
+	seen[barcode] = struct{}{}
+
+	return nil
+}
+
+func identityError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidBarcode):
+		return models.ErrInvalidBarcode
+	case errors.Is(err, domain.ErrInvalidOrderID):
+		return models.ErrInvalidOrderID
+	case errors.Is(err, domain.ErrInvalidSKU):
+		return models.ErrInvalidSKU
+	default:
+		return models.ErrInternal
+	}
+}
+
 func (p *Product) getActiveReceprion(
 	ctx context.Context,
 	pvzID uuid.UUID,
+	requireActive bool,
 ) (*domain.Reception, error) {
-	err := p.pvz.Exist(ctx, pvzID)
-	if errors.Is(err, domain.ErrPVZNotExist) {
-		return nil, models.ErrPVZNotFound
+	err := checkPVZAccess(ctx, p.staff, pvzID)
+	if err != nil {
+		return nil, err
 	}
 
+	err = checkPVZStatus(ctx, p.pvz, pvzID, requireActive)
 	if err != nil {
-		return nil, models.ErrInternal
+		return nil, err
 	}
 
 	reception, err := p.reception.GetLast(ctx, pvzID)
@@ -83,7 +287,9 @@ func (p *Product) getActiveReceprion(
 }
 
 func (p *Product) DeleteLast(ctx context.Context, pvzID domain.PVZID) error {
-	reception, err := p.getActiveReceprion(ctx, uuid.UUID(pvzID))
+	// Удаление только исправляет уже открытую приемку, поэтому доступно
+	// и в приостановленном ПВЗ.
+	reception, err := p.getActiveReceprion(ctx, uuid.UUID(pvzID), false)
 	if err != nil {
 		return err
 	}
@@ -93,18 +299,88 @@ func (p *Product) DeleteLast(ctx context.Context, pvzID domain.PVZID) error {
 		return models.ErrProductNotFound
 	}
 
-	err = p.product.Delete(ctx, product)
 	if err != nil {
 		return models.ErrInternal
 	}
 
+	// Товар не удаляется, а аннулируется с системной причиной, как при Void.
+	void := domain.NewProductVoid(ctx, reception.ID, domain.ProductVoidRequest{
+		ProductID: &product.ID,
+		Reason:    domain.DeleteLastVoidReason,
+	})
+
+	voided, err := p.product.Void(ctx, void)
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.ErrProductNotFound
+	}
+
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditProductDelete,
+		Entity:   domain.AuditEntityProduct,
+		EntityID: voided.ID,
+		Before:   product.ToDto(),
+		After:    voided.ToDto(),
+	})
+
 	return nil
 }
 
-func NewProduct(product ProductProvider, reception ReceptionGetter, pvz PVZChecker) *Product {
+// Void аннулирует товар текущей приемки по ID или штрихкоду. В отличие от
+// DeleteLast товар может быть любым, а причину указывает сотрудник.
+func (p *Product) Void(ctx context.Context, request domain.ProductVoidRequest) (*domain.Product, error) {
+	err := request.Validate()
+	if errors.Is(err, domain.ErrInvalidProductSelector) {
+		return nil, models.ErrInvalidProductSelector
+	}
+
+	if err != nil {
+		return nil, models.ErrInvalidVoidReason
+	}
+
+	reception, err := p.getActiveReceprion(ctx, uuid.UUID(request.PVZID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	void := domain.NewProductVoid(ctx, reception.ID, request)
+
+	product, err := p.product.Void(ctx, void)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrProductNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditProductVoid,
+		Entity:   domain.AuditEntityProduct,
+		EntityID: product.ID,
+		After:    product.ToDto(),
+	})
+
+	return product, nil
+}
+
+func NewProduct(
+	product ProductProvider,
+	reception ReceptionGetter,
+	pvz PVZChecker,
+	types ProductTypeResolver,
+	staff AssignmentChecker,
+	audit AuditRecorder,
+) *Product {
 	return &Product{
 		product:   product,
 		reception: reception,
 		pvz:       pvz,
+		types:     types,
+		staff:     staff,
+		audit:     audit,
 	}
 }
@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -14,6 +15,32 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// knownProductTypes знает типы electronics и shoes (обувь), clothing отключен.
+func knownProductTypes(t *testing.T) *service.MockProductTypeResolver {
+	t.Helper()
+
+	catalog := map[string]domain.ProductTypeInfo{
+		"electronics": {Code: "electronics", NameRu: "электроника", Active: true},
+		"shoes":       {Code: "shoes", NameRu: "обувь", Active: true},
+		"обувь":       {Code: "shoes", NameRu: "обувь", Active: true},
+		"clothing":    {Code: "clothing", NameRu: "одежда"},
+	}
+
+	types := service.NewMockProductTypeResolver(t)
+	types.On("Resolve", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, value string) (*domain.ProductTypeInfo, error) {
+			productType, ok := catalog[value]
+			if !ok || !productType.Active {
+				return nil, models.ErrInvalidProductType
+			}
+
+			return &productType, nil
+		}).
+		Maybe()
+
+	return types
+}
+
 func TestProduct_Create(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -26,7 +53,7 @@ func TestProduct_Create(t *testing.T) {
 			name: "successful product creation",
 			product: domain.ProductToAdd{
 				UUID: domain.PVZID(uuid.Max),
-				Type: domain.ProductType(domain.ProductTypeElectronics),
+				Type: "electronics",
 			},
 			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
 				pvzID := uuid.Max
@@ -37,12 +64,12 @@ func TestProduct_Create(t *testing.T) {
 					CreatedAt: time.Now(),
 				}
 
-				mc.On("Exist", mock.Anything, pvzID).Return(nil)
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
 				mr.On("GetLast", mock.Anything, pvzID).Return(reception, nil)
 				mp.On("Create", mock.Anything, mock.Anything).Return(nil)
 			},
 			expected: &domain.Product{
-				Type: domain.ProductType(domain.ProductTypeElectronics),
+				Type: "electronics",
 			},
 			expectedErr: nil,
 		},
@@ -57,28 +84,75 @@ func TestProduct_Create(t *testing.T) {
 			expected:    nil,
 			expectedErr: models.ErrInvalidProductType,
 		},
+		{
+			name: "inactive product type",
+			product: domain.ProductToAdd{
+				UUID: domain.PVZID(uuid.Max),
+				Type: "clothing",
+			},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
+			},
+			expected:    nil,
+			expectedErr: models.ErrInvalidProductType,
+		},
+		{
+			name: "legacy russian type name",
+			product: domain.ProductToAdd{
+				UUID: domain.PVZID(uuid.Max),
+				Type: "обувь",
+			},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
+				reception := &domain.Reception{
+					ID:     uuid.Max,
+					PvzID:  uuid.Max,
+					Status: domain.ReceptionStatusInProgress,
+				}
+
+				mc.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatusActive, nil)
+				mr.On("GetLast", mock.Anything, uuid.Max).Return(reception, nil)
+				mp.On("Create", mock.Anything, mock.MatchedBy(func(p *domain.Product) bool {
+					return p.Type == "shoes" && p.TypeName == "обувь"
+				})).Return(nil)
+			},
+			expected: &domain.Product{
+				Type: "shoes",
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "PVZ not found",
 			product: domain.ProductToAdd{
 				UUID: domain.PVZID(uuid.Max),
-				Type: domain.ProductType(domain.ProductTypeElectronics),
+				Type: "electronics",
 			},
 			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
 				pvzID := uuid.Max
-				mc.On("Exist", mock.Anything, pvzID).Return(domain.ErrPVZNotExist)
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatus(""), domain.ErrNotFound)
 			},
 			expected:    nil,
 			expectedErr: models.ErrPVZNotFound,
 		},
+		{
+			name: "PVZ suspended",
+			product: domain.ProductToAdd{
+				UUID: domain.PVZID(uuid.Max),
+				Type: "electronics",
+			},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
+				mc.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatusSuspended, nil)
+			},
+			expected:    nil,
+			expectedErr: models.ErrPVZSuspended,
+		},
 		{
 			name: "reception not found",
 			product: domain.ProductToAdd{
 				UUID: domain.PVZID(uuid.Max),
-				Type: domain.ProductType(domain.ProductTypeElectronics),
+				Type: "electronics",
 			},
 			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
 				pvzID := uuid.Max
-				mc.On("Exist", mock.Anything, pvzID).Return(nil)
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
 				mr.On("GetLast", mock.Anything, pvzID).Return(nil, domain.ErrNotFound)
 			},
 			expected:    nil,
@@ -88,7 +162,7 @@ func TestProduct_Create(t *testing.T) {
 			name: "reception already closed",
 			product: domain.ProductToAdd{
 				UUID: domain.PVZID(uuid.Max),
-				Type: domain.ProductType(domain.ProductTypeElectronics),
+				Type: "electronics",
 			},
 			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
 				pvzID := uuid.Max
@@ -99,7 +173,7 @@ func TestProduct_Create(t *testing.T) {
 					CreatedAt: time.Now(),
 				}
 
-				mc.On("Exist", mock.Anything, pvzID).Return(nil)
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
 				mr.On("GetLast", mock.Anything, pvzID).Return(reception, nil)
 			},
 			expected:    nil,
@@ -116,10 +190,17 @@ func TestProduct_Create(t *testing.T) {
 			tt.setupMocks(mockProduct, mockReception, mockPVZ)
 
 			// Create service
-			service := service.NewProduct(mockProduct, mockReception, mockPVZ)
+			service := service.NewProduct(
+				mockProduct,
+				mockReception,
+				mockPVZ,
+				knownProductTypes(t),
+				assignedStaff(t),
+				noAudit(t),
+			)
 
 			// Call method
-			result, err := service.Create(context.Background(), tt.product)
+			result, err := service.Create(employeeCtx(), tt.product)
 
 			// Assert results
 			if tt.expectedErr != nil {
@@ -160,14 +241,16 @@ func TestProduct_DeleteLast(t *testing.T) {
 				product := &domain.Product{
 					ID:          uuid.Max,
 					ReceptionID: reception.ID,
-					Type:        domain.ProductTypeElectronics,
+					Type:        "electronics",
 					CreatedAt:   time.Now(),
 				}
 
-				mc.On("Exist", mock.Anything, pvzID).Return(nil)
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
 				mr.On("GetLast", mock.Anything, pvzID).Return(reception, nil)
 				mp.On("GetLast", mock.Anything, reception.ID).Return(product, nil)
-				mp.On("Delete", mock.Anything, product).Return(nil)
+				mp.On("Void", mock.Anything, mock.MatchedBy(func(v *domain.ProductVoid) bool {
+					return *v.ProductID == product.ID && v.Reason == domain.DeleteLastVoidReason
+				})).Return(product, nil)
 			},
 			expectedErr: nil,
 		},
@@ -176,7 +259,7 @@ func TestProduct_DeleteLast(t *testing.T) {
 			pvzID: domain.PVZID(uuid.Max),
 			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
 				pvzID := uuid.Max
-				mc.On("Exist", mock.Anything, pvzID).Return(domain.ErrPVZNotExist)
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatus(""), domain.ErrNotFound)
 			},
 			expectedErr: models.ErrPVZNotFound,
 		},
@@ -185,7 +268,7 @@ func TestProduct_DeleteLast(t *testing.T) {
 			pvzID: domain.PVZID(uuid.Max),
 			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
 				pvzID := uuid.Max
-				mc.On("Exist", mock.Anything, pvzID).Return(nil)
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
 				mr.On("GetLast", mock.Anything, pvzID).Return(nil, domain.ErrNotFound)
 			},
 			expectedErr: models.ErrReceptionDontExist,
@@ -202,7 +285,7 @@ func TestProduct_DeleteLast(t *testing.T) {
 					CreatedAt: time.Now(),
 				}
 
-				mc.On("Exist", mock.Anything, pvzID).Return(nil)
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
 				mr.On("GetLast", mock.Anything, pvzID).Return(reception, nil)
 			},
 			expectedErr: models.ErrReceptionAlreadyClosed,
@@ -219,7 +302,7 @@ func TestProduct_DeleteLast(t *testing.T) {
 					CreatedAt: time.Now(),
 				}
 
-				mc.On("Exist", mock.Anything, pvzID).Return(nil)
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
 				mr.On("GetLast", mock.Anything, pvzID).Return(reception, nil)
 				mp.On("GetLast", mock.Anything, reception.ID).Return(nil, domain.ErrNotFound)
 			},
@@ -238,10 +321,17 @@ func TestProduct_DeleteLast(t *testing.T) {
 			tt.setupMocks(mockProduct, mockReception, mockPVZ)
 
 			// Create service
-			service := service.NewProduct(mockProduct, mockReception, mockPVZ)
+			service := service.NewProduct(
+				mockProduct,
+				mockReception,
+				mockPVZ,
+				knownProductTypes(t),
+				assignedStaff(t),
+				noAudit(t),
+			)
 
 			// Call method
-			err := service.DeleteLast(context.Background(), tt.pvzID)
+			err := service.DeleteLast(employeeCtx(), tt.pvzID)
 
 			// Assert results
 			if tt.expectedErr != nil {
@@ -257,3 +347,382 @@ func TestProduct_DeleteLast(t *testing.T) {
 		})
 	}
 }
+
+func TestProduct_CreateBatch(t *testing.T) {
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	openReception := func() *domain.Reception {
+		return &domain.Reception{
+			ID:     receptionID,
+			PvzID:  pvzID,
+			Status: domain.ReceptionStatusInProgress,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		items       []domain.ProductBatchItem
+		setupMocks  func(*service.MockProductProvider, *service.MockReceptionGetter, *service.MockPVZChecker)
+		wantTypes   []domain.ProductType
+		wantErr     error
+		wantIndexes []int
+	}{
+		{
+			name: "created in order",
+			items: []domain.ProductBatchItem{
+				{Type: "shoes"},
+				{Type: "обувь"},
+				{Type: "electronics", Metadata: domain.ProductMetadata{"supplier": "ООО Ромашка"}},
+			},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				mr.On("GetLast", mock.Anything, pvzID).Return(openReception(), nil)
+				mp.On("CreateBatch", mock.Anything, receptionID, mock.MatchedBy(
+					func(products []*domain.Product) bool {
+						return len(products) == 3 &&
+							products[2].Metadata["supplier"] == "ООО Ромашка"
+					},
+				)).Return(nil)
+			},
+			wantTypes: []domain.ProductType{"shoes", "shoes", "electronics"},
+		},
+		{
+			name: "item errors reject whole batch",
+			items: []domain.ProductBatchItem{
+				{Type: "electronics"},
+				{Type: "clothing"},
+				{Type: "shoes", Metadata: domain.ProductMetadata{"": "пустой ключ"}},
+			},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				mr.On("GetLast", mock.Anything, pvzID).Return(openReception(), nil)
+			},
+			wantErr:     models.ErrInvalidBatch,
+			wantIndexes: []int{1, 2},
+		},
+		{
+			name: "barcode conflicts",
+			items: []domain.ProductBatchItem{
+				{Type: "shoes", ProductIdentity: domain.ProductIdentity{Barcode: "A-1"}},
+				{Type: "shoes", ProductIdentity: domain.ProductIdentity{Barcode: "A-2"}},
+				{Type: "shoes", ProductIdentity: domain.ProductIdentity{Barcode: "A-1"}},
+				{Type: "shoes", ProductIdentity: domain.ProductIdentity{Barcode: "A 3"}},
+			},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				mr.On("GetLast", mock.Anything, pvzID).Return(openReception(), nil)
+				mp.On("FindByBarcodes", mock.Anything, []string{"A-1", "A-2", "A-1", "A 3"}).
+					Return([]domain.Product{{
+						ReceptionID:     uuid.Max,
+						ProductIdentity: domain.ProductIdentity{Barcode: "A-2"},
+					}}, nil)
+			},
+			wantErr:     models.ErrInvalidBatch,
+			wantIndexes: []int{1, 2, 3},
+		},
+		{
+			name:       "empty batch",
+			items:      nil,
+			setupMocks: func(*service.MockProductProvider, *service.MockReceptionGetter, *service.MockPVZChecker) {},
+			wantErr:    models.ErrEmptyBatch,
+		},
+		{
+			name:       "batch too large",
+			items:      make([]domain.ProductBatchItem, domain.ProductBatchMaxSize+1),
+			setupMocks: func(*service.MockProductProvider, *service.MockReceptionGetter, *service.MockPVZChecker) {},
+			wantErr:    models.ErrBatchTooLarge,
+		},
+		{
+			name:  "pvz suspended",
+			items: []domain.ProductBatchItem{{Type: "electronics"}},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusSuspended, nil)
+			},
+			wantErr: models.ErrPVZSuspended,
+		},
+		{
+			name:  "reception closed before insert",
+			items: []domain.ProductBatchItem{{Type: "electronics"}},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter, mc *service.MockPVZChecker) {
+				mc.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				mr.On("GetLast", mock.Anything, pvzID).Return(openReception(), nil)
+				mp.On("CreateBatch", mock.Anything, receptionID, mock.Anything).
+					Return(domain.ErrReceptionNotActive)
+			},
+			wantErr: models.ErrReceptionAlreadyClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProduct := service.NewMockProductProvider(t)
+			mockReception := service.NewMockReceptionGetter(t)
+			mockPVZ := service.NewMockPVZChecker(t)
+
+			tt.setupMocks(mockProduct, mockReception, mockPVZ)
+
+			svc := service.NewProduct(
+				mockProduct,
+				mockReception,
+				mockPVZ,
+				knownProductTypes(t),
+				assignedStaff(t),
+				noAudit(t),
+			)
+
+			batch := domain.ProductBatch{PVZID: domain.PVZID(pvzID), Items: tt.items}
+
+			result, err := svc.CreateBatch(employeeCtx(), batch)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+
+				var batchErr *models.BatchError
+				if errors.As(err, &batchErr) {
+					indexes := make([]int, 0, len(batchErr.Items))
+					for _, item := range batchErr.Items {
+						indexes = append(indexes, item.Index)
+					}
+
+					assert.Equal(t, tt.wantIndexes, indexes)
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			types := make([]domain.ProductType, 0, len(result))
+			for _, product := range result {
+				assert.Equal(t, receptionID, product.ReceptionID)
+				types = append(types, product.Type)
+			}
+
+			assert.Equal(t, tt.wantTypes, types)
+		})
+	}
+}
+
+func TestProduct_CreateBarcode(t *testing.T) {
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	heldIn := uuid.New()
+
+	tests := []struct {
+		name          string
+		barcode       string
+		setupMocks    func(*service.MockProductProvider)
+		wantErr       error
+		wantReception uuid.UUID
+	}{
+		{
+			name:    "received",
+			barcode: "4601234567890",
+			setupMocks: func(mp *service.MockProductProvider) {
+				mp.On("FindByBarcodes", mock.Anything, []string{"4601234567890"}).
+					Return([]domain.Product{}, nil)
+				mp.On("Create", mock.Anything, mock.MatchedBy(func(p *domain.Product) bool {
+					return p.Barcode == "4601234567890" && p.OrderID == "order-7"
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "already held",
+			barcode: "4601234567890",
+			setupMocks: func(mp *service.MockProductProvider) {
+				mp.On("FindByBarcodes", mock.Anything, []string{"4601234567890"}).
+					Return([]domain.Product{{
+						ReceptionID:     heldIn,
+						ProductIdentity: domain.ProductIdentity{Barcode: "4601234567890"},
+					}}, nil)
+			},
+			wantErr:       models.ErrBarcodeAlreadyReceived,
+			wantReception: heldIn,
+		},
+		{
+			name:    "received concurrently",
+			barcode: "4601234567890",
+			setupMocks: func(mp *service.MockProductProvider) {
+				mp.On("FindByBarcodes", mock.Anything, []string{"4601234567890"}).
+					Return([]domain.Product{}, nil).
+					Once()
+				mp.On("Create", mock.Anything, mock.Anything).Return(domain.ErrAlreadyExists)
+				mp.On("FindByBarcodes", mock.Anything, []string{"4601234567890"}).
+					Return([]domain.Product{{
+						ReceptionID:     heldIn,
+						ProductIdentity: domain.ProductIdentity{Barcode: "4601234567890"},
+					}}, nil).
+					Once()
+			},
+			wantErr:       models.ErrBarcodeAlreadyReceived,
+			wantReception: heldIn,
+		},
+		{
+			name:       "invalid barcode",
+			barcode:    "460 123",
+			setupMocks: func(*service.MockProductProvider) {},
+			wantErr:    models.ErrInvalidBarcode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProduct := service.NewMockProductProvider(t)
+			mockReception := service.NewMockReceptionGetter(t)
+			mockPVZ := service.NewMockPVZChecker(t)
+
+			mockPVZ.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil).Maybe()
+			mockReception.On("GetLast", mock.Anything, pvzID).Return(&domain.Reception{
+				ID:     receptionID,
+				PvzID:  pvzID,
+				Status: domain.ReceptionStatusInProgress,
+			}, nil).Maybe()
+			tt.setupMocks(mockProduct)
+
+			svc := service.NewProduct(
+				mockProduct,
+				mockReception,
+				mockPVZ,
+				knownProductTypes(t),
+				assignedStaff(t),
+				noAudit(t),
+			)
+
+			result, err := svc.Create(employeeCtx(), domain.ProductToAdd{
+				UUID: domain.PVZID(pvzID),
+				Type: "electronics",
+				ProductIdentity: domain.ProductIdentity{
+					Barcode: tt.barcode,
+					OrderID: "order-7",
+				},
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+
+				var duplicate *models.DuplicateBarcodeError
+				if errors.As(err, &duplicate) {
+					assert.Equal(t, tt.wantReception, duplicate.ReceptionID)
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.barcode, result.Barcode)
+		})
+	}
+}
+
+func TestProduct_Void(t *testing.T) {
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	productID := uuid.New()
+
+	tests := []struct {
+		name       string
+		request    domain.ProductVoidRequest
+		setupMocks func(*service.MockProductProvider, *service.MockReceptionGetter)
+		wantErr    error
+	}{
+		{
+			name: "voided by id",
+			request: domain.ProductVoidRequest{
+				ProductID: &productID,
+				Reason:    " Отсканирован не тот товар ",
+			},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter) {
+				mr.On("GetLast", mock.Anything, pvzID).Return(&domain.Reception{
+					ID:     receptionID,
+					Status: domain.ReceptionStatusInProgress,
+				}, nil)
+				mp.On("Void", mock.Anything, mock.MatchedBy(func(v *domain.ProductVoid) bool {
+					return v.ReceptionID == receptionID &&
+						*v.ProductID == productID &&
+						v.Reason == "Отсканирован не тот товар"
+				})).Return(&domain.Product{ID: productID, ReceptionID: receptionID}, nil)
+			},
+		},
+		{
+			name:    "voided by barcode",
+			request: domain.ProductVoidRequest{Barcode: "4601234567890", Reason: "Брак"},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter) {
+				mr.On("GetLast", mock.Anything, pvzID).Return(&domain.Reception{
+					ID:     receptionID,
+					Status: domain.ReceptionStatusInProgress,
+				}, nil)
+				mp.On("Void", mock.Anything, mock.MatchedBy(func(v *domain.ProductVoid) bool {
+					return v.ProductID == nil && v.Barcode == "4601234567890"
+				})).Return(&domain.Product{ID: productID, ReceptionID: receptionID}, nil)
+			},
+		},
+		{
+			name:       "both selectors",
+			request:    domain.ProductVoidRequest{ProductID: &productID, Barcode: "1", Reason: "Брак"},
+			setupMocks: func(*service.MockProductProvider, *service.MockReceptionGetter) {},
+			wantErr:    models.ErrInvalidProductSelector,
+		},
+		{
+			name:       "no reason",
+			request:    domain.ProductVoidRequest{ProductID: &productID, Reason: "  "},
+			setupMocks: func(*service.MockProductProvider, *service.MockReceptionGetter) {},
+			wantErr:    models.ErrInvalidVoidReason,
+		},
+		{
+			name:    "reception closed",
+			request: domain.ProductVoidRequest{ProductID: &productID, Reason: "Брак"},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter) {
+				mr.On("GetLast", mock.Anything, pvzID).Return(&domain.Reception{
+					ID:     receptionID,
+					Status: domain.ReceptionStatusClosed,
+				}, nil)
+			},
+			wantErr: models.ErrReceptionAlreadyClosed,
+		},
+		{
+			name:    "product not in reception",
+			request: domain.ProductVoidRequest{ProductID: &productID, Reason: "Брак"},
+			setupMocks: func(mp *service.MockProductProvider, mr *service.MockReceptionGetter) {
+				mr.On("GetLast", mock.Anything, pvzID).Return(&domain.Reception{
+					ID:     receptionID,
+					Status: domain.ReceptionStatusInProgress,
+				}, nil)
+				mp.On("Void", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrProductNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProduct := service.NewMockProductProvider(t)
+			mockReception := service.NewMockReceptionGetter(t)
+			mockPVZ := service.NewMockPVZChecker(t)
+
+			mockPVZ.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil).Maybe()
+			tt.setupMocks(mockProduct, mockReception)
+
+			svc := service.NewProduct(
+				mockProduct,
+				mockReception,
+				mockPVZ,
+				knownProductTypes(t),
+				assignedStaff(t),
+				noAudit(t),
+			)
+
+			tt.request.PVZID = domain.PVZID(pvzID)
+
+			result, err := svc.Void(employeeCtx(), tt.request)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, productID, result.ID)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+type ProductTypeProvider interface {
+	Create(ctx context.Context, productType *domain.ProductTypeInfo) error
+	GetByCode(ctx context.Context, code domain.ProductType) (*domain.ProductTypeInfo, error)
+	List(ctx context.Context) ([]domain.ProductTypeInfo, error)
+	Update(ctx context.Context, productType *domain.ProductTypeInfo) error
+}
+
+// ProductTypes справочник типов товаров. Как и справочник городов,
+// кешируется на ttl, сбрасывается при изменениях через этот экземпляр
+// и загружается без блокировки.
+type ProductTypes struct {
+	repo  ProductTypeProvider
+	audit AuditRecorder
+	ttl   time.Duration
+
+	loads singleflight.Group
+	cache atomic.Pointer[productTypesByKey]
+}
+
+// productTypesByKey снимок справочника по коду и русскому названию.
+type productTypesByKey struct {
+	byKey    map[string]domain.ProductTypeInfo
+	loadedAt time.Time
+}
+
+func (p *ProductTypes) Create(
+	ctx context.Context,
+	params domain.ProductTypeToCreate,
+) (*domain.ProductTypeInfo, error) {
+	productType, err := domain.NewProductTypeInfo(params)
+	if err != nil {
+		return nil, productTypeError(err)
+	}
+
+	err = p.repo.Create(ctx, productType)
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		return nil, models.ErrProductTypeExists
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	p.invalidate()
+
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditProductTypeCreate,
+		Entity:   domain.AuditEntityProductType,
+		EntityID: productType.ID,
+		After:    productType.ToDTO(),
+	})
+
+	return productType, nil
+}
+
+func (p *ProductTypes) List(ctx context.Context) ([]domain.ProductTypeInfo, error) {
+	productTypes, err := p.repo.List(ctx)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return productTypes, nil
+}
+
+// Update меняет названия, атрибуты и активность типа. Код не меняется:
+// на него ссылаются принятые товары.
+func (p *ProductTypes) Update(
+	ctx context.Context,
+	code domain.ProductType,
+	params domain.ProductTypeToUpdate,
+) (*domain.ProductTypeInfo, error) {
+	before, err := p.repo.GetByCode(ctx, code)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrProductTypeNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	after, err := before.Apply(params)
+	if err != nil {
+		return nil, productTypeError(err)
+	}
+
+	err = p.repo.Update(ctx, after)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrProductTypeNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	p.invalidate()
+
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditProductTypeUpdate,
+		Entity:   domain.AuditEntityProductType,
+		EntityID: after.ID,
+		Before:   before.ToDTO(),
+		After:    after.ToDTO(),
+	})
+
+	return after, nil
+}
+
+// Resolve находит активный тип по коду. Для клиентов, которые передают
+// тип по-старому, принимается и русское название.
+func (p *ProductTypes) Resolve(ctx context.Context, value string) (*domain.ProductTypeInfo, error) {
+	cache := p.cache.Load()
+	if cache.stale(p.ttl) {
+		loaded, err, _ := p.loads.Do("product_types", func() (any, error) {
+			return p.load(context.WithoutCancel(ctx), cache)
+		})
+		if err != nil {
+			return nil, models.ErrInternal
+		}
+
+		cache = loaded.(*productTypesByKey)
+	}
+
+	productType, ok := cache.byKey[value]
+	if !ok || !productType.Active {
+		return nil, models.ErrInvalidProductType
+	}
+
+	return &productType, nil
+}
+
+// load читает справочник и заменяет им снимок prev, если кеш не сбросили,
+// пока шла загрузка.
+func (p *ProductTypes) load(
+	ctx context.Context,
+	prev *productTypesByKey,
+) (*productTypesByKey, error) {
+	productTypes, err := p.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &productTypesByKey{
+		byKey:    make(map[string]domain.ProductTypeInfo, 2*len(productTypes)),
+		loadedAt: time.Now(),
+	}
+
+	for _, productType := range productTypes {
+		cache.byKey[productType.NameRu] = productType
+	}
+
+	// Коды добавляются вторыми, чтобы код не перекрывался чужим названием.
+	for _, productType := range productTypes {
+		cache.byKey[string(productType.Code)] = productType
+	}
+
+	p.cache.CompareAndSwap(prev, cache)
+
+	return cache, nil
+}
+
+func (p *ProductTypes) invalidate() {
+	p.cache.Store(&productTypesByKey{})
+}
+
+func (c *productTypesByKey) stale(ttl time.Duration) bool {
+	return c == nil || time.Since(c.loadedAt) >= ttl
+}
+
+func productTypeError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidProductTypeCode):
+		return models.ErrInvalidProductTypeCode
+	case errors.Is(err, domain.ErrInvalidProductTypeName):
+		return models.ErrInvalidProductTypeName
+	default:
+		return models.ErrInternal
+	}
+}
+
+func NewProductTypeService(
+	repo ProductTypeProvider,
+	audit AuditRecorder,
+	ttl time.Duration,
+) *ProductTypes {
+	return &ProductTypes{
+		repo:  repo,
+		audit: audit,
+		ttl:   ttl,
+	}
+}
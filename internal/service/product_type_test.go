@@ -0,0 +1,204 @@
+package service_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductTypes_Create(t *testing.T) {
+	t.Parallel()
+
+	valid := domain.ProductTypeToCreate{
+		Code:       "alcohol",
+		NameRu:     "алкоголь",
+		NameEn:     "Alcohol",
+		Attributes: domain.ProductTypeAttributes{RequiresAgeCheck: true},
+	}
+
+	tests := []struct {
+		name       string
+		params     func() domain.ProductTypeToCreate
+		setupMocks func(repo *service.MockProductTypeProvider)
+		wantErr    error
+	}{
+		{
+			name:   "created",
+			params: func() domain.ProductTypeToCreate { return valid },
+			setupMocks: func(repo *service.MockProductTypeProvider) {
+				repo.On("Create", mock.Anything, mock.MatchedBy(func(p *domain.ProductTypeInfo) bool {
+					return p.Code == "alcohol" && p.Active && p.Attributes.RequiresAgeCheck
+				})).Return(nil)
+			},
+		},
+		{
+			name: "invalid_code",
+			params: func() domain.ProductTypeToCreate {
+				p := valid
+				p.Code = "Алкоголь"
+
+				return p
+			},
+			wantErr: models.ErrInvalidProductTypeCode,
+		},
+		{
+			name: "missing_english_name",
+			params: func() domain.ProductTypeToCreate {
+				p := valid
+				p.NameEn = " "
+
+				return p
+			},
+			wantErr: models.ErrInvalidProductTypeName,
+		},
+		{
+			name:   "duplicate",
+			params: func() domain.ProductTypeToCreate { return valid },
+			setupMocks: func(repo *service.MockProductTypeProvider) {
+				repo.On("Create", mock.Anything, mock.Anything).Return(domain.ErrAlreadyExists)
+			},
+			wantErr: models.ErrProductTypeExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := service.NewMockProductTypeProvider(t)
+			if tt.setupMocks != nil {
+				tt.setupMocks(repo)
+			}
+
+			svc := service.NewProductTypeService(repo, noAudit(t), time.Minute)
+
+			productType, err := svc.Create(moderatorCtx(uuid.New()), tt.params())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.ProductType("alcohol"), productType.Code)
+		})
+	}
+}
+
+func TestProductTypes_Update(t *testing.T) {
+	t.Parallel()
+
+	shoes := &domain.ProductTypeInfo{
+		ID:     uuid.New(),
+		Code:   "shoes",
+		NameRu: "обувь",
+		NameEn: "Shoes",
+		Active: true,
+	}
+
+	repo := service.NewMockProductTypeProvider(t)
+	repo.On("GetByCode", mock.Anything, domain.ProductType("shoes")).Return(shoes, nil)
+	repo.On("GetByCode", mock.Anything, domain.ProductType("boats")).
+		Return(nil, domain.ErrNotFound)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(p *domain.ProductTypeInfo) bool {
+		return p.ID == shoes.ID && !p.Active && p.NameEn == "Footwear"
+	})).Return(nil)
+
+	svc := service.NewProductTypeService(repo, noAudit(t), time.Minute)
+	ctx := moderatorCtx(uuid.New())
+
+	got, err := svc.Update(ctx, "shoes", domain.ProductTypeToUpdate{
+		NameRu: "обувь",
+		NameEn: "Footwear",
+	})
+	require.NoError(t, err)
+	assert.False(t, got.Active)
+	assert.True(t, shoes.Active, "исходный тип не меняется")
+
+	_, err = svc.Update(ctx, "shoes", domain.ProductTypeToUpdate{NameRu: "", NameEn: "Shoes"})
+	require.ErrorIs(t, err, models.ErrInvalidProductTypeName)
+
+	_, err = svc.Update(ctx, "boats", domain.ProductTypeToUpdate{NameRu: "лодки", NameEn: "Boats"})
+	require.ErrorIs(t, err, models.ErrProductTypeNotFound)
+}
+
+func TestProductTypes_Resolve(t *testing.T) {
+	t.Parallel()
+
+	repo := service.NewMockProductTypeProvider(t)
+	repo.On("List", mock.Anything).Return([]domain.ProductTypeInfo{
+		{Code: "electronics", NameRu: "электроника", Active: true},
+		{Code: "clothing", NameRu: "одежда", Active: false},
+	}, nil).Once()
+
+	svc := service.NewProductTypeService(repo, noAudit(t), time.Hour)
+	ctx := context.Background()
+
+	tests := []struct {
+		value    string
+		wantCode domain.ProductType
+		wantErr  error
+	}{
+		{value: "electronics", wantCode: "electronics"},
+		{value: "электроника", wantCode: "electronics"},
+		{value: "clothing", wantErr: models.ErrInvalidProductType},
+		{value: "toys", wantErr: models.ErrInvalidProductType},
+	}
+
+	// Справочник читается из репозитория один раз, дальше из кеша.
+	for _, tt := range tests {
+		productType, err := svc.Resolve(ctx, tt.value)
+		if tt.wantErr != nil {
+			require.ErrorIs(t, err, tt.wantErr, tt.value)
+
+			continue
+		}
+
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.wantCode, productType.Code)
+	}
+}
+
+func TestProductTypes_ResolveLoadsOnce(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+
+	repo := service.NewMockProductTypeProvider(t)
+	repo.On("List", mock.Anything).
+		Run(func(mock.Arguments) { <-release }).
+		Return([]domain.ProductTypeInfo{
+			{Code: "electronics", NameRu: "электроника", Active: true},
+		}, nil).
+		Once()
+
+	svc := service.NewProductTypeService(repo, noAudit(t), time.Hour)
+
+	var wg sync.WaitGroup
+
+	for range 5 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			productType, err := svc.Resolve(context.Background(), "electronics")
+			if assert.NoError(t, err) {
+				assert.Equal(t, domain.ProductType("electronics"), productType.Code)
+			}
+		}()
+	}
+
+	close(release)
+	wg.Wait()
+}
@@ -5,20 +5,38 @@ import (
 	"avito_pvz/internal/models/domain"
 	"context"
 	"errors"
+
+	"github.com/google/uuid"
 )
 
 type PVZProvider interface {
 	Create(ctx context.Context, pvz *domain.PVZ) error
-	GetAll(ctx context.Context) ([]domain.PVZ, error)
+	GetAll(ctx context.Context, status *domain.PVZStatus) ([]domain.PVZ, error)
 	GetWithParam(ctx context.Context, params domain.Params) ([]domain.PVZAgregate, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.PVZ, error)
+	UpdateProfile(ctx context.Context, change *domain.PVZProfileChange) error
+	GetProfileHistory(ctx context.Context, pvzID uuid.UUID) ([]domain.PVZProfileChange, error)
+	FindNearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error)
+	SetStatus(ctx context.Context, change *domain.PVZStatusChange) error
+}
+
+type CityChecker interface {
+	IsActive(ctx context.Context, name domain.PvzCity) (bool, error)
 }
 
 type PVZ struct {
-	repo PVZProvider
+	repo   PVZProvider
+	cities CityChecker
+	audit  AuditRecorder
 }
 
-func (p *PVZ) GetAllPVZ(ctx context.Context) (domain.PVZList, error) {
-	pvzs, err := p.repo.GetAll(ctx)
+// GetAllPVZ возвращает все ПВЗ, а с непустым status только ПВЗ в этом состоянии.
+func (p *PVZ) GetAllPVZ(ctx context.Context, status *domain.PVZStatus) (domain.PVZList, error) {
+	if status != nil && !status.IsValid() {
+		return nil, models.ErrInvalidPVZStatus
+	}
+
+	pvzs, err := p.repo.GetAll(ctx, status)
 
 	if errors.Is(err, domain.ErrNotFound) {
 		return nil, models.ErrPVZNotFound
@@ -37,6 +55,10 @@ func (p *PVZ) GetAllPVZ(ctx context.Context) (domain.PVZList, error) {
 }
 
 func (p *PVZ) List(ctx context.Context, params domain.Params) (*[]domain.PVZAgregate, error) {
+	if params.Status != nil && !params.Status.IsValid() {
+		return nil, models.ErrInvalidPVZStatus
+	}
+
 	pvzs, err := p.repo.GetWithParam(ctx, params)
 
 	if errors.Is(err, domain.ErrNotFound) {
@@ -50,24 +72,233 @@ func (p *PVZ) List(ctx context.Context, params domain.Params) (*[]domain.PVZAgre
 	return &pvzs, nil
 }
 
-func (p *PVZ) Create(ctx context.Context, city domain.PvzCity) (*domain.PVZ, error) {
-	valCity := domain.PvzCity(city)
-	if !valCity.IsValid() {
+func (p *PVZ) Create(
+	ctx context.Context,
+	city domain.PvzCity,
+	update domain.PVZProfileUpdate,
+) (*domain.PVZ, error) {
+	profile, err := domain.PVZProfile{}.Apply(update)
+	if err != nil {
+		return nil, profileError(err)
+	}
+
+	active, err := p.cities.IsActive(ctx, city)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	if !active {
 		return nil, models.ErrInvalidCity
 	}
 
-	pvz := domain.NewPVZ(valCity)
+	pvz := domain.NewPVZ(city, profile)
+
+	err = p.repo.Create(ctx, pvz)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditPVZCreate,
+		Entity:   domain.AuditEntityPVZ,
+		EntityID: uuid.UUID(*pvz.ID),
+		After:    pvz.ToDTO(),
+	})
+
+	return pvz, nil
+}
+
+// UpdateProfile меняет профиль ПВЗ и сохраняет изменение в истории.
+func (p *PVZ) UpdateProfile(
+	ctx context.Context,
+	id domain.PVZID,
+	update domain.PVZProfileUpdate,
+) (*domain.PVZ, error) {
+	pvz, err := p.repo.GetByID(ctx, uuid.UUID(id))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrPVZNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	profile, err := pvz.Profile.Apply(update)
+	if err != nil {
+		return nil, profileError(err)
+	}
+
+	before := pvz.ToDTO()
+	change := domain.NewPVZProfileChange(ctx, uuid.UUID(id), pvz.Profile, profile)
+
+	err = p.repo.UpdateProfile(ctx, change)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrPVZNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	pvz.Profile = profile
+
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditPVZUpdate,
+		Entity:   domain.AuditEntityPVZ,
+		EntityID: uuid.UUID(id),
+		Before:   before,
+		After:    pvz.ToDTO(),
+	})
+
+	return pvz, nil
+}
+
+// ChangeStatus переводит ПВЗ в состояние to. Закрыть ПВЗ с открытой приемкой
+// нельзя, закрытый ПВЗ остается закрытым.
+func (p *PVZ) ChangeStatus(
+	ctx context.Context,
+	id domain.PVZID,
+	to domain.PVZStatus,
+	reason string,
+) (*domain.PVZ, error) {
+	pvz, err := p.repo.GetByID(ctx, uuid.UUID(id))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrPVZNotFound
+	}
 
-	err := p.repo.Create(ctx, pvz)
 	if err != nil {
 		return nil, models.ErrInternal
 	}
 
+	change, err := domain.NewPVZStatusChange(pvz, to, reason)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	err = p.repo.SetStatus(ctx, change)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	before := pvz.ToDTO()
+
+	change.Apply(pvz)
+
+	p.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditPVZStatusChange,
+		Entity:   domain.AuditEntityPVZ,
+		EntityID: uuid.UUID(id),
+		Before:   before,
+		After:    pvz.ToDTO(),
+	})
+
 	return pvz, nil
 }
 
-func NewPVZServce(repo PVZProvider) *PVZ {
+// History возвращает изменения профиля ПВЗ, новые первыми.
+func (p *PVZ) History(ctx context.Context, id domain.PVZID) ([]domain.PVZProfileChange, error) {
+	_, err := p.repo.GetByID(ctx, uuid.UUID(id))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrPVZNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	history, err := p.repo.GetProfileHistory(ctx, uuid.UUID(id))
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return history, nil
+}
+
+// Nearest ищет ближайшие к точке ПВЗ, ближайшие первыми.
+func (p *PVZ) Nearest(ctx context.Context, query domain.NearbyQuery) ([]domain.NearbyPVZ, error) {
+	err := query.Validate()
+	switch {
+	case errors.Is(err, domain.ErrInvalidLocation):
+		return nil, models.ErrInvalidLocation
+	case errors.Is(err, domain.ErrInvalidRadius):
+		return nil, models.ErrInvalidRadius
+	case errors.Is(err, domain.ErrInvalidLimit):
+		return nil, models.ErrInvalidLimit
+	case err != nil:
+		return nil, models.ErrInternal
+	}
+
+	found, err := p.repo.FindNearest(ctx, query)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return found, nil
+}
+
+func profileError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidAddress):
+		return models.ErrInvalidAddress
+	case errors.Is(err, domain.ErrInvalidLocation):
+		return models.ErrInvalidLocation
+	case errors.Is(err, domain.ErrInvalidTimezone):
+		return models.ErrInvalidTimezone
+	case errors.Is(err, domain.ErrInvalidWorkingHours):
+		return models.ErrInvalidWorkingHours
+	case errors.Is(err, domain.ErrInvalidPhone):
+		return models.ErrInvalidPhone
+	default:
+		return models.ErrInternal
+	}
+}
+
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return models.ErrPVZNotFound
+	case errors.Is(err, domain.ErrInvalidStatusReason):
+		return models.ErrInvalidStatusReason
+	case errors.Is(err, domain.ErrInvalidStatusTransition),
+		errors.Is(err, domain.ErrStatusChanged):
+		return models.ErrInvalidStatusChange
+	case errors.Is(err, domain.ErrHasOpenReception):
+		return models.ErrPVZHasOpenReception
+	default:
+		return models.ErrInternal
+	}
+}
+
+// checkPVZStatus проверяет, что ПВЗ существует, а с requireActive еще и что он
+// принимает новые приемки и товары.
+func checkPVZStatus(ctx context.Context, pvz PVZChecker, pvzID uuid.UUID, requireActive bool) error {
+	status, err := pvz.GetStatus(ctx, pvzID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.ErrPVZNotFound
+	}
+
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	if !requireActive {
+		return nil
+	}
+
+	switch status {
+	case domain.PVZStatusSuspended:
+		return models.ErrPVZSuspended
+	case domain.PVZStatusClosed:
+		return models.ErrPVZClosed
+	default:
+		return nil
+	}
+}
+
+func NewPVZServce(repo PVZProvider, cities CityChecker, audit AuditRecorder) *PVZ {
 	return &PVZ{
-		repo: repo,
+		repo:   repo,
+		cities: cities,
+		audit:  audit,
 	}
 }
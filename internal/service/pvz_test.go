@@ -15,12 +15,26 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// activeCities считает активными только Москву и Казань.
+func activeCities(t *testing.T) *service.MockCityChecker {
+	t.Helper()
+
+	cities := service.NewMockCityChecker(t)
+	cities.On("IsActive", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, name domain.PvzCity) (bool, error) {
+			return name == "Москва" || name == "Казань", nil
+		}).
+		Maybe()
+
+	return cities
+}
+
 func TestPVZ_List(t *testing.T) {
 	tests := []struct {
 		name string // description of this test case
 		// Named input parameters for receiver constructor.
 		setupMocks func(*service.MockPVZProvider)
-		want       *domain.PVZList
+		want       *[]domain.PVZAgregate
 		wantErr    error
 	}{
 		{
@@ -44,21 +58,25 @@ func TestPVZ_List(t *testing.T) {
 		{
 			name: "successful list",
 			setupMocks: func(mp *service.MockPVZProvider) {
-				mockDomainPVZs := []domain.PVZ{
+				mockAggregates := []domain.PVZAgregate{
 					{
-						ID:               (*domain.PVZID)(&uuid.Max),
-						City:             "Москва",
-						RegistrationDate: time.Time{},
+						Pvz: &domain.PVZ{
+							ID:               (*domain.PVZID)(&uuid.Max),
+							City:             "Москва",
+							RegistrationDate: time.Time{},
+						},
 					},
 				}
 				mp.On("GetWithParam", mock.Anything, mock.Anything).
-					Return(mockDomainPVZs, nil)
+					Return(mockAggregates, nil)
 			},
-			want: &domain.PVZList{
+			want: &[]domain.PVZAgregate{
 				{
-					ID:               (*domain.PVZID)(&uuid.Max),
-					City:             "Москва",
-					RegistrationDate: time.Time{},
+					Pvz: &domain.PVZ{
+						ID:               (*domain.PVZID)(&uuid.Max),
+						City:             "Москва",
+						RegistrationDate: time.Time{},
+					},
 				},
 			},
 			wantErr: nil,
@@ -68,7 +86,7 @@ func TestPVZ_List(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockPVZ := service.NewMockPVZProvider(t)
 			tt.setupMocks(mockPVZ)
-			service := service.NewPVZServce(mockPVZ)
+			service := service.NewPVZServce(mockPVZ, service.NewMockCityChecker(t), noAudit(t))
 
 			params := domain.Params{}
 			got, err := service.List(context.Background(), params)
@@ -89,9 +107,13 @@ func TestPVZ_List(t *testing.T) {
 }
 
 func TestPVZ_GetAllPVZ(t *testing.T) {
+	closed := domain.PVZStatusClosed
+	unknown := domain.PVZStatus("unknown")
+
 	tests := []struct {
 		name string // description of this test case
 		// Named input parameters for receiver constructor.
+		status     *domain.PVZStatus
 		setupMocks func(*service.MockPVZProvider)
 		want       domain.PVZList
 		wantErr    error
@@ -104,7 +126,7 @@ func TestPVZ_GetAllPVZ(t *testing.T) {
 					City:             "Москва",
 					RegistrationDate: time.Time{},
 				}}
-				mp.On("GetAll", mock.Anything).
+				mp.On("GetAll", mock.Anything, (*domain.PVZStatus)(nil)).
 					Return(pvzs, nil)
 			},
 			want: domain.PVZList{{
@@ -117,7 +139,7 @@ func TestPVZ_GetAllPVZ(t *testing.T) {
 		{
 			name: "empty pvz list",
 			setupMocks: func(mp *service.MockPVZProvider) {
-				mp.On("GetAll", mock.Anything).
+				mp.On("GetAll", mock.Anything, (*domain.PVZStatus)(nil)).
 					Return([]domain.PVZ{}, nil)
 			},
 			want:    domain.PVZList{},
@@ -126,7 +148,7 @@ func TestPVZ_GetAllPVZ(t *testing.T) {
 		{
 			name: "pvz not found error from repo",
 			setupMocks: func(mp *service.MockPVZProvider) {
-				mp.On("GetAll", mock.Anything).
+				mp.On("GetAll", mock.Anything, (*domain.PVZStatus)(nil)).
 					Return(nil, domain.ErrNotFound)
 			},
 			want:    nil,
@@ -135,21 +157,38 @@ func TestPVZ_GetAllPVZ(t *testing.T) {
 		{
 			name: "unexpected internal error from repo",
 			setupMocks: func(mp *service.MockPVZProvider) {
-				mp.On("GetAll", mock.Anything).
+				mp.On("GetAll", mock.Anything, (*domain.PVZStatus)(nil)).
 					Return(nil, assert.AnError)
 			},
 			want:    nil,
 			wantErr: models.ErrInternal,
 		},
+		{
+			name:   "filter by status",
+			status: &closed,
+			setupMocks: func(mp *service.MockPVZProvider) {
+				mp.On("GetAll", mock.Anything, &closed).
+					Return([]domain.PVZ{}, nil)
+			},
+			want:    domain.PVZList{},
+			wantErr: nil,
+		},
+		{
+			name:       "invalid status filter",
+			status:     &unknown,
+			setupMocks: func(mp *service.MockPVZProvider) {},
+			want:       nil,
+			wantErr:    models.ErrInvalidPVZStatus,
+		},
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockPVZ := service.NewMockPVZProvider(t)
 			tt.setupMocks(mockPVZ)
-			service := service.NewPVZServce(mockPVZ)
+			service := service.NewPVZServce(mockPVZ, service.NewMockCityChecker(t), noAudit(t))
 
-			got, err := service.GetAllPVZ(context.Background())
+			got, err := service.GetAllPVZ(context.Background(), tt.status)
 
 			// Assert results
 			if tt.wantErr != nil {
@@ -189,10 +228,14 @@ func TestPVZ_Create(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "invalid city",
-			pvz:  "!!!",
-			setupMocks: func(mp *service.MockPVZProvider) {
-			},
+			name:    "invalid city",
+			pvz:     "!!!",
+			want:    nil,
+			wantErr: models.ErrInvalidCity,
+		},
+		{
+			name:    "inactive city",
+			pvz:     "Санкт-Петербург",
 			want:    nil,
 			wantErr: models.ErrInvalidCity,
 		},
@@ -211,10 +254,12 @@ func TestPVZ_Create(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockPVZ := service.NewMockPVZProvider(t)
-			tt.setupMocks(mockPVZ)
-			service := service.NewPVZServce(mockPVZ)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockPVZ)
+			}
+			service := service.NewPVZServce(mockPVZ, activeCities(t), noAudit(t))
 
-			got, err := service.Create(context.Background(), tt.pvz)
+			got, err := service.Create(context.Background(), tt.pvz, domain.PVZProfileUpdate{})
 
 			// Assert results
 			if tt.wantErr != nil {
@@ -237,3 +282,249 @@ func TestPVZ_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestPVZ_UpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	newPVZ := func() *domain.PVZ {
+		return &domain.PVZ{
+			ID:   (*domain.PVZID)(&id),
+			City: "Москва",
+			Profile: domain.PVZProfile{
+				Address:  "ул. Тверская, д. 7",
+				Timezone: "Europe/Moscow",
+			},
+		}
+	}
+	moderatorID := uuid.New()
+	phone := "+74951234567"
+
+	tests := []struct {
+		name       string
+		update     domain.PVZProfileUpdate
+		setupMocks func(repo *service.MockPVZProvider)
+		wantErr    error
+	}{
+		{
+			name:   "updated_with_history",
+			update: domain.PVZProfileUpdate{Phone: &phone},
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("GetByID", mock.Anything, id).Return(newPVZ(), nil)
+				repo.On("UpdateProfile", mock.Anything, mock.MatchedBy(
+					func(c *domain.PVZProfileChange) bool {
+						return c.PVZID == id &&
+							c.Before.Phone == "" &&
+							c.After.Phone == phone &&
+							c.After.Address == "ул. Тверская, д. 7" &&
+							*c.ChangedBy == moderatorID
+					},
+				)).Return(nil)
+			},
+		},
+		{
+			name:   "invalid_profile",
+			update: domain.PVZProfileUpdate{Location: &domain.GeoPoint{Lat: 100}},
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("GetByID", mock.Anything, id).Return(newPVZ(), nil)
+			},
+			wantErr: models.ErrInvalidLocation,
+		},
+		{
+			name:   "pvz_not_found",
+			update: domain.PVZProfileUpdate{Phone: &phone},
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("GetByID", mock.Anything, id).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrPVZNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := service.NewMockPVZProvider(t)
+			tt.setupMocks(repo)
+
+			svc := service.NewPVZServce(repo, service.NewMockCityChecker(t), noAudit(t))
+
+			got, err := svc.UpdateProfile(moderatorCtx(moderatorID), domain.PVZID(id), tt.update)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, phone, got.Profile.Phone)
+		})
+	}
+}
+
+func TestPVZ_ChangeStatus(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	newPVZ := func(status domain.PVZStatus) *domain.PVZ {
+		return &domain.PVZ{
+			ID:     (*domain.PVZID)(&id),
+			City:   "Москва",
+			Status: status,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		to         domain.PVZStatus
+		reason     string
+		setupMocks func(repo *service.MockPVZProvider)
+		wantErr    error
+	}{
+		{
+			name:   "suspended",
+			to:     domain.PVZStatusSuspended,
+			reason: " Ремонт помещения ",
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("GetByID", mock.Anything, id).Return(newPVZ(domain.PVZStatusActive), nil)
+				repo.On("SetStatus", mock.Anything, mock.MatchedBy(
+					func(c *domain.PVZStatusChange) bool {
+						return c.PVZID == id &&
+							c.From == domain.PVZStatusActive &&
+							c.To == domain.PVZStatusSuspended &&
+							c.Reason == "Ремонт помещения"
+					},
+				)).Return(nil)
+			},
+		},
+		{
+			name:   "empty_reason",
+			to:     domain.PVZStatusSuspended,
+			reason: "  ",
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("GetByID", mock.Anything, id).Return(newPVZ(domain.PVZStatusActive), nil)
+			},
+			wantErr: models.ErrInvalidStatusReason,
+		},
+		{
+			name:   "closed_is_terminal",
+			to:     domain.PVZStatusActive,
+			reason: "Ошибка",
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("GetByID", mock.Anything, id).Return(newPVZ(domain.PVZStatusClosed), nil)
+			},
+			wantErr: models.ErrInvalidStatusChange,
+		},
+		{
+			name:   "close_with_open_reception",
+			to:     domain.PVZStatusClosed,
+			reason: "Переезд",
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("GetByID", mock.Anything, id).Return(newPVZ(domain.PVZStatusActive), nil)
+				repo.On("SetStatus", mock.Anything, mock.Anything).Return(domain.ErrHasOpenReception)
+			},
+			wantErr: models.ErrPVZHasOpenReception,
+		},
+		{
+			name:   "changed_concurrently",
+			to:     domain.PVZStatusSuspended,
+			reason: "Ремонт",
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("GetByID", mock.Anything, id).Return(newPVZ(domain.PVZStatusActive), nil)
+				repo.On("SetStatus", mock.Anything, mock.Anything).Return(domain.ErrStatusChanged)
+			},
+			wantErr: models.ErrInvalidStatusChange,
+		},
+		{
+			name:   "pvz_not_found",
+			to:     domain.PVZStatusSuspended,
+			reason: "Ремонт",
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("GetByID", mock.Anything, id).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrPVZNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := service.NewMockPVZProvider(t)
+			tt.setupMocks(repo)
+
+			svc := service.NewPVZServce(repo, service.NewMockCityChecker(t), noAudit(t))
+
+			got, err := svc.ChangeStatus(context.Background(), domain.PVZID(id), tt.to, tt.reason)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.to, got.Status)
+			require.Equal(t, "Ремонт помещения", got.StatusReason)
+		})
+	}
+}
+
+func TestPVZ_Nearest(t *testing.T) {
+	t.Parallel()
+
+	point := domain.GeoPoint{Lat: 55.75, Lon: 37.61}
+
+	tests := []struct {
+		name       string
+		query      domain.NearbyQuery
+		setupMocks func(repo *service.MockPVZProvider)
+		wantErr    error
+	}{
+		{
+			name:  "found",
+			query: domain.NearbyQuery{Point: point, RadiusKm: 5, Limit: 10},
+			setupMocks: func(repo *service.MockPVZProvider) {
+				repo.On("FindNearest", mock.Anything, mock.Anything).
+					Return([]domain.NearbyPVZ{{DistanceKm: 1.2}}, nil)
+			},
+		},
+		{
+			name:    "invalid_point",
+			query:   domain.NearbyQuery{Point: domain.GeoPoint{Lat: 95}, RadiusKm: 5, Limit: 10},
+			wantErr: models.ErrInvalidLocation,
+		},
+		{
+			name:    "radius_too_large",
+			query:   domain.NearbyQuery{Point: point, RadiusKm: 500, Limit: 10},
+			wantErr: models.ErrInvalidRadius,
+		},
+		{
+			name:    "zero_limit",
+			query:   domain.NearbyQuery{Point: point, RadiusKm: 5},
+			wantErr: models.ErrInvalidLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := service.NewMockPVZProvider(t)
+			if tt.setupMocks != nil {
+				tt.setupMocks(repo)
+			}
+
+			svc := service.NewPVZServce(repo, service.NewMockCityChecker(t), noAudit(t))
+
+			found, err := svc.Nearest(context.Background(), tt.query)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, found, 1)
+		})
+	}
+}
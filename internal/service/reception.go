@@ -5,66 +5,142 @@ import (
 	"avito_pvz/internal/models/domain"
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type ReceptionProvider interface {
 	Close(ctx context.Context, reception domain.Reception) error
+	CloseReconciled(
+		ctx context.Context,
+		reception domain.Reception,
+		report *domain.ReceptionReconciliation,
+	) error
 	GetLast(ctx context.Context, pvz uuid.UUID) (*domain.Reception, error)
 	Create(ctx context.Context, reception domain.Reception) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Reception, error)
+	Reopen(ctx context.Context, correction *domain.ReceptionCorrection) error
 }
+
+type ASNGetter interface {
+	GetForReception(ctx context.Context, reception domain.Reception) (*domain.ASN, error)
+}
+
+type ReceptionProducts interface {
+	GetByReception(ctx context.Context, receptionID uuid.UUID) ([]domain.Product, error)
+}
+
 type Reception struct {
 	reception ReceptionProvider
 	pvz       PVZChecker
+	staff     AssignmentChecker
+	audit     AuditRecorder
+	asn       ASNGetter
+	products  ReceptionProducts
+	// correctionWindow сколько после закрытия приемку еще можно исправить.
+	correctionWindow time.Duration
 }
 
+// CloseLastReception закрывает открытую приемку ПВЗ. Если для ПВЗ есть
+// манифест поставки, приемка сверяется с ним и результат сверки
+// сохраняется вместе с закрытием; без манифеста сверка nil.
 func (r *Reception) CloseLastReception(
 	ctx context.Context,
 	pvzID domain.PVZID,
-) (*domain.Reception, error) {
-	err := r.pvz.Exist(ctx, uuid.UUID(pvzID))
-	if errors.Is(err, domain.ErrNotFound) {
-		return nil, models.ErrPVZNotFound
+) (*domain.Reception, *domain.ReceptionReconciliation, error) {
+	err := checkPVZAccess(ctx, r.staff, uuid.UUID(pvzID))
+	if err != nil {
+		return nil, nil, err
 	}
 
+	// Открытую приемку можно закрыть и в приостановленном ПВЗ.
+	err = checkPVZStatus(ctx, r.pvz, uuid.UUID(pvzID), false)
 	if err != nil {
-		return nil, models.ErrInternal
+		return nil, nil, err
 	}
 
 	reception, err := r.reception.GetLast(ctx, uuid.UUID(pvzID))
 	if reception != nil && !reception.IsActive() {
-		return nil, models.ErrReceptionAlreadyClosed
+		return nil, nil, models.ErrReceptionAlreadyClosed
 	}
 
 	if errors.Is(err, domain.ErrNotFound) {
-		return nil, models.ErrReceptionDontExist
+		return nil, nil, models.ErrReceptionDontExist
 	}
 
 	if err != nil {
-		return nil, models.ErrInternal
+		return nil, nil, models.ErrInternal
 	}
 
-	err = r.reception.Close(ctx, *reception)
+	report, err := r.reconcile(ctx, reception)
 	if err != nil {
-		return nil, models.ErrInternal
+		return nil, nil, err
 	}
 
+	before := reception.ToDTO()
+
 	reception.Close()
 
-	return reception, nil
+	if report != nil {
+		err = r.reception.CloseReconciled(ctx, *reception, report)
+	} else {
+		err = r.reception.Close(ctx, *reception)
+	}
+
+	if errors.Is(err, domain.ErrStatusChanged) {
+		return nil, nil, models.ErrReceptionAlreadyClosed
+	}
+
+	if err != nil {
+		return nil, nil, models.ErrInternal
+	}
+
+	r.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditReceptionClose,
+		Entity:   domain.AuditEntityReception,
+		EntityID: reception.ID,
+		Before:   before,
+		After:    reception.ToClosedDTO(report),
+	})
+
+	return reception, report, nil
 }
 
-func (r *Reception) Create(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error) {
-	err := r.pvz.Exist(ctx, uuid.UUID(pvzID))
+// reconcile сверяет товары приемки с манифестом ПВЗ. Без манифеста
+// возвращает nil.
+func (r *Reception) reconcile(
+	ctx context.Context,
+	reception *domain.Reception,
+) (*domain.ReceptionReconciliation, error) {
+	asn, err := r.asn.GetForReception(ctx, *reception)
 	if errors.Is(err, domain.ErrNotFound) {
-		return nil, models.ErrPVZNotFound
+		return nil, nil
 	}
 
 	if err != nil {
 		return nil, models.ErrInternal
 	}
 
+	products, err := r.products.GetByReception(ctx, reception.ID)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return asn.Reconcile(reception.ID, products), nil
+}
+
+func (r *Reception) Create(ctx context.Context, pvzID domain.PVZID) (*domain.Reception, error) {
+	err := checkPVZAccess(ctx, r.staff, uuid.UUID(pvzID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkPVZStatus(ctx, r.pvz, uuid.UUID(pvzID), true)
+	if err != nil {
+		return nil, err
+	}
+
 	oldReception, err := r.reception.GetLast(ctx, uuid.UUID(pvzID))
 	if oldReception != nil && oldReception.IsActive() {
 		return nil, models.ErrReceptionAlreadyExist
@@ -81,12 +157,106 @@ func (r *Reception) Create(ctx context.Context, pvzID domain.PVZID) (*domain.Rec
 		return nil, models.ErrInternal
 	}
 
+	r.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditReceptionCreate,
+		Entity:   domain.AuditEntityReception,
+		EntityID: reception.ID,
+		After:    reception.ToDTO(),
+	})
+
 	return reception, nil
 }
 
-func NewReceptionService(reception ReceptionProvider, pvz PVZChecker) *Reception {
+// Reopen открывает закрытую приемку для исправления. Доступно только
+// модератору, в пределах correctionWindow после закрытия и пока в ПВЗ
+// не появилась более новая приемка.
+func (r *Reception) Reopen(
+	ctx context.Context,
+	id uuid.UUID,
+	justification string,
+) (*domain.Reception, error) {
+	reception, err := r.reception.GetByID(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrReceptionNotFound
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	// Исправление добавляет и аннулирует товары, поэтому ПВЗ должен работать.
+	err = checkPVZStatus(ctx, r.pvz, reception.PvzID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := r.reception.GetLast(ctx, reception.PvzID)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	if last.ID != reception.ID {
+		return nil, models.ErrNewerReceptionExists
+	}
+
+	correction, err := domain.NewReceptionCorrection(ctx, reception, justification, r.correctionWindow)
+	if err != nil {
+		return nil, correctionError(err)
+	}
+
+	err = r.reception.Reopen(ctx, correction)
+	if errors.Is(err, domain.ErrStatusChanged) {
+		return nil, models.ErrNewerReceptionExists
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	before := reception.ToDTO()
+
+	correction.Apply(reception)
+
+	r.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditReceptionReopen,
+		Entity:   domain.AuditEntityReception,
+		EntityID: reception.ID,
+		Before:   before,
+		After:    reception.ToDTO(),
+	})
+
+	return reception, nil
+}
+
+func correctionError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidJustification):
+		return models.ErrInvalidJustification
+	case errors.Is(err, domain.ErrReceptionNotClosed):
+		return models.ErrReceptionNotClosed
+	case errors.Is(err, domain.ErrCorrectionWindowExpired):
+		return models.ErrCorrectionExpired
+	default:
+		return models.ErrInternal
+	}
+}
+
+func NewReceptionService(
+	reception ReceptionProvider,
+	pvz PVZChecker,
+	staff AssignmentChecker,
+	audit AuditRecorder,
+	asn ASNGetter,
+	products ReceptionProducts,
+	correctionWindow time.Duration,
+) *Reception {
 	return &Reception{
-		reception: reception,
-		pvz:       pvz,
+		reception:        reception,
+		pvz:              pvz,
+		staff:            staff,
+		audit:            audit,
+		asn:              asn,
+		products:         products,
+		correctionWindow: correctionWindow,
 	}
 }
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+type IdleReceptionCloser interface {
+	CloseIdle(ctx context.Context, idleSince time.Time) ([]domain.Reception, error)
+}
+
+// ReceptionAutoClose закрывает забытые приемки: открытые дольше idleTimeout
+// с последнего товара (или с открытия, если товаров нет). Без этого
+// следующая смена не может начать приемку в том же ПВЗ.
+type ReceptionAutoClose struct {
+	receptions  IdleReceptionCloser
+	log         *slog.Logger
+	idleTimeout time.Duration
+	interval    time.Duration
+}
+
+// Run проверяет приемки каждые interval, пока не отменят ctx. Несколько
+// экземпляров сервиса могут запускать Run одновременно: проход выполняет
+// только тот, кто взял блокировку в базе.
+func (a *ReceptionAutoClose) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := a.CloseIdle(ctx)
+			if err != nil && ctx.Err() == nil {
+				a.log.ErrorContext(ctx, "auto close receptions failed", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// CloseIdle выполняет один проход и возвращает закрытые приемки.
+// Если проход выполняет другой экземпляр, возвращает пустой список.
+func (a *ReceptionAutoClose) CloseIdle(ctx context.Context) ([]domain.Reception, error) {
+	// Записи аудита о закрытии пишет репозиторий в транзакции прохода.
+	ctx = domain.WithSystemActor(ctx)
+
+	receptions, err := a.receptions.CloseIdle(ctx, time.Now().Add(-a.idleTimeout))
+	if errors.Is(err, domain.ErrLocked) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	for _, reception := range receptions {
+		a.log.InfoContext(ctx, "reception closed by system",
+			slog.String("reception_id", reception.ID.String()),
+			slog.String("pvz_id", reception.PvzID.String()),
+		)
+	}
+
+	return receptions, nil
+}
+
+func NewReceptionAutoClose(
+	receptions IdleReceptionCloser,
+	log *slog.Logger,
+	idleTimeout time.Duration,
+	interval time.Duration,
+) *ReceptionAutoClose {
+	return &ReceptionAutoClose{
+		receptions:  receptions,
+		log:         log,
+		idleTimeout: idleTimeout,
+		interval:    interval,
+	}
+}
@@ -0,0 +1,145 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceptionAutoClose_CloseIdle(t *testing.T) {
+	t.Parallel()
+
+	closedAt := time.Now()
+	closed := domain.Reception{
+		ID:          uuid.New(),
+		PvzID:       uuid.New(),
+		Status:      domain.ReceptionStatusClosed,
+		ClosedAt:    &closedAt,
+		CloseReason: domain.ReceptionClosedBySystem,
+	}
+
+	idleSince := mock.MatchedBy(func(since time.Time) bool {
+		return time.Since(since) >= 2*time.Hour && time.Since(since) < 2*time.Hour+time.Minute
+	})
+
+	tests := []struct {
+		name       string
+		setupMocks func(*service.MockIdleReceptionCloser)
+		want       []domain.Reception
+		wantErr    error
+	}{
+		{
+			name: "closes as system",
+			setupMocks: func(rp *service.MockIdleReceptionCloser) {
+				rp.On("CloseIdle", mock.Anything, idleSince).
+					Run(func(args mock.Arguments) {
+						entry, err := domain.NewAuditEntry(
+							args.Get(0).(context.Context),
+							domain.AuditEvent{Action: domain.AuditReceptionClose},
+						)
+						require.NoError(t, err)
+						assert.Equal(t, domain.ActorSystem, entry.ActorType)
+					}).
+					Return([]domain.Reception{closed}, nil)
+			},
+			want: []domain.Reception{closed},
+		},
+		{
+			name: "nothing to close",
+			setupMocks: func(rp *service.MockIdleReceptionCloser) {
+				rp.On("CloseIdle", mock.Anything, idleSince).Return([]domain.Reception{}, nil)
+			},
+			want: []domain.Reception{},
+		},
+		{
+			name: "another replica holds the lock",
+			setupMocks: func(rp *service.MockIdleReceptionCloser) {
+				rp.On("CloseIdle", mock.Anything, idleSince).Return(nil, domain.ErrLocked)
+			},
+		},
+		{
+			name: "repository fails",
+			setupMocks: func(rp *service.MockIdleReceptionCloser) {
+				rp.On("CloseIdle", mock.Anything, idleSince).Return(nil, domain.ErrInternal)
+			},
+			wantErr: models.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			receptions := service.NewMockIdleReceptionCloser(t)
+			tt.setupMocks(receptions)
+
+			svc := service.NewReceptionAutoClose(
+				receptions,
+				slog.New(slog.NewTextHandler(io.Discard, nil)),
+				2*time.Hour,
+				time.Minute,
+			)
+
+			got, err := svc.CloseIdle(context.Background())
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReceptionAutoClose_RunStopsOnCancel(t *testing.T) {
+	t.Parallel()
+
+	swept := make(chan struct{}, 1)
+
+	receptions := service.NewMockIdleReceptionCloser(t)
+	receptions.On("CloseIdle", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) {
+			select {
+			case swept <- struct{}{}:
+			default:
+			}
+		}).
+		Return([]domain.Reception{}, nil).
+		Maybe()
+
+	svc := service.NewReceptionAutoClose(
+		receptions,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		time.Hour,
+		time.Millisecond,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		svc.Run(ctx)
+	}()
+
+	select {
+	case <-swept:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not sweep")
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop after cancel")
+	}
+}
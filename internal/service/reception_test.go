@@ -17,14 +17,35 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const correctionWindow = 24 * time.Hour
+
+// noASN ПВЗ без манифестов поставки: приемки закрываются без сверки.
+func noASN(t *testing.T) *service.MockASNGetter {
+	t.Helper()
+
+	asn := service.NewMockASNGetter(t)
+	asn.On("GetForReception", mock.Anything, mock.Anything).
+		Return(nil, domain.ErrNotFound).
+		Maybe()
+
+	return asn
+}
+
 func TestReception_CloseLastReception(t *testing.T) {
 	id := domain.PVZID(uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"))
-	activeReception := &domain.Reception{
-		ID:        uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
-		PvzID:     uuid.UUID(id),
-		CreatedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
-		Status:    "in_progress",
+	// Успешное закрытие меняет статус приемки, поэтому каждый сценарий
+	// получает свою копию.
+	activeReception := func() *domain.Reception {
+		return &domain.Reception{
+			ID:        uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
+			PvzID:     uuid.UUID(id),
+			CreatedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			Status:    "in_progress",
+		}
 	}
+	closed := mock.MatchedBy(func(r domain.Reception) bool {
+		return r.Status == domain.ReceptionStatusClosed && r.ClosedAt != nil
+	})
 	inactiveReception := &domain.Reception{
 		ID:        uuid.MustParse("cccccccc-cccc-cccc-cccc-cccccccccccc"),
 		PvzID:     uuid.UUID(id),
@@ -40,24 +61,24 @@ func TestReception_CloseLastReception(t *testing.T) {
 		{
 			name: "pvz not found",
 			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.UUID(id)).
-					Return(domain.ErrNotFound)
+				pvz.On("GetStatus", mock.Anything, uuid.UUID(id)).
+					Return(domain.PVZStatus(""), domain.ErrNotFound)
 			},
 			wantErr: models.ErrPVZNotFound,
 		},
 		{
 			name: "pvz exist returns internal error",
 			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.UUID(id)).
-					Return(errors.New("db fail"))
+				pvz.On("GetStatus", mock.Anything, uuid.UUID(id)).
+					Return(domain.PVZStatus(""), errors.New("db fail"))
 			},
 			wantErr: models.ErrInternal,
 		},
 		{
 			name: "reception not found",
 			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.UUID(id)).
-					Return(nil)
+				pvz.On("GetStatus", mock.Anything, uuid.UUID(id)).
+					Return(domain.PVZStatusActive, nil)
 				rp.On("GetLast", mock.Anything, uuid.UUID(id)).
 					Return(nil, domain.ErrNotFound)
 			},
@@ -66,8 +87,8 @@ func TestReception_CloseLastReception(t *testing.T) {
 		{
 			name: "reception already closed",
 			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.UUID(id)).
-					Return(nil)
+				pvz.On("GetStatus", mock.Anything, uuid.UUID(id)).
+					Return(domain.PVZStatusActive, nil)
 				rp.On("GetLast", mock.Anything, uuid.UUID(id)).
 					Return(inactiveReception, nil)
 			},
@@ -76,8 +97,8 @@ func TestReception_CloseLastReception(t *testing.T) {
 		{
 			name: "reception get returns internal error",
 			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.UUID(id)).
-					Return(nil)
+				pvz.On("GetStatus", mock.Anything, uuid.UUID(id)).
+					Return(domain.PVZStatusActive, nil)
 				rp.On("GetLast", mock.Anything, uuid.UUID(id)).
 					Return(nil, errors.New("db error"))
 			},
@@ -86,23 +107,47 @@ func TestReception_CloseLastReception(t *testing.T) {
 		{
 			name: "close fails",
 			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.UUID(id)).
-					Return(nil)
+				pvz.On("GetStatus", mock.Anything, uuid.UUID(id)).
+					Return(domain.PVZStatusActive, nil)
 				rp.On("GetLast", mock.Anything, uuid.UUID(id)).
-					Return(activeReception, nil)
-				rp.On("Close", mock.Anything, *activeReception).
+					Return(activeReception(), nil)
+				rp.On("Close", mock.Anything, closed).
 					Return(errors.New("db error"))
 			},
 			wantErr: models.ErrInternal,
 		},
+		{
+			name: "closed concurrently",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				pvz.On("GetStatus", mock.Anything, uuid.UUID(id)).
+					Return(domain.PVZStatusActive, nil)
+				rp.On("GetLast", mock.Anything, uuid.UUID(id)).
+					Return(activeReception(), nil)
+				rp.On("Close", mock.Anything, closed).
+					Return(domain.ErrStatusChanged)
+			},
+			wantErr: models.ErrReceptionAlreadyClosed,
+		},
 		{
 			name: "successful close",
 			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.UUID(id)).
+				pvz.On("GetStatus", mock.Anything, uuid.UUID(id)).
+					Return(domain.PVZStatusActive, nil)
+				rp.On("GetLast", mock.Anything, uuid.UUID(id)).
+					Return(activeReception(), nil)
+				rp.On("Close", mock.Anything, closed).
 					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "close allowed in suspended pvz",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				pvz.On("GetStatus", mock.Anything, uuid.UUID(id)).
+					Return(domain.PVZStatusSuspended, nil)
 				rp.On("GetLast", mock.Anything, uuid.UUID(id)).
-					Return(activeReception, nil)
-				rp.On("Close", mock.Anything, *activeReception).
+					Return(activeReception(), nil)
+				rp.On("Close", mock.Anything, closed).
 					Return(nil)
 			},
 			wantErr: nil,
@@ -114,9 +159,17 @@ func TestReception_CloseLastReception(t *testing.T) {
 			mockReception := service.NewMockReceptionProvider(t)
 			tt.setupMocks(mockPVZ, mockReception)
 
-			svc := service.NewReceptionService(mockReception, mockPVZ)
+			svc := service.NewReceptionService(
+				mockReception,
+				mockPVZ,
+				assignedStaff(t),
+				noAudit(t),
+				noASN(t),
+				service.NewMockReceptionProducts(t),
+				correctionWindow,
+			)
 
-			_, err := svc.CloseLastReception(context.Background(), id)
+			_, _, err := svc.CloseLastReception(employeeCtx(), id)
 			if tt.wantErr != nil {
 				require.ErrorIs(t, err, tt.wantErr)
 			} else {
@@ -129,6 +182,91 @@ func TestReception_CloseLastReception(t *testing.T) {
 	}
 }
 
+func TestReception_CloseLastReceptionReconciles(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	asn, err := domain.NewASN(context.Background(), domain.ASNRequest{
+		PVZID: domain.PVZID(pvzID),
+		Lines: []domain.ASNLine{{Barcode: "A1"}, {Type: "shoes", Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		closeErr error
+		wantErr  error
+	}{
+		{
+			name: "report saved with close",
+		},
+		{
+			name:     "closed concurrently",
+			closeErr: domain.ErrStatusChanged,
+			wantErr:  models.ErrReceptionAlreadyClosed,
+		},
+		{
+			name:     "save fails",
+			closeErr: domain.ErrInternal,
+			wantErr:  models.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reception := domain.NewReception(pvzID)
+			shoes := domain.Product{ID: uuid.New(), ReceptionID: reception.ID, Type: "shoes"}
+
+			pvz := service.NewMockPVZChecker(t)
+			pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+
+			asns := service.NewMockASNGetter(t)
+			asns.On("GetForReception", mock.Anything, mock.MatchedBy(func(r domain.Reception) bool {
+				return r.ID == reception.ID
+			})).Return(asn, nil)
+
+			products := service.NewMockReceptionProducts(t)
+			products.On("GetByReception", mock.Anything, reception.ID).
+				Return([]domain.Product{shoes}, nil)
+
+			receptions := service.NewMockReceptionProvider(t)
+			receptions.On("GetLast", mock.Anything, pvzID).Return(reception, nil)
+			receptions.On("CloseReconciled",
+				mock.Anything,
+				mock.MatchedBy(func(r domain.Reception) bool { return r.IsClosed() }),
+				mock.MatchedBy(func(report *domain.ReceptionReconciliation) bool {
+					return report.ASNID == asn.ID && report.ReceptionID == reception.ID
+				}),
+			).Return(tt.closeErr)
+
+			svc := service.NewReceptionService(
+				receptions,
+				pvz,
+				assignedStaff(t),
+				noAudit(t),
+				asns,
+				products,
+				correctionWindow,
+			)
+
+			got, report, err := svc.CloseLastReception(employeeCtx(), domain.PVZID(pvzID))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.ReceptionStatusClosed, got.Status)
+			require.NotNil(t, report)
+			assert.Equal(t, []domain.ASNDiscrepancy{{Barcode: "A1", Expected: 1}}, report.Shortages)
+			assert.Empty(t, report.Surpluses)
+			assert.Empty(t, report.Unexpected)
+		})
+	}
+}
+
 func TestReception_Create(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -148,7 +286,7 @@ func TestReception_Create(t *testing.T) {
 			},
 			wantErr: nil,
 			setupMocks: func(pvz *service.MockPVZChecker, reception *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.Max).Return(nil)
+				pvz.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatusActive, nil)
 				reception.On("GetLast", mock.Anything, uuid.Max).Return(
 					&domain.Reception{
 						ID:        uuid.Max,
@@ -169,7 +307,7 @@ func TestReception_Create(t *testing.T) {
 			want:    nil,
 			wantErr: models.ErrReceptionAlreadyExist,
 			setupMocks: func(pvz *service.MockPVZChecker, reception *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.Max).Return(nil)
+				pvz.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatusActive, nil)
 				reception.On("GetLast", mock.Anything, uuid.Max).Return(
 					&domain.Reception{
 						ID:        uuid.Max,
@@ -186,7 +324,25 @@ func TestReception_Create(t *testing.T) {
 			want:    nil,
 			wantErr: models.ErrPVZNotFound,
 			setupMocks: func(pvz *service.MockPVZChecker, reception *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.Max).Return(domain.ErrNotFound)
+				pvz.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatus(""), domain.ErrNotFound)
+			},
+		},
+		{
+			name:    "pvz suspended",
+			pvzID:   domain.PVZID(uuid.Max),
+			want:    nil,
+			wantErr: models.ErrPVZSuspended,
+			setupMocks: func(pvz *service.MockPVZChecker, reception *service.MockReceptionProvider) {
+				pvz.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatusSuspended, nil)
+			},
+		},
+		{
+			name:    "pvz closed",
+			pvzID:   domain.PVZID(uuid.Max),
+			want:    nil,
+			wantErr: models.ErrPVZClosed,
+			setupMocks: func(pvz *service.MockPVZChecker, reception *service.MockReceptionProvider) {
+				pvz.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatusClosed, nil)
 			},
 		},
 		{
@@ -195,7 +351,7 @@ func TestReception_Create(t *testing.T) {
 			want:    nil,
 			wantErr: models.ErrInternal,
 			setupMocks: func(pvz *service.MockPVZChecker, reception *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.Max).Return(assert.AnError)
+				pvz.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatus(""), assert.AnError)
 			},
 		},
 		{
@@ -204,7 +360,7 @@ func TestReception_Create(t *testing.T) {
 			want:    nil,
 			wantErr: models.ErrInternal,
 			setupMocks: func(pvz *service.MockPVZChecker, reception *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.Max).Return(nil)
+				pvz.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatusActive, nil)
 				reception.On("GetLast", mock.Anything, uuid.Max).Return(nil, assert.AnError)
 			},
 		},
@@ -214,7 +370,7 @@ func TestReception_Create(t *testing.T) {
 			want:    nil,
 			wantErr: models.ErrInternal,
 			setupMocks: func(pvz *service.MockPVZChecker, reception *service.MockReceptionProvider) {
-				pvz.On("Exist", mock.Anything, uuid.Max).Return(nil)
+				pvz.On("GetStatus", mock.Anything, uuid.Max).Return(domain.PVZStatusActive, nil)
 				reception.On("GetLast", mock.Anything, uuid.Max).Return(
 					&domain.Reception{
 						ID:        uuid.Max,
@@ -236,9 +392,17 @@ func TestReception_Create(t *testing.T) {
 			mockReception := service.NewMockReceptionProvider(t)
 			tt.setupMocks(mockPVZ, mockReception)
 
-			svc := service.NewReceptionService(mockReception, mockPVZ)
+			svc := service.NewReceptionService(
+				mockReception,
+				mockPVZ,
+				assignedStaff(t),
+				noAudit(t),
+				noASN(t),
+				service.NewMockReceptionProducts(t),
+				correctionWindow,
+			)
 
-			got, err := svc.Create(context.Background(), tt.pvzID)
+			got, err := svc.Create(employeeCtx(), tt.pvzID)
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -256,3 +420,158 @@ func TestReception_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestReception_Reopen(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	moderatorID := uuid.New()
+
+	closedReception := func(closedAgo time.Duration) *domain.Reception {
+		closedAt := time.Now().Add(-closedAgo)
+
+		return &domain.Reception{
+			ID:            receptionID,
+			PvzID:         pvzID,
+			Status:        domain.ReceptionStatusClosed,
+			CreatedAt:     closedAt.Add(-time.Hour),
+			ClosedAt:      &closedAt,
+			FirstClosedAt: &closedAt,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		justification string
+		setupMocks    func(*service.MockPVZChecker, *service.MockReceptionProvider)
+		wantErr       error
+	}{
+		{
+			name:          "reopened",
+			justification: "  забыли отсканировать коробку  ",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				rp.On("GetByID", mock.Anything, receptionID).Return(closedReception(time.Hour), nil)
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				rp.On("GetLast", mock.Anything, pvzID).Return(closedReception(time.Hour), nil)
+				rp.On("Reopen", mock.Anything, mock.MatchedBy(func(c *domain.ReceptionCorrection) bool {
+					return c.ReceptionID == receptionID &&
+						c.Justification == "забыли отсканировать коробку" &&
+						c.ReopenedBy != nil && *c.ReopenedBy == moderatorID
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "reception not found",
+			justification: "ошибка",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				rp.On("GetByID", mock.Anything, receptionID).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrReceptionNotFound,
+		},
+		{
+			name:          "pvz closed",
+			justification: "ошибка",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				rp.On("GetByID", mock.Anything, receptionID).Return(closedReception(time.Hour), nil)
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusClosed, nil)
+			},
+			wantErr: models.ErrPVZClosed,
+		},
+		{
+			name:          "newer reception exists",
+			justification: "ошибка",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				rp.On("GetByID", mock.Anything, receptionID).Return(closedReception(time.Hour), nil)
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				rp.On("GetLast", mock.Anything, pvzID).Return(domain.NewReception(pvzID), nil)
+			},
+			wantErr: models.ErrNewerReceptionExists,
+		},
+		{
+			name:          "empty justification",
+			justification: "   ",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				rp.On("GetByID", mock.Anything, receptionID).Return(closedReception(time.Hour), nil)
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				rp.On("GetLast", mock.Anything, pvzID).Return(closedReception(time.Hour), nil)
+			},
+			wantErr: models.ErrInvalidJustification,
+		},
+		{
+			name:          "reception in progress",
+			justification: "ошибка",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				open := &domain.Reception{ID: receptionID, PvzID: pvzID, Status: domain.ReceptionStatusInProgress}
+				rp.On("GetByID", mock.Anything, receptionID).Return(open, nil)
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				rp.On("GetLast", mock.Anything, pvzID).Return(open, nil)
+			},
+			wantErr: models.ErrReceptionNotClosed,
+		},
+		{
+			name:          "window expired",
+			justification: "ошибка",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				rp.On("GetByID", mock.Anything, receptionID).Return(closedReception(48*time.Hour), nil)
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				rp.On("GetLast", mock.Anything, pvzID).Return(closedReception(48*time.Hour), nil)
+			},
+			wantErr: models.ErrCorrectionExpired,
+		},
+		{
+			name:          "newer reception created concurrently",
+			justification: "ошибка",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				rp.On("GetByID", mock.Anything, receptionID).Return(closedReception(time.Hour), nil)
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				rp.On("GetLast", mock.Anything, pvzID).Return(closedReception(time.Hour), nil)
+				rp.On("Reopen", mock.Anything, mock.Anything).Return(domain.ErrStatusChanged)
+			},
+			wantErr: models.ErrNewerReceptionExists,
+		},
+		{
+			name:          "reopen fails",
+			justification: "ошибка",
+			setupMocks: func(pvz *service.MockPVZChecker, rp *service.MockReceptionProvider) {
+				rp.On("GetByID", mock.Anything, receptionID).Return(closedReception(time.Hour), nil)
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				rp.On("GetLast", mock.Anything, pvzID).Return(closedReception(time.Hour), nil)
+				rp.On("Reopen", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: models.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPVZ := service.NewMockPVZChecker(t)
+			mockReception := service.NewMockReceptionProvider(t)
+			tt.setupMocks(mockPVZ, mockReception)
+
+			svc := service.NewReceptionService(
+				mockReception,
+				mockPVZ,
+				service.NewMockAssignmentChecker(t),
+				noAudit(t),
+				noASN(t),
+				service.NewMockReceptionProducts(t),
+				correctionWindow,
+			)
+
+			got, err := svc.Reopen(moderatorCtx(moderatorID), receptionID, tt.justification)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, got)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.ReceptionStatusInProgress, got.Status)
+			assert.Nil(t, got.ClosedAt)
+			require.NotNil(t, got.ReopenedAt)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionProvider interface {
+	Create(ctx context.Context, token *domain.RefreshToken) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error)
+	GetByHash(ctx context.Context, hash string) (*domain.RefreshToken, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeAllByUser(ctx context.Context, userID uuid.UUID) error
+}
+
+type UserGetter interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
+}
+
+type TokenIssuer interface {
+	GenerateSessionToken(userUUID, role, sessionID string) (string, time.Time, error)
+	ParseToken(tokenString string) (*UserClaims, error)
+}
+
+// Session выдает пары access/refresh токенов и отзывает их.
+// Access-токен привязан к сессии через claim sid, поэтому
+// отзыв сессии сразу делает недействительным и выданный access-токен.
+type Session struct {
+	sessions   SessionProvider
+	users      UserGetter
+	tokens     TokenIssuer
+	refreshTTL time.Duration
+}
+
+func (s *Session) Start(ctx context.Context, user *domain.User) (*domain.TokenPair, error) {
+	return s.start(ctx, user, nil)
+}
+
+// start выдает пару токенов. При обмене refresh-токена новый токен
+// продолжает цепочку family, при входе начинается новая.
+func (s *Session) start(
+	ctx context.Context,
+	user *domain.User,
+	family *uuid.UUID,
+) (*domain.TokenPair, error) {
+	refresh, raw, err := domain.NewRefreshToken(user.ID, s.refreshTTL)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	if family != nil {
+		refresh.FamilyID = *family
+	}
+
+	err = s.sessions.Create(ctx, refresh)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	access, expiresAt, err := s.tokens.GenerateSessionToken(
+		user.ID.String(),
+		string(user.Role),
+		refresh.ID.String(),
+	)
+	if err != nil {
+		return nil, models.ErrInternalCodeGen
+	}
+
+	return &domain.TokenPair{
+		AccessToken:  access,
+		RefreshToken: raw,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+// Refresh обменивает refresh-токен на новую пару токенов.
+// Использованный refresh-токен отзывается. Повторное предъявление уже
+// отозванного токена, в том числе параллельно с его обменом, считается
+// кражей: отзывается вся цепочка токенов этого входа.
+func (s *Session) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	session, err := s.sessions.GetByHash(ctx, domain.HashToken(refreshToken))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrInvalidRefreshToken
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	if session.RevokedAt != nil {
+		return nil, s.revokeReused(ctx, session)
+	}
+
+	if !session.IsActive(time.Now()) {
+		return nil, models.ErrInvalidRefreshToken
+	}
+
+	user, err := s.users.GetByID(ctx, session.UserID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrInvalidRefreshToken
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	if !user.Active {
+		return nil, models.ErrInvalidRefreshToken
+	}
+
+	err = s.sessions.Revoke(ctx, session.ID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, s.revokeReused(ctx, session)
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return s.start(ctx, user, &session.FamilyID)
+}
+
+// revokeReused отзывает цепочку, в которой refresh-токен предъявлен повторно.
+func (s *Session) revokeReused(ctx context.Context, session *domain.RefreshToken) error {
+	err := s.sessions.RevokeFamily(ctx, session.FamilyID)
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	return models.ErrInvalidRefreshToken
+}
+
+func (s *Session) Logout(ctx context.Context, sessionID uuid.UUID) error {
+	if sessionID == uuid.Nil {
+		return nil
+	}
+
+	err := s.sessions.Revoke(ctx, sessionID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return models.ErrInternal
+	}
+
+	return nil
+}
+
+// RevokeAll завершает все сессии пользователя.
+func (s *Session) RevokeAll(ctx context.Context, userID uuid.UUID) error {
+	err := s.sessions.RevokeAllByUser(ctx, userID)
+	if err != nil {
+		return models.ErrInternal
+	}
+
+	return nil
+}
+
+// Authenticate проверяет access-токен, то, что его сессия не отозвана, и что
+// учетная запись не отключена. Без sid принимаются только тестовые токены
+// /dummyLogin: у них нет ни сессии, ни пользователя.
+func (s *Session) Authenticate(ctx context.Context, accessToken string) (*domain.Identity, error) {
+	claims, err := s.tokens.ParseToken(accessToken)
+	if err != nil {
+		return nil, models.ErrInvalidToken
+	}
+
+	userID, err := uuid.Parse(claims.UUID)
+	if err != nil || !domain.Role(claims.Role).IsValid() {
+		return nil, models.ErrInvalidTokenClaims
+	}
+
+	identity := &domain.Identity{
+		Actor:  domain.ActorUser,
+		UserID: userID,
+		Role:   domain.Role(claims.Role),
+	}
+
+	if claims.SessionID == "" {
+		if !claims.Dummy {
+			return nil, models.ErrInvalidTokenClaims
+		}
+
+		identity.Actor = domain.ActorDummy
+
+		return identity, nil
+	}
+
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return nil, models.ErrInvalidTokenClaims
+	}
+
+	session, err := s.sessions.GetByID(ctx, sessionID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrSessionRevoked
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	if session.RevokedAt != nil || session.UserID != userID {
+		return nil, models.ErrSessionRevoked
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrSessionRevoked
+	}
+
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	if !user.Active {
+		return nil, models.ErrUserDeactivated
+	}
+
+	identity.SessionID = sessionID
+
+	return identity, nil
+}
+
+func NewSessionService(
+	sessions SessionProvider,
+	users UserGetter,
+	tokens TokenIssuer,
+	refreshTTL time.Duration,
+) *Session {
+	return &Session{
+		sessions:   sessions,
+		users:      users,
+		tokens:     tokens,
+		refreshTTL: refreshTTL,
+	}
+}
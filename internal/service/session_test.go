@@ -0,0 +1,322 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession_Refresh(t *testing.T) {
+	t.Parallel()
+
+	user, _ := domain.NewUser("user@example.com", "hashedPassword123", "employee")
+	revokedAt := time.Now().Add(-time.Minute)
+
+	active := &domain.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		FamilyID:  uuid.New(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name       string
+		setupMocks func(
+			s *service.MockSessionProvider,
+			u *service.MockUserGetter,
+			j *service.MockTokenIssuer,
+		)
+		wantErr error
+	}{
+		{
+			name: "refresh_success",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				s.On("GetByHash", mock.Anything, domain.HashToken("refresh")).
+					Return(active, nil)
+				u.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+				s.On("Revoke", mock.Anything, active.ID).Return(nil)
+				s.On("Create", mock.Anything, mock.MatchedBy(func(t *domain.RefreshToken) bool {
+					return t.UserID == user.ID && t.ID != active.ID && t.FamilyID == active.FamilyID
+				})).Return(nil)
+				j.On("GenerateSessionToken", user.ID.String(), "employee", mock.Anything).
+					Return("access", time.Now().Add(time.Minute), nil)
+			},
+		},
+		{
+			name: "unknown_token",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				s.On("GetByHash", mock.Anything, mock.Anything).
+					Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrInvalidRefreshToken,
+		},
+		{
+			name: "reused_token_revokes_family",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				s.On("GetByHash", mock.Anything, mock.Anything).
+					Return(&domain.RefreshToken{
+						ID:        uuid.New(),
+						UserID:    user.ID,
+						FamilyID:  active.FamilyID,
+						ExpiresAt: time.Now().Add(time.Hour),
+						RevokedAt: &revokedAt,
+					}, nil)
+				s.On("RevokeFamily", mock.Anything, active.FamilyID).Return(nil)
+			},
+			wantErr: models.ErrInvalidRefreshToken,
+		},
+		{
+			name: "concurrent_refresh_revokes_family",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				s.On("GetByHash", mock.Anything, domain.HashToken("refresh")).
+					Return(active, nil)
+				u.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+				s.On("Revoke", mock.Anything, active.ID).Return(domain.ErrNotFound)
+				s.On("RevokeFamily", mock.Anything, active.FamilyID).Return(nil)
+			},
+			wantErr: models.ErrInvalidRefreshToken,
+		},
+		{
+			name: "expired_token",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				s.On("GetByHash", mock.Anything, mock.Anything).
+					Return(&domain.RefreshToken{
+						ID:        uuid.New(),
+						UserID:    user.ID,
+						ExpiresAt: time.Now().Add(-time.Hour),
+					}, nil)
+			},
+			wantErr: models.ErrInvalidRefreshToken,
+		},
+		{
+			name: "repo_error",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				s.On("GetByHash", mock.Anything, mock.Anything).
+					Return(nil, errors.New("database error"))
+			},
+			wantErr: models.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sessions := service.NewMockSessionProvider(t)
+			users := service.NewMockUserGetter(t)
+			tokens := service.NewMockTokenIssuer(t)
+			tt.setupMocks(sessions, users, tokens)
+
+			s := service.NewSessionService(sessions, users, tokens, time.Hour)
+
+			got, err := s.Refresh(context.Background(), "refresh")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, got)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "access", got.AccessToken)
+			require.NotEmpty(t, got.RefreshToken)
+			require.NotEqual(t, "refresh", got.RefreshToken)
+		})
+	}
+}
+
+func TestSession_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	sessionID := uuid.New()
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name       string
+		setupMocks func(
+			s *service.MockSessionProvider,
+			u *service.MockUserGetter,
+			j *service.MockTokenIssuer,
+		)
+		want    *domain.Identity
+		wantErr error
+	}{
+		{
+			name: "active_session",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				j.On("ParseToken", "token").Return(&service.UserClaims{
+					UUID:      userID.String(),
+					Role:      "moderator",
+					SessionID: sessionID.String(),
+				}, nil)
+				s.On("GetByID", mock.Anything, sessionID).Return(&domain.RefreshToken{
+					ID:     sessionID,
+					UserID: userID,
+				}, nil)
+				u.On("GetByID", mock.Anything, userID).Return(&domain.User{ID: userID, Active: true}, nil)
+			},
+			want: &domain.Identity{
+				Actor:     domain.ActorUser,
+				UserID:    userID,
+				Role:      domain.RoleModerator,
+				SessionID: sessionID,
+			},
+		},
+		{
+			name: "deactivated_user",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				j.On("ParseToken", "token").Return(&service.UserClaims{
+					UUID:      userID.String(),
+					Role:      "moderator",
+					SessionID: sessionID.String(),
+				}, nil)
+				s.On("GetByID", mock.Anything, sessionID).Return(&domain.RefreshToken{
+					ID:     sessionID,
+					UserID: userID,
+				}, nil)
+				u.On("GetByID", mock.Anything, userID).Return(&domain.User{ID: userID}, nil)
+			},
+			wantErr: models.ErrUserDeactivated,
+		},
+		{
+			name: "dummy_token_without_session",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				j.On("ParseToken", "token").Return(&service.UserClaims{
+					UUID:  userID.String(),
+					Role:  "employee",
+					Dummy: true,
+				}, nil)
+			},
+			want: &domain.Identity{UserID: userID, Role: domain.RoleEmploye, Actor: domain.ActorDummy},
+		},
+		{
+			name: "token_without_session",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				j.On("ParseToken", "token").Return(&service.UserClaims{
+					UUID: userID.String(),
+					Role: "employee",
+				}, nil)
+			},
+			wantErr: models.ErrInvalidTokenClaims,
+		},
+		{
+			name: "revoked_session",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				j.On("ParseToken", "token").Return(&service.UserClaims{
+					UUID:      userID.String(),
+					Role:      "moderator",
+					SessionID: sessionID.String(),
+				}, nil)
+				s.On("GetByID", mock.Anything, sessionID).Return(&domain.RefreshToken{
+					ID:        sessionID,
+					UserID:    userID,
+					RevokedAt: &revokedAt,
+				}, nil)
+			},
+			wantErr: models.ErrSessionRevoked,
+		},
+		{
+			name: "invalid_token",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				j.On("ParseToken", "token").Return(nil, errors.New("bad token"))
+			},
+			wantErr: models.ErrInvalidToken,
+		},
+		{
+			name: "invalid_claims",
+			setupMocks: func(
+				s *service.MockSessionProvider,
+				u *service.MockUserGetter,
+				j *service.MockTokenIssuer,
+			) {
+				j.On("ParseToken", "token").Return(&service.UserClaims{
+					UUID: "dummy",
+					Role: "moderator",
+				}, nil)
+			},
+			wantErr: models.ErrInvalidTokenClaims,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sessions := service.NewMockSessionProvider(t)
+			users := service.NewMockUserGetter(t)
+			tokens := service.NewMockTokenIssuer(t)
+			tt.setupMocks(sessions, users, tokens)
+
+			s := service.NewSessionService(sessions, users, tokens, time.Hour)
+
+			got, err := s.Authenticate(context.Background(), "token")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, got)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
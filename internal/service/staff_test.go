@@ -0,0 +1,278 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"avito_pvz/internal/models"
+	"avito_pvz/internal/models/domain"
+	"avito_pvz/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func employeeCtx() context.Context {
+	return domain.WithIdentity(context.Background(), domain.Identity{
+		UserID: uuid.New(),
+		Role:   domain.RoleEmploye,
+	})
+}
+
+// assignedStaff считает вызывающего сотрудника закрепленным за любым ПВЗ.
+func assignedStaff(t *testing.T) *service.MockAssignmentChecker {
+	t.Helper()
+
+	staff := service.NewMockAssignmentChecker(t)
+	staff.On("IsAssigned", mock.Anything, mock.Anything, mock.Anything).
+		Return(true, nil).
+		Maybe()
+
+	return staff
+}
+
+func TestStaff_Assign(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	employee := &domain.User{ID: uuid.New(), Role: domain.RoleEmploye}
+	moderator := &domain.User{ID: uuid.New(), Role: domain.RoleModerator}
+
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		setupMocks func(users *service.MockStaffProvider, pvz *service.MockStaffLister)
+		wantErr    error
+	}{
+		{
+			name:   "assign_success",
+			userID: employee.ID,
+			setupMocks: func(users *service.MockStaffProvider, pvz *service.MockStaffLister) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				users.On("GetByID", mock.Anything, employee.ID).Return(employee, nil)
+				users.On("AssignPVZ", mock.Anything, employee.ID, pvzID).Return(nil)
+			},
+		},
+		{
+			name:   "pvz_not_found",
+			userID: employee.ID,
+			setupMocks: func(users *service.MockStaffProvider, pvz *service.MockStaffLister) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatus(""), domain.ErrNotFound)
+			},
+			wantErr: models.ErrPVZNotFound,
+		},
+		{
+			name:   "pvz_closed",
+			userID: employee.ID,
+			setupMocks: func(users *service.MockStaffProvider, pvz *service.MockStaffLister) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusClosed, nil)
+			},
+			wantErr: models.ErrPVZClosed,
+		},
+		{
+			name:   "pvz_suspended",
+			userID: employee.ID,
+			setupMocks: func(users *service.MockStaffProvider, pvz *service.MockStaffLister) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusSuspended, nil)
+				users.On("GetByID", mock.Anything, employee.ID).Return(employee, nil)
+				users.On("AssignPVZ", mock.Anything, employee.ID, pvzID).Return(nil)
+			},
+		},
+		{
+			name:   "user_not_found",
+			userID: employee.ID,
+			setupMocks: func(users *service.MockStaffProvider, pvz *service.MockStaffLister) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				users.On("GetByID", mock.Anything, employee.ID).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrUserNotFoud,
+		},
+		{
+			name:   "user_is_not_employee",
+			userID: moderator.ID,
+			setupMocks: func(users *service.MockStaffProvider, pvz *service.MockStaffLister) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				users.On("GetByID", mock.Anything, moderator.ID).Return(moderator, nil)
+			},
+			wantErr: models.ErrUserNotEmployee,
+		},
+		{
+			name:   "already_assigned",
+			userID: employee.ID,
+			setupMocks: func(users *service.MockStaffProvider, pvz *service.MockStaffLister) {
+				pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+				users.On("GetByID", mock.Anything, employee.ID).Return(employee, nil)
+				users.On("AssignPVZ", mock.Anything, employee.ID, pvzID).
+					Return(domain.ErrAlreadyExists)
+			},
+			wantErr: models.ErrStaffAlreadyAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			users := service.NewMockStaffProvider(t)
+			pvz := service.NewMockStaffLister(t)
+			tt.setupMocks(users, pvz)
+
+			svc := service.NewStaffService(users, pvz, noAudit(t))
+
+			err := svc.Assign(context.Background(), domain.PVZID(pvzID), tt.userID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStaff_Unassign(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	userID := uuid.New()
+
+	users := service.NewMockStaffProvider(t)
+	users.On("UnassignPVZ", mock.Anything, userID, pvzID).Return(domain.ErrNotFound).Once()
+	users.On("UnassignPVZ", mock.Anything, userID, pvzID).Return(nil).Once()
+
+	svc := service.NewStaffService(users, service.NewMockStaffLister(t), noAudit(t))
+
+	err := svc.Unassign(context.Background(), domain.PVZID(pvzID), userID)
+	require.ErrorIs(t, err, models.ErrStaffNotAssigned)
+
+	err = svc.Unassign(context.Background(), domain.PVZID(pvzID), userID)
+	require.NoError(t, err)
+}
+
+func TestPVZAccess_NotAssigned(t *testing.T) {
+	t.Parallel()
+
+	pvzID := domain.PVZID(uuid.New())
+
+	tests := []struct {
+		name string
+		call func(
+			ctx context.Context,
+			rec *service.Reception,
+			prod *service.Product,
+		) error
+	}{
+		{
+			name: "create_reception",
+			call: func(ctx context.Context, rec *service.Reception, _ *service.Product) error {
+				_, err := rec.Create(ctx, pvzID)
+
+				return err
+			},
+		},
+		{
+			name: "close_reception",
+			call: func(ctx context.Context, rec *service.Reception, _ *service.Product) error {
+				_, _, err := rec.CloseLastReception(ctx, pvzID)
+
+				return err
+			},
+		},
+		{
+			name: "add_product",
+			call: func(ctx context.Context, _ *service.Reception, prod *service.Product) error {
+				_, err := prod.Create(ctx, domain.ProductToAdd{UUID: pvzID, Type: "обувь"})
+
+				return err
+			},
+		},
+		{
+			name: "delete_product",
+			call: func(ctx context.Context, _ *service.Reception, prod *service.Product) error {
+				return prod.DeleteLast(ctx, pvzID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			staff := service.NewMockAssignmentChecker(t)
+			staff.On("IsAssigned", mock.Anything, mock.Anything, uuid.UUID(pvzID)).
+				Return(false, nil)
+
+			rec := service.NewReceptionService(
+				service.NewMockReceptionProvider(t),
+				service.NewMockPVZChecker(t),
+				staff,
+				noAudit(t),
+				noASN(t),
+				service.NewMockReceptionProducts(t),
+				correctionWindow,
+			)
+			prod := service.NewProduct(
+				service.NewMockProductProvider(t),
+				service.NewMockReceptionGetter(t),
+				service.NewMockPVZChecker(t),
+				knownProductTypes(t),
+				staff,
+				noAudit(t),
+			)
+
+			err := tt.call(employeeCtx(), rec, prod)
+			assert.ErrorIs(t, err, models.ErrPVZAccessDenied)
+
+			// Без идентичности в контексте доступ тоже запрещен.
+			err = tt.call(context.Background(), rec, prod)
+			assert.ErrorIs(t, err, models.ErrPVZAccessDenied)
+
+			// API-ключ, ограниченный другим ПВЗ, не проверяется по закреплениям.
+			keyID := uuid.New()
+			otherPVZ := uuid.New()
+			err = tt.call(domain.WithIdentity(context.Background(), domain.Identity{
+				UserID:   keyID,
+				Role:     domain.RoleEmploye,
+				Actor:    domain.ActorAPIKey,
+				APIKeyID: keyID,
+				Scopes:   []domain.Scope{domain.ScopeReceptionsWrite, domain.ScopeProductsWrite},
+				PVZID:    &otherPVZ,
+			}), rec, prod)
+			assert.ErrorIs(t, err, models.ErrPVZAccessDenied)
+		})
+	}
+}
+
+func TestPVZAccess_DummyIdentity(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	pvz := service.NewMockPVZChecker(t)
+	pvz.On("GetStatus", mock.Anything, pvzID).Return(domain.PVZStatusActive, nil)
+
+	receptions := service.NewMockReceptionProvider(t)
+	receptions.On("GetLast", mock.Anything, pvzID).Return(nil, domain.ErrNotFound)
+	receptions.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	// Закрепления для тестового токена не проверяются: IsAssigned не ожидается.
+	rec := service.NewReceptionService(
+		receptions,
+		pvz,
+		service.NewMockAssignmentChecker(t),
+		noAudit(t),
+		noASN(t),
+		service.NewMockReceptionProducts(t),
+		correctionWindow,
+	)
+
+	ctx := domain.WithIdentity(context.Background(), domain.Identity{
+		UserID: uuid.New(),
+		Role:   domain.RoleEmploye,
+		Actor:  domain.ActorDummy,
+	})
+
+	_, err := rec.Create(ctx, domain.PVZID(pvzID))
+	require.NoError(t, err)
+}
@@ -5,19 +5,42 @@ import (
 	"avito_pvz/internal/models/domain"
 	"context"
 	"errors"
+	"sync"
+
+	"github.com/google/uuid"
 )
 
-type JWTGenerator interface {
-	GenerateToken(email, role string) (string, error)
+type SessionManager interface {
+	Start(ctx context.Context, user *domain.User) (*domain.TokenPair, error)
+	RevokeAll(ctx context.Context, userID uuid.UUID) error
 }
+
 type UserProvider interface {
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
 	Create(ctx context.Context, user *domain.User) error
+	List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)
+	UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error
+	SetActive(ctx context.Context, id uuid.UUID, active bool) error
+	UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error
+}
+
+type LoginLimiter interface {
+	Check(ctx context.Context, email string, ip string) error
+	Fail(ctx context.Context, email string, ip string) error
+	Succeed(ctx context.Context, email string) error
 }
 
 type User struct {
-	jwt  JWTGenerator
-	repo UserProvider
+	sessions SessionManager
+	repo     UserProvider
+	lockout  LoginLimiter
+	hasher   PasswordHasher
+	policy   domain.PasswordPolicy
+	audit    AuditRecorder
+
+	// dummyHash нужен для проверки пароля, когда пользователь не найден.
+	dummyHash func() string
 }
 
 func (u *User) Create(
@@ -35,7 +58,17 @@ func (u *User) Create(
 		return nil, models.ErrUserAlreadyExist
 	}
 
-	user, err := domain.NewUser(string(email), password, string(role))
+	err = checkPassword(u.policy, password)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := u.hasher.Hash(password)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	user, err := domain.NewUser(string(email), hash, string(role))
 	if errors.Is(err, domain.ErrInvalidEmail) {
 		return nil, models.ErrInvalidEmail
 	}
@@ -49,34 +82,257 @@ func (u *User) Create(
 		return nil, models.ErrInternal
 	}
 
+	u.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditUserCreate,
+		Entity:   domain.AuditEntityUser,
+		EntityID: user.ID,
+		After:    user.ToDto(),
+	})
+
 	return user, nil
 }
 
-func (u *User) Auth(ctx context.Context, email string, password string) (*string, error) {
+func (u *User) Auth(
+	ctx context.Context,
+	email string,
+	password string,
+) (*domain.TokenPair, error) {
+	ip := domain.ClientIPFromCtx(ctx)
+
+	err := u.lockout.Check(ctx, email, ip)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := u.repo.GetByEmail(ctx, email)
-	if errors.Is(err, domain.ErrNotFound) {
-		return nil, models.ErrUserNotFoud
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, models.ErrInternal
+	}
+
+	if user == nil {
+		// Проверяем пароль и для несуществующего email,
+		// чтобы по времени ответа нельзя было подобрать учетные записи.
+		u.hasher.Verify(u.dummyHash(), password)
+	}
+
+	if user == nil || !u.hasher.Verify(user.PasswordHash, password) {
+		err = u.lockout.Fail(ctx, email, ip)
+		if err != nil {
+			return nil, models.ErrInternal
+		}
+
+		return nil, models.ErrInvalidCredentials
+	}
+
+	if !user.Active {
+		return nil, models.ErrInvalidCredentials
 	}
 
+	err = u.lockout.Succeed(ctx, email)
 	if err != nil {
 		return nil, models.ErrInternal
 	}
 
-	if !user.CheckPasswordHash(password) {
-		return nil, models.ErrInvalidPassword
+	u.rehash(ctx, user, password)
+
+	return u.sessions.Start(ctx, user)
+}
+
+// rehash пересчитывает хеш, созданный устаревшим алгоритмом или параметрами.
+// Пароль в открытом виде есть только при входе, поэтому обновление
+// происходит здесь. Ошибка не мешает входу: хеш обновится в следующий раз.
+func (u *User) rehash(ctx context.Context, user *domain.User, password string) {
+	if !u.hasher.NeedsRehash(user.PasswordHash) {
+		return
+	}
+
+	hash, err := u.hasher.Hash(password)
+	if err != nil {
+		return
+	}
+
+	if u.repo.UpdatePassword(ctx, user.ID, hash) == nil {
+		user.PasswordHash = hash
+	}
+}
+
+func (u *User) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	users, err := u.repo.List(ctx, filter)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return users, nil
+}
+
+// ChangeRole меняет роль пользователя. Выданные токены содержат старую
+// роль, поэтому все сессии пользователя отзываются.
+func (u *User) ChangeRole(
+	ctx context.Context,
+	id uuid.UUID,
+	role domain.Role,
+) (*domain.User, error) {
+	if !role.IsValid() {
+		return nil, models.ErrInvalidRole
+	}
+
+	if isSelf(ctx, id) {
+		return nil, models.ErrSelfModify
+	}
+
+	before, err := u.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repo.UpdateRole(ctx, id, role)
+	if err != nil {
+		return nil, mapUserErr(err)
+	}
+
+	after, err := u.revokeAndGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u.recordChange(ctx, domain.AuditUserRoleChange, before, after)
+
+	return after, nil
+}
+
+// SetActive включает или отключает учетную запись.
+// При отключении все сессии пользователя отзываются.
+func (u *User) SetActive(ctx context.Context, id uuid.UUID, active bool) (*domain.User, error) {
+	if isSelf(ctx, id) {
+		return nil, models.ErrSelfModify
 	}
 
-	token, err := u.jwt.GenerateToken(user.Email, string(user.Role))
+	before, err := u.get(ctx, id)
 	if err != nil {
-		return nil, models.ErrInternalCodeGen
+		return nil, err
 	}
 
-	return &token, nil
+	err = u.repo.SetActive(ctx, id, active)
+	if err != nil {
+		return nil, mapUserErr(err)
+	}
+
+	action := domain.AuditUserActivate
+	get := u.get
+
+	if !active {
+		action = domain.AuditUserDeactivate
+		get = u.revokeAndGet
+	}
+
+	after, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u.recordChange(ctx, action, before, after)
+
+	return after, nil
 }
 
-func NewUserService(repo UserProvider, jwt JWTGenerator) *User {
+// ResetPassword заменяет пароль пользователя временным и возвращает его.
+func (u *User) ResetPassword(ctx context.Context, id uuid.UUID) (string, error) {
+	password, err := domain.NewTemporaryPassword()
+	if err != nil {
+		return "", models.ErrInternal
+	}
+
+	hash, err := u.hasher.Hash(password)
+	if err != nil {
+		return "", models.ErrInternal
+	}
+
+	err = u.repo.UpdatePassword(ctx, id, hash)
+	if err != nil {
+		return "", mapUserErr(err)
+	}
+
+	err = u.sessions.RevokeAll(ctx, id)
+	if err != nil {
+		return "", models.ErrInternal
+	}
+
+	// Снимки не пишутся: в журнал не должны попадать ни пароль, ни хеш.
+	u.audit.Record(ctx, domain.AuditEvent{
+		Action:   domain.AuditUserPasswordReset,
+		Entity:   domain.AuditEntityUser,
+		EntityID: id,
+	})
+
+	return password, nil
+}
+
+func (u *User) recordChange(
+	ctx context.Context,
+	action domain.AuditAction,
+	before, after *domain.User,
+) {
+	u.audit.Record(ctx, domain.AuditEvent{
+		Action:   action,
+		Entity:   domain.AuditEntityUser,
+		EntityID: after.ID,
+		Before:   before.ToDto(),
+		After:    after.ToDto(),
+	})
+}
+
+func (u *User) revokeAndGet(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	err := u.sessions.RevokeAll(ctx, id)
+	if err != nil {
+		return nil, models.ErrInternal
+	}
+
+	return u.get(ctx, id)
+}
+
+func (u *User) get(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	user, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, mapUserErr(err)
+	}
+
+	return user, nil
+}
+
+func mapUserErr(err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.ErrUserNotFoud
+	}
+
+	return models.ErrInternal
+}
+
+// isSelf сообщает, что модератор пытается изменить собственную учетную запись.
+func isSelf(ctx context.Context, id uuid.UUID) bool {
+	identity, ok := domain.IdentityFromCtx(ctx)
+
+	return ok && identity.UserID == id
+}
+
+func NewUserService(
+	repo UserProvider,
+	sessions SessionManager,
+	lockout LoginLimiter,
+	hasher PasswordHasher,
+	policy domain.PasswordPolicy,
+	audit AuditRecorder,
+) *User {
 	return &User{
-		repo: repo,
-		jwt:  jwt,
+		repo:     repo,
+		sessions: sessions,
+		lockout:  lockout,
+		hasher:   hasher,
+		policy:   policy,
+		audit:    audit,
+		dummyHash: sync.OnceValue(func() string {
+			hash, _ := hasher.Hash("dummy-password")
+
+			return hash
+		}),
 	}
 }
@@ -3,8 +3,11 @@ package service_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"avito_pvz/internal/hasher"
 	"avito_pvz/internal/models"
 	"avito_pvz/internal/models/domain"
 	"avito_pvz/internal/service"
@@ -12,31 +15,37 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestUser_Auth(t *testing.T) {
 	t.Parallel()
-	user, _ := domain.NewUser("user@example.com", "securePassword123", "moderator")
+	user, _ := domain.NewUser("user@example.com", mustHash(t, "securePassword123"), "moderator")
+	pair := &domain.TokenPair{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		ExpiresAt:    time.Now().Add(time.Minute),
+	}
 
 	tests := []struct {
 		name       string
 		email      string
 		password   string
-		want       *string
+		want       *domain.TokenPair
 		wantErr    error
-		setupMocks func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator)
+		setupMocks func(repo *service.MockUserProvider, sessions *service.MockSessionManager)
 	}{
 		{
 			name:     "auth_success",
 			email:    "user@example.com",
 			password: "securePassword123",
-			want:     stringPtr("token"),
+			want:     pair,
 			wantErr:  nil,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "user@example.com").
 					Return(user, nil)
-				jwt.On("GenerateToken", "user@example.com", "moderator").
-					Return("token", nil)
+				sessions.On("Start", mock.Anything, user).
+					Return(pair, nil)
 			},
 		},
 		{
@@ -44,8 +53,8 @@ func TestUser_Auth(t *testing.T) {
 			email:    "nonexistent@example.com",
 			password: "password123",
 			want:     nil,
-			wantErr:  models.ErrUserNotFoud,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			wantErr:  models.ErrInvalidCredentials,
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "nonexistent@example.com").
 					Return(nil, domain.ErrNotFound)
 			},
@@ -55,8 +64,8 @@ func TestUser_Auth(t *testing.T) {
 			email:    "user@example.com",
 			password: "wrongPassword",
 			want:     nil,
-			wantErr:  models.ErrInvalidPassword,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			wantErr:  models.ErrInvalidCredentials,
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "user@example.com").
 					Return(&domain.User{
 						Email:        "user@example.com",
@@ -71,23 +80,37 @@ func TestUser_Auth(t *testing.T) {
 			password: "password123",
 			want:     nil,
 			wantErr:  models.ErrInternal,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "error@example.com").
 					Return(nil, errors.New("database error"))
 			},
 		},
 		{
-			name:     "token_generation_fails",
+			name:     "deactivated_user",
+			email:    "user@example.com",
+			password: "securePassword123",
+			want:     nil,
+			wantErr:  models.ErrInvalidCredentials,
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
+				inactive := *user
+				inactive.Active = false
+
+				repo.On("GetByEmail", mock.Anything, "user@example.com").
+					Return(&inactive, nil)
+			},
+		},
+		{
+			name:     "session_start_fails",
 			email:    "user@example.com",
 			password: "securePassword123",
 			want:     nil,
 			wantErr:  models.ErrInternalCodeGen,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "user@example.com").
 					Return(user, nil)
 
-				jwt.On("GenerateToken", "user@example.com", "moderator").
-					Return("", models.ErrInternalCodeGen)
+				sessions.On("Start", mock.Anything, user).
+					Return(nil, models.ErrInternalCodeGen)
 			},
 		},
 	}
@@ -98,13 +121,20 @@ func TestUser_Auth(t *testing.T) {
 			t.Parallel()
 
 			repo := service.NewMockUserProvider(t)
-			jwt := service.NewMockJWTGenerator(t)
+			sessions := service.NewMockSessionManager(t)
 
 			if tt.setupMocks != nil {
-				tt.setupMocks(repo, jwt)
+				tt.setupMocks(repo, sessions)
 			}
 
-			service := service.NewUserService(repo, jwt)
+			service := service.NewUserService(
+				repo,
+				sessions,
+				openLockout(t),
+				testHasher(),
+				testPolicy,
+				noAudit(t),
+			)
 
 			got, err := service.Auth(context.Background(), tt.email, tt.password)
 
@@ -117,13 +147,173 @@ func TestUser_Auth(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
-			jwt.AssertExpectations(t)
+			sessions.AssertExpectations(t)
 		})
 	}
 }
 
-func stringPtr(s string) *string {
-	return &s
+// openLockout не блокирует ни одну попытку входа.
+func openLockout(t *testing.T) *service.MockLoginLimiter {
+	t.Helper()
+
+	lockout := service.NewMockLoginLimiter(t)
+	lockout.On("Check", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	lockout.On("Fail", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	lockout.On("Succeed", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return lockout
+}
+
+// testPolicy политика паролей для тестов сервисов.
+var testPolicy = domain.PasswordPolicy{
+	MinLength:    8,
+	RequireLower: true,
+	RequireDigit: true,
+	Denylist: map[string]struct{}{
+		"password123": {},
+	},
+}
+
+// testHasher хеширует argon2id с минимальными параметрами, чтобы тесты
+// оставались быстрыми, и проверяет старые хеши bcrypt.
+func testHasher() *hasher.Hasher {
+	return hasher.New(
+		hasher.NewArgon2id(hasher.Argon2Params{
+			Memory:      1024,
+			Iterations:  1,
+			Parallelism: 1,
+			SaltLength:  16,
+			KeyLength:   32,
+		}),
+		hasher.NewBcrypt(bcrypt.MinCost),
+	)
+}
+
+func mustHash(t *testing.T, password string) string {
+	t.Helper()
+
+	hash, err := testHasher().Hash(password)
+	require.NoError(t, err)
+
+	return hash
+}
+
+func TestUser_AuthRehash(t *testing.T) {
+	t.Parallel()
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte("securePassword123"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	pair := &domain.TokenPair{AccessToken: "access", RefreshToken: "refresh"}
+
+	tests := []struct {
+		name      string
+		updateErr error
+	}{
+		{name: "legacy_hash_upgraded"},
+		{name: "upgrade_failure_does_not_block_login", updateErr: domain.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			user := &domain.User{
+				ID:           uuid.New(),
+				Email:        "user@example.com",
+				PasswordHash: string(legacy),
+				Role:         domain.RoleEmploye,
+				Active:       true,
+			}
+
+			var upgraded string
+
+			repo := service.NewMockUserProvider(t)
+			repo.On("GetByEmail", mock.Anything, "user@example.com").Return(user, nil)
+			repo.On("UpdatePassword", mock.Anything, user.ID, mock.Anything).
+				Run(func(args mock.Arguments) { upgraded = args.String(2) }).
+				Return(tt.updateErr)
+
+			sessions := service.NewMockSessionManager(t)
+			sessions.On("Start", mock.Anything, user).Return(pair, nil)
+
+			svc := service.NewUserService(
+				repo,
+				sessions,
+				openLockout(t),
+				testHasher(),
+				testPolicy,
+				noAudit(t),
+			)
+
+			got, err := svc.Auth(context.Background(), "user@example.com", "securePassword123")
+			require.NoError(t, err)
+			require.Equal(t, pair, got)
+
+			require.True(t, strings.HasPrefix(upgraded, "$argon2id$"))
+			require.True(t, testHasher().Verify(upgraded, "securePassword123"))
+
+			if tt.updateErr == nil {
+				require.Equal(t, upgraded, user.PasswordHash)
+			} else {
+				require.Equal(t, string(legacy), user.PasswordHash)
+			}
+		})
+	}
+}
+
+func TestUser_AuthLockout(t *testing.T) {
+	t.Parallel()
+
+	ctx := domain.WithClientIP(context.Background(), "10.0.0.1")
+
+	t.Run("locked", func(t *testing.T) {
+		t.Parallel()
+
+		lockout := service.NewMockLoginLimiter(t)
+		lockout.On("Check", mock.Anything, "user@example.com", "10.0.0.1").
+			Return(&models.RetryError{Err: models.ErrLoginLocked, RetryAfter: time.Minute})
+
+		svc := service.NewUserService(
+			service.NewMockUserProvider(t),
+			service.NewMockSessionManager(t),
+			lockout,
+			testHasher(),
+			testPolicy,
+			noAudit(t),
+		)
+
+		_, err := svc.Auth(ctx, "user@example.com", "password")
+		require.ErrorIs(t, err, models.ErrLoginLocked)
+
+		var retry *models.RetryError
+		require.ErrorAs(t, err, &retry)
+		require.Equal(t, time.Minute, retry.RetryAfter)
+	})
+
+	t.Run("unknown_email_counts_as_failure", func(t *testing.T) {
+		t.Parallel()
+
+		repo := service.NewMockUserProvider(t)
+		repo.On("GetByEmail", mock.Anything, "ghost@example.com").
+			Return(nil, domain.ErrNotFound)
+
+		lockout := service.NewMockLoginLimiter(t)
+		lockout.On("Check", mock.Anything, "ghost@example.com", "10.0.0.1").Return(nil)
+		lockout.On("Fail", mock.Anything, "ghost@example.com", "10.0.0.1").Return(nil)
+
+		svc := service.NewUserService(
+			repo,
+			service.NewMockSessionManager(t),
+			lockout,
+			testHasher(),
+			testPolicy,
+			noAudit(t),
+		)
+
+		_, err := svc.Auth(ctx, "ghost@example.com", "password")
+		require.ErrorIs(t, err, models.ErrInvalidCredentials)
+	})
 }
 
 func TestUser_Create(t *testing.T) {
@@ -136,7 +326,7 @@ func TestUser_Create(t *testing.T) {
 		role       domain.Role
 		want       *domain.User
 		wantErr    error
-		setupMocks func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator)
+		setupMocks func(repo *service.MockUserProvider, sessions *service.MockSessionManager)
 	}{
 		{
 			name:     "user_created_successfully",
@@ -148,14 +338,11 @@ func TestUser_Create(t *testing.T) {
 				Role:  "moderator",
 			},
 			wantErr: nil,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "user@example.com").
 					Return(nil, domain.ErrNotFound)
 				repo.On("Create", mock.Anything, mock.Anything).Return(nil)
 
-				jwt.On("GenerateToken", "user@example.com", "moderator").
-					Return("token", nil).
-					Maybe()
 			},
 		},
 		{
@@ -165,7 +352,7 @@ func TestUser_Create(t *testing.T) {
 			role:     "moderator",
 			want:     nil,
 			wantErr:  models.ErrUserAlreadyExist,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "existing@example.com").
 					Return(&domain.User{Email: "existing@example.com"}, nil)
 			},
@@ -177,7 +364,7 @@ func TestUser_Create(t *testing.T) {
 			role:     "moderator",
 			want:     nil,
 			wantErr:  models.ErrInternal,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "err@example.com").
 					Return(nil, errors.New("db connection failed"))
 			},
@@ -185,23 +372,47 @@ func TestUser_Create(t *testing.T) {
 		{
 			name:     "invalid_email_error",
 			email:    "invalid-email",
-			password: "pass",
+			password: "securePassword123",
 			role:     "moderator",
 			want:     nil,
 			wantErr:  models.ErrInvalidEmail,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "invalid-email").
 					Return(nil, domain.ErrNotFound)
 			},
 		},
+		{
+			name:     "weak_password",
+			email:    "newuser@example.com",
+			password: "short",
+			role:     "employee",
+			want:     nil,
+			wantErr:  models.ErrWeakPassword,
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
+				repo.On("GetByEmail", mock.Anything, "newuser@example.com").
+					Return(nil, domain.ErrNotFound)
+			},
+		},
+		{
+			name:     "common_password",
+			email:    "newuser@example.com",
+			password: "Password123",
+			role:     "employee",
+			want:     nil,
+			wantErr:  models.ErrWeakPassword,
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
+				repo.On("GetByEmail", mock.Anything, "newuser@example.com").
+					Return(nil, domain.ErrNotFound)
+			},
+		},
 		{
 			name:     "create_repo_fails_with_internal_error",
 			email:    "newuser@example.com",
-			password: "12345",
+			password: "securePassword123",
 			role:     "moderator",
 			want:     nil,
 			wantErr:  models.ErrInternal,
-			setupMocks: func(repo *service.MockUserProvider, jwt *service.MockJWTGenerator) {
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
 				repo.On("GetByEmail", mock.Anything, "newuser@example.com").
 					Return(nil, domain.ErrNotFound)
 
@@ -221,13 +432,20 @@ func TestUser_Create(t *testing.T) {
 			t.Parallel()
 
 			repo := service.NewMockUserProvider(t)
-			jwt := service.NewMockJWTGenerator(t)
+			sessions := service.NewMockSessionManager(t)
 
 			if tt.setupMocks != nil {
-				tt.setupMocks(repo, jwt)
+				tt.setupMocks(repo, sessions)
 			}
 
-			service := service.NewUserService(repo, jwt)
+			service := service.NewUserService(
+				repo,
+				sessions,
+				openLockout(t),
+				testHasher(),
+				testPolicy,
+				noAudit(t),
+			)
 
 			got, err := service.Create(context.Background(), tt.email, tt.password, tt.role)
 
@@ -241,7 +459,156 @@ func TestUser_Create(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
-			jwt.AssertExpectations(t)
+			sessions.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUser_SetActive(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	moderatorID := uuid.New()
+
+	moderatorCtx := domain.WithIdentity(context.Background(), domain.Identity{
+		UserID: moderatorID,
+		Role:   domain.RoleModerator,
+	})
+
+	tests := []struct {
+		name       string
+		id         uuid.UUID
+		active     bool
+		setupMocks func(repo *service.MockUserProvider, sessions *service.MockSessionManager)
+		wantErr    error
+	}{
+		{
+			name:   "deactivate_revokes_sessions",
+			id:     userID,
+			active: false,
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
+				repo.On("SetActive", mock.Anything, userID, false).Return(nil)
+				sessions.On("RevokeAll", mock.Anything, userID).Return(nil)
+				repo.On("GetByID", mock.Anything, userID).
+					Return(&domain.User{ID: userID, Active: false}, nil)
+			},
+		},
+		{
+			name:   "activate",
+			id:     userID,
+			active: true,
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
+				repo.On("SetActive", mock.Anything, userID, true).Return(nil)
+				repo.On("GetByID", mock.Anything, userID).
+					Return(&domain.User{ID: userID, Active: true}, nil)
+			},
+		},
+		{
+			name:   "user_not_found",
+			id:     userID,
+			active: false,
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {
+				repo.On("GetByID", mock.Anything, userID).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: models.ErrUserNotFoud,
+		},
+		{
+			name:       "cannot_deactivate_self",
+			id:         moderatorID,
+			active:     false,
+			setupMocks: func(repo *service.MockUserProvider, sessions *service.MockSessionManager) {},
+			wantErr:    models.ErrSelfModify,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := service.NewMockUserProvider(t)
+			sessions := service.NewMockSessionManager(t)
+			tt.setupMocks(repo, sessions)
+
+			svc := service.NewUserService(
+				repo,
+				sessions,
+				openLockout(t),
+				testHasher(),
+				testPolicy,
+				noAudit(t),
+			)
+
+			got, err := svc.SetActive(moderatorCtx, tt.id, tt.active)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, got)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.active, got.Active)
 		})
 	}
 }
+
+func TestUser_ChangeRole(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	repo := service.NewMockUserProvider(t)
+	sessions := service.NewMockSessionManager(t)
+
+	repo.On("UpdateRole", mock.Anything, userID, domain.RoleModerator).Return(nil)
+	sessions.On("RevokeAll", mock.Anything, userID).Return(nil)
+	repo.On("GetByID", mock.Anything, userID).
+		Return(&domain.User{ID: userID, Role: domain.RoleModerator}, nil)
+
+	svc := service.NewUserService(
+		repo,
+		sessions,
+		openLockout(t),
+		testHasher(),
+		testPolicy,
+		noAudit(t),
+	)
+
+	got, err := svc.ChangeRole(context.Background(), userID, domain.RoleModerator)
+	require.NoError(t, err)
+	require.Equal(t, domain.RoleModerator, got.Role)
+
+	_, err = svc.ChangeRole(context.Background(), userID, "admin")
+	require.ErrorIs(t, err, models.ErrInvalidRole)
+}
+
+func TestUser_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	var hash string
+
+	repo := service.NewMockUserProvider(t)
+	sessions := service.NewMockSessionManager(t)
+
+	repo.On("UpdatePassword", mock.Anything, userID, mock.Anything).
+		Run(func(args mock.Arguments) { hash = args.String(2) }).
+		Return(nil)
+	sessions.On("RevokeAll", mock.Anything, userID).Return(nil)
+
+	svc := service.NewUserService(
+		repo,
+		sessions,
+		openLockout(t),
+		testHasher(),
+		testPolicy,
+		noAudit(t),
+	)
+
+	password, err := svc.ResetPassword(context.Background(), userID)
+	require.NoError(t, err)
+	require.NotEmpty(t, password)
+
+	require.True(t, testHasher().Verify(hash, password))
+}
@@ -13,13 +13,13 @@ CREATE TABLE pvzs (
 );
 CREATE TABLE recepcions (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    pvz_id UUID NOT NULL REFERENCES pvz(id),
+    pvz_id UUID NOT NULL REFERENCES pvzs(id),
     status TEXT NOT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT now()
 );
 CREATE TABLE products (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    reception_id UUID NOT NULL REFERENCES intakes(id),
+    reception_id UUID NOT NULL REFERENCES recepcions(id),
     product_type TEXT NOT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT now()
 );
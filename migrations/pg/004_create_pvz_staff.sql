@@ -0,0 +1,8 @@
+CREATE TABLE pvz_staff (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    pvz_id UUID NOT NULL REFERENCES pvzs(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, pvz_id)
+);
+
+CREATE INDEX pvz_staff_pvz_id_idx ON pvz_staff (pvz_id);
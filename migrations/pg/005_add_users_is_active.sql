@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT true;
+
+CREATE INDEX users_email_idx ON users (lower(email) text_pattern_ops);
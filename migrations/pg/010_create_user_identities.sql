@@ -0,0 +1,12 @@
+-- Учетные записи внешнего провайдера (OIDC), связанные с пользователями.
+-- У пользователей, созданных при первом входе через провайдера, пароля нет:
+-- password_hash у них пустой, и вход по паролю для них невозможен.
+CREATE TABLE user_identities (
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (issuer, subject)
+);
+
+CREATE INDEX user_identities_user_id_idx ON user_identities (user_id);
@@ -0,0 +1,18 @@
+-- Справочник городов вместо зашитого в код перечисления.
+CREATE TABLE cities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT UNIQUE NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+INSERT INTO cities (name) VALUES ('Москва'), ('Санкт-Петербург'), ('Казань');
+
+-- Города уже открытых ПВЗ попадают в справочник, чтобы внешний ключ
+-- не отверг существующие строки.
+INSERT INTO cities (name)
+SELECT DISTINCT city FROM pvzs
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE pvzs
+    ADD CONSTRAINT pvzs_city_fkey FOREIGN KEY (city) REFERENCES cities (name);
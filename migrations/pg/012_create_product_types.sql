@@ -0,0 +1,33 @@
+-- Справочник типов товаров. products.product_type хранит код типа,
+-- название для ответов API берется из справочника.
+CREATE TABLE product_types (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code TEXT UNIQUE NOT NULL,
+    name_ru TEXT NOT NULL,
+    name_en TEXT NOT NULL,
+    requires_serial BOOLEAN NOT NULL DEFAULT false,
+    requires_age_check BOOLEAN NOT NULL DEFAULT false,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+INSERT INTO product_types (code, name_ru, name_en) VALUES
+    ('electronics', 'электроника', 'Electronics'),
+    ('clothing', 'одежда', 'Clothing'),
+    ('shoes', 'обувь', 'Shoes');
+
+-- Товары ссылались на тип по русскому названию.
+UPDATE products p
+SET product_type = t.code
+FROM product_types t
+WHERE p.product_type = t.name_ru;
+
+-- Неизвестные типы сохраняются в справочнике как есть, но новые товары
+-- этих типов не принимаются, пока модератор их не включит.
+INSERT INTO product_types (code, name_ru, name_en, active)
+SELECT DISTINCT product_type, product_type, product_type, false FROM products
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE products
+    ADD CONSTRAINT products_product_type_fkey
+    FOREIGN KEY (product_type) REFERENCES product_types (code);
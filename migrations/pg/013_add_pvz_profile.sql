@@ -0,0 +1,22 @@
+-- Профиль ПВЗ: адрес, координаты, часы работы и контакты.
+ALTER TABLE pvzs
+    ADD COLUMN address TEXT NOT NULL DEFAULT '',
+    ADD COLUMN latitude DOUBLE PRECISION,
+    ADD COLUMN longitude DOUBLE PRECISION,
+    ADD COLUMN timezone TEXT NOT NULL DEFAULT '',
+    ADD COLUMN working_hours JSONB NOT NULL DEFAULT '{"weekly": []}',
+    ADD COLUMN phone TEXT NOT NULL DEFAULT '',
+    ADD CONSTRAINT pvzs_location_check
+        CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+-- История изменений профиля, записи только добавляются.
+CREATE TABLE pvz_profile_history (
+    id UUID PRIMARY KEY,
+    pvz_id UUID NOT NULL REFERENCES pvzs(id),
+    changed_by UUID,
+    before JSONB NOT NULL,
+    after JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX pvz_profile_history_pvz_idx ON pvz_profile_history (pvz_id, created_at DESC);
@@ -0,0 +1,4 @@
+-- Поиск ближайших ПВЗ сначала отбирает кандидатов по прямоугольнику
+-- координат, поэтому достаточно обычного B-tree индекса.
+CREATE INDEX pvzs_location_idx ON pvzs (latitude, longitude)
+    WHERE latitude IS NOT NULL;
@@ -0,0 +1,9 @@
+-- Состояние ПВЗ: приемки и товары принимаются только в active.
+ALTER TABLE pvzs
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'active',
+    ADD COLUMN status_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN status_changed_at TIMESTAMP,
+    ADD CONSTRAINT pvzs_status_check
+        CHECK (status IN ('active', 'suspended', 'closed'));
+
+CREATE INDEX pvzs_status_idx ON pvzs (status);
@@ -0,0 +1,3 @@
+-- Произвольные пары ключ-значение, которые отправитель передает с товаром.
+ALTER TABLE products
+    ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,10 @@
+-- Штрихкод, номер заказа и артикул товара.
+ALTER TABLE products
+    ADD COLUMN barcode TEXT,
+    ADD COLUMN order_id TEXT,
+    ADD COLUMN sku TEXT;
+
+-- Выдачи товаров пока нет, поэтому товар находится в ПВЗ, пока его строка
+-- не удалена: один штрихкод не может быть принят дважды.
+CREATE UNIQUE INDEX products_barcode_held_idx ON products (barcode)
+    WHERE barcode IS NOT NULL;
@@ -0,0 +1,12 @@
+-- Аннулированный товар остается в таблице с причиной и автором.
+ALTER TABLE products
+    ADD COLUMN voided_at TIMESTAMP,
+    ADD COLUMN void_reason TEXT,
+    ADD COLUMN voided_by UUID;
+
+-- Аннулированный товар больше не находится в ПВЗ, и его штрихкод можно
+-- принять снова.
+DROP INDEX products_barcode_held_idx;
+
+CREATE UNIQUE INDEX products_barcode_held_idx ON products (barcode)
+    WHERE barcode IS NOT NULL AND voided_at IS NULL;
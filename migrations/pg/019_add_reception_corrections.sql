@@ -0,0 +1,16 @@
+-- Время закрытия нужно, чтобы ограничить срок исправления приемки.
+-- У приемок, закрытых до этой миграции, его нет, и исправить их нельзя.
+ALTER TABLE recepcions
+    ADD COLUMN closed_at TIMESTAMP,
+    ADD COLUMN reopened_at TIMESTAMP;
+
+-- Каждое открытие закрытой приемки модератором с обоснованием.
+CREATE TABLE reception_corrections (
+    id UUID PRIMARY KEY,
+    reception_id UUID NOT NULL REFERENCES recepcions(id),
+    reopened_by UUID,
+    justification TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX reception_corrections_reception_idx ON reception_corrections (reception_id, created_at);
@@ -0,0 +1,10 @@
+-- Причина закрытия: пустая, если приемку закрыл сотрудник,
+-- closed_by_system, если ее закрыл фоновый обработчик после простоя.
+ALTER TABLE recepcions
+    ADD COLUMN close_reason TEXT NOT NULL DEFAULT '';
+
+-- Обработчик простоя перебирает только открытые приемки.
+CREATE INDEX recepcions_in_progress_idx ON recepcions (created_at)
+    WHERE status = 'in_progress';
+
+CREATE INDEX products_reception_created_idx ON products (reception_id, created_at);
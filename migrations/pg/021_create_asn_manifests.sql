@@ -0,0 +1,29 @@
+-- Манифесты ожидаемых поставок. reception_id заполняется, когда
+-- манифест сверен с закрытой приемкой; до этого поставка ожидается.
+CREATE TABLE asn_manifests (
+    id UUID PRIMARY KEY,
+    pvz_id UUID NOT NULL REFERENCES pvzs(id),
+    reference TEXT NOT NULL DEFAULT '',
+    lines JSONB NOT NULL,
+    created_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    reception_id UUID REFERENCES recepcions(id)
+);
+
+CREATE INDEX asn_manifests_pending_idx ON asn_manifests (pvz_id, created_at)
+    WHERE reception_id IS NULL;
+
+-- Результаты сверки. После исправления приемки и повторного закрытия
+-- добавляется новая запись, актуальна последняя.
+CREATE TABLE reception_reconciliations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    reception_id UUID NOT NULL REFERENCES recepcions(id),
+    asn_id UUID NOT NULL REFERENCES asn_manifests(id),
+    shortages JSONB NOT NULL,
+    surpluses JSONB NOT NULL,
+    unexpected JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX reception_reconciliations_reception_idx
+    ON reception_reconciliations (reception_id, created_at DESC);
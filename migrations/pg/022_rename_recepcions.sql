@@ -0,0 +1,5 @@
+-- Таблица приемок создавалась с опечаткой в имени, а запросы обращаются
+-- к receptions. Внешние ключи других таблиц переименование переживают.
+ALTER TABLE recepcions RENAME TO receptions;
+ALTER INDEX recepcions_pkey RENAME TO receptions_pkey;
+ALTER INDEX recepcions_in_progress_idx RENAME TO receptions_in_progress_idx;
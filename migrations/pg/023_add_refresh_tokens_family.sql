@@ -0,0 +1,9 @@
+-- Цепочка refresh-токенов одного входа. Повторное предъявление уже
+-- обмененного токена отзывает все токены цепочки.
+ALTER TABLE refresh_tokens ADD COLUMN family_id UUID;
+
+UPDATE refresh_tokens SET family_id = id;
+
+ALTER TABLE refresh_tokens ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens (family_id);
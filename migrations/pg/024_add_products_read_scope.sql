@@ -0,0 +1,7 @@
+-- Справочник типов товаров читается по отдельному scope products:read.
+-- Ключам с products:write он выдается сразу, чтобы интеграции, которые
+-- уже читают справочник, не потеряли к нему доступ.
+UPDATE api_keys
+SET scopes = array_append(scopes, 'products:read')
+WHERE 'products:write' = ANY (scopes)
+  AND NOT 'products:read' = ANY (scopes);
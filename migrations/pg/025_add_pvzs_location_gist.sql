@@ -0,0 +1,11 @@
+-- Поиск ближайших ПВЗ идет по GiST-индексу earthdistance: оператор <->
+-- отдает строки в порядке удаления от точки (KNN), и запрос читает только
+-- ближайших кандидатов, а не все ПВЗ в прямоугольнике вокруг точки.
+-- Расширения ставятся в public, чтобы их функции были видны из любой схемы.
+CREATE EXTENSION IF NOT EXISTS cube WITH SCHEMA public;
+CREATE EXTENSION IF NOT EXISTS earthdistance WITH SCHEMA public;
+
+DROP INDEX IF EXISTS pvzs_location_idx;
+
+CREATE INDEX pvzs_location_idx ON pvzs USING gist (ll_to_earth(latitude, longitude))
+    WHERE latitude IS NOT NULL;
@@ -0,0 +1,12 @@
+-- Срок исправления считается от первого закрытия приемки: closed_at
+-- сбрасывается при каждом открытии, и по нему окно начиналось бы заново.
+ALTER TABLE receptions
+    ADD COLUMN first_closed_at TIMESTAMP;
+
+-- Первое закрытие было не позже первого исправления и текущего закрытия.
+UPDATE receptions r
+SET first_closed_at = LEAST(
+    r.closed_at,
+    (SELECT min(c.created_at) FROM reception_corrections c WHERE c.reception_id = r.id)
+)
+WHERE r.closed_at IS NOT NULL OR r.reopened_at IS NOT NULL;
@@ -0,0 +1,42 @@
+-- pvz_open_at сообщает, работает ли ПВЗ в момент at по своим часам работы
+-- (working_hours) в своем часовом поясе. Особый день заменяет расписание
+-- дня недели. Без часового пояса ПВЗ открытым не считается; сам пояс
+-- проверяется при сохранении профиля. Время в расписании хранится как ЧЧ:ММ,
+-- поэтому интервалы сравниваются как строки, Close может быть 24:00.
+CREATE FUNCTION pvz_open_at(hours JSONB, tz TEXT, at TIMESTAMPTZ) RETURNS BOOLEAN
+    LANGUAGE plpgsql STABLE AS $$
+DECLARE
+    local_at TIMESTAMP;
+    clock TEXT;
+    special JSONB;
+BEGIN
+    IF tz = '' THEN
+        RETURN false;
+    END IF;
+
+    local_at := at AT TIME ZONE tz;
+    clock := to_char(local_at, 'HH24:MI');
+
+    SELECT e INTO special
+    FROM jsonb_array_elements(COALESCE(hours -> 'exceptions', '[]')) e
+    WHERE e ->> 'date' = to_char(local_at, 'YYYY-MM-DD')
+    LIMIT 1;
+
+    IF special IS NOT NULL THEN
+        RETURN COALESCE(
+            NOT COALESCE((special ->> 'closed')::BOOLEAN, false)
+                AND special ->> 'open' <= clock
+                AND clock < special ->> 'close',
+            false
+        );
+    END IF;
+
+    RETURN EXISTS (
+        SELECT 1
+        FROM jsonb_array_elements(COALESCE(hours -> 'weekly', '[]')) d
+        WHERE d ->> 'day' = lower(to_char(local_at, 'Dy'))
+            AND d ->> 'open' <= clock
+            AND clock < d ->> 'close'
+    );
+END;
+$$;